@@ -5,270 +5,220 @@
 // Amazon SP-API Go SDK is dual-licensed:
 //
 // 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
-//    - Free for personal, educational, and open source projects
-//    - Your project must also be open sourced under AGPL-3.0
-//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
 //
 // 2. Commercial License for proprietary/commercial use
-//    - Required for any commercial, enterprise, or proprietary use
-//    - Allows closed source distribution
-//    - Contact: vanling1111@gmail.com
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
 //
 // Unless you have obtained a commercial license, this file is licensed
 // under AGPL-3.0. By using this software, you agree to comply with the
-// terms of the applicable license.
-//
-// This file is part of Amazon SP-API Go SDK.
-//
-// Amazon SP-API Go SDK is dual-licensed:
-//
-// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
-//    - Free for personal, educational, and open source projects
-//    - Your project must also be open sourced under AGPL-3.0
-//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+// terms of the applicable license. All rights reserved.
+
+// Package main reports semantic changes between two versions of the
+// Amazon SP-API OpenAPI models.
 //
-// 2. Commercial License for proprietary/commercial use
-//    - Required for any commercial, enterprise, or proprietary use
-//    - Allows closed source distribution
-//    - Contact: vanling1111@gmail.com
+// Both versions are read from a local models directory or from git
+// revisions of a selling-partner-api-models checkout, so the monitor
+// runs offline:
 //
-// Unless you have obtained a commercial license, this file is licensed
-// under AGPL-3.0. By using this software, you agree to comply with the
-// terms of the applicable license.
-
-// Package main monitors Amazon SP-API OpenAPI specifications for changes
+//	go run ./cmd/api-monitor -old old/models -new new/models
+//	go run ./cmd/api-monitor -repo selling-partner-api-models -old HEAD~10 -new HEAD \
+//	    -markdown changes.md -json changes.json
 package main
 
 import (
-	"context"
-	"crypto/sha256"
+	_ "embed"
 	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
 	"io"
-	"log"
-	"net/http"
 	"os"
-	"time"
-)
 
-const (
-	baseURL = "https://raw.githubusercontent.com/amzn/selling-partner-api-models/main/models"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/openapi"
 )
 
-// APISpec represents an API specification
-type APISpec struct {
+//go:embed api-list.json
+var defaultAPIList []byte
+
+// APIConfig represents API configuration
+type APIConfig struct {
 	Name    string `json:"name"`
 	Version string `json:"version"`
 	File    string `json:"file"`
-	Hash    string `json:"hash"`
-	Updated string `json:"updated"`
-}
-
-// Monitor monitors API specifications for changes
-type Monitor struct {
-	specs       map[string]*APISpec
-	stateFile   string
-	githubToken string
 }
 
-// NewMonitor creates a new API monitor
-func NewMonitor(stateFile, githubToken string) *Monitor {
-	return &Monitor{
-		specs:       make(map[string]*APISpec),
-		stateFile:   stateFile,
-		githubToken: githubToken,
-	}
-}
+// Compare parses every API model in both sources and collects the
+// semantic changes.
+func Compare(apis []APIConfig, old, new Source) (*Report, error) {
+	report := &Report{Old: old.String(), New: new.String()}
 
-// LoadState loads previous state from file
-func (m *Monitor) LoadState() error {
-	data, err := os.ReadFile(m.stateFile)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return nil // First run
-		}
-		return err
-	}
+	for _, api := range apis {
+		key := api.Name + "-" + api.Version
 
-	var specs []APISpec
-	if err := json.Unmarshal(data, &specs); err != nil {
-		return err
-	}
+		before, oldErr := load(old, api)
+		after, newErr := load(new, api)
 
-	for i := range specs {
-		key := specs[i].Name + "-" + specs[i].Version
-		m.specs[key] = &specs[i]
+		switch {
+		case oldErr != nil && !errors.Is(oldErr, errNotFound):
+			return nil, fmt.Errorf("%s (%s): %w", key, old, oldErr)
+		case newErr != nil && !errors.Is(newErr, errNotFound):
+			return nil, fmt.Errorf("%s (%s): %w", key, new, newErr)
+		case oldErr != nil && newErr != nil:
+			continue
+		case oldErr != nil:
+			report.add(api, []openapi.Change{{
+				Kind: openapi.APIAdded, Location: api.File, Message: "API model added",
+			}})
+		case newErr != nil:
+			report.add(api, []openapi.Change{{
+				Kind: openapi.APIRemoved, Breaking: true, Location: api.File, Message: "API model removed",
+			}})
+		default:
+			report.add(api, openapi.Diff(before, after))
+		}
 	}
 
-	return nil
+	return report, nil
 }
 
-// SaveState saves current state to file
-func (m *Monitor) SaveState() error {
-	var specs []APISpec
-	for _, spec := range m.specs {
-		specs = append(specs, *spec)
-	}
-
-	data, err := json.MarshalIndent(specs, "", "  ")
+// load reads and parses the model of api from source.
+func load(source Source, api APIConfig) (*openapi.Document, error) {
+	data, err := source.Read(api)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return openapi.Parse(data)
+}
 
-	return os.WriteFile(m.stateFile, data, 0644)
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout))
 }
 
-// FetchSpec fetches an API specification from GitHub
-func (m *Monitor) FetchSpec(ctx context.Context, apiName, version, fileName string) ([]byte, error) {
-	// Build URL
-	modelDir := apiName
-	if modelDir != "amazon-warehousing-and-distribution-model" &&
-		modelDir != "easy-ship-model" &&
-		!contains(modelDir, "-model") {
-		modelDir += "-api-model"
-	}
+// run executes the monitor and returns the process exit code:
+// 0 without changes, 1 when changes were found and 2 on errors.
+func run(args []string, stdout io.Writer) int {
+	flags := flag.NewFlagSet("api-monitor", flag.ContinueOnError)
+	oldFlag := flags.String("old", "", "old models directory, or git revision with -repo")
+	newFlag := flags.String("new", "", "new models directory, or git revision with -repo (default HEAD)")
+	repo := flags.String("repo", "", "selling-partner-api-models git checkout; -old and -new are revisions")
+	config := flags.String("config", "", "API list (defaults to the embedded api-list.json)")
+	markdown := flags.String("markdown", "", "write the Markdown report to this file (- for stdout)")
+	jsonOut := flags.String("json", "", "write the JSON report to this file (- for stdout)")
+	failOn := flags.String("fail-on", "any", "exit with status 1 on: any, breaking or none")
 
-	url := fmt.Sprintf("%s/%s/%s", baseURL, modelDir, fileName)
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *oldFlag == "" || (*repo == "" && *newFlag == "") {
+		fmt.Fprintln(os.Stderr, "api-monitor: -old and -new are required (-new defaults to HEAD with -repo)")
+		flags.PrintDefaults()
+		return 2
+	}
+	if *failOn != "any" && *failOn != "breaking" && *failOn != "none" {
+		fmt.Fprintf(os.Stderr, "api-monitor: invalid -fail-on %q\n", *failOn)
+		return 2
+	}
 
-	// Create request
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	apis, err := loadAPIList(*config)
 	if err != nil {
-		return nil, err
+		fmt.Fprintln(os.Stderr, "api-monitor:", err)
+		return 2
 	}
 
-	if m.githubToken != "" {
-		req.Header.Set("Authorization", "token "+m.githubToken)
+	old, new, err := sources(*repo, *oldFlag, *newFlag)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "api-monitor:", err)
+		return 2
 	}
 
-	// Send request
-	resp, err := http.DefaultClient.Do(req)
+	report, err := Compare(apis, old, new)
 	if err != nil {
-		return nil, err
+		fmt.Fprintln(os.Stderr, "api-monitor:", err)
+		return 2
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("HTTP %d: %s", resp.StatusCode, resp.Status)
+	if *markdown == "" && *jsonOut == "" {
+		*markdown = "-"
 	}
-
-	return io.ReadAll(resp.Body)
-}
-
-// CalculateHash calculates SHA256 hash of content
-func CalculateHash(content []byte) string {
-	hash := sha256.Sum256(content)
-	return fmt.Sprintf("%x", hash)
-}
-
-// CheckForUpdates checks all API specifications for updates
-func (m *Monitor) CheckForUpdates(ctx context.Context, apis []APIConfig) ([]Change, error) {
-	var changes []Change
-
-	for _, api := range apis {
-		key := api.Name + "-" + api.Version
-
-		// Fetch current spec
-		content, err := m.FetchSpec(ctx, api.Name, api.Version, api.File)
+	if err := writeReport(*markdown, stdout, []byte(report.Markdown())); err != nil {
+		fmt.Fprintln(os.Stderr, "api-monitor:", err)
+		return 2
+	}
+	if *jsonOut != "" {
+		data, err := json.MarshalIndent(report, "", "  ")
 		if err != nil {
-			log.Printf("Failed to fetch %s: %v", key, err)
-			continue
-		}
-
-		// Calculate hash
-		currentHash := CalculateHash(content)
-
-		// Compare with stored hash
-		if stored, exists := m.specs[key]; exists {
-			if stored.Hash != currentHash {
-				changes = append(changes, Change{
-					API:     key,
-					OldHash: stored.Hash,
-					NewHash: currentHash,
-					File:    api.File,
-				})
-			}
+			fmt.Fprintln(os.Stderr, "api-monitor:", err)
+			return 2
 		}
-
-		// Update state
-		m.specs[key] = &APISpec{
-			Name:    api.Name,
-			Version: api.Version,
-			File:    api.File,
-			Hash:    currentHash,
-			Updated: time.Now().Format(time.RFC3339),
+		if err := writeReport(*jsonOut, stdout, append(data, '\n')); err != nil {
+			fmt.Fprintln(os.Stderr, "api-monitor:", err)
+			return 2
 		}
 	}
 
-	return changes, nil
-}
-
-// Change represents a detected change
-type Change struct {
-	API     string
-	OldHash string
-	NewHash string
-	File    string
-}
-
-// APIConfig represents API configuration
-type APIConfig struct {
-	Name    string
-	Version string
-	File    string
-}
-
-func contains(s, substr string) bool {
-	return len(s) >= len(substr) && s[len(s)-len(substr):] == substr
-}
+	fmt.Fprintf(os.Stderr, "Checked %d APIs: %d breaking, %d non-breaking changes.\n",
+		len(apis), report.Breaking, report.NonBreaking)
 
-func main() {
-	ctx := context.Background()
-
-	// Get GitHub token from environment
-	githubToken := os.Getenv("GITHUB_TOKEN")
-
-	// Create monitor
-	monitor := NewMonitor("api-state.json", githubToken)
-
-	// Load previous state
-	if err := monitor.LoadState(); err != nil {
-		log.Fatalf("Failed to load state: %v", err)
+	switch {
+	case *failOn == "any" && len(report.APIs) > 0:
+		return 1
+	case *failOn == "breaking" && report.Breaking > 0:
+		return 1
 	}
+	return 0
+}
 
-	// Load API list from configuration file
-	apiListData, err := os.ReadFile("api-list.json")
-	if err != nil {
-		log.Fatalf("Failed to load api-list.json: %v", err)
+// loadAPIList reads the monitored APIs.
+func loadAPIList(path string) ([]APIConfig, error) {
+	data := defaultAPIList
+	if path != "" {
+		var err error
+		if data, err = os.ReadFile(path); err != nil {
+			return nil, fmt.Errorf("read API list: %w", err)
+		}
 	}
 
 	var apis []APIConfig
-	if err := json.Unmarshal(apiListData, &apis); err != nil {
-		log.Fatalf("Failed to parse api-list.json: %v", err)
+	if err := json.Unmarshal(data, &apis); err != nil {
+		return nil, fmt.Errorf("parse API list: %w", err)
 	}
+	return apis, nil
+}
 
-	fmt.Printf("Checking %d API specifications for updates...\n", len(apis))
+// sources creates the old and new sources from the command line.
+func sources(repo, old, new string) (Source, Source, error) {
+	if repo == "" {
+		return NewDirSource(old), NewDirSource(new), nil
+	}
 
-	// Check for updates
-	changes, err := monitor.CheckForUpdates(ctx, apis)
+	if new == "" {
+		new = "HEAD"
+	}
+	before, err := NewGitSource(repo, old)
 	if err != nil {
-		log.Fatalf("Failed to check updates: %v", err)
+		return nil, nil, err
 	}
-
-	// Save state
-	if err := monitor.SaveState(); err != nil {
-		log.Fatalf("Failed to save state: %v", err)
+	after, err := NewGitSource(repo, new)
+	if err != nil {
+		return nil, nil, err
 	}
+	return before, after, nil
+}
 
-	// Report changes
-	if len(changes) > 0 {
-		fmt.Printf("\n⚠️  Found %d API specification changes:\n\n", len(changes))
-		for _, change := range changes {
-			fmt.Printf("  - %s\n", change.API)
-			fmt.Printf("    Old: %s\n", change.OldHash[:16])
-			fmt.Printf("    New: %s\n", change.NewHash[:16])
-		}
-		os.Exit(1) // Exit with error to trigger CI notification
-	} else {
-		fmt.Println("✓ No changes detected. All APIs are up to date.")
+// writeReport writes data to path, or to stdout when path is "-".
+func writeReport(path string, stdout io.Writer, data []byte) error {
+	switch path {
+	case "":
+		return nil
+	case "-":
+		_, err := stdout.Write(data)
+		return err
+	default:
+		return os.WriteFile(path, data, 0o644)
 	}
 }
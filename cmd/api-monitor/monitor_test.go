@@ -0,0 +1,266 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	ordersV1 = `{
+  "swagger": "2.0",
+  "info": {"title": "Orders", "version": "v0"},
+  "paths": {
+    "/orders/v0/orders/{orderId}": {
+      "get": {
+        "operationId": "getOrder",
+        "parameters": [{"name": "orderId", "in": "path", "required": true, "type": "string"}],
+        "responses": {"200": {"description": "OK", "schema": {"$ref": "#/definitions/Order"}}}
+      }
+    }
+  },
+  "definitions": {
+    "Order": {"type": "object", "properties": {"AmazonOrderId": {"type": "string"}, "BuyerEmail": {"type": "string"}}}
+  }
+}`
+
+	ordersV2 = `{
+  "swagger": "2.0",
+  "info": {"title": "Orders", "version": "v0"},
+  "paths": {
+    "/orders/v0/orders/{orderId}": {
+      "get": {
+        "operationId": "getOrder",
+        "parameters": [
+          {"name": "orderId", "in": "path", "required": true, "type": "string"},
+          {"name": "locale", "in": "query", "type": "string"}
+        ],
+        "responses": {"200": {"description": "OK", "schema": {"$ref": "#/definitions/Order"}}}
+      }
+    }
+  },
+  "definitions": {
+    "Order": {"type": "object", "properties": {"AmazonOrderId": {"type": "string"}}}
+  }
+}`
+
+	feedsV1 = `{"swagger": "2.0", "info": {"title": "Feeds", "version": "2021-06-30"}, "paths": {}}`
+)
+
+var testAPIs = []APIConfig{
+	{Name: "orders", Version: "v0", File: "ordersV0.json"},
+	{Name: "feeds", Version: "v2021-06-30", File: "feeds_2021-06-30.json"},
+}
+
+// writeModels creates a models directory containing files, keyed by
+// path relative to the directory.
+func writeModels(t *testing.T, files map[string]string) string {
+	t.Helper()
+	root := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+func TestCompare_Dir(t *testing.T) {
+	old := writeModels(t, map[string]string{
+		"orders-api-model/ordersV0.json": ordersV1,
+	})
+	new := writeModels(t, map[string]string{
+		// 不在标准目录下的文件通过文件名查找
+		"orders/ordersV0.json":                  ordersV2,
+		"feeds-api-model/feeds_2021-06-30.json": feedsV1,
+	})
+
+	report, err := Compare(testAPIs, NewDirSource(old), NewDirSource(new))
+	if err != nil {
+		t.Fatalf("Compare() error = %v", err)
+	}
+
+	if report.Breaking != 1 || report.NonBreaking != 2 {
+		t.Errorf("Breaking, NonBreaking = %d, %d; want 1, 2", report.Breaking, report.NonBreaking)
+	}
+	if len(report.APIs) != 2 {
+		t.Fatalf("len(APIs) = %d, want 2", len(report.APIs))
+	}
+
+	orders := report.APIs[0]
+	if orders.API != "orders-v0" || len(orders.Changes) != 2 {
+		t.Fatalf("APIs[0] = %+v", orders)
+	}
+	if c := orders.Changes[0]; c.Kind != "response-field-removed" || !c.Breaking {
+		t.Errorf("Changes[0] = %+v, want breaking response-field-removed", c)
+	}
+	if c := orders.Changes[1]; c.Kind != "parameter-added" || c.Breaking {
+		t.Errorf("Changes[1] = %+v, want non-breaking parameter-added", c)
+	}
+
+	if c := report.APIs[1].Changes[0]; report.APIs[1].API != "feeds-v2021-06-30" || c.Kind != "api-added" {
+		t.Errorf("APIs[1] = %+v, want api-added", report.APIs[1])
+	}
+}
+
+func TestCompare_ParseError(t *testing.T) {
+	old := writeModels(t, map[string]string{"orders-api-model/ordersV0.json": ordersV1})
+	new := writeModels(t, map[string]string{"orders-api-model/ordersV0.json": "{"})
+
+	if _, err := Compare(testAPIs, NewDirSource(old), NewDirSource(new)); err == nil {
+		t.Fatal("Compare() expected error for invalid model")
+	}
+}
+
+func TestRun(t *testing.T) {
+	old := writeModels(t, map[string]string{"orders-api-model/ordersV0.json": ordersV1})
+	new := writeModels(t, map[string]string{"orders-api-model/ordersV0.json": ordersV2})
+	same := writeModels(t, map[string]string{"orders-api-model/ordersV0.json": ordersV1})
+
+	config := filepath.Join(t.TempDir(), "apis.json")
+	data, _ := json.Marshal(testAPIs)
+	if err := os.WriteFile(config, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		args []string
+		code int
+	}{
+		{"changes", []string{"-old", old, "-new", new}, 1},
+		{"no changes", []string{"-old", old, "-new", same}, 0},
+		{"fail on none", []string{"-old", old, "-new", new, "-fail-on", "none"}, 0},
+		{"fail on breaking", []string{"-old", old, "-new", new, "-fail-on", "breaking"}, 1},
+		{"missing new", []string{"-old", old}, 2},
+		{"invalid fail-on", []string{"-old", old, "-new", new, "-fail-on", "sometimes"}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := append([]string{"-config", config}, tt.args...)
+			if code := run(args, &bytes.Buffer{}); code != tt.code {
+				t.Errorf("run() = %d, want %d", code, tt.code)
+			}
+		})
+	}
+}
+
+func TestRun_Reports(t *testing.T) {
+	old := writeModels(t, map[string]string{"orders-api-model/ordersV0.json": ordersV1})
+	new := writeModels(t, map[string]string{"orders-api-model/ordersV0.json": ordersV2})
+
+	out := t.TempDir()
+	markdown := filepath.Join(out, "changes.md")
+	var stdout bytes.Buffer
+
+	code := run([]string{"-old", old, "-new", new, "-markdown", markdown, "-json", "-"}, &stdout)
+	if code != 1 {
+		t.Fatalf("run() = %d, want 1", code)
+	}
+
+	var report Report
+	if err := json.Unmarshal(stdout.Bytes(), &report); err != nil {
+		t.Fatalf("stdout is not a JSON report: %v\n%s", err, stdout.String())
+	}
+	if report.Breaking != 1 || report.NonBreaking != 1 || report.APIs[0].API != "orders-v0" {
+		t.Errorf("report = %+v", report)
+	}
+
+	data, err := os.ReadFile(markdown)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"# SP-API Model Changes",
+		"**1 breaking, 1 non-breaking changes in 1 APIs.**",
+		"## orders-v0",
+		"### Breaking",
+		"- **response-field-removed** `Order`: response field BuyerEmail removed",
+		"### Non-breaking",
+		"- **parameter-added** `GET /orders/v0/orders/{orderId}` (getOrder): optional query parameter locale added",
+	} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("Markdown report missing %q:\n%s", want, data)
+		}
+	}
+}
+
+func TestGitSource(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	repo := t.TempDir()
+	gitRun := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", repo, "-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	commit := func(content, message string) {
+		t.Helper()
+		dir := filepath.Join(repo, "models", "orders-api-model")
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "ordersV0.json"), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		gitRun("add", "-A")
+		gitRun("commit", "-q", "-m", message)
+	}
+
+	gitRun("init", "-q")
+	commit(ordersV1, "v1")
+	commit(ordersV2, "v2")
+
+	old, new, err := sources(repo, "HEAD~1", "")
+	if err != nil {
+		t.Fatalf("sources() error = %v", err)
+	}
+	if !strings.HasPrefix(new.String(), "HEAD (") {
+		t.Errorf("String() = %q, want revision and commit", new.String())
+	}
+
+	report, err := Compare(testAPIs, old, new)
+	if err != nil {
+		t.Fatalf("Compare() error = %v", err)
+	}
+	if report.Breaking != 1 || report.NonBreaking != 1 {
+		t.Errorf("Breaking, NonBreaking = %d, %d; want 1, 1", report.Breaking, report.NonBreaking)
+	}
+
+	if _, err := NewGitSource(repo, "no-such-revision"); err == nil {
+		t.Error("NewGitSource() expected error for unknown revision")
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/openapi"
+)
+
+// Report is the result of comparing two versions of the API models.
+type Report struct {
+	Old         string       `json:"old"`
+	New         string       `json:"new"`
+	Breaking    int          `json:"breaking"`
+	NonBreaking int          `json:"nonBreaking"`
+	APIs        []APIChanges `json:"apis"`
+}
+
+// APIChanges lists the semantic changes of one API.
+type APIChanges struct {
+	API     string           `json:"api"`
+	File    string           `json:"file"`
+	Changes []openapi.Change `json:"changes"`
+}
+
+// add appends the changes of one API and updates the totals.
+func (r *Report) add(api APIConfig, changes []openapi.Change) {
+	if len(changes) == 0 {
+		return
+	}
+
+	for _, change := range changes {
+		if change.Breaking {
+			r.Breaking++
+		} else {
+			r.NonBreaking++
+		}
+	}
+
+	r.APIs = append(r.APIs, APIChanges{
+		API:     api.Name + "-" + api.Version,
+		File:    api.File,
+		Changes: changes,
+	})
+}
+
+// Markdown renders the report for release notes and issues.
+func (r *Report) Markdown() string {
+	var b strings.Builder
+
+	b.WriteString("# SP-API Model Changes\n\n")
+	fmt.Fprintf(&b, "Compared `%s` → `%s`.\n\n", r.Old, r.New)
+
+	if len(r.APIs) == 0 {
+		b.WriteString("No semantic changes.\n")
+		return b.String()
+	}
+
+	fmt.Fprintf(&b, "**%d breaking, %d non-breaking changes in %d APIs.**\n", r.Breaking, r.NonBreaking, len(r.APIs))
+
+	for _, api := range r.APIs {
+		fmt.Fprintf(&b, "\n## %s\n", api.API)
+
+		for _, section := range []struct {
+			title    string
+			breaking bool
+		}{
+			{"Breaking", true},
+			{"Non-breaking", false},
+		} {
+			var lines []string
+			for _, change := range api.Changes {
+				if change.Breaking == section.breaking {
+					lines = append(lines, markdownLine(change))
+				}
+			}
+			if len(lines) == 0 {
+				continue
+			}
+
+			fmt.Fprintf(&b, "\n### %s\n\n", section.title)
+			for _, line := range lines {
+				b.WriteString(line)
+			}
+		}
+	}
+
+	return b.String()
+}
+
+// markdownLine renders one change as a list item.
+func markdownLine(change openapi.Change) string {
+	location := "`" + change.Location + "`"
+	if change.Operation != "" {
+		location += " (" + change.Operation + ")"
+	}
+	return fmt.Sprintf("- **%s** %s: %s\n", change.Kind, location, change.Message)
+}
@@ -0,0 +1,180 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/openapi"
+)
+
+// modelsPrefix is the models directory inside selling-partner-api-models.
+const modelsPrefix = "models"
+
+// errNotFound reports that a model file does not exist in a source.
+var errNotFound = errors.New("model file not found")
+
+// Source reads API model files from one version of the models.
+type Source interface {
+	// Read returns the content of the model file of api, or an error
+	// wrapping errNotFound when the source does not contain it.
+	Read(api APIConfig) ([]byte, error)
+
+	// String describes the source in reports.
+	String() string
+}
+
+// DirSource reads models from a local models directory.
+type DirSource struct {
+	root string
+}
+
+// NewDirSource creates a source for a models directory, e.g.
+// selling-partner-api-models/models.
+func NewDirSource(root string) *DirSource {
+	return &DirSource{root: root}
+}
+
+// Read implements Source.
+//
+// Models are looked up in "<name>-api-model/<file>" first; when that
+// does not exist the directory is searched for the file name.
+func (s *DirSource) Read(api APIConfig) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(s.root, openapi.ModelDir(api.Name), api.File))
+	if err == nil || !errors.Is(err, fs.ErrNotExist) {
+		return data, err
+	}
+
+	var found string
+	err = filepath.WalkDir(s.root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && d.Name() == api.File {
+			found = p
+			return filepath.SkipAll
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if found == "" {
+		return nil, fmt.Errorf("%s: %w", api.File, errNotFound)
+	}
+	return os.ReadFile(found)
+}
+
+// String implements Source.
+func (s *DirSource) String() string {
+	return s.root
+}
+
+// GitSource reads models from a revision of a selling-partner-api-models
+// checkout, so two versions can be compared without network access.
+type GitSource struct {
+	repo   string
+	rev    string
+	commit string
+	files  map[string]string
+}
+
+// NewGitSource creates a source for revision rev of the repository at repo.
+func NewGitSource(repo, rev string) (*GitSource, error) {
+	out, err := git(repo, "rev-parse", "--verify", rev+"^{commit}")
+	if err != nil {
+		return nil, fmt.Errorf("resolve revision %s: %w", rev, err)
+	}
+	return &GitSource{repo: repo, rev: rev, commit: strings.TrimSpace(string(out))}, nil
+}
+
+// Read implements Source.
+func (s *GitSource) Read(api APIConfig) ([]byte, error) {
+	name := path.Join(modelsPrefix, openapi.ModelDir(api.Name), api.File)
+
+	if err := s.index(); err != nil {
+		return nil, err
+	}
+	if _, ok := s.files[name]; !ok {
+		var found bool
+		if name, found = s.files[api.File]; !found {
+			return nil, fmt.Errorf("%s: %w", api.File, errNotFound)
+		}
+	}
+
+	return git(s.repo, "show", s.commit+":"+name)
+}
+
+// index lists the model files of the revision once.
+//
+// files maps full paths to themselves and base names to the first full
+// path with that name.
+func (s *GitSource) index() error {
+	if s.files != nil {
+		return nil
+	}
+
+	out, err := git(s.repo, "ls-tree", "-r", "--name-only", s.commit, modelsPrefix)
+	if err != nil {
+		return fmt.Errorf("list models at %s: %w", s.rev, err)
+	}
+
+	s.files = make(map[string]string)
+	for _, line := range strings.Split(string(out), "\n") {
+		if line == "" {
+			continue
+		}
+		s.files[line] = line
+		if _, ok := s.files[path.Base(line)]; !ok {
+			s.files[path.Base(line)] = line
+		}
+	}
+	return nil
+}
+
+// String implements Source.
+func (s *GitSource) String() string {
+	if strings.HasPrefix(s.commit, s.rev) {
+		return s.commit[:12]
+	}
+	return s.rev + " (" + s.commit[:12] + ")"
+}
+
+// git runs a git command in repo and returns its standard output.
+func git(repo string, args ...string) ([]byte, error) {
+	var stderr bytes.Buffer
+	cmd := exec.Command("git", append([]string{"-C", repo}, args...)...)
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git %s: %w: %s", strings.Join(args, " "), err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
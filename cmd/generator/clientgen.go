@@ -0,0 +1,204 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/openapi"
+)
+
+// spapiImport is the import path of the core SDK package.
+const spapiImport = "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+
+// licenseHeader is the comment at the top of generated Go files in the
+// API packages.
+const licenseHeader = `// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+`
+
+var pathParamPattern = regexp.MustCompile(`\{([^}]+)\}`)
+
+// clientMethod is one operation rendered as a Client method.
+type clientMethod struct {
+	name       string
+	method     string
+	path       string
+	summary    string
+	pathParams []string
+}
+
+// clientMethods returns the operations of doc in output order, keeping
+// the first operation of a duplicated operationId.
+func clientMethods(doc *openapi.Document) []clientMethod {
+	var methods []clientMethod
+	seen := make(map[string]bool)
+
+	for _, op := range doc.Operations {
+		if op.ID == "" || seen[op.ID] {
+			continue
+		}
+		seen[op.ID] = true
+
+		var params []string
+		for _, match := range pathParamPattern.FindAllStringSubmatch(op.Path, -1) {
+			params = append(params, match[1])
+		}
+
+		methods = append(methods, clientMethod{
+			name:       camelize(op.ID),
+			method:     op.Method,
+			path:       op.Path,
+			summary:    oneLine(op.Summary),
+			pathParams: params,
+		})
+	}
+
+	sort.SliceStable(methods, func(i, j int) bool {
+		return methods[i].name < methods[j].name
+	})
+	return methods
+}
+
+// generateClient renders client.go.
+//
+// Every operation becomes one method returning the decoded JSON
+// response. GET operations take a query map; POST, PUT and PATCH take a
+// request body; DELETE takes only its path parameters.
+func generateClient(api APIConfig, doc *openapi.Document, out *Output) error {
+	methods := clientMethods(doc)
+
+	needStrings := false
+	for _, m := range methods {
+		if len(m.pathParams) > 0 {
+			needStrings = true
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString(licenseHeader)
+	fmt.Fprintf(&b, "package %s\n\n", api.Package())
+	b.WriteString("import (\n\t\"context\"\n\t\"fmt\"\n")
+	if needStrings {
+		b.WriteString("\t\"strings\"\n")
+	}
+	fmt.Fprintf(&b, "\n\t%q\n)\n\n", spapiImport)
+
+	fmt.Fprintf(&b, "// Client %s API %s\n", api.Name, api.Version)
+	b.WriteString("type Client struct {\n\tbaseClient *spapi.Client\n}\n\n")
+	b.WriteString("// NewClient creates API client\n")
+	b.WriteString("func NewClient(baseClient *spapi.Client) *Client {\n\treturn &Client{baseClient: baseClient}\n}\n")
+
+	for _, m := range methods {
+		args := ""
+		for _, param := range m.pathParams {
+			args += ", " + paramName(param) + " string"
+		}
+		switch m.method {
+		case "GET":
+			args += ", query map[string]string"
+		case "POST", "PUT", "PATCH":
+			args += ", body interface{}"
+		}
+
+		b.WriteString("\n")
+		fmt.Fprintf(&b, "// %s\n", strings.TrimSpace(m.name+" "+m.summary))
+		fmt.Fprintf(&b, "// Method: %s | Path: %s\n", m.method, m.path)
+		fmt.Fprintf(&b, "func (c *Client) %s(ctx context.Context%s) (interface{}, error) {\n", m.name, args)
+		fmt.Fprintf(&b, "\tpath := %q\n", m.path)
+		for _, param := range m.pathParams {
+			fmt.Fprintf(&b, "\tpath = strings.Replace(path, %q, %s, 1)\n", "{"+param+"}", paramName(param))
+		}
+		b.WriteString("\tvar result interface{}\n")
+
+		switch m.method {
+		case "GET":
+			b.WriteString("\terr := c.baseClient.Get(ctx, path, query, &result)\n")
+		case "POST":
+			b.WriteString("\terr := c.baseClient.Post(ctx, path, body, &result)\n")
+		case "PUT":
+			b.WriteString("\terr := c.baseClient.Put(ctx, path, body, &result)\n")
+		case "DELETE":
+			b.WriteString("\terr := c.baseClient.Delete(ctx, path, &result)\n")
+		default:
+			fmt.Fprintf(&b, "\terr := c.baseClient.DoRequest(ctx, %q, path, nil, body, &result)\n", m.method)
+		}
+
+		b.WriteString("\tif err != nil {\n")
+		fmt.Fprintf(&b, "\t\treturn nil, fmt.Errorf(\"%s: %%w\", err)\n", m.name)
+		b.WriteString("\t}\n\treturn result, nil\n}\n")
+	}
+
+	return out.Add(api.Dir()+"/client.go", []byte(b.String()))
+}
+
+// generateTests renders client_test.go.
+func generateTests(api APIConfig, doc *openapi.Document, out *Output) error {
+	var b strings.Builder
+	b.WriteString("// Copyright 2025 Amazon SP-API Go SDK Authors.\n")
+	fmt.Fprintf(&b, "package %s_test\n\n", api.Package())
+	fmt.Fprintf(&b, "import (\n\t\"context\"\n\t%q\n\tapi %q\n\t\"testing\"\n)\n\n", spapiImport, spapiImport+"/"+api.Dir())
+
+	b.WriteString(`func TestNewClient(t *testing.T) {
+	baseClient, err := spapi.NewClient(
+		spapi.WithRegion(spapi.RegionNA),
+		spapi.WithCredentials("test", "test", "test"),
+	)
+	if err != nil {
+		t.Fatalf("create base client: %v", err)
+	}
+	defer baseClient.Close(context.Background())
+
+	client := api.NewClient(baseClient)
+	if client == nil {
+		t.Error("NewClient returned nil")
+	}
+}
+
+func TestMethodCount(t *testing.T) {
+	// Verify API has expected number of methods
+`)
+	fmt.Fprintf(&b, "\texpected := %d\n", len(clientMethods(doc)))
+	b.WriteString("\tt.Logf(\"API has %d methods\", expected)\n}\n")
+
+	return out.Add(api.Dir()+"/client_test.go", []byte(b.String()))
+}
@@ -0,0 +1,242 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package main
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/openapi"
+)
+
+//go:embed apis.json
+var defaultConfig []byte
+
+// Config lists the APIs to generate.
+type Config struct {
+	APIs []APIConfig `json:"apis"`
+}
+
+// APIConfig describes one versioned API.
+type APIConfig struct {
+	// Name is the API name, e.g. "catalog-items"
+	Name string `json:"name"`
+
+	// Version is the API version, e.g. "v2022-04-01"
+	Version string `json:"version"`
+
+	// File is the model file name inside the models repository
+	File string `json:"file"`
+
+	// ModelDir overrides the model directory inside the models repository
+	ModelDir string `json:"modelDir,omitempty"`
+
+	// Field overrides the name of the API's field in the sdk package,
+	// e.g. "Catalog"; defaults to the camel-cased name. Versions sharing
+	// a field name are told apart by a version suffix.
+	Field string `json:"field,omitempty"`
+
+	// Grantless lists the Client methods called with a grantless
+	// (client_credentials) access token, e.g. "GetDestinations"
+	Grantless []string `json:"grantless,omitempty"`
+
+	// Iterators lists the paginated operations that get an Iterate* method.
+	// APIs without iterators keep their hand-written iterator.go (if any).
+	Iterators []IteratorConfig `json:"iterators,omitempty"`
+}
+
+// IteratorConfig describes how to page through one operation.
+type IteratorConfig struct {
+	// Name is the iterator method name; defaults to "Iterate" + Method
+	// without its Get/List/Search prefix
+	Name string `json:"name,omitempty"`
+
+	// Method is the client method that returns one page
+	Method string `json:"method"`
+
+	// Description names the yielded items in the doc comment
+	Description string `json:"description"`
+
+	// PathParams lists the path parameters of Method, in order
+	PathParams []PathParam `json:"pathParams,omitempty"`
+
+	// Items is the dotted path to the item array in the response
+	Items string `json:"items"`
+
+	// Token is the dotted path to the next page token in the response
+	Token string `json:"token"`
+
+	// TokenParam is the query parameter carrying the token; defaults to
+	// the last element of Token
+	TokenParam string `json:"tokenParam,omitempty"`
+
+	// Page yields the object at Items once per page instead of the
+	// elements of an array
+	Page bool `json:"page,omitempty"`
+}
+
+// PathParam is a path parameter passed through an iterator.
+type PathParam struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// LoadConfig reads the API list from path, or the embedded apis.json
+// when path is empty.
+func LoadConfig(path string) (*Config, error) {
+	data := defaultConfig
+	if path != "" {
+		var err error
+		if data, err = os.ReadFile(path); err != nil {
+			return nil, fmt.Errorf("read config: %w", err)
+		}
+	}
+
+	var config Config
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("parse config: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	for _, api := range config.APIs {
+		if api.Name == "" || api.Version == "" || api.File == "" {
+			return nil, fmt.Errorf("config: api %q %q: name, version and file are required", api.Name, api.Version)
+		}
+		if seen[api.Dir()] {
+			return nil, fmt.Errorf("config: duplicate api %s", api.Dir())
+		}
+		seen[api.Dir()] = true
+
+		for _, it := range api.Iterators {
+			if it.Method == "" || it.Items == "" || it.Token == "" {
+				return nil, fmt.Errorf("config: %s: iterator needs method, items and token", api.Dir())
+			}
+		}
+	}
+
+	return &config, nil
+}
+
+// Filter returns the APIs whose directory name is in only (all when empty).
+func (c *Config) Filter(only []string) ([]APIConfig, error) {
+	if len(only) == 0 {
+		return c.APIs, nil
+	}
+
+	byDir := make(map[string]APIConfig, len(c.APIs))
+	for _, api := range c.APIs {
+		byDir[api.Dir()] = api
+	}
+
+	apis := make([]APIConfig, 0, len(only))
+	for _, dir := range only {
+		api, ok := byDir[dir]
+		if !ok {
+			return nil, fmt.Errorf("unknown api %q", dir)
+		}
+		apis = append(apis, api)
+	}
+	return apis, nil
+}
+
+// Dir returns the package directory name, e.g. "orders-v0".
+func (a APIConfig) Dir() string {
+	return a.Name + "-" + a.Version
+}
+
+// Package returns the Go package name, e.g. "orders_v0".
+func (a APIConfig) Package() string {
+	return strings.NewReplacer("-", "_", ".", "_").Replace(a.Dir())
+}
+
+// FieldName returns the base name of the API's field in the sdk
+// package, e.g. "Orders" or "Catalog".
+func (a APIConfig) FieldName() string {
+	if a.Field != "" {
+		return a.Field
+	}
+	return camelize(strings.TrimSuffix(a.Name, "-model"))
+}
+
+// SpecPath returns the model file path inside the models directory.
+//
+// Models live in "<name>-api-model/<file>" except for APIs whose name
+// already ends with "-model". When that path does not exist the models
+// directory is searched for the file name, since a few APIs (e.g. the
+// transfers API) are filed under a different directory upstream.
+func (a APIConfig) SpecPath(models string) (string, error) {
+	dir := a.ModelDir
+	if dir == "" {
+		dir = openapi.ModelDir(a.Name)
+	}
+
+	path := filepath.Join(models, dir, a.File)
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	}
+
+	var found string
+	err := filepath.WalkDir(models, func(p string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && d.Name() == a.File {
+			found = p
+			return filepath.SkipAll
+		}
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("search models: %w", err)
+	}
+	if found == "" {
+		return "", fmt.Errorf("%s: model file %s not found under %s", a.Dir(), a.File, models)
+	}
+	return found, nil
+}
+
+// IteratorName returns the iterator method name.
+func (it IteratorConfig) IteratorName() string {
+	if it.Name != "" {
+		return it.Name
+	}
+
+	name := it.Method
+	for _, prefix := range []string{"Get", "List", "Search"} {
+		if rest, ok := strings.CutPrefix(name, prefix); ok && rest != "" {
+			name = rest
+			break
+		}
+	}
+	return "Iterate" + name
+}
+
+// QueryParam returns the query parameter that carries the page token.
+func (it IteratorConfig) QueryParam() string {
+	if it.TokenParam != "" {
+		return it.TokenParam
+	}
+	return it.Token[strings.LastIndex(it.Token, ".")+1:]
+}
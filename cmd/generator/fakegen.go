@@ -0,0 +1,433 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// fakeRecorderMethods are promoted from the embedded spapi.FakeRecorder
+// and must not be shadowed by API methods.
+var fakeRecorderMethods = map[string]bool{
+	"Record": true, "Calls": true, "CallsTo": true, "ResetCalls": true,
+}
+
+var majorVersionPattern = regexp.MustCompile(`^v[0-9]+$`)
+
+// apiMethod is one exported Client method mirrored by API and Fake.
+type apiMethod struct {
+	name    string
+	doc     []string
+	params  []apiParam
+	results []string
+
+	// seqItem is the element type when the method returns
+	// iter.Seq2[T, error].
+	seqItem string
+
+	// calls lists the Client methods called from the method body.
+	calls []string
+}
+
+// apiParam is one parameter of an apiMethod.
+type apiParam struct {
+	name string
+	typ  string
+}
+
+// signature renders the parameter and result lists of m.
+func (m apiMethod) signature() string {
+	params := make([]string, len(m.params))
+	for i, p := range m.params {
+		params[i] = p.name + " " + p.typ
+	}
+
+	results := strings.Join(m.results, ", ")
+	if len(m.results) > 1 {
+		results = "(" + results + ")"
+	}
+	return "(" + strings.Join(params, ", ") + ") " + results
+}
+
+// args renders the arguments that forward the parameters of m.
+func (m apiMethod) args() string {
+	args := make([]string, len(m.params))
+	for i, p := range m.params {
+		args[i] = p.name
+		if strings.HasPrefix(p.typ, "...") {
+			args[i] += "..."
+		}
+	}
+	return strings.Join(args, ", ")
+}
+
+// recorded renders the arguments recorded by the Fake: every parameter
+// except the context.
+func (m apiMethod) recorded() string {
+	var args []string
+	for _, p := range m.params {
+		if p.typ != "context.Context" {
+			args = append(args, p.name)
+		}
+	}
+	return strings.Join(args, ", ")
+}
+
+// notConfigured renders the return statement of an unprogrammed method.
+func (m apiMethod) notConfigured() string {
+	err := fmt.Sprintf("fmt.Errorf(%q, spapi.ErrFakeNotConfigured)", m.name+": %w")
+	if m.seqItem != "" {
+		return fmt.Sprintf("return spapi.FakeSeq[%s](nil, %s)", m.seqItem, err)
+	}
+
+	values := make([]string, len(m.results))
+	for i, typ := range m.results[:len(m.results)-1] {
+		values[i] = zeroValue(typ)
+	}
+	values[len(values)-1] = err
+	return "return " + strings.Join(values, ", ")
+}
+
+// zeroValue returns the zero value literal of the Go type typ.
+func zeroValue(typ string) string {
+	switch {
+	case typ == "bool":
+		return "false"
+	case typ == "string":
+		return `""`
+	case typ == "interface{}" || typ == "any" || typ == "error",
+		strings.HasPrefix(typ, "*"), strings.HasPrefix(typ, "[]"),
+		strings.HasPrefix(typ, "map["), strings.HasPrefix(typ, "func("),
+		strings.HasPrefix(typ, "chan "):
+		return "nil"
+	case strings.HasPrefix(typ, "int"), strings.HasPrefix(typ, "uint"),
+		strings.HasPrefix(typ, "float"):
+		return "0"
+	default:
+		return "*new(" + typ + ")"
+	}
+}
+
+// clientAPI extracts the exported *Client methods from the package
+// sources together with the import paths their signatures use.
+//
+// Methods are returned sorted by name so the output does not depend on
+// which file declares them.
+func clientAPI(dir string, sources map[string][]byte) ([]apiMethod, []string, error) {
+	names := make([]string, 0, len(sources))
+	for name := range sources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var methods []apiMethod
+	imports := make(map[string]bool)
+	fset := token.NewFileSet()
+
+	for _, name := range names {
+		file, err := parser.ParseFile(fset, name, sources[name], parser.ParseComments)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", dir, err)
+		}
+
+		fileImports := make(map[string]string)
+		for _, spec := range file.Imports {
+			importPath, err := strconv.Unquote(spec.Path.Value)
+			if err != nil {
+				return nil, nil, fmt.Errorf("%s/%s: %w", dir, name, err)
+			}
+			fileImports[importName(spec, importPath)] = importPath
+		}
+
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || !isClientMethod(fn) {
+				continue
+			}
+
+			m, err := newAPIMethod(fset, fn)
+			if err != nil {
+				return nil, nil, fmt.Errorf("%s/%s: %w", dir, name, err)
+			}
+			m.calls = receiverCalls(fn)
+			methods = append(methods, m)
+
+			ast.Inspect(fn.Type, func(n ast.Node) bool {
+				if sel, ok := n.(*ast.SelectorExpr); ok {
+					if ident, ok := sel.X.(*ast.Ident); ok && fileImports[ident.Name] != "" {
+						imports[fileImports[ident.Name]] = true
+					}
+				}
+				return true
+			})
+		}
+	}
+
+	sort.Slice(methods, func(i, j int) bool {
+		return methods[i].name < methods[j].name
+	})
+
+	paths := make([]string, 0, len(imports))
+	for importPath := range imports {
+		paths = append(paths, importPath)
+	}
+	sort.Strings(paths)
+
+	return methods, paths, nil
+}
+
+// importName returns the name a file refers to an import by.
+func importName(spec *ast.ImportSpec, importPath string) string {
+	if spec.Name != nil {
+		return spec.Name.Name
+	}
+	name := path.Base(importPath)
+	if majorVersionPattern.MatchString(name) {
+		name = path.Base(path.Dir(importPath))
+	}
+	return name
+}
+
+// isClientMethod reports whether fn is an exported method on *Client.
+func isClientMethod(fn *ast.FuncDecl) bool {
+	if fn.Recv == nil || len(fn.Recv.List) != 1 || !fn.Name.IsExported() {
+		return false
+	}
+	star, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := star.X.(*ast.Ident)
+	return ok && ident.Name == "Client"
+}
+
+// receiverCalls returns the methods fn calls on its own receiver.
+func receiverCalls(fn *ast.FuncDecl) []string {
+	names := fn.Recv.List[0].Names
+	if len(names) == 0 || fn.Body == nil {
+		return nil
+	}
+	recv := names[0].Name
+
+	var calls []string
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok && ident.Name == recv && !slices.Contains(calls, sel.Sel.Name) {
+				calls = append(calls, sel.Sel.Name)
+			}
+		}
+		return true
+	})
+	return calls
+}
+
+// newAPIMethod converts a *Client method declaration.
+func newAPIMethod(fset *token.FileSet, fn *ast.FuncDecl) (apiMethod, error) {
+	m := apiMethod{name: fn.Name.Name}
+	if fakeRecorderMethods[m.name] {
+		return m, fmt.Errorf("%s: name collides with spapi.FakeRecorder", m.name)
+	}
+
+	if fn.Doc != nil {
+		paragraph, _, _ := strings.Cut(strings.TrimSpace(fn.Doc.Text()), "\n\n")
+		m.doc = strings.Split(paragraph, "\n")
+	}
+
+	for i, field := range fn.Type.Params.List {
+		typ := render(fset, field.Type)
+		if len(field.Names) == 0 {
+			m.params = append(m.params, apiParam{name: fmt.Sprintf("arg%d", i), typ: typ})
+			continue
+		}
+		for _, name := range field.Names {
+			if name.Name == "f" || name.Name == "_" {
+				return m, fmt.Errorf("%s: parameter name %q is reserved", m.name, name.Name)
+			}
+			m.params = append(m.params, apiParam{name: name.Name, typ: typ})
+		}
+	}
+
+	if fn.Type.Results == nil {
+		return m, fmt.Errorf("%s: methods without results are not supported", m.name)
+	}
+	for _, field := range fn.Type.Results.List {
+		typ := render(fset, field.Type)
+		for range max(len(field.Names), 1) {
+			m.results = append(m.results, typ)
+		}
+	}
+
+	switch last := m.results[len(m.results)-1]; {
+	case len(m.results) == 1 && strings.HasPrefix(last, "iter.Seq2[") && strings.HasSuffix(last, ", error]"):
+		m.seqItem = strings.TrimSuffix(strings.TrimPrefix(last, "iter.Seq2["), ", error]")
+	case last != "error":
+		return m, fmt.Errorf("%s: the last result must be error or the method must return iter.Seq2[T, error]", m.name)
+	}
+
+	return m, nil
+}
+
+// render prints the Go source of expr.
+func render(fset *token.FileSet, expr ast.Expr) string {
+	var buf bytes.Buffer
+	printer.Fprint(&buf, fset, expr)
+	return buf.String()
+}
+
+// generateInterfaces renders api.go and fake.go.
+//
+// The API interface and its Fake mirror every exported *Client method
+// found in the package, including hand-written helpers such as pollers,
+// so they are derived from the Go sources rather than the model. Files
+// generated earlier in the same run are used in place of the files on
+// disk.
+func generateInterfaces(api APIConfig, out *Output) error {
+	sources, err := out.Sources(api.Dir(), "api.go", "fake.go")
+	if err != nil {
+		return err
+	}
+	methods, imports, err := clientAPI(api.Dir(), sources)
+	if err != nil {
+		return err
+	}
+
+	if err := out.Add(api.Dir()+"/api.go", renderAPI(api, methods, imports)); err != nil {
+		return err
+	}
+
+	fakeImports := append([]string{spapiImport}, imports...)
+	if len(methods) > 0 {
+		fakeImports = append(fakeImports, "fmt")
+	}
+	return out.Add(api.Dir()+"/fake.go", renderFake(api, methods, fakeImports))
+}
+
+// renderAPI renders the API interface.
+func renderAPI(api APIConfig, methods []apiMethod, imports []string) []byte {
+	var b strings.Builder
+	b.WriteString(licenseHeader)
+	fmt.Fprintf(&b, "package %s\n\n", api.Package())
+	writeImports(&b, imports)
+
+	fmt.Fprintf(&b, "// API 是 %s API %s 客户端的全部方法，包括分页迭代器等辅助方法。\n", api.Name, api.Version)
+	b.WriteString("//\n// 业务代码依赖 API 而不是 *Client，即可在单元测试中用 Fake 替换真实客户端。\n")
+	b.WriteString("type API interface {\n")
+	for i, m := range methods {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		for _, line := range m.doc {
+			fmt.Fprintf(&b, "\t// %s\n", line)
+		}
+		fmt.Fprintf(&b, "\t%s%s\n", m.name, m.signature())
+	}
+	b.WriteString("}\n\n")
+
+	b.WriteString("// 确保 Client 实现了 API。\n")
+	b.WriteString("var _ API = (*Client)(nil)\n")
+	return []byte(b.String())
+}
+
+// renderFake renders the in-memory Fake implementation of API.
+func renderFake(api APIConfig, methods []apiMethod, imports []string) []byte {
+	var b strings.Builder
+	b.WriteString(licenseHeader)
+	fmt.Fprintf(&b, "package %s\n\n", api.Package())
+	writeImports(&b, imports)
+
+	b.WriteString("// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。\n")
+	b.WriteString("//\n// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回\n")
+	b.WriteString("// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，\n")
+	b.WriteString("// 可以通过 Calls 和 CallsTo 查看。\n")
+	b.WriteString("type Fake struct {\n\tspapi.FakeRecorder\n")
+	for _, m := range methods {
+		fmt.Fprintf(&b, "\n\t// %sFunc 实现 %s。\n", m.name, m.name)
+		fmt.Fprintf(&b, "\t%sFunc func%s\n", m.name, m.signature())
+	}
+	b.WriteString("}\n\n")
+
+	b.WriteString("// NewFake 创建未编程任何响应的 Fake。\n")
+	b.WriteString("func NewFake() *Fake {\n\treturn &Fake{}\n}\n")
+
+	for _, m := range methods {
+		recorded := strconv.Quote(m.name)
+		if args := m.recorded(); args != "" {
+			recorded += ", " + args
+		}
+
+		fmt.Fprintf(&b, "\n// %s 记录调用并执行 %sFunc。\n", m.name, m.name)
+		fmt.Fprintf(&b, "func (f *Fake) %s%s {\n", m.name, m.signature())
+		fmt.Fprintf(&b, "\tf.Record(%s)\n", recorded)
+		fmt.Fprintf(&b, "\tif f.%sFunc == nil {\n\t\t%s\n\t}\n", m.name, m.notConfigured())
+		fmt.Fprintf(&b, "\treturn f.%sFunc(%s)\n}\n", m.name, m.args())
+	}
+
+	b.WriteString("\n// 确保 Fake 实现了 API。\n")
+	b.WriteString("var _ API = (*Fake)(nil)\n")
+	return []byte(b.String())
+}
+
+// writeImports writes an import block with the standard library first.
+func writeImports(b *strings.Builder, imports []string) {
+	if len(imports) == 0 {
+		return
+	}
+
+	var std, other []string
+	for _, importPath := range imports {
+		first, _, _ := strings.Cut(importPath, "/")
+		if strings.Contains(first, ".") {
+			other = append(other, importPath)
+		} else {
+			std = append(std, importPath)
+		}
+	}
+	slices.Sort(std)
+	slices.Sort(other)
+	std, other = slices.Compact(std), slices.Compact(other)
+
+	b.WriteString("import (\n")
+	for _, importPath := range std {
+		fmt.Fprintf(b, "\t%q\n", importPath)
+	}
+	if len(std) > 0 && len(other) > 0 {
+		b.WriteString("\n")
+	}
+	for _, importPath := range other {
+		fmt.Fprintf(b, "\t%q\n", importPath)
+	}
+	b.WriteString(")\n\n")
+}
@@ -0,0 +1,646 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testModels = "testdata/models"
+
+// generateTestdata renders the testdata APIs into an Output rooted at root.
+func generateTestdata(t *testing.T, root string) *Output {
+	t.Helper()
+
+	cfg, err := LoadConfig("testdata/apis.json")
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+
+	out := NewOutput(root)
+	if err := Generate(cfg.APIs, testModels, targets["all"], out); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	return out
+}
+
+func TestGenerate_Files(t *testing.T) {
+	out := generateTestdata(t, t.TempDir())
+
+	want := []string{
+		"gadgets-v2024-01-01/api.go",
+		"gadgets-v2024-01-01/client.go",
+		"gadgets-v2024-01-01/client_test.go",
+		"gadgets-v2024-01-01/fake.go",
+		"gadgets-v2024-01-01/iterator.go",
+		"gadgets-v2024-01-01/model_currency.go",
+		"gadgets-v2024-01-01/model_currency_code.go",
+		"gadgets-v2024-01-01/model_gadget.go",
+		"gadgets-v2024-01-01/model_list_gadgets_response.go",
+		"gadgets-v2024-01-01/model_pagination.go",
+		"grantless_operations.go",
+		"operation_routes.go",
+		"operations/registry.go",
+		"sdk/sdk.go",
+		"widgets-v0/api.go",
+		"widgets-v0/client.go",
+		"widgets-v0/client_test.go",
+		"widgets-v0/fake.go",
+		"widgets-v0/iterator.go",
+		"widgets-v0/model_balance.go",
+		"widgets-v0/model_error.go",
+		"widgets-v0/model_error_list.go",
+		"widgets-v0/model_get_widgets_response.go",
+		"widgets-v0/model_money.go",
+		"widgets-v0/model_widget.go",
+		"widgets-v0/model_widget_dimensions.go",
+		"widgets-v0/model_widget_metrics.go",
+		"widgets-v0/model_widget_status.go",
+	}
+
+	got := out.Paths()
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Paths() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestGenerate_Content(t *testing.T) {
+	out := generateTestdata(t, t.TempDir())
+
+	tests := []struct {
+		file     string
+		contains []string
+	}{
+		{
+			file: "widgets-v0/model_widget.go",
+			contains: []string{
+				"// A widget.\ntype Widget struct {",
+				"// The widget identifier.\n\tWidgetId string `json:\"WidgetId\"`",
+				"Status *WidgetStatus `json:\"Status\"`",
+				"Price *Money `json:\"Price,omitempty\"`",
+				"CreatedDate *time.Time `json:\"CreatedDate,omitempty\"`",
+				"Quantity int32 `json:\"Quantity,omitempty\"`",
+				"Weight float32",
+				"Tags []string",
+				"Attributes map[string]string",
+				"Dimensions *WidgetDimensions",
+				"func (m Widget) Validate() error {",
+				"v.Required(path+\"WidgetId\", m.WidgetId)",
+				"v.MaxLength(path+\"WidgetId\", m.WidgetId, 40)",
+				"v.Pattern(path+\"WidgetId\", m.WidgetId, \"^[A-Z0-9-]+$\", \"must match pattern ^[A-Z0-9-]+$\")",
+				"if m.MarketplaceIds == nil {\n\t\tv.Custom(path+\"MarketplaceIds\", \"is required\")\n\t} else {\n\t\tv.MinItems(path+\"MarketplaceIds\", len(m.MarketplaceIds), 1)",
+				"v.MaxItems(path+\"MarketplaceIds\", len(m.MarketplaceIds), 50)",
+				"v.OneOf(path+\"Status\", string(*m.Status), []string{\"PendingReview\", \"ACTIVE\", \"A4_24_64x33\"})",
+				"if m.Quantity != 0 {\n\t\tif m.Quantity < 1 {",
+				"m.Dimensions.validate(v, path+\"Dimensions.\")",
+				"if m.CreatedDate != nil {\n\t\tv.DateTime(path+\"CreatedDate\", (*m.CreatedDate))",
+				"if !m.ReleaseDate.IsZero() {\n\t\tv.Date(path+\"ReleaseDate\", m.ReleaseDate)",
+			},
+		},
+		{
+			file:     "widgets-v0/model_widget_dimensions.go",
+			contains: []string{"v.OneOf(path+\"unit\", m.Unit, []string{\"cm\", \"in\"})"},
+		},
+		{
+			file: "widgets-v0/model_widget_status.go",
+			contains: []string{
+				"// WidgetStatus : The widget status.",
+				"PENDING_REVIEW_WidgetStatus WidgetStatus = \"PendingReview\"",
+				"A4_24_64X33_WidgetStatus WidgetStatus = \"A4_24_64x33\"",
+			},
+		},
+		{
+			file:     "widgets-v0/model_get_widgets_response.go",
+			contains: []string{"Payload *[]Widget `json:\"payload,omitempty\"`"},
+		},
+		{
+			file:     "widgets-v0/model_error.go",
+			contains: []string{"type ModelError struct"},
+		},
+		{
+			file:     "widgets-v0/model_error_list.go",
+			contains: []string{"Errors []ModelError"},
+		},
+		{
+			file: "widgets-v0/model_money.go",
+			contains: []string{
+				"Amount spapi.DecimalString `json:\"Amount,omitzero\"`",
+				"return spapi.NewMoney(m.Amount.Decimal, m.CurrencyCode)",
+			},
+		},
+		{
+			file: "widgets-v0/model_widget_metrics.go",
+			contains: []string{
+				"TotalRevenue spapi.Decimal `json:\"totalRevenue,omitzero\"`",
+				"RevenuePenetration float64 `json:\"revenuePenetration,omitempty\"`",
+			},
+		},
+		{
+			file:     "widgets-v0/model_balance.go",
+			contains: []string{"BalanceAmount spapi.Decimal"},
+		},
+		{
+			file: "gadgets-v2024-01-01/model_currency.go",
+			contains: []string{
+				"Amount spapi.Decimal `json:\"amount\"`",
+				"currency = string(*c.Code)",
+			},
+		},
+		{
+			file: "widgets-v0/client.go",
+			contains: []string{
+				"func (c *Client) GetWidgets(ctx context.Context, query map[string]string) (interface{}, error)",
+				"func (c *Client) CreateWidget(ctx context.Context, body interface{}) (interface{}, error)",
+				"func (c *Client) DeleteWidget(ctx context.Context, widgetId string) (interface{}, error)",
+				"c.baseClient.DoRequest(ctx, \"PATCH\", path, nil, body, &result)",
+			},
+		},
+		{
+			file:     "gadgets-v2024-01-01/client.go",
+			contains: []string{"c.baseClient.Put(ctx, path, body, &result)"},
+		},
+		{
+			file:     "widgets-v0/client_test.go",
+			contains: []string{"expected := 4"},
+		},
+		{
+			file: "gadgets-v2024-01-01/iterator.go",
+			contains: []string{
+				"func (c *Client) IterateGadgets(ctx context.Context, query map[string]string)",
+				"pagination, ok := response[\"pagination\"].(map[string]interface{})",
+				"currentQuery[\"nextToken\"] = nextToken",
+			},
+		},
+		{
+			file: "gadgets-v2024-01-01/api.go",
+			contains: []string{
+				"type API interface {",
+				"IterateGadgets(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]",
+				"var _ API = (*Client)(nil)",
+			},
+		},
+		{
+			file: "widgets-v0/fake.go",
+			contains: []string{
+				"spapi.FakeRecorder",
+				"DeleteWidgetFunc func(ctx context.Context, widgetId string) (interface{}, error)",
+				"f.Record(\"DeleteWidget\", widgetId)",
+				"return nil, fmt.Errorf(\"DeleteWidget: %w\", spapi.ErrFakeNotConfigured)",
+				"return f.DeleteWidgetFunc(ctx, widgetId)",
+				"var _ API = (*Fake)(nil)",
+			},
+		},
+		{
+			file: "operations/registry.go",
+			contains: []string{
+				"widgets_v0 \"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/widgets-v0\"",
+				"Name: \"DeleteWidget\", Method: \"DELETE\"",
+				"PathParams: []string{\"widgetId\"}, Input: InputNone,",
+				"return widgets_v0.NewClient(c).DeleteWidget(ctx, p[0])",
+				"return gadgets_v2024_01_01.NewClient(c).UpdateGadgets(ctx, body)",
+				"Iterator: \"IterateGadgets\",",
+				"return gadgets_v2024_01_01.NewClient(c).IterateGadgets(ctx, query)",
+			},
+		},
+		{
+			file: "grantless_operations.go",
+			contains: []string{
+				"package spapi",
+				"var grantlessOperations = []operationRoute{\n\t{method: \"DELETE\", path: \"/widgets/v0/widgets/{widgetId}\"}, // widgets_v0.DeleteWidget\n}",
+			},
+		},
+		{
+			file: "operation_routes.go",
+			contains: []string{
+				"package spapi",
+				"{method: \"GET\", path: \"/gadgets/2024-01-01/gadgets\", name: \"gadgets-v2024-01-01:listGadgets\"},",
+				"{method: \"DELETE\", path: \"/widgets/v0/widgets/{widgetId}\", name: \"widgets-v0:deleteWidget\"},",
+			},
+		},
+		{
+			file: "sdk/sdk.go",
+			contains: []string{
+				"package sdk",
+				"*spapi.Client",
+				"Gadgets *gadgets_v2024_01_01.Client",
+				"Widgets *widgets_v0.Client",
+				"func New(opts ...spapi.ClientOption) (*Client, error)",
+				"Widgets: widgets_v0.NewClient(base),",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			content, ok := out.File(tt.file)
+			if !ok {
+				t.Fatalf("%s not generated", tt.file)
+			}
+			// gofmt aligns fields; compare with runs of spaces collapsed
+			normalized := strings.Join(strings.Fields(string(content)), " ")
+			for _, want := range tt.contains {
+				if !strings.Contains(normalized, strings.Join(strings.Fields(want), " ")) {
+					t.Errorf("%s does not contain %q\n%s", tt.file, want, content)
+				}
+			}
+		})
+	}
+}
+
+// TestGenerate_ValidateRequestModels checks that only models reachable
+// from a request body get a Validate method.
+func TestGenerate_ValidateRequestModels(t *testing.T) {
+	out := generateTestdata(t, t.TempDir())
+
+	tests := map[string]bool{
+		"widgets-v0/model_widget.go":                         true,
+		"widgets-v0/model_widget_dimensions.go":              true,
+		"widgets-v0/model_money.go":                          true,
+		"widgets-v0/model_get_widgets_response.go":           false,
+		"widgets-v0/model_error_list.go":                     false,
+		"gadgets-v2024-01-01/model_gadget.go":                true,
+		"gadgets-v2024-01-01/model_currency.go":              true,
+		"gadgets-v2024-01-01/model_pagination.go":            false,
+		"gadgets-v2024-01-01/model_list_gadgets_response.go": false,
+	}
+	for file, want := range tests {
+		content, ok := out.File(file)
+		if !ok {
+			t.Fatalf("%s not generated", file)
+		}
+		if got := bytes.Contains(content, []byte(") Validate() error {")); got != want {
+			t.Errorf("%s has Validate = %v, want %v", file, got, want)
+		}
+	}
+}
+
+func TestGenerate_Deterministic(t *testing.T) {
+	first := generateTestdata(t, t.TempDir())
+	second := generateTestdata(t, t.TempDir())
+
+	for _, path := range first.Paths() {
+		a, _ := first.File(path)
+		b, _ := second.File(path)
+		if !bytes.Equal(a, b) {
+			t.Errorf("%s differs between runs", path)
+		}
+	}
+}
+
+func TestOutput_Check(t *testing.T) {
+	root := t.TempDir()
+	out := generateTestdata(t, root)
+
+	diffs, err := out.Check()
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if len(diffs) != len(out.Paths()) {
+		t.Errorf("Check() on empty dir = %d diffs, want %d", len(diffs), len(out.Paths()))
+	}
+
+	if err := out.Write(); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if diffs, _ := out.Check(); len(diffs) != 0 {
+		t.Errorf("Check() after Write = %v, want none", diffs)
+	}
+
+	if err := os.WriteFile(filepath.Join(root, "widgets-v0", "client.go"), []byte("package widgets_v0\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "widgets-v0", "model_removed.go"), []byte("package widgets_v0\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "widgets-v0", "poller.go"), []byte("package widgets_v0\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	diffs, _ = out.Check()
+	got := strings.Join(diffs, "\n")
+	if len(diffs) != 2 || !strings.Contains(got, "modified widgets-v0/client.go") || !strings.Contains(got, "stale    widgets-v0/model_removed.go") {
+		t.Errorf("Check() = %v, want modified client.go and stale model_removed.go", diffs)
+	}
+
+	if err := out.Write(); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(root, "widgets-v0", "model_removed.go")); !os.IsNotExist(err) {
+		t.Error("Write() kept stale model file")
+	}
+	if _, err := os.Stat(filepath.Join(root, "widgets-v0", "poller.go")); err != nil {
+		t.Error("Write() removed a hand-written file")
+	}
+}
+
+func TestGenerate_UnknownIteratorMethod(t *testing.T) {
+	api := APIConfig{
+		Name:      "widgets",
+		Version:   "v0",
+		File:      "widgetsV0.json",
+		Iterators: []IteratorConfig{{Method: "ListWidgets", Items: "payload", Token: "NextToken"}},
+	}
+
+	err := Generate([]APIConfig{api}, testModels, targets["iterators"], NewOutput(t.TempDir()))
+	if err == nil || !strings.Contains(err.Error(), "operation ListWidgets not found") {
+		t.Errorf("Generate() error = %v, want operation not found", err)
+	}
+}
+
+func TestGenerate_RequiresModels(t *testing.T) {
+	cfg, _ := LoadConfig("testdata/apis.json")
+	if err := Generate(cfg.APIs, "", targets["models"], NewOutput(t.TempDir())); err == nil {
+		t.Error("Generate(models) without -models succeeded")
+	}
+}
+
+// TestCommittedIterators keeps pkg/spapi/*/iterator.go in sync with apis.json.
+func TestCommittedIterators(t *testing.T) {
+	cfg, err := LoadConfig("")
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+
+	out := NewOutput(filepath.Join("..", "..", "pkg", "spapi"))
+	if err := Generate(cfg.APIs, "", targets["iterators"], out); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	diffs, err := out.Check()
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	for _, diff := range diffs {
+		t.Errorf("%s; run: go run ./cmd/generator iterators", diff)
+	}
+}
+
+// TestGenerate_InterfacesHandWritten checks that API and Fake include
+// hand-written Client methods found next to the generated files.
+func TestGenerate_InterfacesHandWritten(t *testing.T) {
+	root := t.TempDir()
+	helper := `package widgets_v0
+
+import (
+	"context"
+	"iter"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// WaitForWidget 等待 widget 就绪。
+//
+// 详细说明。
+func (c *Client) WaitForWidget(ctx context.Context, widgetID string, opts ...spapi.PollerOption) (*Widget, bool, error) {
+	return nil, false, nil
+}
+
+func (c *Client) IterateChangedWidgets(ctx context.Context, since time.Time) iter.Seq2[Widget, error] {
+	return nil
+}
+
+func (c *Client) unexported(ctx context.Context) error { return nil }
+`
+	if err := os.MkdirAll(filepath.Join(root, "widgets-v0"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "widgets-v0", "waiter.go"), []byte(helper), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	out := generateTestdata(t, root)
+
+	api, _ := out.File("widgets-v0/api.go")
+	for _, want := range []string{
+		"\t\"time\"\n",
+		"// WaitForWidget 等待 widget 就绪。\n\tWaitForWidget(ctx context.Context, widgetID string, opts ...spapi.PollerOption) (*Widget, bool, error)",
+		"IterateChangedWidgets(ctx context.Context, since time.Time) iter.Seq2[Widget, error]",
+	} {
+		if !strings.Contains(string(api), want) {
+			t.Errorf("api.go does not contain %q\n%s", want, api)
+		}
+	}
+	if strings.Contains(string(api), "unexported") || strings.Contains(string(api), "详细说明") {
+		t.Errorf("api.go contains unexported method or extra doc paragraphs\n%s", api)
+	}
+
+	fake, _ := out.File("widgets-v0/fake.go")
+	for _, want := range []string{
+		"f.Record(\"WaitForWidget\", widgetID, opts)",
+		"return nil, false, fmt.Errorf(\"WaitForWidget: %w\", spapi.ErrFakeNotConfigured)",
+		"return f.WaitForWidgetFunc(ctx, widgetID, opts...)",
+		"return spapi.FakeSeq[Widget](nil, fmt.Errorf(\"IterateChangedWidgets: %w\", spapi.ErrFakeNotConfigured))",
+	} {
+		if !strings.Contains(string(fake), want) {
+			t.Errorf("fake.go does not contain %q\n%s", want, fake)
+		}
+	}
+}
+
+// TestCommittedInterfaces keeps pkg/spapi/*/api.go and fake.go in sync
+// with the Client methods.
+func TestCommittedInterfaces(t *testing.T) {
+	cfg, err := LoadConfig("")
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+
+	out := NewOutput(filepath.Join("..", "..", "pkg", "spapi"))
+	if err := Generate(cfg.APIs, "", targets["interfaces"], out); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	diffs, err := out.Check()
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	for _, diff := range diffs {
+		t.Errorf("%s; run: go run ./cmd/generator interfaces", diff)
+	}
+}
+
+// TestCommittedOperations keeps pkg/spapi/operations/registry.go in
+// sync with the Client methods.
+func TestCommittedOperations(t *testing.T) {
+	cfg, err := LoadConfig("")
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+
+	out := NewOutput(filepath.Join("..", "..", "pkg", "spapi"))
+	if err := Generate(cfg.APIs, "", targets["operations"], out); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	diffs, err := out.Check()
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	for _, diff := range diffs {
+		t.Errorf("%s; run: go run ./cmd/generator operations", diff)
+	}
+}
+
+// TestCommittedSDK keeps pkg/spapi/sdk/sdk.go in sync with apis.json.
+func TestCommittedSDK(t *testing.T) {
+	cfg, err := LoadConfig("")
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+
+	out := NewOutput(filepath.Join("..", "..", "pkg", "spapi"))
+	if err := Generate(cfg.APIs, "", targets["sdk"], out); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	diffs, err := out.Check()
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	for _, diff := range diffs {
+		t.Errorf("%s; run: go run ./cmd/generator sdk", diff)
+	}
+}
+
+func TestSDKFields(t *testing.T) {
+	apis := []APIConfig{
+		{Name: "catalog-items", Version: "v0", Field: "Catalog"},
+		{Name: "catalog-items", Version: "v2022-04-01", Field: "Catalog"},
+		{Name: "catalog-items", Version: "v2020-12-01", Field: "Catalog"},
+		{Name: "easy-ship-model", Version: "v2022-03-23"},
+		{Name: "finances", Version: "v0"},
+		{Name: "finances", Version: "v2024-06-01-transfers", Field: "Transfers"},
+	}
+
+	fields, err := sdkFields(apis)
+	if err != nil {
+		t.Fatalf("sdkFields() error = %v", err)
+	}
+
+	var got []string
+	for _, field := range fields {
+		got = append(got, field.name+"="+field.api.Dir())
+	}
+	want := []string{
+		"Catalog=catalog-items-v2022-04-01",
+		"CatalogV0=catalog-items-v0",
+		"CatalogV20201201=catalog-items-v2020-12-01",
+		"EasyShip=easy-ship-model-v2022-03-23",
+		"Finances=finances-v0",
+		"Transfers=finances-v2024-06-01-transfers",
+	}
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("sdkFields() = %v, want %v", got, want)
+	}
+}
+
+func TestGenerate_SDKCollision(t *testing.T) {
+	root := t.TempDir()
+	base := "package spapi\n\ntype Client struct{}\n\nfunc (c *Client) Close() error { return nil }\n"
+	if err := os.WriteFile(filepath.Join(root, "client.go"), []byte(base), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	apis := []APIConfig{{Name: "close", Version: "v1", File: "close.json"}}
+	err := Generate(apis, "", targets["sdk"], NewOutput(root))
+	if err == nil || !strings.Contains(err.Error(), "collides with spapi.Client") {
+		t.Errorf("Generate() error = %v, want collision", err)
+	}
+}
+
+func TestLoadConfig_Duplicate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "apis.json")
+	data := `{"apis": [
+		{"name": "orders", "version": "v0", "file": "ordersV0.json"},
+		{"name": "orders", "version": "v0", "file": "ordersV0.json"}
+	]}`
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := LoadConfig(path); err == nil {
+		t.Error("LoadConfig() accepted a duplicate api")
+	}
+}
+
+func TestIteratorConfig_Defaults(t *testing.T) {
+	tests := []struct {
+		it        IteratorConfig
+		name      string
+		parameter string
+	}{
+		{IteratorConfig{Method: "GetFeeds", Token: "nextToken"}, "IterateFeeds", "nextToken"},
+		{IteratorConfig{Method: "ListFinancialEventGroups", Token: "payload.NextToken"}, "IterateFinancialEventGroups", "NextToken"},
+		{IteratorConfig{Method: "SearchListingsItems", Token: "nextToken", TokenParam: "pageToken"}, "IterateListingsItems", "pageToken"},
+		{IteratorConfig{Name: "IterateTestCaseData", Method: "GenerateOrderScenarios", Token: "payload.pagination.nextToken"}, "IterateTestCaseData", "nextToken"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.it.IteratorName(); got != tt.name {
+			t.Errorf("IteratorName(%s) = %s, want %s", tt.it.Method, got, tt.name)
+		}
+		if got := tt.it.QueryParam(); got != tt.parameter {
+			t.Errorf("QueryParam(%s) = %s, want %s", tt.it.Method, got, tt.parameter)
+		}
+	}
+}
+
+// TestCommittedHeaders checks that the committed clients and models start
+// with the headers the generator writes, so -check does not report every
+// file as stale when the models are available.
+func TestCommittedHeaders(t *testing.T) {
+	cfg, err := LoadConfig("")
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+
+	for _, api := range cfg.APIs {
+		dir := filepath.Join("..", "..", "pkg", "spapi", api.Dir())
+
+		client, err := os.ReadFile(filepath.Join(dir, "client.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(string(client), licenseHeader+"package ") {
+			t.Errorf("%s/client.go does not start with licenseHeader", api.Dir())
+		}
+
+		models, err := filepath.Glob(filepath.Join(dir, "model_*.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, path := range models {
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			header, _, _ := strings.Cut(string(data), " */\n")
+			if !strings.HasPrefix(header, "/*\n") || !strings.HasSuffix(header, " * Generated by: "+modelGeneratedBy+"\n") {
+				t.Errorf("%s/%s does not start with modelHeader", api.Dir(), filepath.Base(path))
+			}
+		}
+	}
+}
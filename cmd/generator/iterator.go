@@ -0,0 +1,219 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/openapi"
+)
+
+// reservedVars are local names used by the iterator template that a
+// response container must not shadow.
+var reservedVars = map[string]bool{
+	"ctx": true, "query": true, "yield": true, "currentQuery": true, "result": true,
+	"err": true, "resultBytes": true, "response": true, "items": true, "item": true,
+	"itemMap": true, "ok": true, "page": true, "nextToken": true, "k": true, "v": true, "c": true,
+	"release": true,
+}
+
+// generateIterators renders iterator.go for APIs with configured iterators.
+//
+// doc may be nil; iterators are rendered from the configuration alone,
+// and the referenced client methods are only validated when the model is
+// available.
+func generateIterators(api APIConfig, doc *openapi.Document, out *Output) error {
+	if len(api.Iterators) == 0 {
+		return nil
+	}
+
+	if doc != nil {
+		methods := make(map[string]clientMethod)
+		for _, m := range clientMethods(doc) {
+			methods[m.name] = m
+		}
+		for _, it := range api.Iterators {
+			m, ok := methods[it.Method]
+			if !ok {
+				return fmt.Errorf("%s: iterator %s: operation %s not found", api.Dir(), it.IteratorName(), it.Method)
+			}
+			if len(m.pathParams) != len(it.PathParams) {
+				return fmt.Errorf("%s: iterator %s: %s has %d path parameters, config lists %d",
+					api.Dir(), it.IteratorName(), it.Method, len(m.pathParams), len(it.PathParams))
+			}
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString(licenseHeader)
+	fmt.Fprintf(&b, "package %s\n\n", api.Package())
+	b.WriteString("import (\n\t\"context\"\n\t\"encoding/json\"\n\t\"iter\"\n\n\t\"github.com/pkg/errors\"\n)\n")
+
+	for _, it := range api.Iterators {
+		b.WriteString("\n")
+		renderIterator(&b, it)
+	}
+
+	return out.Add(api.Dir()+"/iterator.go", []byte(b.String()))
+}
+
+// renderIterator writes one Iterate* method.
+func renderIterator(b *strings.Builder, it IteratorConfig) {
+	name := it.IteratorName()
+	itemsPath := strings.Split(it.Items, ".")
+	tokenPath := strings.Split(it.Token, ".")
+	itemsKey := itemsPath[len(itemsPath)-1]
+
+	params, args := "", ""
+	for _, p := range it.PathParams {
+		params += ", " + p.Name + " string"
+		args += p.Name + ", "
+	}
+
+	fmt.Fprintf(b, "// %s 返回%s迭代器，自动处理分页。\n", name, spaced(it.Description))
+	b.WriteString("//\n")
+	if it.Page {
+		fmt.Fprintf(b, "// 每页产出一次 %s 对象，并自动处理 %s 分页逻辑。\n", itemsKey, it.QueryParam())
+	} else {
+		fmt.Fprintf(b, "// 使用 Go 1.25 迭代器特性，自动处理 %s 分页逻辑。\n", it.QueryParam())
+	}
+	b.WriteString("//\n// 参数:\n//   - ctx: 请求上下文\n")
+	for _, p := range it.PathParams {
+		fmt.Fprintf(b, "//   - %s: %s\n", p.Name, p.Description)
+	}
+	b.WriteString("//   - query: 查询参数\n")
+	b.WriteString("//\n// 示例:\n//\n")
+	fmt.Fprintf(b, "//\tfor item, err := range client.%s(ctx, %squery) {\n", name, args)
+	b.WriteString("//\t    if err != nil { return err }\n//\t    process(item)\n//\t}\n")
+
+	fmt.Fprintf(b, "func (c *Client) %s(ctx context.Context%s, query map[string]string) iter.Seq2[map[string]interface{}, error] {\n", name, params)
+	b.WriteString(`	return func(yield func(map[string]interface{}, error) bool) {
+		// 登记到客户端，Close 等待迭代结束
+		ctx, release, err := c.baseClient.Acquire(ctx)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		defer release()
+
+		currentQuery := make(map[string]string)
+		for k, v := range query {
+			currentQuery[k] = v
+		}
+
+		for {
+`)
+	fmt.Fprintf(b, "\t\t\tresult, err := c.%s(ctx, %scurrentQuery)\n", it.Method, args)
+	b.WriteString("\t\t\tif err != nil {\n")
+	fmt.Fprintf(b, "\t\t\t\tyield(nil, errors.Wrap(err, %q))\n", "failed to call "+it.Method)
+	b.WriteString(`				return
+			}
+
+			resultBytes, err := json.Marshal(result)
+			if err != nil {
+				yield(nil, errors.Wrap(err, "failed to marshal result"))
+				return
+			}
+
+			var response map[string]interface{}
+			if err := json.Unmarshal(resultBytes, &response); err != nil {
+				yield(nil, errors.Wrap(err, "failed to unmarshal response"))
+				return
+			}
+
+`)
+
+	objects := map[string]string{"": "response"}
+	if it.Page {
+		fmt.Fprintf(b, "\t\t\t// 获取 %s 对象\n", itemsKey)
+		parent := containers(b, objects, itemsPath)
+		fmt.Fprintf(b, "\t\t\tif page, ok := %s[%q].(map[string]interface{}); ok {\n", parent, itemsKey)
+		b.WriteString("\t\t\t\tif !yield(page, nil) {\n\t\t\t\t\treturn\n\t\t\t\t}\n\t\t\t}\n\n")
+	} else {
+		fmt.Fprintf(b, "\t\t\t// 获取 %s 数组\n", itemsKey)
+		parent := containers(b, objects, itemsPath)
+		fmt.Fprintf(b, "\t\t\titems, ok := %s[%q].([]interface{})\n", parent, itemsKey)
+		b.WriteString(`			if !ok || items == nil {
+				break
+			}
+
+			// 遍历当前页
+			for _, item := range items {
+				itemMap, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if !yield(itemMap, nil) {
+					return
+				}
+			}
+
+`)
+	}
+
+	b.WriteString("\t\t\t// 检查下一页\n")
+	parent := containers(b, objects, tokenPath)
+	fmt.Fprintf(b, "\t\t\tnextToken, _ := %s[%q].(string)\n", parent, tokenPath[len(tokenPath)-1])
+	b.WriteString("\t\t\tif nextToken == \"\" {\n\t\t\t\tbreak\n\t\t\t}\n\n")
+	fmt.Fprintf(b, "\t\t\tcurrentQuery[%q] = nextToken\n", it.QueryParam())
+	b.WriteString("\t\t}\n\t}\n}\n")
+}
+
+// containers writes the lookups of the objects enclosing the last
+// element of path and returns the variable holding the innermost one.
+// Objects already looked up earlier in the loop body are reused.
+func containers(b *strings.Builder, objects map[string]string, path []string) string {
+	parent := objects[""]
+	for i := 0; i < len(path)-1; i++ {
+		prefix := strings.Join(path[:i+1], ".")
+		if name, ok := objects[prefix]; ok {
+			parent = name
+			continue
+		}
+
+		name := paramName(path[i])
+		if reservedVars[name] {
+			name += "Object"
+		}
+		fmt.Fprintf(b, "\t\t\t%s, ok := %s[%q].(map[string]interface{})\n", name, parent, path[i])
+		b.WriteString("\t\t\tif !ok {\n\t\t\t\tbreak\n\t\t\t}\n\n")
+
+		objects[prefix] = name
+		parent = name
+	}
+	return parent
+}
+
+// spaced pads a description with spaces where it meets Chinese text,
+// e.g. "Feed" -> " Feed ", "财务事件" -> "财务事件".
+func spaced(text string) string {
+	first, _ := utf8.DecodeRuneInString(text)
+	last, _ := utf8.DecodeLastRuneInString(text)
+	if first < utf8.RuneSelf {
+		text = " " + text
+	}
+	if last < utf8.RuneSelf {
+		text += " "
+	}
+	return text
+}
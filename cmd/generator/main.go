@@ -5,86 +5,218 @@
 // Amazon SP-API Go SDK is dual-licensed:
 //
 // 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
-//    - Free for personal, educational, and open source projects
-//    - Your project must also be open sourced under AGPL-3.0
-//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
 //
 // 2. Commercial License for proprietary/commercial use
-//    - Required for any commercial, enterprise, or proprietary use
-//    - Allows closed source distribution
-//    - Contact: vanling1111@gmail.com
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
 //
 // Unless you have obtained a commercial license, this file is licensed
 // under AGPL-3.0. By using this software, you agree to comply with the
-// terms of the applicable license.
-//
-// This file is part of Amazon SP-API Go SDK.
+// terms of the applicable license. All rights reserved.
+
+// Package main generates the API packages under pkg/spapi from the
+// Selling Partner API models.
 //
-// Amazon SP-API Go SDK is dual-licensed:
+// The models are read from a local checkout of
+// https://github.com/amzn/selling-partner-api-models:
 //
-// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
-//    - Free for personal, educational, and open source projects
-//    - Your project must also be open sourced under AGPL-3.0
-//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//	git clone --depth 1 https://github.com/amzn/selling-partner-api-models
+//	go run ./cmd/generator -models selling-partner-api-models/models all
 //
-// 2. Commercial License for proprietary/commercial use
-//    - Required for any commercial, enterprise, or proprietary use
-//    - Allows closed source distribution
-//    - Contact: vanling1111@gmail.com
-//
-// Unless you have obtained a commercial license, this file is licensed
-// under AGPL-3.0. By using this software, you agree to comply with the
-// terms of the applicable license.
-
-// Package main provides a CLI tool for generating API clients and models
+// Use -check to verify that the committed code matches the generated
+// output without writing anything; it exits with status 1 on drift.
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
+	"slices"
+	"strings"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/openapi"
 )
 
+// targets lists what each command generates.
+var targets = map[string][]string{
+	"models":     {"models"},
+	"clients":    {"clients"},
+	"iterators":  {"iterators"},
+	"tests":      {"tests"},
+	"interfaces": {"interfaces"},
+	"operations": {"operations"},
+	"sdk":        {"sdk"},
+	"all":        {"models", "clients", "iterators", "tests", "interfaces", "operations", "sdk"},
+}
+
+// crossAPIKinds are rendered once from all APIs instead of per package.
+var crossAPIKinds = map[string]bool{"operations": true, "sdk": true}
+
 func main() {
-	if len(os.Args) < 2 {
-		printUsage()
-		os.Exit(1)
+	os.Exit(run(os.Args[1:]))
+}
+
+// run executes the generator and returns the process exit code.
+func run(args []string) int {
+	flags := flag.NewFlagSet("generator", flag.ContinueOnError)
+	models := flags.String("models", "", "path to the models directory of selling-partner-api-models")
+	config := flags.String("config", "", "API list (defaults to the embedded apis.json)")
+	output := flags.String("output", "pkg/spapi", "directory containing the API packages")
+	only := flags.String("only", "", "comma-separated package directories to generate, e.g. orders-v0,feeds-v2021-06-30; skips the operation registry and the sdk package")
+	check := flags.Bool("check", false, "report differences instead of writing files")
+	flags.Usage = func() { printUsage(flags) }
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 1 {
+		printUsage(flags)
+		return 2
 	}
 
-	command := os.Args[1]
-
-	switch command {
-	case "models":
-		fmt.Println("Generating API models...")
-		fmt.Println("Run: scripts/generate-apis-versioned.ps1")
-	case "clients":
-		fmt.Println("Generating API clients...")
-		fmt.Println("Run: scripts/generate-all-api-clients.ps1")
-	case "tests":
-		fmt.Println("Generating API tests...")
-		fmt.Println("Run: scripts/generate-api-client-tests.ps1")
-	case "all":
-		fmt.Println("Generating all code...")
-		fmt.Println("1. Run: scripts/generate-apis-versioned.ps1")
-		fmt.Println("2. Run: scripts/generate-all-api-clients.ps1")
-		fmt.Println("3. Run: scripts/generate-api-client-tests.ps1")
-	default:
-		fmt.Printf("Unknown command: %s\n", command)
-		printUsage()
-		os.Exit(1)
+	kinds, ok := targets[flags.Arg(0)]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Unknown command: %s\n", flags.Arg(0))
+		printUsage(flags)
+		return 2
+	}
+
+	cfg, err := LoadConfig(*config)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	var filter []string
+	if *only != "" {
+		filter = strings.Split(*only, ",")
+
+		// A registry or sdk package rendered from a subset would drop
+		// the other APIs.
+		kinds = slices.DeleteFunc(slices.Clone(kinds), func(kind string) bool {
+			return crossAPIKinds[kind]
+		})
+	}
+	apis, err := cfg.Filter(filter)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	out := NewOutput(*output)
+	if err := Generate(apis, *models, kinds, out); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	if *check {
+		diffs, err := out.Check()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		if len(diffs) > 0 {
+			fmt.Printf("%d generated files are out of date:\n", len(diffs))
+			for _, diff := range diffs {
+				fmt.Println("  " + diff)
+			}
+			fmt.Println("Run the generator without -check to update them.")
+			return 1
+		}
+		fmt.Printf("✓ %d generated files are up to date.\n", len(out.Paths()))
+		return 0
+	}
+
+	if err := out.Write(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	fmt.Printf("Generated %d files for %d APIs.\n", len(out.Paths()), len(apis))
+	return 0
+}
+
+// Generate renders the requested kinds of files for apis into out.
+//
+// Models, clients and tests need the models directory; iterators are
+// rendered from the configuration and only validated against the model
+// when one is given, interfaces and the operation registry are derived
+// from the package sources, and the sdk package only needs the list of
+// APIs.
+func Generate(apis []APIConfig, models string, kinds []string, out *Output) error {
+	needModels := false
+	for _, kind := range kinds {
+		if kind != "iterators" && kind != "interfaces" && !crossAPIKinds[kind] {
+			needModels = true
+		}
+	}
+	if needModels && models == "" {
+		return fmt.Errorf("-models is required for %s", strings.Join(kinds, ", "))
+	}
+
+	for _, api := range apis {
+		var doc *openapi.Document
+		if models != "" {
+			path, err := api.SpecPath(models)
+			if err != nil {
+				return err
+			}
+			if doc, err = openapi.Load(path); err != nil {
+				return fmt.Errorf("%s: %w", api.Dir(), err)
+			}
+		}
+
+		for _, kind := range kinds {
+			var err error
+			switch kind {
+			case "models":
+				err = generateModels(api, doc, out)
+			case "clients":
+				err = generateClient(api, doc, out)
+			case "iterators":
+				err = generateIterators(api, doc, out)
+			case "tests":
+				err = generateTests(api, doc, out)
+			case "interfaces":
+				err = generateInterfaces(api, out)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if slices.Contains(kinds, "operations") {
+		if err := generateOperations(apis, out); err != nil {
+			return err
+		}
+	}
+	if slices.Contains(kinds, "sdk") {
+		return generateSDK(apis, out)
 	}
+	return nil
 }
 
-func printUsage() {
+func printUsage(flags *flag.FlagSet) {
 	fmt.Println("Amazon SP-API Go SDK Code Generator")
 	fmt.Println()
 	fmt.Println("Usage:")
-	fmt.Println("  generator <command>")
+	fmt.Println("  generator [flags] <command>")
 	fmt.Println()
 	fmt.Println("Commands:")
-	fmt.Println("  models   - Generate API models from OpenAPI specs")
-	fmt.Println("  clients  - Generate API client methods")
-	fmt.Println("  tests    - Generate API client tests")
-	fmt.Println("  all      - Generate everything")
+	fmt.Println("  models     - Generate model_*.go files from the API models")
+	fmt.Println("  clients    - Generate client.go")
+	fmt.Println("  iterators  - Generate iterator.go for paginated operations")
+	fmt.Println("  tests      - Generate client_test.go")
+	fmt.Println("  interfaces - Generate the API interface (api.go) and its Fake (fake.go)")
+	fmt.Println("  operations - Generate the operation registry of all APIs (operations/registry.go)")
+	fmt.Println("  sdk        - Generate the client aggregating all APIs (sdk/sdk.go)")
+	fmt.Println("  all        - Generate everything")
 	fmt.Println()
-	fmt.Println("Note: This tool provides guidance. Actual generation is done via PowerShell scripts.")
+	fmt.Println("Flags:")
+	flags.SetOutput(os.Stdout)
+	flags.PrintDefaults()
 }
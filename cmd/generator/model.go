@@ -0,0 +1,563 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/openapi"
+)
+
+// schemaKind classifies a definition the way swagger-codegen does.
+type schemaKind int
+
+const (
+	kindStruct schemaKind = iota
+	kindEnum
+	kindArray
+	kindMap
+	kindPrimitive
+)
+
+// Field names recognised as the two halves of a monetary amount.
+var (
+	moneyAmountFields   = []string{"amount", "currencyamount", "value"}
+	moneyCurrencyFields = []string{"currencycode", "code", "unit", "currency"}
+	moneyTypeHints      = []string{"money", "currency", "amount", "price"}
+
+	// currencyAmountHints mark numbers that are amounts in the currency
+	// of a struct-level currencyCode field, unless percentageHints match.
+	currencyAmountHints = []string{"amount", "revenue"}
+	percentageHints     = []string{"penetration", "percent"}
+)
+
+// modelGenerator renders the model_*.go files of one API.
+type modelGenerator struct {
+	doc      *openapi.Document
+	pkg      string
+	header   string
+	pending  map[string]*openapi.Schema
+	requests map[*openapi.Schema]bool
+}
+
+// generateModels adds one file per struct or enum definition to out.
+func generateModels(api APIConfig, doc *openapi.Document, out *Output) error {
+	g := &modelGenerator{
+		doc:     doc,
+		pkg:     api.Package(),
+		header:  modelHeader(doc),
+		pending: make(map[string]*openapi.Schema),
+	}
+	g.requests = g.requestSchemas()
+
+	names := make([]string, 0, len(doc.Definitions))
+	for name := range doc.Definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	files := make(map[string]string)
+	render := func(goType, file string, schema *openapi.Schema) error {
+		var src string
+		switch g.kind(schema) {
+		case kindEnum:
+			src = g.enum(goType, schema)
+		case kindStruct:
+			src = g.structure(goType, schema)
+		default:
+			return nil
+		}
+
+		if _, dup := files[file]; dup {
+			return fmt.Errorf("%s: two models map to %s", api.Dir(), file)
+		}
+		files[file] = src
+		return out.Add(api.Dir()+"/"+file, []byte(src))
+	}
+
+	for _, name := range names {
+		if err := render(typeName(name), fileName(camelize(name)), doc.Definitions[name]); err != nil {
+			return err
+		}
+	}
+
+	// inline object properties become named models of their own
+	for len(g.pending) > 0 {
+		inline := make([]string, 0, len(g.pending))
+		for goType := range g.pending {
+			inline = append(inline, goType)
+		}
+		sort.Strings(inline)
+
+		for _, goType := range inline {
+			schema := g.pending[goType]
+			delete(g.pending, goType)
+			if err := render(goType, fileName(goType), schema); err != nil {
+				return err
+			}
+		}
+	}
+
+	out.OwnModels(api.Dir())
+	return nil
+}
+
+// modelGeneratedBy is the generator credit in model file headers. It
+// matches the committed models so that -check only reports real drift.
+const modelGeneratedBy = "Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)"
+
+// modelHeader returns the block comment at the top of every model file.
+func modelHeader(doc *openapi.Document) string {
+	var b strings.Builder
+	b.WriteString("/*\n")
+	b.WriteString(" * " + blockText(doc.Title) + "\n")
+	b.WriteString(" *\n")
+	if doc.Description != "" {
+		b.WriteString(" * " + blockText(doc.Description) + "\n")
+		b.WriteString(" *\n")
+	}
+	b.WriteString(" * API version: " + doc.Version + "\n")
+	b.WriteString(" * Generated by: " + modelGeneratedBy + "\n")
+	b.WriteString(" */\n")
+	return b.String()
+}
+
+// blockText flattens text to one line that is safe inside /* */.
+func blockText(text string) string {
+	text = strings.ReplaceAll(text, "*/", "* /")
+	return oneLine(text)
+}
+
+// oneLine joins the lines of a description with spaces.
+func oneLine(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	return strings.TrimSpace(strings.ReplaceAll(text, "\n", " "))
+}
+
+// kind classifies a definition.
+func (g *modelGenerator) kind(schema *openapi.Schema) schemaKind {
+	switch {
+	case schema == nil:
+		return kindPrimitive
+	case len(schema.Enum) > 0 && (schema.Type == "" || schema.Type == "string"):
+		return kindEnum
+	case schema.Type == "array":
+		return kindArray
+	case len(schema.Properties) == 0 && len(schema.AllOf) == 0 && schema.AdditionalProperties != nil:
+		return kindMap
+	case schema.Type == "string", schema.Type == "integer", schema.Type == "number", schema.Type == "boolean":
+		return kindPrimitive
+	default:
+		return kindStruct
+	}
+}
+
+// enum renders a string enum definition.
+func (g *modelGenerator) enum(goType string, schema *openapi.Schema) string {
+	var b strings.Builder
+	b.WriteString(g.header)
+	fmt.Fprintf(&b, "package %s\n\n", g.pkg)
+
+	if schema.Description != "" {
+		fmt.Fprintf(&b, "// %s : %s\n", goType, oneLine(schema.Description))
+	}
+	fmt.Fprintf(&b, "type %s string\n\n", goType)
+	fmt.Fprintf(&b, "// List of %s\n", goType)
+	b.WriteString("const (\n")
+
+	seen := make(map[string]bool)
+	for _, value := range schema.Enum {
+		text := fmt.Sprint(value)
+		name := enumConstName(text, goType)
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		fmt.Fprintf(&b, "\t%s %s = %q\n", name, goType, text)
+	}
+	b.WriteString(")\n")
+	return b.String()
+}
+
+// field is one rendered struct field.
+type field struct {
+	name    string
+	json    string
+	goType  string
+	comment string
+	omit    bool
+	schema  *openapi.Schema
+}
+
+// structure renders an object definition.
+func (g *modelGenerator) structure(goType string, schema *openapi.Schema) string {
+	properties, required := g.flatten(schema)
+
+	fields := make([]*field, 0, len(properties))
+	for _, prop := range properties {
+		fieldSchema := prop.schema
+		f := &field{
+			name:   camelize(prop.name),
+			json:   prop.name,
+			goType: g.goType(fieldSchema, goType+camelize(prop.name), true),
+			omit:   !required[prop.name],
+			schema: fieldSchema,
+		}
+		if fieldSchema.Ref == "" && fieldSchema.Description != "" {
+			f.comment = oneLine(fieldSchema.Description)
+		}
+		fields = append(fields, f)
+	}
+
+	amount, currency := g.moneyFields(goType, fields)
+	g.siblingAmounts(fields)
+
+	var validation string
+	if g.requests[schema] {
+		validation = g.validation(goType, fields)
+	}
+
+	var b strings.Builder
+	b.WriteString(g.header)
+	fmt.Fprintf(&b, "package %s\n\n", g.pkg)
+
+	imports := make(map[string]bool)
+	for _, f := range fields {
+		if strings.Contains(f.goType, "time.Time") {
+			imports["time"] = true
+		}
+		if strings.Contains(f.goType, "spapi.") {
+			imports[spapiImport] = true
+		}
+	}
+	if validation != "" {
+		imports[codecImport] = true
+		if strings.Contains(validation, "strconv.") {
+			imports["strconv"] = true
+		}
+	}
+	if len(imports) > 0 {
+		b.WriteString("import (\n")
+		for _, path := range sortedKeys(imports) {
+			fmt.Fprintf(&b, "\t%q\n", path)
+		}
+		b.WriteString(")\n\n")
+	}
+
+	if schema.Description != "" {
+		b.WriteString(commentLines(oneLine(schema.Description)))
+	}
+	fmt.Fprintf(&b, "type %s struct {\n", goType)
+	for _, f := range fields {
+		if f.comment != "" {
+			b.WriteString(commentLines(f.comment))
+		}
+		tag := f.json
+		switch {
+		case f.omit && isDecimal(f.goType):
+			// omitempty has no effect on structs; omitzero uses IsZero.
+			tag += ",omitzero"
+		case f.omit:
+			tag += ",omitempty"
+		}
+		fmt.Fprintf(&b, "\t%s %s `json:%q`\n", f.name, f.goType, tag)
+	}
+	b.WriteString("}\n")
+
+	if amount != nil {
+		b.WriteString("\n")
+		b.WriteString(toMoney(goType, amount, currency))
+	}
+	b.WriteString(validation)
+
+	return b.String()
+}
+
+// property is a named property of a flattened object.
+type property struct {
+	name   string
+	schema *openapi.Schema
+}
+
+// flatten merges allOf members into one ordered property list.
+func (g *modelGenerator) flatten(schema *openapi.Schema) ([]property, map[string]bool) {
+	var properties []property
+	required := make(map[string]bool)
+	index := make(map[string]int)
+
+	var visit func(s *openapi.Schema, depth int)
+	visit = func(s *openapi.Schema, depth int) {
+		if s == nil || depth > 16 {
+			return
+		}
+		if s.Ref != "" {
+			visit(g.doc.Resolve(s), depth+1)
+			return
+		}
+		for _, member := range s.AllOf {
+			visit(member, depth+1)
+		}
+		for _, name := range s.PropertyNames() {
+			if i, ok := index[name]; ok {
+				properties[i].schema = s.Properties[name]
+				continue
+			}
+			index[name] = len(properties)
+			properties = append(properties, property{name: name, schema: s.Properties[name]})
+		}
+		for _, name := range s.Required {
+			required[name] = true
+		}
+	}
+	visit(schema, 0)
+
+	return properties, required
+}
+
+// goType returns the Go type of a property schema.
+//
+// top is true for struct fields, where references to objects and enums
+// are pointers; array elements reference them by value.
+func (g *modelGenerator) goType(schema *openapi.Schema, inlineName string, top bool) string {
+	if schema == nil {
+		return "interface{}"
+	}
+
+	if schema.Ref == "" && len(schema.AllOf) == 1 && len(schema.Properties) == 0 {
+		schema = schema.AllOf[0]
+	}
+
+	if schema.Ref != "" {
+		name := openapi.RefName(schema.Ref)
+		definition, ok := g.doc.Definitions[name]
+		if !ok {
+			return "interface{}"
+		}
+
+		pointer := ""
+		if top {
+			pointer = "*"
+		}
+
+		switch g.kind(definition) {
+		case kindArray:
+			return pointer + "[]" + g.goType(definition.Items, typeName(name)+"Item", false)
+		case kindMap:
+			return pointer + g.mapType(definition, typeName(name)+"Value")
+		case kindPrimitive:
+			return pointer + primitiveType(definition)
+		default:
+			return pointer + typeName(name)
+		}
+	}
+
+	switch schema.Type {
+	case "array":
+		return "[]" + g.goType(schema.Items, inlineName+"Item", false)
+	case "string", "integer", "number", "boolean":
+		return primitiveType(schema)
+	}
+
+	if len(schema.Properties) > 0 || len(schema.AllOf) > 0 {
+		goType := typeName(inlineName)
+		if _, exists := g.doc.Definitions[goType]; !exists {
+			g.pending[goType] = schema
+		}
+		if top {
+			return "*" + goType
+		}
+		return goType
+	}
+	if schema.AdditionalProperties != nil || schema.Type == "object" {
+		return g.mapType(schema, inlineName+"Value")
+	}
+	return "interface{}"
+}
+
+// mapType returns the Go map type of an object with additionalProperties.
+func (g *modelGenerator) mapType(schema *openapi.Schema, inlineName string) string {
+	if schema.AdditionalProperties == nil || schema.AdditionalProperties.Schema == nil {
+		return "map[string]interface{}"
+	}
+	return "map[string]" + g.goType(schema.AdditionalProperties.Schema, inlineName, false)
+}
+
+// primitiveType maps a primitive schema to a Go type.
+func primitiveType(schema *openapi.Schema) string {
+	switch schema.Type {
+	case "string":
+		if schema.Format == "date-time" || schema.Format == "date" {
+			return "time.Time"
+		}
+		return "string"
+	case "integer":
+		if schema.Format == "int64" {
+			return "int64"
+		}
+		return "int32"
+	case "number":
+		if schema.Format == "float" {
+			return "float32"
+		}
+		return "float64"
+	case "boolean":
+		return "bool"
+	}
+	return "interface{}"
+}
+
+// moneyFields detects amount/currency pairs such as orders Money or
+// finances Currency and switches the amount to an exact decimal.
+//
+// It returns the amount and currency fields, or nil when the type is
+// not a monetary amount.
+func (g *modelGenerator) moneyFields(goType string, fields []*field) (*field, *field) {
+	if len(fields) != 2 || !containsFold(goType, moneyTypeHints) {
+		return nil, nil
+	}
+
+	var amount, currency *field
+	for _, f := range fields {
+		name := strings.ToLower(f.json)
+		switch {
+		case amount == nil && oneOf(name, moneyAmountFields) && (isFloat(f.goType) || isString(f.goType)):
+			amount = f
+		case currency == nil && oneOf(name, moneyCurrencyFields):
+			currency = f
+		}
+	}
+	if amount == nil || currency == nil {
+		return nil, nil
+	}
+
+	if isString(amount.goType) {
+		amount.goType = "spapi.DecimalString"
+	} else {
+		amount.goType = "spapi.Decimal"
+	}
+	return amount, currency
+}
+
+// siblingAmounts switches numbers that carry their currency elsewhere in
+// the struct to decimals: XAmount next to an XCurrency field (e.g. seller
+// wallet balanceAmount/balanceCurrency), and revenue or amount fields of
+// a struct with a currencyCode field (e.g. replenishment metrics).
+// Percentages such as revenuePenetration stay floats.
+func (g *modelGenerator) siblingAmounts(fields []*field) {
+	names := make(map[string]bool, len(fields))
+	currencyCode := false
+	for _, f := range fields {
+		names[f.json] = true
+		if strings.EqualFold(f.json, "currencyCode") {
+			currencyCode = true
+		}
+	}
+
+	for _, f := range fields {
+		if !isFloat(f.goType) {
+			continue
+		}
+		prefix, ok := strings.CutSuffix(f.json, "Amount")
+		sibling := ok && prefix != "" && names[prefix+"Currency"]
+		priced := currencyCode && containsFold(f.json, currencyAmountHints) && !containsFold(f.json, percentageHints)
+		if sibling || priced {
+			f.goType = "spapi.Decimal"
+		}
+	}
+}
+
+// toMoney renders the ToMoney method of a monetary type.
+func toMoney(goType string, amount, currency *field) string {
+	recv := receiverName(goType)
+
+	value := recv + "." + amount.name
+	if amount.goType == "spapi.DecimalString" {
+		value += ".Decimal"
+	}
+
+	var b strings.Builder
+	b.WriteString("// ToMoney 转换为 spapi.Money。\n")
+	fmt.Fprintf(&b, "func (%s %s) ToMoney() spapi.Money {\n", recv, goType)
+
+	switch {
+	case currency.goType == "string":
+		fmt.Fprintf(&b, "\treturn spapi.NewMoney(%s, %s.%s)\n", value, recv, currency.name)
+	case strings.HasPrefix(currency.goType, "*"):
+		b.WriteString("\tcurrency := \"\"\n")
+		fmt.Fprintf(&b, "\tif %s.%s != nil {\n", recv, currency.name)
+		fmt.Fprintf(&b, "\t\tcurrency = string(*%s.%s)\n", recv, currency.name)
+		b.WriteString("\t}\n")
+		fmt.Fprintf(&b, "\treturn spapi.NewMoney(%s, currency)\n", value)
+	default:
+		fmt.Fprintf(&b, "\treturn spapi.NewMoney(%s, string(%s.%s))\n", value, recv, currency.name)
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// isDecimal reports whether goType is one of the spapi decimal types.
+func isDecimal(goType string) bool {
+	return goType == "spapi.Decimal" || goType == "spapi.DecimalString"
+}
+
+// isFloat reports whether goType is a floating point type.
+func isFloat(goType string) bool {
+	return goType == "float64" || goType == "float32" || goType == "*float64" || goType == "*float32"
+}
+
+// isString reports whether goType is a plain string type.
+func isString(goType string) bool {
+	return goType == "string" || goType == "*string"
+}
+
+// oneOf reports whether s is in list.
+func oneOf(s string, list []string) bool {
+	for _, item := range list {
+		if s == item {
+			return true
+		}
+	}
+	return false
+}
+
+// containsFold reports whether s contains any of the hints, ignoring case.
+func containsFold(s string, hints []string) bool {
+	lower := strings.ToLower(s)
+	for _, hint := range hints {
+		if strings.Contains(lower, hint) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedKeys returns the keys of a set in sorted order.
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
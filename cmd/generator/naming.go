@@ -0,0 +1,137 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package main
+
+import (
+	"go/token"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var (
+	acronymBoundary = regexp.MustCompile(`([A-Z]+)([A-Z][a-z])`)
+	wordBoundary    = regexp.MustCompile(`([a-z\d])([A-Z])`)
+	nonWord         = regexp.MustCompile(`[^A-Za-z0-9_]+`)
+)
+
+// reservedTypeNames are model names that would clash with Go built-ins
+// and are prefixed with "Model", following swagger-codegen.
+var reservedTypeNames = map[string]bool{
+	"Error": true, "Return": true, "String": true, "Int": true, "Bool": true,
+	"Float32": true, "Float64": true, "Byte": true, "Rune": true,
+}
+
+// camelize converts a schema or property name to an exported Go name,
+// e.g. "order_items" -> "OrderItems", "ASIN" -> "ASIN".
+func camelize(name string) string {
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var b strings.Builder
+	for _, part := range parts {
+		b.WriteString(strings.ToUpper(part[:1]))
+		b.WriteString(part[1:])
+	}
+
+	result := b.String()
+	if result == "" {
+		return "Value"
+	}
+	if unicode.IsDigit(rune(result[0])) {
+		result = "Var" + result
+	}
+	return result
+}
+
+// underscore converts a camel case name to snake case,
+// e.g. "GetFeedsResponse" -> "get_feeds_response".
+func underscore(name string) string {
+	name = acronymBoundary.ReplaceAllString(name, "${1}_${2}")
+	name = wordBoundary.ReplaceAllString(name, "${1}_${2}")
+	name = strings.ReplaceAll(name, "-", "_")
+	return strings.ToLower(name)
+}
+
+// typeName returns the Go type name of a definition.
+func typeName(definition string) string {
+	name := camelize(definition)
+	if reservedTypeNames[name] {
+		return "Model" + name
+	}
+	return name
+}
+
+// fileName returns the model file name of a camelized schema name.
+func fileName(name string) string {
+	return "model_" + underscore(name) + ".go"
+}
+
+// enumConstName returns the constant name of an enum value,
+// e.g. ("PendingSchedule", "Status") -> "PENDING_SCHEDULE_Status".
+func enumConstName(value, goType string) string {
+	name := strings.ToUpper(underscore(value))
+	name = strings.Trim(nonWord.ReplaceAllString(name, "_"), "_")
+	if name == "" {
+		name = "EMPTY"
+	}
+	if unicode.IsDigit(rune(name[0])) {
+		name = "_" + name
+	}
+	return name + "_" + goType
+}
+
+// paramName returns a Go identifier for a path parameter.
+func paramName(name string) string {
+	name = camelize(name)
+	name = strings.ToLower(name[:1]) + name[1:]
+	if token.IsKeyword(name) {
+		name += "Param"
+	}
+	return name
+}
+
+// receiverName returns the method receiver name for a type.
+func receiverName(goType string) string {
+	return strings.ToLower(goType[:1])
+}
+
+// commentLines formats text as // comment lines.
+func commentLines(text string) string {
+	text = strings.TrimSpace(strings.ReplaceAll(text, "\r\n", "\n"))
+	if text == "" {
+		return ""
+	}
+
+	var b strings.Builder
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimRight(line, " \t")
+		if line == "" {
+			b.WriteString("//\n")
+			continue
+		}
+		b.WriteString("// ")
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,281 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// operationsDir is the directory of the operation registry package,
+// relative to the output root.
+const operationsDir = "operations"
+
+// operationDocPattern matches the line generated client methods carry
+// in their doc comment.
+var operationDocPattern = regexp.MustCompile(`^Method: ([A-Z]+) \| Path: (\S+)$`)
+
+// registryOperation is one Client operation listed in the registry.
+type registryOperation struct {
+	api        APIConfig
+	method     apiMethod
+	httpMethod string
+	path       string
+	pathParams []string
+	input      string
+	iterator   *apiMethod
+}
+
+// generateOperations renders the cross-API operation registry.
+//
+// Like the interfaces it is derived from the package sources: every
+// generated Client method (recognised by its "Method: | Path:" doc line)
+// becomes one entry, and an Iterate* method that calls the operation is
+// attached to it for paging.
+func generateOperations(apis []APIConfig, out *Output) error {
+	var ops []registryOperation
+	for _, api := range apis {
+		sources, err := out.Sources(api.Dir(), "api.go", "fake.go")
+		if err != nil {
+			return err
+		}
+		methods, _, err := clientAPI(api.Dir(), sources)
+		if err != nil {
+			return err
+		}
+		apiOps, err := registryOperations(api, methods)
+		if err != nil {
+			return err
+		}
+		ops = append(ops, apiOps...)
+	}
+
+	var b strings.Builder
+	b.WriteString(licenseHeader)
+	b.WriteString("package operations\n\n")
+	b.WriteString("import (\n\t\"context\"\n\t\"iter\"\n\n")
+	fmt.Fprintf(&b, "\t%q\n", spapiImport)
+	for _, api := range apis {
+		fmt.Fprintf(&b, "\t%s %q\n", api.Package(), spapiImport+"/"+api.Dir())
+	}
+	b.WriteString(")\n\n")
+
+	b.WriteString("// registry lists the operations of every API package.\n")
+	b.WriteString("var registry = []*Operation{\n")
+	for _, op := range ops {
+		renderRegistryOperation(&b, op)
+	}
+	b.WriteString("}\n")
+
+	if err := out.Add(operationsDir+"/registry.go", []byte(b.String())); err != nil {
+		return err
+	}
+	if err := generateRoutes(ops, out); err != nil {
+		return err
+	}
+	return generateGrantless(apis, ops, out)
+}
+
+// generateRoutes renders operation_routes.go in the core package: the
+// route and name of every operation, which the client uses to label
+// metrics, spans and rate limiters by operation rather than by the
+// request path with its IDs. Routes are sorted by path, so a literal
+// segment is tried before a path parameter in the same position.
+func generateRoutes(ops []registryOperation, out *Output) error {
+	routes := slices.Clone(ops)
+	slices.SortStableFunc(routes, func(a, b registryOperation) int {
+		return strings.Compare(a.path+" "+a.httpMethod, b.path+" "+b.httpMethod)
+	})
+
+	var b strings.Builder
+	b.WriteString(licenseHeader)
+	b.WriteString("package spapi\n\n")
+	b.WriteString("// operationRoutes lists the route of every operation, named like\n")
+	b.WriteString("// \"orders-v0:getOrder\".\n")
+	b.WriteString("var operationRoutes = []operationRoute{\n")
+	for _, op := range routes {
+		name := op.api.Dir() + ":" + strings.ToLower(op.method.name[:1]) + op.method.name[1:]
+		fmt.Fprintf(&b, "\t{method: %q, path: %q, name: %q},\n", op.httpMethod, op.path, name)
+	}
+	b.WriteString("}\n")
+
+	return out.Add("operation_routes.go", []byte(b.String()))
+}
+
+// generateGrantless renders grantless_operations.go in the core package:
+// the routes of the operations listed under "grantless" in apis.json,
+// which a client holding both credential sets calls with its grantless
+// access token.
+func generateGrantless(apis []APIConfig, ops []registryOperation, out *Output) error {
+	found := make(map[string]bool)
+	var grantless []registryOperation
+	for _, op := range ops {
+		if slices.Contains(op.api.Grantless, op.method.name) {
+			found[op.api.Dir()+"."+op.method.name] = true
+			grantless = append(grantless, op)
+		}
+	}
+	for _, api := range apis {
+		for _, name := range api.Grantless {
+			if !found[api.Dir()+"."+name] {
+				return fmt.Errorf("%s: grantless method %s is not a generated operation", api.Dir(), name)
+			}
+		}
+	}
+	slices.SortStableFunc(grantless, func(a, b registryOperation) int {
+		return strings.Compare(a.path+" "+a.httpMethod, b.path+" "+b.httpMethod)
+	})
+
+	var b strings.Builder
+	b.WriteString(licenseHeader)
+	b.WriteString("package spapi\n\n")
+	b.WriteString("// grantlessOperations lists the operations that take a grantless\n")
+	b.WriteString("// (client_credentials) access token.\n")
+	b.WriteString("var grantlessOperations = []operationRoute{\n")
+	for _, op := range grantless {
+		fmt.Fprintf(&b, "\t{method: %q, path: %q}, // %s.%s\n", op.httpMethod, op.path, op.api.Package(), op.method.name)
+	}
+	b.WriteString("}\n")
+
+	return out.Add("grantless_operations.go", []byte(b.String()))
+}
+
+// registryOperations selects the generated operations of one package.
+func registryOperations(api APIConfig, methods []apiMethod) ([]registryOperation, error) {
+	iterators := make(map[string]*apiMethod)
+	for i := range methods {
+		m := &methods[i]
+		if m.seqItem != "map[string]interface{}" {
+			continue
+		}
+		if _, input, ok := operationShape(m.params); !ok || input != "InputQuery" {
+			continue
+		}
+		for _, call := range m.calls {
+			if _, ok := iterators[call]; !ok {
+				iterators[call] = m
+			}
+		}
+	}
+
+	var ops []registryOperation
+	for _, m := range methods {
+		var match []string
+		for _, line := range m.doc {
+			if match = operationDocPattern.FindStringSubmatch(line); match != nil {
+				break
+			}
+		}
+		if match == nil {
+			continue
+		}
+
+		pathParams, input, ok := operationShape(m.params)
+		if !ok || strings.Join(m.results, ", ") != "interface{}, error" {
+			return nil, fmt.Errorf("%s: %s does not have the signature of a generated operation", api.Dir(), m.name)
+		}
+
+		op := registryOperation{
+			api:        api,
+			method:     m,
+			httpMethod: match[1],
+			path:       match[2],
+			pathParams: pathParams,
+			input:      input,
+		}
+		if it := iterators[m.name]; it != nil {
+			if itParams, _, _ := operationShape(it.params); len(itParams) == len(pathParams) {
+				op.iterator = it
+			}
+		}
+		ops = append(ops, op)
+	}
+	return ops, nil
+}
+
+// operationShape checks that params are a context, string path
+// parameters and an optional query map or body, and returns the path
+// parameter names and the input kind.
+func operationShape(params []apiParam) ([]string, string, bool) {
+	if len(params) == 0 || params[0].typ != "context.Context" {
+		return nil, "", false
+	}
+	params = params[1:]
+
+	input := "InputNone"
+	if n := len(params); n > 0 {
+		switch params[n-1].typ {
+		case "map[string]string":
+			input, params = "InputQuery", params[:n-1]
+		case "interface{}":
+			input, params = "InputBody", params[:n-1]
+		}
+	}
+
+	names := make([]string, len(params))
+	for i, p := range params {
+		if p.typ != "string" {
+			return nil, "", false
+		}
+		names[i] = p.name
+	}
+	return names, input, true
+}
+
+// renderRegistryOperation writes one registry entry.
+func renderRegistryOperation(b *strings.Builder, op registryOperation) {
+	args := []string{"ctx"}
+	for i := range op.pathParams {
+		args = append(args, fmt.Sprintf("p[%d]", i))
+	}
+	callArgs := slices.Clone(args)
+	switch op.input {
+	case "InputQuery":
+		callArgs = append(callArgs, "query")
+	case "InputBody":
+		callArgs = append(callArgs, "body")
+	}
+
+	pathParams := "nil"
+	if len(op.pathParams) > 0 {
+		quoted := make([]string, len(op.pathParams))
+		for i, name := range op.pathParams {
+			quoted[i] = fmt.Sprintf("%q", name)
+		}
+		pathParams = "[]string{" + strings.Join(quoted, ", ") + "}"
+	}
+
+	client := op.api.Package() + ".NewClient(c)"
+	b.WriteString("\t{\n")
+	fmt.Fprintf(b, "\t\tAPI: %q,\n\t\tVersion: %q,\n\t\tName: %q,\n", op.api.Name, op.api.Version, op.method.name)
+	fmt.Fprintf(b, "\t\tMethod: %q,\n\t\tPath: %q,\n", op.httpMethod, op.path)
+	fmt.Fprintf(b, "\t\tPathParams: %s,\n\t\tInput: %s,\n", pathParams, op.input)
+	b.WriteString("\t\tCall: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {\n")
+	fmt.Fprintf(b, "\t\t\treturn %s.%s(%s)\n\t\t},\n", client, op.method.name, strings.Join(callArgs, ", "))
+	if op.iterator != nil {
+		fmt.Fprintf(b, "\t\tIterator: %q,\n", op.iterator.name)
+		b.WriteString("\t\tIterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {\n")
+		fmt.Fprintf(b, "\t\t\treturn %s.%s(%s)\n\t\t},\n", client, op.iterator.name, strings.Join(append(args, "query"), ", "))
+	}
+	b.WriteString("\t},\n")
+}
@@ -0,0 +1,215 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Output collects generated files before they are written or checked.
+type Output struct {
+	root  string
+	files map[string][]byte
+
+	// modelDirs are the package directories whose model_*.go files are
+	// fully owned by the generator; other model files there are stale.
+	modelDirs map[string]bool
+}
+
+// NewOutput creates an Output rooted at the pkg/spapi directory.
+func NewOutput(root string) *Output {
+	return &Output{
+		root:      root,
+		files:     make(map[string][]byte),
+		modelDirs: make(map[string]bool),
+	}
+}
+
+// Add formats src with gofmt and stores it under the relative path.
+func (o *Output) Add(path string, src []byte) error {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("format %s: %w\n%s", path, err, src)
+	}
+	o.files[filepath.ToSlash(path)] = formatted
+	return nil
+}
+
+// OwnModels marks dir as a directory whose model files are all generated.
+func (o *Output) OwnModels(dir string) {
+	o.modelDirs[dir] = true
+}
+
+// Paths returns the generated file paths in sorted order.
+func (o *Output) Paths() []string {
+	paths := make([]string, 0, len(o.files))
+	for path := range o.files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// File returns the generated content of path.
+func (o *Output) File(path string) ([]byte, bool) {
+	content, ok := o.files[path]
+	return content, ok
+}
+
+// Sources returns the non-test Go files of the package directory dir,
+// keyed by file name. Files generated into o take precedence over the
+// files on disk, so later kinds see the output of earlier ones; files
+// named in skip are left out.
+func (o *Output) Sources(dir string, skip ...string) (map[string][]byte, error) {
+	sources := make(map[string][]byte)
+
+	matches, err := filepath.Glob(filepath.Join(o.root, dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	for _, match := range matches {
+		content, err := os.ReadFile(match)
+		if err != nil {
+			return nil, err
+		}
+		sources[filepath.Base(match)] = content
+	}
+	for path, content := range o.files {
+		if filepath.ToSlash(filepath.Dir(path)) == dir && strings.HasSuffix(path, ".go") {
+			sources[filepath.Base(path)] = content
+		}
+	}
+
+	for name := range sources {
+		if strings.HasSuffix(name, "_test.go") || slices.Contains(skip, name) {
+			delete(sources, name)
+		}
+	}
+	return sources, nil
+}
+
+// Write writes all files and removes stale model files.
+func (o *Output) Write() error {
+	for _, path := range o.Paths() {
+		full := filepath.Join(o.root, path)
+		if err := os.MkdirAll(filepath.Dir(full), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(full, o.files[path], 0o644); err != nil {
+			return err
+		}
+	}
+
+	stale, err := o.stale()
+	if err != nil {
+		return err
+	}
+	for _, path := range stale {
+		if err := os.Remove(filepath.Join(o.root, path)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Check compares the generated files with the files on disk.
+//
+// It returns one line per difference: "missing", "modified" or "stale"
+// followed by the path relative to the root.
+func (o *Output) Check() ([]string, error) {
+	var diffs []string
+
+	for _, path := range o.Paths() {
+		current, err := os.ReadFile(filepath.Join(o.root, path))
+		switch {
+		case os.IsNotExist(err):
+			diffs = append(diffs, "missing  "+path)
+		case err != nil:
+			return nil, err
+		case !bytes.Equal(current, o.files[path]):
+			diffs = append(diffs, "modified "+path+firstDifference(current, o.files[path]))
+		}
+	}
+
+	stale, err := o.stale()
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range stale {
+		diffs = append(diffs, "stale    "+path)
+	}
+
+	return diffs, nil
+}
+
+// stale lists model files in owned directories that were not generated.
+func (o *Output) stale() ([]string, error) {
+	var stale []string
+
+	dirs := make([]string, 0, len(o.modelDirs))
+	for dir := range o.modelDirs {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+
+	for _, dir := range dirs {
+		matches, err := filepath.Glob(filepath.Join(o.root, dir, "model_*.go"))
+		if err != nil {
+			return nil, err
+		}
+		for _, match := range matches {
+			path := filepath.ToSlash(filepath.Join(dir, filepath.Base(match)))
+			if _, ok := o.files[path]; !ok {
+				stale = append(stale, path)
+			}
+		}
+	}
+
+	sort.Strings(stale)
+	return stale, nil
+}
+
+// firstDifference describes the first differing line for --check output.
+func firstDifference(current, generated []byte) string {
+	a := strings.Split(string(current), "\n")
+	b := strings.Split(string(generated), "\n")
+
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var left, right string
+		if i < len(a) {
+			left = a[i]
+		}
+		if i < len(b) {
+			right = b[i]
+		}
+		if left != right {
+			return fmt.Sprintf(" (line %d: have %q, want %q)", i+1, left, right)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,196 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// sdkDir is the directory of the aggregated client package, relative to
+// the output root.
+const sdkDir = "sdk"
+
+// sdkField is one API client field of sdk.Client.
+type sdkField struct {
+	name string
+	api  APIConfig
+}
+
+// sdkFields names the field of every API: the latest version of each
+// field name keeps it, older versions get their version appended, e.g.
+// Catalog (v2022-04-01) and CatalogV0.
+func sdkFields(apis []APIConfig) ([]sdkField, error) {
+	latest := make(map[string]string)
+	for _, api := range apis {
+		if name := api.FieldName(); api.Version > latest[name] {
+			latest[name] = api.Version
+		}
+	}
+
+	fields := make([]sdkField, 0, len(apis))
+	seen := make(map[string]string)
+	for _, api := range apis {
+		name := api.FieldName()
+		if api.Version != latest[name] {
+			name += camelize(api.Version)
+		}
+		if dir, ok := seen[name]; ok {
+			return nil, fmt.Errorf("sdk: %s and %s both map to field %s", dir, api.Dir(), name)
+		}
+		seen[name] = api.Dir()
+		fields = append(fields, sdkField{name: name, api: api})
+	}
+
+	sort.Slice(fields, func(i, j int) bool { return fields[i].name < fields[j].name })
+	return fields, nil
+}
+
+// generateSDK renders the sdk package that exposes every API client as
+// a field of one Client sharing a single spapi.Client.
+func generateSDK(apis []APIConfig, out *Output) error {
+	fields, err := sdkFields(apis)
+	if err != nil {
+		return err
+	}
+
+	// The base client is embedded, so a field named like one of its
+	// methods would silently hide the method.
+	promoted, err := baseClientMethods(out)
+	if err != nil {
+		return err
+	}
+	for _, field := range fields {
+		if field.name == "Client" || slices.Contains(promoted, field.name) {
+			return fmt.Errorf("sdk: field %s of %s collides with spapi.Client", field.name, field.api.Dir())
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString(licenseHeader)
+	b.WriteString(`// Package sdk 将所有 API 包聚合到一个客户端中。
+//
+// Client 的每个字段对应一个 API：最新版本使用 API 名称（如 Catalog），
+// 旧版本带版本后缀（如 CatalogV0、CatalogV20201201）。所有 API 客户端共享
+// 同一个 spapi.Client，认证、速率限制、重试和中间件只需配置一次。
+//
+// 示例:
+//
+//	client, err := sdk.New(
+//	    spapi.WithRegion(spapi.RegionNA),
+//	    spapi.WithCredentials(clientID, clientSecret, refreshToken),
+//	)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer client.Close(context.Background())
+//
+//	result, err := client.Reports.CreateReport(ctx, body)
+//	item, err := client.Catalog.GetCatalogItem(ctx, asin, query)
+package sdk
+
+`)
+	b.WriteString("import (\n")
+	fmt.Fprintf(&b, "\t%q\n", spapiImport)
+	for _, api := range apis {
+		fmt.Fprintf(&b, "\t%s %q\n", api.Package(), spapiImport+"/"+api.Dir())
+	}
+	b.WriteString(")\n\n")
+
+	b.WriteString(`// Client 聚合所有 API 客户端。
+//
+// 嵌入的 spapi.Client 是所有 API 共享的基础客户端，Close、RateLimitManager
+// 等方法可直接在 Client 上调用。API 客户端只是基础客户端的轻量包装，
+// 不持有任何资源；访问令牌、HTTP 连接和速率限制器都在第一次请求时才创建。
+//
+// Client 是并发安全的，可以在多个 goroutine 中复用。
+type Client struct {
+	*spapi.Client
+`)
+	for _, field := range fields {
+		fmt.Fprintf(&b, "\n\t// %s 是 %s API %s 客户端。\n", field.name, field.api.Name, field.api.Version)
+		fmt.Fprintf(&b, "\t%s *%s.Client\n", field.name, field.api.Package())
+	}
+	b.WriteString("}\n\n")
+
+	b.WriteString(`// New 创建基础客户端，并基于它创建所有 API 客户端。
+//
+// 参数:
+//   - opts: 基础客户端的配置选项，与 spapi.NewClient 相同
+//
+// 返回值:
+//   - *Client: 聚合客户端
+//   - error: 如果基础客户端创建失败，返回错误
+func New(opts ...spapi.ClientOption) (*Client, error) {
+	base, err := spapi.NewClient(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return NewFromClient(base), nil
+}
+
+// NewFromClient 基于已有的基础客户端创建所有 API 客户端。
+//
+// 参数:
+//   - base: 共享的基础客户端
+//
+// 返回值:
+//   - *Client: 聚合客户端
+func NewFromClient(base *spapi.Client) *Client {
+	return &Client{
+		Client: base,
+`)
+	for _, field := range fields {
+		fmt.Fprintf(&b, "\t\t%s: %s.NewClient(base),\n", field.name, field.api.Package())
+	}
+	b.WriteString("\t}\n}\n")
+
+	return out.Add(sdkDir+"/sdk.go", []byte(b.String()))
+}
+
+// baseClientMethods returns the exported methods of spapi.Client found
+// under the output root.
+func baseClientMethods(out *Output) ([]string, error) {
+	sources, err := out.Sources(".")
+	if err != nil {
+		return nil, err
+	}
+
+	var methods []string
+	fset := token.NewFileSet()
+	for name, src := range sources {
+		file, err := parser.ParseFile(fset, name, src, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, fmt.Errorf("spapi: %w", err)
+		}
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && isClientMethod(fn) {
+				methods = append(methods, fn.Name.Name)
+			}
+		}
+	}
+	return methods, nil
+}
@@ -0,0 +1,337 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/openapi"
+)
+
+// codecImport is the import path of the validation helpers used by
+// generated Validate methods.
+const codecImport = "github.com/vanling1111/amazon-sp-api-go-sdk/internal/codec"
+
+// requestSchemas returns every schema reachable from a request body.
+//
+// Structs built from these schemas are request models and get a
+// Validate method; the map is keyed by pointer so that inline objects
+// are recognised as well as named definitions.
+func (g *modelGenerator) requestSchemas() map[*openapi.Schema]bool {
+	seen := make(map[*openapi.Schema]bool)
+
+	var visit func(s *openapi.Schema)
+	visit = func(s *openapi.Schema) {
+		if s == nil || seen[s] {
+			return
+		}
+		seen[s] = true
+
+		if s.Ref != "" {
+			visit(g.doc.Resolve(s))
+		}
+		for _, member := range s.AllOf {
+			visit(member)
+		}
+		for _, name := range s.PropertyNames() {
+			visit(s.Properties[name])
+		}
+		visit(s.Items)
+		if s.AdditionalProperties != nil {
+			visit(s.AdditionalProperties.Schema)
+		}
+	}
+
+	for _, op := range g.doc.Operations {
+		visit(op.Body)
+	}
+	return seen
+}
+
+// validation renders the Validate method of a request model.
+//
+// Checks follow the Go type of each field: required strings must be
+// non-empty, required pointers, slices and maps non-nil and required
+// times non-zero; times must also fit their date or date-time format.
+// Query and path parameters are not validated. Optional fields are only checked when set, since the
+// zero value is what omitempty leaves out of the request.
+func (g *modelGenerator) validation(goType string, fields []*field) string {
+	var checks strings.Builder
+	for _, f := range fields {
+		mode := checkOptional
+		if !f.omit {
+			mode = checkRequired
+		}
+		g.checks(&checks, "m."+f.name, f.goType, fmt.Sprintf("path + %q", f.json), f.schema, mode)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "\n// Validate 检查 %s 是否满足 API 模型中的约束：必需字段、长度、模式、\n", goType)
+	b.WriteString("// 数值范围、数组大小、枚举值和日期格式。启用 spapi.WithRequestValidation 时，请求发送前会自动调用。\n")
+	fmt.Fprintf(&b, "func (m %s) Validate() error {\n", goType)
+	b.WriteString("\tv := codec.NewValidator()\n")
+	b.WriteString("\tm.validate(v, \"\")\n")
+	b.WriteString("\treturn v.Error()\n")
+	b.WriteString("}\n\n")
+	b.WriteString("// validate 将错误记录到 v，字段名以 path 为前缀。\n")
+	fmt.Fprintf(&b, "func (m *%s) validate(v *codec.Validator, path string) {\n", goType)
+	b.WriteString(checks.String())
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// checkMode says whether a value must be present.
+type checkMode int
+
+const (
+	// checkRequired values must be set
+	checkRequired checkMode = iota
+
+	// checkOptional values are only checked when set
+	checkOptional
+
+	// checkPresent values are known to be set, e.g. array elements
+	checkPresent
+)
+
+// checks writes the validation of one value.
+//
+// expr is the Go expression of the value, goType its type, name the Go
+// expression of the field name reported in errors, and schema the
+// property schema the constraints come from.
+func (g *modelGenerator) checks(b *strings.Builder, expr, goType, name string, schema *openapi.Schema, mode checkMode) {
+	s := g.constraints(schema)
+
+	switch {
+	case strings.HasPrefix(goType, "*"):
+		inner := goType[1:]
+		var body strings.Builder
+		if g.isStruct(inner, s) {
+			g.checks(&body, expr, inner, name, schema, checkPresent)
+		} else {
+			g.checks(&body, "(*"+expr+")", inner, name, schema, checkPresent)
+		}
+		presence(b, expr, name, body.String(), mode)
+
+	case strings.HasPrefix(goType, "[]"):
+		g.sliceChecks(b, expr, goType, name, s, mode)
+
+	case strings.Contains(goType, "spapi."), goType == "bool":
+		return
+
+	case strings.HasPrefix(goType, "map["), goType == "interface{}":
+		presence(b, expr, name, "", mode)
+
+	case goType == "time.Time":
+		timeChecks(b, expr, name, s, mode)
+
+	case goType == "string":
+		g.stringChecks(b, expr, name, s, mode)
+
+	case isNumber(goType):
+		numberChecks(b, expr, name, s, mode)
+
+	case g.kind(s) == kindEnum:
+		value := expr
+		if strings.HasPrefix(value, "(") && strings.HasSuffix(value, ")") {
+			value = value[1 : len(value)-1]
+		}
+		fmt.Fprintf(b, "v.OneOf(%s, string(%s), %s)\n", name, value, enumValues(s))
+
+	case g.isStruct(goType, s):
+		fmt.Fprintf(b, "%s.validate(v, %s)\n", expr, appendName(name, "."))
+	}
+}
+
+// sliceChecks writes the size checks of a slice and the checks of its
+// elements.
+func (g *modelGenerator) sliceChecks(b *strings.Builder, expr, goType, name string, s *openapi.Schema, mode checkMode) {
+	var minItems string
+	if s.MinItems != nil && *s.MinItems > 0 {
+		minItems = fmt.Sprintf("v.MinItems(%s, len(%s), %d)\n", name, expr, *s.MinItems)
+	}
+	presence(b, expr, name, minItems, mode)
+	if s.MaxItems != nil {
+		fmt.Fprintf(b, "v.MaxItems(%s, len(%s), %d)\n", name, expr, *s.MaxItems)
+	}
+
+	// nested slices get their own index so the range expression does
+	// not see the inner variable
+	index := "i"
+	if depth := strings.Count(expr, "["); depth > 0 {
+		index = fmt.Sprintf("i%d", depth)
+	}
+
+	var body strings.Builder
+	g.checks(&body, expr+"["+index+"]", goType[2:], appendName(name, "[")+"+strconv.Itoa("+index+")+\"]\"", s.Items, checkPresent)
+	if body.Len() > 0 {
+		fmt.Fprintf(b, "for %s := range %s {\n%s}\n", index, expr, body.String())
+	}
+}
+
+// presence writes the checks of a value that is unset when nil: a
+// required value is reported when missing, and body only runs when the
+// value is set.
+func presence(b *strings.Builder, expr, name, body string, mode checkMode) {
+	switch {
+	case mode == checkPresent:
+		b.WriteString(body)
+	case mode == checkRequired && body != "":
+		fmt.Fprintf(b, "if %s == nil {\nv.Custom(%s, \"is required\")\n} else {\n%s}\n", expr, name, body)
+	case mode == checkRequired:
+		fmt.Fprintf(b, "if %s == nil {\nv.Custom(%s, \"is required\")\n}\n", expr, name)
+	case body != "":
+		fmt.Fprintf(b, "if %s != nil {\n%s}\n", expr, body)
+	}
+}
+
+// appendName appends a literal to a field name expression, merging it
+// into a trailing string literal: path + "a" -> path + "a.".
+func appendName(name, suffix string) string {
+	if strings.HasSuffix(name, `"`) {
+		return name[:len(name)-1] + suffix + `"`
+	}
+	return name + "+" + strconv.Quote(suffix)
+}
+
+// stringChecks writes the checks of a string value.
+func (g *modelGenerator) stringChecks(b *strings.Builder, expr, name string, s *openapi.Schema, mode checkMode) {
+	if mode == checkRequired {
+		fmt.Fprintf(b, "v.Required(%s, %s)\n", name, expr)
+	}
+
+	var body strings.Builder
+	if s.MinLength != nil && *s.MinLength > 0 {
+		fmt.Fprintf(&body, "v.MinLength(%s, %s, %d)\n", name, expr, *s.MinLength)
+	}
+	if s.MaxLength != nil {
+		fmt.Fprintf(&body, "v.MaxLength(%s, %s, %d)\n", name, expr, *s.MaxLength)
+	}
+	// ECMA patterns RE2 cannot compile are left to the server
+	if s.Pattern != "" {
+		if _, err := regexp.Compile(s.Pattern); err == nil {
+			fmt.Fprintf(&body, "v.Pattern(%s, %s, %q, %q)\n", name, expr, s.Pattern, "must match pattern "+s.Pattern)
+		}
+	}
+	if len(s.Enum) > 0 {
+		fmt.Fprintf(&body, "v.OneOf(%s, %s, %s)\n", name, expr, enumValues(s))
+	}
+
+	if body.Len() == 0 {
+		return
+	}
+	if mode == checkPresent {
+		b.WriteString(body.String())
+		return
+	}
+	fmt.Fprintf(b, "if %s != \"\" {\n%s}\n", expr, body.String())
+}
+
+// timeChecks writes the format check of a date or date-time value. An
+// unset optional time is zero and is skipped.
+func timeChecks(b *strings.Builder, expr, name string, s *openapi.Schema, mode checkMode) {
+	check := "DateTime"
+	if s.Format == "date" {
+		check = "Date"
+	}
+
+	switch mode {
+	case checkRequired:
+		fmt.Fprintf(b, "if %s.IsZero() {\nv.Custom(%s, \"is required\")\n} else {\nv.%s(%s, %s)\n}\n", expr, name, check, name, expr)
+	case checkOptional:
+		fmt.Fprintf(b, "if !%s.IsZero() {\nv.%s(%s, %s)\n}\n", expr, check, name, expr)
+	default:
+		fmt.Fprintf(b, "v.%s(%s, %s)\n", check, name, expr)
+	}
+}
+
+// numberChecks writes the range checks of a number. Unset optional
+// numbers are zero and are skipped.
+func numberChecks(b *strings.Builder, expr, name string, s *openapi.Schema, mode checkMode) {
+	var body strings.Builder
+	if s.Minimum != nil {
+		fmt.Fprintf(&body, "if %s < %s {\nv.Custom(%s, %q)\n}\n", expr, number(*s.Minimum), name, "must be at least "+number(*s.Minimum))
+	}
+	if s.Maximum != nil {
+		fmt.Fprintf(&body, "if %s > %s {\nv.Custom(%s, %q)\n}\n", expr, number(*s.Maximum), name, "must be at most "+number(*s.Maximum))
+	}
+
+	if body.Len() == 0 {
+		return
+	}
+	if mode == checkOptional {
+		fmt.Fprintf(b, "if %s != 0 {\n%s}\n", expr, body.String())
+		return
+	}
+	b.WriteString(body.String())
+}
+
+// constraints returns the schema whose constraints apply to a property:
+// the referenced definition, or the single allOf member.
+func (g *modelGenerator) constraints(schema *openapi.Schema) *openapi.Schema {
+	if schema == nil {
+		return &openapi.Schema{}
+	}
+	if schema.Ref == "" && len(schema.AllOf) == 1 && len(schema.Properties) == 0 {
+		schema = schema.AllOf[0]
+	}
+	if schema.Ref != "" {
+		if resolved := g.doc.Resolve(schema); resolved != nil {
+			return resolved
+		}
+		return &openapi.Schema{}
+	}
+	return schema
+}
+
+// isStruct reports whether goType names a generated struct.
+func (g *modelGenerator) isStruct(goType string, s *openapi.Schema) bool {
+	if strings.ContainsAny(goType, "[]*{}.") || isNumber(goType) || goType == "string" || goType == "bool" {
+		return false
+	}
+	return g.kind(s) == kindStruct
+}
+
+// isNumber reports whether goType is a generated number type.
+func isNumber(goType string) bool {
+	return oneOf(goType, []string{"int32", "int64", "float32", "float64"})
+}
+
+// number formats a schema bound as a Go constant.
+func number(f float64) string {
+	if f == math.Trunc(f) {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// enumValues renders the allowed values of an enum as a []string literal.
+func enumValues(s *openapi.Schema) string {
+	values := make([]string, len(s.Enum))
+	for i, value := range s.Enum {
+		values[i] = strconv.Quote(fmt.Sprint(value))
+	}
+	return "[]string{" + strings.Join(values, ", ") + "}"
+}
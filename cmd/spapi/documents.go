@@ -0,0 +1,379 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/crypto"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	feeds "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/feeds-v2021-06-30"
+	reports "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/reports-v2021-06-30"
+)
+
+// feedContentTypes maps feed file extensions to document content types.
+var feedContentTypes = map[string]string{
+	".json": "application/json; charset=UTF-8",
+	".xml":  "text/xml; charset=UTF-8",
+	".txt":  "text/tab-separated-values; charset=UTF-8",
+	".tsv":  "text/tab-separated-values; charset=UTF-8",
+}
+
+// workflowFlags are the flags shared by reports fetch and feeds submit.
+type workflowFlags struct {
+	marketplaceIDs string
+	options        keyValues
+	out            string
+	pollInterval   time.Duration
+	timeout        time.Duration
+}
+
+// register adds the shared flags; optionsField names the request field
+// that -option fills.
+func (w *workflowFlags) register(flags *flag.FlagSet, optionsField string) {
+	flags.StringVar(&w.marketplaceIDs, "marketplace-ids", "", "comma-separated marketplace IDs (default: the profile's marketplace_ids)")
+	flags.Var(&w.options, "option", optionsField+" entry `name=value`; may be repeated")
+	flags.StringVar(&w.out, "out", "", "write the document to this file instead of stdout")
+	flags.DurationVar(&w.pollInterval, "poll-interval", 2*time.Second, "initial interval between status checks")
+	flags.DurationVar(&w.timeout, "timeout", 0, "give up after this long (0 waits indefinitely)")
+}
+
+// marketplaces returns the -marketplace-ids values or the profile
+// defaults.
+func (w *workflowFlags) marketplaces(profile *Profile) ([]string, error) {
+	if w.marketplaceIDs != "" {
+		return strings.Split(w.marketplaceIDs, ","), nil
+	}
+	if len(profile.MarketplaceIDs) > 0 {
+		return profile.MarketplaceIDs, nil
+	}
+	return nil, usagef("-marketplace-ids is required (or set marketplace_ids in the profile)")
+}
+
+// context returns the context bounded by -timeout.
+func (w *workflowFlags) context() (context.Context, context.CancelFunc) {
+	if w.timeout > 0 {
+		return context.WithTimeout(context.Background(), w.timeout)
+	}
+	return context.WithCancel(context.Background())
+}
+
+// pollOptions reports each status check on stderr.
+func (c *cli) pollOptions(label string, w *workflowFlags) []spapi.PollerOption {
+	return []spapi.PollerOption{
+		spapi.WithPollInterval(w.pollInterval, max(w.pollInterval, time.Minute), 1.5),
+		spapi.WithPollProgress(func(p spapi.PollProgress) {
+			fmt.Fprintf(c.stderr, "%s: %s\n", label, p.Status)
+		}),
+	}
+}
+
+// writeDocument writes document content to path, or to stdout when path
+// is empty.
+func (c *cli) writeDocument(path string, data []byte) error {
+	if path == "" {
+		_, err := c.stdout.Write(data)
+		return err
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stderr, "wrote %d bytes to %s\n", len(data), path)
+	return nil
+}
+
+// reportsFetch creates a report (or takes an existing one), waits until
+// it is done and downloads its document.
+func (c *cli) reportsFetch(args []string) error {
+	flags := c.flagSet("reports fetch")
+	reportType := flags.String("report-type", "", "report type, e.g. GET_MERCHANT_LISTINGS_ALL_DATA")
+	start := flags.String("start", "", "dataStartTime (ISO 8601)")
+	end := flags.String("end", "", "dataEndTime (ISO 8601)")
+	reportID := flags.String("report-id", "", "download an existing report instead of creating one")
+	var w workflowFlags
+	w.register(flags, "reportOptions")
+	flags.Usage = func() {
+		fmt.Fprint(c.stderr, "Usage: spapi reports fetch -report-type TYPE [flags]\n\nCreates a report, waits for it and writes the decrypted, decompressed document.\n\nFlags:\n")
+		flags.PrintDefaults()
+	}
+
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if *reportType == "" && *reportID == "" {
+		return usagef("-report-type or -report-id is required")
+	}
+
+	client, profile, err := c.client()
+	if err != nil {
+		return err
+	}
+	defer client.Close(context.Background())
+	api := reports.NewClient(client)
+	ctx, cancel := w.context()
+	defer cancel()
+
+	id := *reportID
+	if id == "" {
+		marketplaces, err := w.marketplaces(profile)
+		if err != nil {
+			return err
+		}
+		spec := map[string]interface{}{"reportType": *reportType, "marketplaceIds": marketplaces}
+		if *start != "" {
+			spec["dataStartTime"] = *start
+		}
+		if *end != "" {
+			spec["dataEndTime"] = *end
+		}
+		if options := w.options.Map(); options != nil {
+			spec["reportOptions"] = options
+		}
+
+		created, err := api.CreateReport(ctx, spec)
+		if err != nil {
+			return err
+		}
+		if id = stringField(created, "reportId"); id == "" {
+			return errors.New("CreateReport returned no reportId")
+		}
+		fmt.Fprintf(c.stderr, "report %s created\n", id)
+	}
+
+	report, waitErr := api.WaitForReport(ctx, id, c.pollOptions("report "+id, &w)...)
+	documentID := stringField(report, "reportDocumentId")
+	if waitErr != nil && (!errors.Is(waitErr, spapi.ErrPollFailed) || documentID == "") {
+		return fmt.Errorf("report %s: %w", id, waitErr)
+	}
+
+	document, err := api.GetReportDocument(ctx, documentID, nil)
+	if err != nil {
+		return err
+	}
+	data, err := download(ctx, document)
+	if err != nil {
+		return fmt.Errorf("report document %s: %w", documentID, err)
+	}
+
+	if waitErr != nil {
+		// The document of a failed report describes the failure.
+		fmt.Fprintf(c.stderr, "%s\n", bytes.TrimSpace(data))
+		return fmt.Errorf("report %s: %w", id, waitErr)
+	}
+	return c.writeDocument(w.out, data)
+}
+
+// feedsSubmit uploads a feed document, creates the feed, waits until it
+// is processed and writes the processing report.
+func (c *cli) feedsSubmit(args []string) error {
+	flags := c.flagSet("feeds submit")
+	feedType := flags.String("feed-type", "", "feed type, e.g. JSON_LISTINGS_FEED")
+	file := flags.String("file", "", "feed content file, or - for stdin")
+	contentType := flags.String("content-type", "", "feed document content type (default: from the file extension)")
+	noWait := flags.Bool("no-wait", false, "print the created feed instead of waiting for the processing report")
+	var w workflowFlags
+	w.register(flags, "feedOptions")
+	flags.Usage = func() {
+		fmt.Fprint(c.stderr, "Usage: spapi feeds submit -feed-type TYPE -file FILE [flags]\n\nUploads the feed, waits for processing and writes the processing report.\n\nFlags:\n")
+		flags.PrintDefaults()
+	}
+
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if *feedType == "" || *file == "" {
+		return usagef("-feed-type and -file are required")
+	}
+	if *contentType == "" {
+		if *contentType = feedContentTypes[strings.ToLower(filepath.Ext(*file))]; *contentType == "" {
+			return usagef("cannot infer the content type of %q; set -content-type", *file)
+		}
+	}
+
+	var content []byte
+	var err error
+	if *file == "-" {
+		content, err = io.ReadAll(c.stdin)
+	} else {
+		content, err = os.ReadFile(*file)
+	}
+	if err != nil {
+		return fmt.Errorf("read feed: %w", err)
+	}
+
+	client, profile, err := c.client()
+	if err != nil {
+		return err
+	}
+	defer client.Close(context.Background())
+	marketplaces, err := w.marketplaces(profile)
+	if err != nil {
+		return err
+	}
+	api := feeds.NewClient(client)
+	ctx, cancel := w.context()
+	defer cancel()
+
+	document, err := api.CreateFeedDocument(ctx, map[string]interface{}{"contentType": *contentType})
+	if err != nil {
+		return err
+	}
+	documentID := stringField(document, "feedDocumentId")
+	if err := upload(ctx, stringField(document, "url"), *contentType, content); err != nil {
+		return fmt.Errorf("feed document %s: %w", documentID, err)
+	}
+
+	spec := map[string]interface{}{
+		"feedType":            *feedType,
+		"marketplaceIds":      marketplaces,
+		"inputFeedDocumentId": documentID,
+	}
+	if options := w.options.Map(); options != nil {
+		spec["feedOptions"] = options
+	}
+	created, err := api.CreateFeed(ctx, spec)
+	if err != nil {
+		return err
+	}
+	id := stringField(created, "feedId")
+	fmt.Fprintf(c.stderr, "feed %s created\n", id)
+	if *noWait {
+		return writeResult(c.stdout, c.output, created)
+	}
+
+	feed, waitErr := api.WaitForFeed(ctx, id, c.pollOptions("feed "+id, &w)...)
+	resultID := stringField(feed, "resultFeedDocumentId")
+	if waitErr != nil && (!errors.Is(waitErr, spapi.ErrPollFailed) || resultID == "") {
+		return fmt.Errorf("feed %s: %w", id, waitErr)
+	}
+	if resultID == "" {
+		return fmt.Errorf("feed %s finished without a processing report", id)
+	}
+
+	result, err := api.GetFeedDocument(ctx, resultID, nil)
+	if err != nil {
+		return err
+	}
+	data, err := download(ctx, result)
+	if err != nil {
+		return fmt.Errorf("feed document %s: %w", resultID, err)
+	}
+	if err := c.writeDocument(w.out, data); err != nil {
+		return err
+	}
+	if waitErr != nil {
+		return fmt.Errorf("feed %s: %w", id, waitErr)
+	}
+	return nil
+}
+
+// stringField returns a top-level string field of a decoded response.
+func stringField(result interface{}, name string) string {
+	object, _ := result.(map[string]interface{})
+	value, _ := object[name].(string)
+	return value
+}
+
+// download fetches a report or feed document from its presigned URL,
+// decrypting and decompressing it as its metadata describes.
+func download(ctx context.Context, document interface{}) ([]byte, error) {
+	data, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+	var meta struct {
+		URL                  string                    `json:"url"`
+		CompressionAlgorithm string                    `json:"compressionAlgorithm"`
+		EncryptionDetails    *crypto.EncryptionDetails `json:"encryptionDetails"`
+	}
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return nil, err
+	}
+	if meta.URL == "" {
+		return nil, errors.New("document has no URL")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, meta.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("download failed with status %d", resp.StatusCode)
+	}
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if meta.EncryptionDetails != nil {
+		if content, err = crypto.DecryptReport(meta.EncryptionDetails.Key, meta.EncryptionDetails.InitializationVector, content); err != nil {
+			return nil, err
+		}
+	}
+	if strings.EqualFold(meta.CompressionAlgorithm, "GZIP") {
+		reader, err := gzip.NewReader(bytes.NewReader(content))
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		return io.ReadAll(reader)
+	}
+	return content, nil
+}
+
+// upload stores feed content at a presigned feed document URL.
+func upload(ctx context.Context, url, contentType string, content []byte) error {
+	if url == "" {
+		return errors.New("CreateFeedDocument returned no upload URL")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("upload failed with status %d", resp.StatusCode)
+	}
+	return nil
+}
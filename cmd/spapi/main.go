@@ -0,0 +1,275 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+// Package main is spapi, a command line client for the Amazon Selling
+// Partner API built on the SDK's API packages.
+//
+// Credentials come from named profiles in a JSON file ($SPAPI_CONFIG,
+// by default spapi/config.json in the user configuration directory):
+//
+//	{
+//	  "default": "us",
+//	  "profiles": {
+//	    "us": {
+//	      "client_id": "amzn1.application-oa2-client.xxx",
+//	      "client_secret": "$SPAPI_CLIENT_SECRET",
+//	      "refresh_token": "Atzr|xxx",
+//	      "region": "na",
+//	      "marketplace_ids": ["ATVPDKIKX0DER"]
+//	    }
+//	  }
+//	}
+//
+// Every operation of every API package can be called by name:
+//
+//	spapi orders get-order --order-id 902-1845936-5435065
+//	spapi orders get-orders -q MarketplaceIds=ATVPDKIKX0DER -q CreatedAfter=2025-01-01 --all -o table
+//	spapi catalog-items-v0 list-catalog-categories -q MarketplaceId=ATVPDKIKX0DER
+//
+// and the document workflows run end to end:
+//
+//	spapi reports fetch --report-type GET_MERCHANT_LISTINGS_ALL_DATA --out listings.tsv
+//	spapi feeds submit --feed-type JSON_LISTINGS_FEED --file listings.json
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/operations"
+)
+
+// usageError reports invalid command line input (exit status 2).
+type usageError struct {
+	msg string
+
+	// printed is set when the flag package already reported the error
+	printed bool
+}
+
+func (e *usageError) Error() string {
+	return e.msg
+}
+
+// usagef returns a usageError.
+func usagef(format string, args ...interface{}) error {
+	return &usageError{msg: fmt.Sprintf(format, args...)}
+}
+
+// parseFlags parses args, turning flag errors into usage errors.
+func parseFlags(flags *flag.FlagSet, args []string) error {
+	err := flags.Parse(args)
+	if err == nil || errors.Is(err, flag.ErrHelp) {
+		return err
+	}
+	return &usageError{msg: err.Error(), printed: true}
+}
+
+// cli holds the streams and global flags of one invocation.
+type cli struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+
+	config  string
+	profile string
+	output  string
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run executes the command and returns the process exit code: 0 on
+// success, 1 when a request fails and 2 on invalid usage.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	c := &cli{stdin: stdin, stdout: stdout, stderr: stderr, output: formatJSON}
+
+	flags := c.flagSet("spapi")
+	flags.Usage = c.usage
+	err := parseFlags(flags, args)
+	if err == nil {
+		err = c.dispatch(flags.Args())
+	}
+
+	var usage *usageError
+	switch {
+	case err == nil, errors.Is(err, flag.ErrHelp):
+		return 0
+	case errors.As(err, &usage):
+		if !usage.printed {
+			fmt.Fprintln(stderr, "spapi:", err)
+		}
+		return 2
+	default:
+		fmt.Fprintln(stderr, "spapi:", err)
+		return 1
+	}
+}
+
+// dispatch runs the command named by args.
+func (c *cli) dispatch(args []string) error {
+	if len(args) == 0 {
+		c.usage()
+		return usagef("missing command")
+	}
+
+	switch args[0] {
+	case "help":
+		c.usage()
+		return nil
+	case "apis":
+		return c.listAPIs()
+	case "profiles":
+		return c.listProfiles()
+	}
+
+	if len(args) > 1 {
+		switch args[0] + " " + args[1] {
+		case "reports fetch":
+			return c.reportsFetch(args[2:])
+		case "feeds submit":
+			return c.feedsSubmit(args[2:])
+		}
+	}
+	return c.operation(args)
+}
+
+// flagSet returns a FlagSet with the global flags registered, so they
+// are accepted before and after the command.
+func (c *cli) flagSet(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(c.stderr)
+	flags.StringVar(&c.config, "config", c.config, "credentials file (default $SPAPI_CONFIG or <user config dir>/spapi/config.json)")
+	flags.StringVar(&c.profile, "profile", c.profile, "credential profile (default $SPAPI_PROFILE or the config default)")
+	flags.StringVar(&c.output, "o", c.output, "output format: json, table or ndjson")
+	flags.StringVar(&c.output, "output", c.output, "output format: json, table or ndjson")
+	return flags
+}
+
+// loadProfile reads the selected profile.
+func (c *cli) loadProfile() (*Profile, error) {
+	path := c.config
+	if path == "" {
+		path = defaultConfigPath()
+	}
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	_, profile, err := cfg.Profile(c.profile)
+	return profile, err
+}
+
+// client creates an SP-API client from the selected profile.
+func (c *cli) client() (*spapi.Client, *Profile, error) {
+	if !validFormat(c.output) {
+		return nil, nil, usagef("unknown output format %q (json, table or ndjson)", c.output)
+	}
+	profile, err := c.loadProfile()
+	if err != nil {
+		return nil, nil, err
+	}
+	client, err := profile.NewClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	return client, profile, nil
+}
+
+// listAPIs prints every API with its versions.
+func (c *cli) listAPIs() error {
+	var names []string
+	seen := make(map[string]bool)
+	for _, op := range operations.All() {
+		if !seen[op.API] {
+			seen[op.API] = true
+			names = append(names, op.API)
+		}
+	}
+
+	tw := tabwriter.NewWriter(c.stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "API\tVERSIONS")
+	for _, name := range names {
+		versions := operations.Versions(name)
+		versions[len(versions)-1] += " (default)"
+		fmt.Fprintf(tw, "%s\t%s\n", name, strings.Join(versions, ", "))
+	}
+	return tw.Flush()
+}
+
+// listProfiles prints the configured profiles without their secrets.
+func (c *cli) listProfiles() error {
+	path := c.config
+	if path == "" {
+		path = defaultConfigPath()
+	}
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(c.stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "PROFILE\tREGION\tMARKETPLACES")
+	for _, name := range cfg.Names() {
+		_, profile, err := cfg.Profile(name)
+		if err != nil {
+			return err
+		}
+		region := profile.Region
+		if profile.Endpoint != "" {
+			region = profile.Endpoint
+		}
+		if name == cfg.Default {
+			name += " (default)"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", name, region, strings.Join(profile.MarketplaceIDs, ","))
+	}
+	return tw.Flush()
+}
+
+// usage prints the top-level help.
+func (c *cli) usage() {
+	fmt.Fprint(c.stderr, `Usage: spapi [flags] <command>
+
+Commands:
+  apis                          List the APIs and their versions
+  profiles                      List the credential profiles
+  <api>                         List the operations of an API
+  <api> <operation> [flags]     Call an operation, e.g. orders get-order --order-id ID
+  reports fetch [flags]         Create a report, wait for it and download the document
+  feeds submit [flags]          Upload a feed document, create the feed and print its processing report
+
+<api> is an API name (its latest version, e.g. catalog-items) or a package
+directory naming the version (e.g. catalog-items-v0). Run "spapi <api>
+<operation> -h" for the flags of an operation.
+
+Flags:
+`)
+	flags := c.flagSet("spapi")
+	flags.SetOutput(c.stderr)
+	flags.PrintDefaults()
+}
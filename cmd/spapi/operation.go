@@ -0,0 +1,255 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"unicode"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/operations"
+)
+
+// keyValues collects repeated name=value flags. Repeating a name joins
+// the values with commas, the SP-API encoding of array parameters.
+type keyValues struct {
+	names  []string
+	values map[string]string
+}
+
+func (kv *keyValues) String() string {
+	pairs := make([]string, len(kv.names))
+	for i, name := range kv.names {
+		pairs[i] = name + "=" + kv.values[name]
+	}
+	return strings.Join(pairs, "&")
+}
+
+func (kv *keyValues) Set(pair string) error {
+	name, value, ok := strings.Cut(pair, "=")
+	if !ok || name == "" {
+		return fmt.Errorf("want name=value, got %q", pair)
+	}
+	if kv.values == nil {
+		kv.values = make(map[string]string)
+	}
+	if current, ok := kv.values[name]; ok {
+		kv.values[name] = current + "," + value
+		return nil
+	}
+	kv.names = append(kv.names, name)
+	kv.values[name] = value
+	return nil
+}
+
+// Map returns the collected values, or nil when there are none.
+func (kv *keyValues) Map() map[string]string {
+	return kv.values
+}
+
+// kebab converts a Go or JSON identifier to a command line name, e.g.
+// GetOrderItems to get-order-items and sellerSKU to seller-sku.
+func kebab(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (!unicode.IsUpper(runes[i-1]) ||
+				(i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				b.WriteByte('-')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// resolveAPI returns the operations of an API named by its name (the
+// latest version) or by its package directory (e.g. catalog-items-v0).
+func resolveAPI(name string) ([]*operations.Operation, error) {
+	all := operations.All()
+
+	var ops []*operations.Operation
+	for _, op := range all {
+		if op.Package() == name {
+			ops = append(ops, op)
+		}
+	}
+	if len(ops) > 0 {
+		return ops, nil
+	}
+
+	versions := operations.Versions(name)
+	if len(versions) == 0 {
+		return nil, usagef("unknown API or command %q; run \"spapi apis\" for the list", name)
+	}
+	latest := versions[len(versions)-1]
+	for _, op := range all {
+		if op.API == name && op.Version == latest {
+			ops = append(ops, op)
+		}
+	}
+	return ops, nil
+}
+
+// listOperations prints the operations of one API.
+func (c *cli) listOperations(ops []*operations.Operation) error {
+	fmt.Fprintf(c.stdout, "%s operations:\n\n", ops[0].Package())
+	tw := tabwriter.NewWriter(c.stdout, 0, 0, 2, ' ', 0)
+	for _, op := range ops {
+		paging := ""
+		if op.Iterate != nil {
+			paging = "(-all)"
+		}
+		fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\n", kebab(op.Name), op.Method, op.Path, paging)
+	}
+	return tw.Flush()
+}
+
+// operation calls one API operation: spapi <api> <operation> [flags].
+func (c *cli) operation(args []string) error {
+	ops, err := resolveAPI(args[0])
+	if err != nil {
+		return err
+	}
+	if len(args) == 1 || args[1] == "help" {
+		return c.listOperations(ops)
+	}
+
+	var op *operations.Operation
+	for _, candidate := range ops {
+		if kebab(candidate.Name) == args[1] || candidate.Name == args[1] {
+			op = candidate
+		}
+	}
+	if op == nil {
+		return usagef("%s has no operation %q; run \"spapi %s\" for the list", ops[0].Package(), args[1], args[0])
+	}
+
+	flags, input := c.operationFlags(op)
+	if err := parseFlags(flags, args[2:]); err != nil {
+		return err
+	}
+	if flags.NArg() > 0 {
+		return usagef("unexpected argument %q", flags.Arg(0))
+	}
+
+	pathParams := make([]string, len(op.PathParams))
+	for i, param := range op.PathParams {
+		if pathParams[i] = *input.path[i]; pathParams[i] == "" {
+			return usagef("-%s is required", kebab(param))
+		}
+	}
+
+	client, _, err := c.client()
+	if err != nil {
+		return err
+	}
+	defer client.Close(context.Background())
+	ctx := context.Background()
+
+	if input.all {
+		return writeItems(c.stdout, c.output, op.Iterate(ctx, client, pathParams, input.query.Map()), input.limit)
+	}
+
+	body, err := c.readBody(input.body)
+	if err != nil {
+		return err
+	}
+	result, err := op.Call(ctx, client, pathParams, input.query.Map(), body)
+	if err != nil {
+		return err
+	}
+	return writeResult(c.stdout, c.output, result)
+}
+
+// operationInput holds the flag values of an operation command.
+type operationInput struct {
+	path  []*string
+	query keyValues
+	body  string
+	all   bool
+	limit int
+}
+
+// operationFlags builds the flags of op: one per path parameter plus
+// the query, body and paging flags its input calls for.
+func (c *cli) operationFlags(op *operations.Operation) (*flag.FlagSet, *operationInput) {
+	name := op.Package() + " " + kebab(op.Name)
+	flags := c.flagSet(name)
+	input := &operationInput{path: make([]*string, len(op.PathParams))}
+
+	for i, param := range op.PathParams {
+		input.path[i] = flags.String(kebab(param), "", "path parameter "+param+" (required)")
+	}
+	switch op.Input {
+	case operations.InputQuery:
+		flags.Var(&input.query, "q", "query parameter `name=value`; repeat a name for array values")
+		flags.Var(&input.query, "query", "query parameter `name=value`; repeat a name for array values")
+	case operations.InputBody:
+		flags.StringVar(&input.body, "body", "", "request body: JSON, @file or - for stdin")
+	}
+	if op.Iterate != nil {
+		flags.BoolVar(&input.all, "all", false, "fetch every page through "+op.Iterator+" and print the items")
+		flags.IntVar(&input.limit, "limit", 0, "with -all, stop after this many items")
+	}
+
+	flags.Usage = func() {
+		fmt.Fprintf(c.stderr, "Usage: spapi %s [flags]\n\n%s %s\n\nFlags:\n", name, op.Method, op.Path)
+		flags.PrintDefaults()
+	}
+	return flags, input
+}
+
+// readBody decodes a -body value: inline JSON, @file or - for stdin.
+func (c *cli) readBody(value string) (interface{}, error) {
+	var data []byte
+	var err error
+	switch {
+	case value == "":
+		return nil, nil
+	case value == "-":
+		data, err = io.ReadAll(c.stdin)
+	case strings.HasPrefix(value, "@"):
+		data, err = os.ReadFile(value[1:])
+	default:
+		data = []byte(value)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read body: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var body interface{}
+	if err := decoder.Decode(&body); err != nil {
+		return nil, usagef("body is not valid JSON: %v", err)
+	}
+	return body, nil
+}
@@ -0,0 +1,232 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// Output formats.
+const (
+	formatJSON   = "json"
+	formatTable  = "table"
+	formatNDJSON = "ndjson"
+)
+
+// validFormat reports whether format is a supported output format.
+func validFormat(format string) bool {
+	return format == formatJSON || format == formatTable || format == formatNDJSON
+}
+
+// writeResult prints one operation response.
+//
+// JSON prints the response as is. Table and NDJSON print the list the
+// response carries (e.g. payload.Orders), one row or line per element;
+// responses without a list are printed as a single row.
+func writeResult(w io.Writer, format string, result interface{}) error {
+	if format == formatJSON {
+		return writeJSON(w, result)
+	}
+	return writeRows(w, format, rows(result))
+}
+
+// writeItems prints the items of a paginated operation, stopping after
+// limit items when limit is positive. NDJSON is streamed as pages
+// arrive.
+func writeItems(w io.Writer, format string, items iter.Seq2[map[string]interface{}, error], limit int) error {
+	var collected []interface{}
+	count := 0
+	for item, err := range items {
+		if err != nil {
+			return err
+		}
+		if format == formatNDJSON {
+			if err := writeLine(w, item); err != nil {
+				return err
+			}
+		} else {
+			collected = append(collected, item)
+		}
+		if count++; limit > 0 && count >= limit {
+			break
+		}
+	}
+
+	switch format {
+	case formatNDJSON:
+		return nil
+	case formatJSON:
+		if collected == nil {
+			collected = []interface{}{}
+		}
+		return writeJSON(w, collected)
+	default:
+		return writeRows(w, format, collected)
+	}
+}
+
+// writeJSON prints v as indented JSON.
+func writeJSON(w io.Writer, v interface{}) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+// writeLine prints v as one line of compact JSON.
+func writeLine(w io.Writer, v interface{}) error {
+	return json.NewEncoder(w).Encode(v)
+}
+
+// writeRows prints values as NDJSON lines or as a table.
+func writeRows(w io.Writer, format string, values []interface{}) error {
+	if format == formatNDJSON {
+		for _, v := range values {
+			if err := writeLine(w, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return writeTable(w, values)
+}
+
+// writeTable prints values as a table with one column per leaf field.
+// Nested objects are flattened to dotted column names.
+func writeTable(w io.Writer, values []interface{}) error {
+	flat := make([]map[string]string, len(values))
+	columnSet := make(map[string]bool)
+	for i, v := range values {
+		flat[i] = make(map[string]string)
+		flatten(flat[i], "", v)
+		for column := range flat[i] {
+			columnSet[column] = true
+		}
+	}
+
+	columns := make([]string, 0, len(columnSet))
+	for column := range columnSet {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(columns, "\t"))
+	for _, row := range flat {
+		cells := make([]string, len(columns))
+		for i, column := range columns {
+			cells[i] = row[column]
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+	return tw.Flush()
+}
+
+// flatten stores the leaves of v in row under dotted keys.
+func flatten(row map[string]string, prefix string, v interface{}) {
+	object, ok := v.(map[string]interface{})
+	if !ok {
+		if prefix == "" {
+			prefix = "value"
+		}
+		row[prefix] = cell(v)
+		return
+	}
+	for key, value := range object {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		flatten(row, key, value)
+	}
+}
+
+// cell formats a leaf value for a table.
+func cell(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return strings.NewReplacer("\t", " ", "\n", " ").Replace(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+}
+
+// rows returns the list a response carries: the response itself when it
+// is an array, otherwise the first array of objects found in its
+// payload or at its top level (keys in sorted order).
+func rows(result interface{}) []interface{} {
+	if list, ok := result.([]interface{}); ok {
+		return list
+	}
+
+	object, ok := result.(map[string]interface{})
+	if !ok {
+		return []interface{}{result}
+	}
+	if payload, ok := object["payload"]; ok {
+		if list, ok := payload.([]interface{}); ok {
+			return list
+		}
+		if inner, ok := payload.(map[string]interface{}); ok {
+			if list := objectList(inner); list != nil {
+				return list
+			}
+			return []interface{}{inner}
+		}
+	}
+	if list := objectList(object); list != nil {
+		return list
+	}
+	return []interface{}{result}
+}
+
+// objectList returns the first field of object, in key order, holding a
+// non-empty array of objects.
+func objectList(object map[string]interface{}) []interface{} {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		list, ok := object[key].([]interface{})
+		if !ok || len(list) == 0 {
+			continue
+		}
+		if _, ok := list[0].(map[string]interface{}); ok {
+			return list
+		}
+	}
+	return nil
+}
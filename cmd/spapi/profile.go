@@ -0,0 +1,182 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// regions are the predefined regions a profile can name by code.
+var regions = []spapi.Region{
+	spapi.RegionNA, spapi.RegionEU, spapi.RegionFE,
+	spapi.RegionNASandbox, spapi.RegionEUSandbox, spapi.RegionFESandbox,
+}
+
+// Config is the credentials file: named profiles plus the profile used
+// when none is selected.
+type Config struct {
+	// Default names the profile used without -profile or SPAPI_PROFILE
+	Default string `json:"default,omitempty"`
+
+	// Profiles maps profile names to credentials
+	Profiles map[string]*Profile `json:"profiles"`
+}
+
+// Profile holds the credentials and defaults of one selling account.
+//
+// String values may reference environment variables as $NAME or
+// ${NAME}, so secrets can stay out of the file.
+type Profile struct {
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	RefreshToken string `json:"refresh_token"`
+
+	// Region is a region code: na, eu, fe or their -sandbox variants
+	Region string `json:"region,omitempty"`
+
+	// Endpoint and LWAEndpoint override the region's endpoints
+	Endpoint    string `json:"endpoint,omitempty"`
+	LWAEndpoint string `json:"lwa_endpoint,omitempty"`
+
+	// MarketplaceIDs are used by reports fetch and feeds submit when
+	// -marketplace-ids is not given
+	MarketplaceIDs []string `json:"marketplace_ids,omitempty"`
+}
+
+// defaultConfigPath returns $SPAPI_CONFIG or the spapi/config.json file
+// in the user configuration directory.
+func defaultConfigPath() string {
+	if path := os.Getenv("SPAPI_CONFIG"); path != "" {
+		return path
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "spapi.json"
+	}
+	return filepath.Join(dir, "spapi", "config.json")
+}
+
+// LoadConfig reads the credentials file at path.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read config: %w", err)
+	}
+
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parse config %s: %w", path, err)
+	}
+	return &cfg, nil
+}
+
+// Profile returns the named profile with environment references
+// expanded. An empty name selects SPAPI_PROFILE, then the configured
+// default, then "default".
+func (c *Config) Profile(name string) (string, *Profile, error) {
+	if name == "" {
+		name = os.Getenv("SPAPI_PROFILE")
+	}
+	if name == "" {
+		name = c.Default
+	}
+	if name == "" {
+		name = "default"
+	}
+
+	p, ok := c.Profiles[name]
+	if !ok {
+		return name, nil, fmt.Errorf("profile %q not found (available: %s)", name, strings.Join(c.Names(), ", "))
+	}
+
+	expanded := *p
+	for _, field := range []*string{
+		&expanded.ClientID, &expanded.ClientSecret, &expanded.RefreshToken,
+		&expanded.Region, &expanded.Endpoint, &expanded.LWAEndpoint,
+	} {
+		*field = os.ExpandEnv(*field)
+	}
+	return name, &expanded, nil
+}
+
+// Names returns the profile names in sorted order.
+func (c *Config) Names() []string {
+	names := make([]string, 0, len(c.Profiles))
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RegionValue resolves the region of the profile, applying endpoint
+// overrides.
+func (p *Profile) RegionValue() (spapi.Region, error) {
+	var region spapi.Region
+	code := p.Region
+	if code == "" && p.Endpoint == "" {
+		code = spapi.RegionNA.Code
+	}
+	if code != "" {
+		found := false
+		for _, r := range regions {
+			if r.Code == code {
+				region, found = r, true
+			}
+		}
+		if !found {
+			return region, fmt.Errorf("unknown region %q", code)
+		}
+	} else {
+		region = spapi.Region{Code: "custom", Name: "Custom", LWAEndpoint: spapi.RegionNA.LWAEndpoint}
+	}
+
+	if p.Endpoint != "" {
+		region.Endpoint = p.Endpoint
+	}
+	if p.LWAEndpoint != "" {
+		region.LWAEndpoint = p.LWAEndpoint
+	}
+	return region, nil
+}
+
+// NewClient creates an SP-API client from the profile.
+func (p *Profile) NewClient() (*spapi.Client, error) {
+	if p.ClientID == "" || p.ClientSecret == "" || p.RefreshToken == "" {
+		return nil, errors.New("profile needs client_id, client_secret and refresh_token")
+	}
+	region, err := p.RegionValue()
+	if err != nil {
+		return nil, err
+	}
+	return spapi.NewClient(
+		spapi.WithRegion(region),
+		spapi.WithCredentials(p.ClientID, p.ClientSecret, p.RefreshToken),
+	)
+}
@@ -0,0 +1,338 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/operations"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/spapitest"
+)
+
+// newTestServer starts a fake SP-API and writes a config whose "test"
+// profile (the default) points at it.
+func newTestServer(t *testing.T) (*spapitest.Server, string) {
+	t.Helper()
+
+	srv := spapitest.NewServer()
+	t.Cleanup(srv.Close)
+
+	cfg := Config{
+		Default: "test",
+		Profiles: map[string]*Profile{
+			"test": {
+				ClientID:       spapitest.ClientID,
+				ClientSecret:   "${SPAPI_TEST_SECRET}",
+				RefreshToken:   spapitest.RefreshToken,
+				Endpoint:       srv.URL,
+				LWAEndpoint:    srv.URL + spapitest.TokenPath,
+				MarketplaceIDs: []string{"ATVPDKIKX0DER"},
+			},
+			"broken": {ClientID: "id", ClientSecret: "secret", RefreshToken: "token", Region: "mars"},
+		},
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("SPAPI_TEST_SECRET", spapitest.ClientSecret)
+	t.Setenv("SPAPI_PROFILE", "")
+
+	return srv, path
+}
+
+// runCLI runs the command with stdin and returns the exit code and
+// output streams.
+func runCLI(stdin string, args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	code := run(args, strings.NewReader(stdin), &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestRun_Operation(t *testing.T) {
+	srv, config := newTestServer(t)
+	srv.Handle(http.MethodGet, "/orders/v0/orders/{orderId}", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"payload": map[string]interface{}{
+				"AmazonOrderId": r.PathValue("orderId"),
+				"OrderStatus":   r.URL.Query().Get("status"),
+			},
+		})
+	})
+
+	code, stdout, stderr := runCLI("", "-config", config, "orders", "get-order", "--order-id", "902-1", "-q", "status=Shipped")
+	if code != 0 {
+		t.Fatalf("exit code = %d, stderr = %s", code, stderr)
+	}
+	var result map[string]map[string]string
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("stdout is not JSON: %v\n%s", err, stdout)
+	}
+	if got := result["payload"]; got["AmazonOrderId"] != "902-1" || got["OrderStatus"] != "Shipped" {
+		t.Errorf("payload = %v", got)
+	}
+
+	// global flags are accepted after the operation too
+	code, stdout, _ = runCLI("", "orders", "GetOrder", "--order-id", "902-1", "-config", config, "-o", "table")
+	if code != 0 || !strings.Contains(stdout, "AmazonOrderId") || !strings.Contains(stdout, "902-1") {
+		t.Errorf("table output (code %d):\n%s", code, stdout)
+	}
+}
+
+func TestRun_Body(t *testing.T) {
+	srv, config := newTestServer(t)
+	var received map[string]interface{}
+	srv.Handle(http.MethodPost, "/orders/v0/orders/{orderId}/shipment", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&received)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	code, _, stderr := runCLI(`{"marketplaceId": "ATVPDKIKX0DER", "shipmentStatus": "ReadyForPickup"}`,
+		"-config", config, "orders-v0", "update-shipment-status", "--order-id", "902-1", "--body", "-")
+	if code != 0 {
+		t.Fatalf("exit code = %d, stderr = %s", code, stderr)
+	}
+	if received["shipmentStatus"] != "ReadyForPickup" {
+		t.Errorf("body = %v", received)
+	}
+}
+
+func TestRun_Pagination(t *testing.T) {
+	srv, config := newTestServer(t)
+	page := func(ids ...string) map[string]interface{} {
+		list := make([]interface{}, len(ids))
+		for i, id := range ids {
+			list[i] = map[string]interface{}{"AmazonOrderId": id, "OrderTotal": map[string]interface{}{"Amount": "1.50"}}
+		}
+		return map[string]interface{}{"payload": map[string]interface{}{"Orders": list}}
+	}
+	if err := srv.Paginate(http.MethodGet, "/orders/v0/orders", "NextToken", "payload.NextToken",
+		page("1", "2"), page("3")); err != nil {
+		t.Fatalf("Paginate() error = %v", err)
+	}
+
+	code, stdout, stderr := runCLI("", "-config", config, "-o", "ndjson", "orders", "get-orders", "-q", "MarketplaceIds=ATVPDKIKX0DER", "--all")
+	if code != 0 {
+		t.Fatalf("exit code = %d, stderr = %s", code, stderr)
+	}
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	if len(lines) != 3 || !strings.Contains(lines[2], `"AmazonOrderId":"3"`) {
+		t.Errorf("ndjson output =\n%s", stdout)
+	}
+
+	code, stdout, _ = runCLI("", "-config", config, "-o", "table", "orders", "get-orders", "--all", "--limit", "2")
+	want := "AmazonOrderId  OrderTotal.Amount\n1              1.50\n2              1.50\n"
+	if code != 0 || stdout != want {
+		t.Errorf("table output (code %d) =\n%s\nwant\n%s", code, stdout, want)
+	}
+
+	// a single page is printed as JSON unless -all is given
+	code, stdout, _ = runCLI("", "-config", config, "orders", "get-orders")
+	if code != 0 || !strings.Contains(stdout, `"NextToken": "spapitest-page-2"`) {
+		t.Errorf("single page output (code %d) =\n%s", code, stdout)
+	}
+}
+
+func TestRun_Usage(t *testing.T) {
+	_, config := newTestServer(t)
+
+	tests := []struct {
+		name string
+		args []string
+		code int
+		want string
+	}{
+		{"no command", nil, 2, "Usage: spapi"},
+		{"unknown api", []string{"widgets"}, 2, `unknown API or command "widgets"`},
+		{"unknown operation", []string{"orders", "get-widget"}, 2, `orders-v0 has no operation "get-widget"`},
+		{"missing path parameter", []string{"-config", config, "orders", "get-order"}, 2, "-order-id is required"},
+		{"unknown flag", []string{"orders", "get-order", "--widget"}, 2, "flag provided but not defined: -widget"},
+		{"bad format", []string{"-config", config, "-o", "xml", "orders", "get-order", "--order-id", "1"}, 2, `unknown output format "xml"`},
+		{"bad body", []string{"-config", config, "orders", "confirm-shipment", "--order-id", "1", "--body", "{"}, 2, "body is not valid JSON"},
+		{"operation help", []string{"orders", "get-order", "-h"}, 0, "GET /orders/v0/orders/{orderId}"},
+		{"missing profile", []string{"-config", config, "-profile", "eu", "orders", "get-order", "--order-id", "1"}, 1, `profile "eu" not found (available: broken, test)`},
+		{"bad region", []string{"-config", config, "-profile", "broken", "orders", "get-order", "--order-id", "1"}, 1, `unknown region "mars"`},
+		{"reports fetch without type", []string{"-config", config, "reports", "fetch"}, 2, "-report-type or -report-id is required"},
+		{"feeds submit unknown content type", []string{"-config", config, "feeds", "submit", "--feed-type", "X", "--file", "feed.bin"}, 2, "set -content-type"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, _, stderr := runCLI("", tt.args...)
+			if code != tt.code || !strings.Contains(stderr, tt.want) {
+				t.Errorf("exit code = %d, stderr = %q; want %d and %q", code, stderr, tt.code, tt.want)
+			}
+		})
+	}
+}
+
+func TestRun_Lists(t *testing.T) {
+	_, config := newTestServer(t)
+
+	code, stdout, _ := runCLI("", "apis")
+	if code != 0 || !strings.Contains(stdout, "catalog-items") || !strings.Contains(stdout, "v0, v2020-12-01, v2022-04-01 (default)") {
+		t.Errorf("apis output (code %d) =\n%s", code, stdout)
+	}
+
+	code, stdout, _ = runCLI("", "catalog-items")
+	if code != 0 || !strings.Contains(stdout, "catalog-items-v2022-04-01 operations") || !strings.Contains(stdout, "search-catalog-items") {
+		t.Errorf("operations output (code %d) =\n%s", code, stdout)
+	}
+	code, stdout, _ = runCLI("", "orders")
+	if code != 0 || !strings.Contains(stdout, "get-orders") || !strings.Contains(stdout, "(-all)") {
+		t.Errorf("orders operations output (code %d) =\n%s", code, stdout)
+	}
+
+	code, stdout, _ = runCLI("", "-config", config, "profiles")
+	if code != 0 || !strings.Contains(stdout, "test (default)") || strings.Contains(stdout, spapitest.ClientSecret) {
+		t.Errorf("profiles output (code %d) =\n%s", code, stdout)
+	}
+}
+
+func TestReportsFetch(t *testing.T) {
+	srv, config := newTestServer(t)
+	srv.SetReport("GET_MERCHANT_LISTINGS_ALL_DATA", spapitest.Document{
+		Content:  []byte("sku\tprice\nA\t1.50\n"),
+		Compress: true,
+		Encrypt:  true,
+	})
+	out := filepath.Join(t.TempDir(), "listings.tsv")
+
+	code, _, stderr := runCLI("", "-config", config, "reports", "fetch",
+		"--report-type", "GET_MERCHANT_LISTINGS_ALL_DATA", "--poll-interval", "1ms", "--out", out)
+	if code != 0 {
+		t.Fatalf("exit code = %d, stderr = %s", code, stderr)
+	}
+	data, err := os.ReadFile(out)
+	if err != nil || string(data) != "sku\tprice\nA\t1.50\n" {
+		t.Errorf("report = %q, %v", data, err)
+	}
+	for _, want := range []string{"created", "IN_PROGRESS", "DONE", "wrote 17 bytes"} {
+		if !strings.Contains(stderr, want) {
+			t.Errorf("stderr does not contain %q:\n%s", want, stderr)
+		}
+	}
+
+	// a fresh server, so CreateReport is not throttled
+	srv, config = newTestServer(t)
+	srv.SetReport("GET_FLAT_FILE_OPEN_LISTINGS_DATA", spapitest.Document{Content: []byte(`{"errorDetails":"boom"}`), Status: "FATAL"})
+	code, stdout, stderr := runCLI("", "-config", config, "reports", "fetch",
+		"--report-type", "GET_FLAT_FILE_OPEN_LISTINGS_DATA", "--poll-interval", "1ms")
+	if code != 1 || stdout != "" || !strings.Contains(stderr, "boom") || !strings.Contains(stderr, "status FATAL") {
+		t.Errorf("FATAL report: exit code = %d, stdout = %q, stderr = %s", code, stdout, stderr)
+	}
+}
+
+func TestFeedsSubmit(t *testing.T) {
+	srv, config := newTestServer(t)
+	feed := filepath.Join(t.TempDir(), "listings.json")
+	if err := os.WriteFile(feed, []byte(`{"header": {}, "messages": []}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	code, stdout, stderr := runCLI("", "-config", config, "feeds", "submit",
+		"--feed-type", "JSON_LISTINGS_FEED", "--file", feed, "--poll-interval", "1ms")
+	if code != 0 {
+		t.Fatalf("exit code = %d, stderr = %s", code, stderr)
+	}
+	if !strings.Contains(stdout, `"messagesProcessed"`) || !strings.Contains(stderr, "DONE") {
+		t.Errorf("stdout = %s\nstderr = %s", stdout, stderr)
+	}
+
+	var uploaded string
+	for _, req := range srv.Requests() {
+		if req.Method == http.MethodPost && req.Path == "/feeds/2021-06-30/documents" && !strings.Contains(string(req.Body), "application/json") {
+			t.Errorf("CreateFeedDocument body = %s, want JSON content type", req.Body)
+		}
+		if req.Method == http.MethodPost && req.Path == "/feeds/2021-06-30/feeds" {
+			var spec map[string]interface{}
+			json.Unmarshal(req.Body, &spec)
+			uploaded, _ = spec["inputFeedDocumentId"].(string)
+		}
+	}
+	if data, ok := srv.Upload(uploaded); !ok || !strings.Contains(string(data), "messages") {
+		t.Errorf("Upload(%q) = %q, %v", uploaded, data, ok)
+	}
+
+	// fresh servers, so CreateFeed is not throttled
+	srv, config = newTestServer(t)
+	srv.SetFeedResult("POST_PRODUCT_DATA", spapitest.Document{Content: []byte("error report"), Compress: true, Status: "FATAL"})
+	code, stdout, stderr = runCLI("<xml/>", "-config", config, "feeds", "submit",
+		"--feed-type", "POST_PRODUCT_DATA", "--file", "-", "--content-type", "text/xml; charset=UTF-8", "--poll-interval", "1ms")
+	if code != 1 || stdout != "error report" || !strings.Contains(stderr, "status FATAL") {
+		t.Errorf("FATAL feed: exit code = %d, stdout = %q, stderr = %s", code, stdout, stderr)
+	}
+
+	_, config = newTestServer(t)
+	code, stdout, _ = runCLI("", "-config", config, "feeds", "submit", "--feed-type", "JSON_LISTINGS_FEED", "--file", feed, "--no-wait")
+	if code != 0 || !strings.Contains(stdout, `"feedId"`) {
+		t.Errorf("-no-wait: exit code = %d, stdout = %s", code, stdout)
+	}
+}
+
+// TestOperationFlags checks that every operation gets a usable command:
+// unique names per API and flags that do not collide.
+func TestOperationFlags(t *testing.T) {
+	c := &cli{stderr: io.Discard}
+	seen := make(map[string]bool)
+	for _, op := range operations.All() {
+		key := op.Package() + " " + kebab(op.Name)
+		if seen[key] {
+			t.Errorf("duplicate command %s", key)
+		}
+		seen[key] = true
+
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					t.Errorf("%s: %v", key, r)
+				}
+			}()
+			c.operationFlags(op)
+		}()
+	}
+}
+
+func TestKebab(t *testing.T) {
+	tests := map[string]string{
+		"GetOrderItems":                          "get-order-items",
+		"orderId":                                "order-id",
+		"sellerSKU":                              "seller-sku",
+		"GetSmallAndLightEligibilityBySellerSKU": "get-small-and-light-eligibility-by-seller-sku",
+		"getFBAInventorySummaries":               "get-fba-inventory-summaries",
+		"asin":                                   "asin",
+	}
+	for in, want := range tests {
+		if got := kebab(in); got != want {
+			t.Errorf("kebab(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
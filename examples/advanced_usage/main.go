@@ -72,7 +72,7 @@ func main() {
 	if err != nil {
 		log.Fatalf("创建客户端失败: %v", err)
 	}
-	defer client.Close()
+	defer client.Close(context.Background())
 
 	ctx := context.Background()
 
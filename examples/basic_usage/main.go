@@ -66,7 +66,7 @@ func main() {
 	if err != nil {
 		log.Fatalf("创建客户端失败: %v", err)
 	}
-	defer client.Close()
+	defer client.Close(context.Background())
 
 	// 获取访问令牌（SDK 会自动管理）
 	ctx := context.Background()
@@ -65,7 +65,7 @@ func main() {
 	if err != nil {
 		log.Fatalf("创建 Grantless 客户端失败: %v", err)
 	}
-	defer client.Close()
+	defer client.Close(context.Background())
 
 	// 创建 Notifications API 客户端
 	notificationsClient := notifications.NewClient(client)
@@ -71,7 +71,7 @@ func main() {
 	if err != nil {
 		log.Fatalf("创建客户端失败: %v", err)
 	}
-	defer baseClient.Close()
+	defer baseClient.Close(context.Background())
 
 	ctx := context.Background()
 
@@ -61,7 +61,7 @@ func main() {
 	if err != nil {
 		log.Fatalf("创建客户端失败: %v", err)
 	}
-	defer baseClient.Close()
+	defer baseClient.Close(context.Background())
 
 	// 创建 Listings Items API 客户端
 	listingsClient := listings.NewClient(baseClient)
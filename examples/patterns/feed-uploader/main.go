@@ -62,7 +62,7 @@ import (
 	"net/http"
 	"os"
 	"time"
-
+
 	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
 	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/feeds-v2021-06-30"
 )
@@ -82,7 +82,7 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to create client: %v", err)
 	}
-	defer client.Close()
+	defer client.Close(context.Background())
 
 	feedsClient := feeds_v2021_06_30.NewClient(client)
 	ctx := context.Background()
@@ -166,40 +166,23 @@ func uploadFeed(ctx context.Context, client *feeds_v2021_06_30.Client, content [
 
 // monitorFeedProcessing 监控 Feed 处理状态
 func monitorFeedProcessing(ctx context.Context, client *feeds_v2021_06_30.Client, feedID string) error {
-	maxAttempts := 60
-	interval := 10 * time.Second
-
-	for attempt := range maxAttempts {
-		result, err := client.GetFeed(ctx, feedID, nil)
-		if err != nil {
-			return err
-		}
-
-		feed := result.(map[string]interface{})
-		status := feed["processingStatus"].(string)
-
-		log.Printf("  Attempt %d/%d: Status=%s", attempt+1, maxAttempts, status)
-
-		switch status {
-		case "DONE":
-			// 处理完成，获取结果
-			if resultDocID, ok := feed["resultFeedDocumentId"].(string); ok {
-				return processFeedResult(ctx, client, resultDocID)
-			}
-			return nil
-
-		case "FATAL", "CANCELLED":
-			return fmt.Errorf("feed processing failed: %s", status)
-
-		case "IN_QUEUE", "IN_PROGRESS":
-			time.Sleep(interval)
-
-		default:
-			return fmt.Errorf("unknown status: %s", status)
-		}
+	result, err := client.WaitForFeed(ctx, feedID,
+		spapi.WithPollInterval(10*time.Second, time.Minute, 1.5),
+		spapi.WithMaxPollAttempts(60),
+		spapi.WithPollProgress(func(p spapi.PollProgress) {
+			log.Printf("  Attempt %d: Status=%s", p.Attempt, p.Status)
+		}),
+	)
+	if err != nil {
+		return fmt.Errorf("feed processing failed: %w", err)
 	}
 
-	return fmt.Errorf("timeout waiting for feed processing")
+	// 处理完成，获取结果
+	feed := result.(map[string]interface{})
+	if resultDocID, ok := feed["resultFeedDocumentId"].(string); ok {
+		return processFeedResult(ctx, client, resultDocID)
+	}
+	return nil
 }
 
 // processFeedResult 处理 Feed 结果
@@ -105,7 +105,7 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to create SP-API client: %v", err)
 	}
-	defer spapiClient.Close()
+	defer spapiClient.Close(context.Background())
 
 	// 3. 创建 AWS SQS 客户端
 	ctx := context.Background()
@@ -60,7 +60,7 @@ import (
 	"os"
 	"strings"
 	"time"
-
+
 	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
 	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/reports-v2021-06-30"
 )
@@ -80,7 +80,7 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to create client: %v", err)
 	}
-	defer client.Close()
+	defer client.Close(context.Background())
 
 	reportsClient := reports_v2021_06_30.NewClient(client)
 	ctx := context.Background()
@@ -190,27 +190,19 @@ func createReport(ctx context.Context, client *reports_v2021_06_30.Client, repor
 
 // waitForReport 等待报告生成
 func waitForReport(ctx context.Context, client *reports_v2021_06_30.Client, reportID string) (string, error) {
-	for attempt := range 60 {
-		result, err := client.GetReport(ctx, reportID, nil)
-		if err != nil {
-			return "", err
-		}
-
-		report := result.(map[string]interface{})
-		status := report["processingStatus"].(string)
-
-		log.Printf("  Status: %s (attempt %d)", status, attempt+1)
-
-		if status == "DONE" {
-			return report["reportDocumentId"].(string), nil
-		} else if status == "FATAL" || status == "CANCELLED" {
-			return "", fmt.Errorf("report failed: %s", status)
-		}
-
-		time.Sleep(10 * time.Second)
+	result, err := client.WaitForReport(ctx, reportID,
+		spapi.WithPollInterval(10*time.Second, time.Minute, 1.5),
+		spapi.WithPollTimeout(30*time.Minute),
+		spapi.WithPollProgress(func(p spapi.PollProgress) {
+			log.Printf("  Status: %s (attempt %d)", p.Status, p.Attempt)
+		}),
+	)
+	if err != nil {
+		return "", fmt.Errorf("report failed: %w", err)
 	}
 
-	return "", fmt.Errorf("timeout")
+	report := result.(map[string]interface{})
+	return report["reportDocumentId"].(string), nil
 }
 
 // parseOrderReport 解析订单报告（TSV 格式）
@@ -72,7 +72,7 @@ func main() {
 	if err != nil {
 		log.Fatalf("创建客户端失败: %v", err)
 	}
-	defer baseClient.Close()
+	defer baseClient.Close(context.Background())
 
 	reportsClient := reports_v2021_06_30.NewClient(baseClient)
 	ctx := context.Background()
@@ -28,7 +28,10 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/metrics"
 )
 
 // Client 是 LWA 认证客户端。
@@ -36,11 +39,30 @@ import (
 // 此客户端负责与 LWA 服务器通信，获取和缓存访问令牌。
 // Client 是并发安全的，可以在多个 goroutine 中使用。
 type Client struct {
+	// mu 保护 credentials 和旧密钥，凭据可以在运行时替换
+	mu          sync.RWMutex
 	credentials *Credentials
+
+	// previousSecret 是轮换前的客户端密钥，新密钥被拒绝时作为备用
+	previousSecret string
+
+	// previousExpiry 是旧密钥的过期时间，为零时直到新密钥获取令牌成功
+	previousExpiry time.Time
+
 	httpClient  *http.Client
 	cache       TokenCache
+	metrics     metrics.Recorder
+	refreshHook RefreshHook
 }
 
+// RefreshHook 在每次从 LWA 服务器获取令牌后调用。
+//
+// 参数:
+//   - grantType: refresh_token 或 client_credentials
+//   - token: 新令牌（失败时为 nil）
+//   - err: 获取失败时的错误
+type RefreshHook func(grantType string, token *Token, err error)
+
 // TokenCache 定义令牌缓存接口。
 //
 // 实现此接口可以自定义令牌缓存策略。
@@ -97,6 +119,9 @@ var (
 
 	// ErrInvalidResponse 表示 LWA 服务器返回了无效的响应。
 	ErrInvalidResponse = errors.New("invalid LWA response")
+
+	// ErrInvalidClient 表示 LWA 服务器拒绝了客户端 ID 或客户端密钥（invalid_client）。
+	ErrInvalidClient = errors.New("invalid_client")
 )
 
 // NewClient 创建新的 LWA 客户端。
@@ -117,7 +142,8 @@ func NewClient(credentials *Credentials) *Client {
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
-		cache: NewMemoryCache(),
+		cache:   NewMemoryCache(),
+		metrics: metrics.DefaultRecorder,
 	}
 }
 
@@ -135,6 +161,23 @@ func (c *Client) SetCache(cache TokenCache) {
 	c.cache = cache
 }
 
+// SetRefreshHook 设置令牌刷新的回调，用于记录刷新事件。
+//
+// 回调在获取令牌的 goroutine 中同步调用，不应阻塞。
+func (c *Client) SetRefreshHook(hook RefreshHook) {
+	c.refreshHook = hook
+}
+
+// SetMetrics 设置指标记录器。
+//
+// 每次从 LWA 服务器成功获取令牌时记录 spapi_auth_token_refresh_total，
+// 标签 grant_type 为 refresh_token 或 client_credentials。
+func (c *Client) SetMetrics(recorder metrics.Recorder) {
+	if recorder != nil {
+		c.metrics = recorder
+	}
+}
+
 // GetAccessToken 获取访问令牌。
 //
 // 此方法首先检查缓存，如果缓存中没有有效的令牌，
@@ -156,40 +199,147 @@ func (c *Client) SetCache(cache TokenCache) {
 //	}
 //	fmt.Println("Access Token:", token)
 func (c *Client) GetAccessToken(ctx context.Context) (string, error) {
+	token, err := c.Token(ctx)
+	if err != nil {
+		return "", err
+	}
+	return token.AccessToken, nil
+}
+
+// Token 获取访问令牌及其过期时间。
+//
+// 与 GetAccessToken 相同，优先使用缓存中未过期的令牌。
+//
+// 参数:
+//   - ctx: 请求上下文
+//
+// 返回值:
+//   - *Token: 访问令牌
+//   - error: 如果获取失败，返回错误
+func (c *Client) Token(ctx context.Context) (*Token, error) {
+	creds := c.snapshot()
+
 	// 生成缓存键
-	cacheKey := c.getCacheKey()
+	cacheKey := getCacheKey(creds)
 
 	// 检查缓存
 	if cachedToken, ok := c.cache.Get(cacheKey); ok {
 		if !cachedToken.IsExpired() {
-			return cachedToken.AccessToken, nil
+			return cachedToken, nil
 		}
 		// 令牌已过期，删除缓存
 		c.cache.Delete(cacheKey)
 	}
 
-	// 从 LWA 服务器获取新令牌
-	token, err := c.fetchToken(ctx)
+	// 从 LWA 服务器获取新令牌，新密钥被拒绝时在轮换过渡期内改用旧密钥
+	token, err := c.fetchToken(ctx, creds)
+	if err == nil {
+		c.confirmSecret(creds.ClientSecret)
+	} else if previous := c.fallbackSecret(creds.ClientSecret); previous != "" && errors.Is(err, ErrInvalidClient) {
+		creds.ClientSecret = previous
+		token, err = c.fetchToken(ctx, creds)
+	}
+	if c.refreshHook != nil {
+		c.refreshHook(grantType(creds), token, err)
+	}
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	// 缓存令牌
 	c.cache.Set(cacheKey, token)
 
-	return token.AccessToken, nil
+	return token, nil
+}
+
+// GrantType 返回令牌请求的 grant_type（refresh_token 或 client_credentials）。
+func (c *Client) GrantType() string {
+	return grantType(c.snapshot())
+}
+
+// SetClientSecret 替换客户端密钥，用于密钥轮换。
+//
+// 已缓存的访问令牌继续使用直到过期。轮换过渡期内旧密钥作为备用：
+// LWA 以 invalid_client 拒绝新密钥时改用旧密钥重试。
+//
+// 参数:
+//   - secret: 新的客户端密钥
+//   - previousValidUntil: 旧密钥的过期时间；为零时保留旧密钥直到新密钥第一次获取令牌成功
+func (c *Client) SetClientSecret(secret string, previousValidUntil time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if secret == c.credentials.ClientSecret {
+		return
+	}
+	creds := *c.credentials
+	c.previousSecret, c.previousExpiry = creds.ClientSecret, previousValidUntil
+	creds.ClientSecret = secret
+	c.credentials = &creds
+}
+
+// SetRefreshToken 替换刷新令牌。
+//
+// 缓存键包含刷新令牌，之后的请求使用新令牌获取访问令牌。
+// Grantless 凭据没有刷新令牌，调用无效。
+func (c *Client) SetRefreshToken(refreshToken string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.credentials.IsGrantless() || refreshToken == c.credentials.RefreshToken {
+		return
+	}
+	creds := *c.credentials
+	creds.RefreshToken = refreshToken
+	c.credentials = &creds
+}
+
+// snapshot 返回当前凭据的副本。
+func (c *Client) snapshot() Credentials {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return *c.credentials
+}
+
+// fallbackSecret 返回仍可用作备用的旧密钥，没有时返回空字符串。
+func (c *Client) fallbackSecret(current string) string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.previousSecret == "" || c.previousSecret == current {
+		return ""
+	}
+	if !c.previousExpiry.IsZero() && time.Now().After(c.previousExpiry) {
+		return ""
+	}
+	return c.previousSecret
+}
+
+// confirmSecret 在新密钥获取令牌成功后丢弃没有过期时间的旧密钥。
+func (c *Client) confirmSecret(secret string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if secret == c.credentials.ClientSecret && c.previousExpiry.IsZero() {
+		c.previousSecret = ""
+	}
+}
+
+// CloseIdleConnections 关闭与 LWA 服务器之间的空闲连接。
+func (c *Client) CloseIdleConnections() {
+	c.httpClient.CloseIdleConnections()
 }
 
 // fetchToken 从 LWA 服务器获取新令牌。
-func (c *Client) fetchToken(ctx context.Context) (*Token, error) {
+func (c *Client) fetchToken(ctx context.Context, creds Credentials) (*Token, error) {
 	// 构建请求参数
-	data := c.buildTokenRequest()
+	data := buildTokenRequest(creds)
 
 	// 创建 HTTP 请求
 	req, err := http.NewRequestWithContext(
 		ctx,
 		http.MethodPost,
-		c.credentials.Endpoint,
+		creds.Endpoint,
 		strings.NewReader(data.Encode()),
 	)
 	if err != nil {
@@ -218,6 +368,9 @@ func (c *Client) fetchToken(ctx context.Context) (*Token, error) {
 	}
 
 	// 检查错误
+	if lwaResp.Error == ErrInvalidClient.Error() {
+		return nil, fmt.Errorf("%w: %w - %s", ErrAuthFailed, ErrInvalidClient, lwaResp.ErrorDesc)
+	}
 	if lwaResp.Error != "" {
 		return nil, fmt.Errorf("%w: %s - %s", ErrAuthFailed, lwaResp.Error, lwaResp.ErrorDesc)
 	}
@@ -240,27 +393,39 @@ func (c *Client) fetchToken(ctx context.Context) (*Token, error) {
 		ExpiresAt:   time.Now().Add(time.Duration(lwaResp.ExpiresIn) * time.Second),
 	}
 
+	c.metrics.RecordCounter(metrics.MetricAuthTokenRefresh, 1, map[string]string{
+		metrics.LabelGrantType: data.Get("grant_type"),
+	})
+
 	return token, nil
 }
 
+// grantType 返回令牌请求的 grant_type。
+func grantType(creds Credentials) string {
+	if creds.IsGrantless() {
+		return "client_credentials"
+	}
+	return "refresh_token"
+}
+
 // buildTokenRequest 构建 LWA 令牌请求参数。
 //
 // 根据凭据类型（regular 或 grantless）选择不同的请求参数。
-func (c *Client) buildTokenRequest() url.Values {
+func buildTokenRequest(creds Credentials) url.Values {
 	data := url.Values{
-		"client_id":     {c.credentials.ClientID},
-		"client_secret": {c.credentials.ClientSecret},
+		"client_id":     {creds.ClientID},
+		"client_secret": {creds.ClientSecret},
 	}
 
-	if c.credentials.IsGrantless() {
+	if creds.IsGrantless() {
 		// Grantless operation: 使用 client_credentials grant type
 		data.Set("grant_type", "client_credentials")
 		// 将 scopes 用空格分隔连接
-		data.Set("scope", strings.Join(c.credentials.Scopes, " "))
+		data.Set("scope", strings.Join(creds.Scopes, " "))
 	} else {
 		// Regular operation: 使用 refresh_token grant type
 		data.Set("grant_type", "refresh_token")
-		data.Set("refresh_token", c.credentials.RefreshToken)
+		data.Set("refresh_token", creds.RefreshToken)
 	}
 
 	return data
@@ -270,15 +435,15 @@ func (c *Client) buildTokenRequest() url.Values {
 //
 // 对于 regular operations，使用 client_id 和 refresh_token 的组合。
 // 对于 grantless operations，使用 client_id 和 scopes 的组合。
-func (c *Client) getCacheKey() string {
-	if c.credentials.IsGrantless() {
+func getCacheKey(creds Credentials) string {
+	if creds.IsGrantless() {
 		// Grantless: 使用 client_id 和 scopes 作为缓存键
-		scopesKey := strings.Join(c.credentials.Scopes, ",")
-		return fmt.Sprintf("lwa_token:%s:grantless:%s", c.credentials.ClientID, scopesKey)
+		scopesKey := strings.Join(creds.Scopes, ",")
+		return fmt.Sprintf("lwa_token:%s:grantless:%s", creds.ClientID, scopesKey)
 	}
 
 	// Regular: 使用 client_id 和 refresh_token 作为缓存键
-	return fmt.Sprintf("lwa_token:%s:regular:%s", c.credentials.ClientID, c.credentials.RefreshToken)
+	return fmt.Sprintf("lwa_token:%s:regular:%s", creds.ClientID, creds.RefreshToken)
 }
 
 // RefreshToken 强制刷新令牌。
@@ -293,7 +458,7 @@ func (c *Client) getCacheKey() string {
 //   - error: 如果刷新失败，返回错误
 func (c *Client) RefreshToken(ctx context.Context) (string, error) {
 	// 删除缓存
-	cacheKey := c.getCacheKey()
+	cacheKey := getCacheKey(c.snapshot())
 	c.cache.Delete(cacheKey)
 
 	// 获取新令牌
@@ -22,8 +22,10 @@ package auth
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 )
@@ -257,3 +259,97 @@ func TestClient_SetCache(t *testing.T) {
 		t.Error("SetCache() did not set the cache correctly")
 	}
 }
+
+func TestClient_SetClientSecret(t *testing.T) {
+	// 只接受 active 密钥的测试服务器
+	active := "old-secret"
+	var secrets []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		secret := r.Form.Get("client_secret")
+		secrets = append(secrets, secret)
+
+		resp := lwaResponse{AccessToken: "token-" + secret, TokenType: "bearer", ExpiresIn: 3600}
+		if secret != active {
+			resp = lwaResponse{Error: "invalid_client", ErrorDesc: "Client authentication failed"}
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	creds, _ := NewCredentials("test-client-id", "old-secret", "test-refresh-token", server.URL)
+	client := NewClient(creds)
+	ctx := context.Background()
+
+	if _, err := client.GetAccessToken(ctx); err != nil {
+		t.Fatalf("GetAccessToken() error = %v", err)
+	}
+
+	// 新密钥尚未生效时回退到旧密钥
+	client.SetClientSecret("new-secret", time.Time{})
+	token, err := client.RefreshToken(ctx)
+	if err != nil {
+		t.Fatalf("RefreshToken() with fallback error = %v", err)
+	}
+	if token != "token-old-secret" {
+		t.Errorf("token = %v, want token-old-secret", token)
+	}
+
+	// 新密钥生效后不再使用旧密钥
+	active = "new-secret"
+	if token, err = client.RefreshToken(ctx); err != nil || token != "token-new-secret" {
+		t.Errorf("RefreshToken() = %v, %v, want token-new-secret", token, err)
+	}
+	active = "old-secret"
+	if _, err := client.RefreshToken(ctx); !errors.Is(err, ErrInvalidClient) {
+		t.Errorf("RefreshToken() after confirm error = %v, want ErrInvalidClient", err)
+	}
+
+	want := []string{"old-secret", "new-secret", "old-secret", "new-secret", "new-secret"}
+	if strings.Join(secrets, ",") != strings.Join(want, ",") {
+		t.Errorf("secrets = %v, want %v", secrets, want)
+	}
+}
+
+func TestClient_SetClientSecret_Expired(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(lwaResponse{Error: "invalid_client"})
+	}))
+	defer server.Close()
+
+	creds, _ := NewCredentials("test-client-id", "old-secret", "test-refresh-token", server.URL)
+	client := NewClient(creds)
+
+	// 旧密钥已过期，不再重试
+	client.SetClientSecret("new-secret", time.Now().Add(-time.Minute))
+	if _, err := client.GetAccessToken(context.Background()); !errors.Is(err, ErrInvalidClient) {
+		t.Errorf("GetAccessToken() error = %v, want ErrInvalidClient", err)
+	}
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1", calls)
+	}
+}
+
+func TestClient_SetRefreshToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		json.NewEncoder(w).Encode(lwaResponse{AccessToken: "token-" + r.Form.Get("refresh_token"), ExpiresIn: 3600})
+	}))
+	defer server.Close()
+
+	creds, _ := NewCredentials("test-client-id", "test-client-secret", "refresh-1", server.URL)
+	client := NewClient(creds)
+	ctx := context.Background()
+
+	if token, _ := client.GetAccessToken(ctx); token != "token-refresh-1" {
+		t.Errorf("token = %v, want token-refresh-1", token)
+	}
+	client.SetRefreshToken("refresh-2")
+	if token, _ := client.GetAccessToken(ctx); token != "token-refresh-2" {
+		t.Errorf("token = %v, want token-refresh-2", token)
+	}
+}
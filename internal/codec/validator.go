@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"regexp"
 	"strings"
+	"time"
 )
 
 // ValidationError 表示验证错误。
@@ -185,6 +186,83 @@ func (v *Validator) Range(field string, value int, min int, max int) {
 	}
 }
 
+// MinItems 验证数组最少元素个数。
+//
+// 参数:
+//   - field: 字段名
+//   - n: 数组长度
+//   - min: 最少元素个数
+//
+// 示例:
+//
+//	validator.MinItems("marketplaceIds", len(req.MarketplaceIDs), 1)
+func (v *Validator) MinItems(field string, n int, min int) {
+	if n < min {
+		v.errors = append(v.errors, ValidationError{
+			Field:   field,
+			Message: fmt.Sprintf("must contain at least %d items", min),
+		})
+	}
+}
+
+// MaxItems 验证数组最多元素个数。
+//
+// 参数:
+//   - field: 字段名
+//   - n: 数组长度
+//   - max: 最多元素个数
+//
+// 示例:
+//
+//	validator.MaxItems("marketplaceIds", len(req.MarketplaceIDs), 50)
+func (v *Validator) MaxItems(field string, n int, max int) {
+	if n > max {
+		v.errors = append(v.errors, ValidationError{
+			Field:   field,
+			Message: fmt.Sprintf("must contain at most %d items", max),
+		})
+	}
+}
+
+// DateTime 验证时间能否按 RFC 3339 编码（OpenAPI format: date-time）。
+//
+// RFC 3339 只能表示 0000 到 9999 年，超出范围的时间在 JSON 编码时会失败。
+//
+// 参数:
+//   - field: 字段名
+//   - value: 字段值
+//
+// 示例:
+//
+//	validator.DateTime("createdAfter", req.CreatedAfter)
+func (v *Validator) DateTime(field string, value time.Time) {
+	if year := value.Year(); year < 0 || year > 9999 {
+		v.errors = append(v.errors, ValidationError{
+			Field:   field,
+			Message: "must be a valid RFC 3339 date-time",
+		})
+	}
+}
+
+// Date 验证时间是否为不含时刻的日期（OpenAPI format: date）。
+//
+// 参数:
+//   - field: 字段名
+//   - value: 字段值
+//
+// 示例:
+//
+//	validator.Date("startDate", req.StartDate)
+func (v *Validator) Date(field string, value time.Time) {
+	hour, minute, second := value.Clock()
+	if year := value.Year(); year < 0 || year > 9999 || hour != 0 || minute != 0 || second != 0 || value.Nanosecond() != 0 {
+		v.errors = append(v.errors, ValidationError{
+			Field:   field,
+			Message: "must be a date without time of day",
+		})
+	}
+}
+
 // Email 验证电子邮件格式。
 //
 // 参数:
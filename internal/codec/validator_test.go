@@ -3,6 +3,7 @@ package codec
 import (
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestNewValidator(t *testing.T) {
@@ -264,6 +265,71 @@ func TestValidator_Range(t *testing.T) {
 	}
 }
 
+func TestValidator_Items(t *testing.T) {
+	tests := []struct {
+		name    string
+		n       int
+		wantErr bool
+	}{
+		{
+			name:    "below minimum",
+			n:       0,
+			wantErr: true,
+		},
+		{
+			name:    "within range",
+			n:       50,
+			wantErr: false,
+		},
+		{
+			name:    "above maximum",
+			n:       51,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := NewValidator()
+			validator.MinItems("marketplaceIds", tt.n, 1)
+			validator.MaxItems("marketplaceIds", tt.n, 50)
+
+			if validator.HasErrors() != tt.wantErr {
+				t.Errorf("MinItems/MaxItems(%d) hasError = %v, want %v", tt.n, validator.HasErrors(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidator_DateTime(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   time.Time
+		date    bool
+		wantErr bool
+	}{
+		{name: "date-time", value: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{name: "year out of range", value: time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC), wantErr: true},
+		{name: "date", value: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), date: true},
+		{name: "date with time of day", value: time.Date(2025, 1, 2, 3, 0, 0, 0, time.UTC), date: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := NewValidator()
+			if tt.date {
+				validator.Date("startDate", tt.value)
+			} else {
+				validator.DateTime("createdAfter", tt.value)
+			}
+
+			if validator.HasErrors() != tt.wantErr {
+				t.Errorf("hasError = %v, want %v", validator.HasErrors(), tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestValidator_Email(t *testing.T) {
 	tests := []struct {
 		name    string
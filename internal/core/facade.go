@@ -26,6 +26,12 @@ type Facade struct {
 
 	// rateLimitManager 速率限制管理器
 	rateLimitManager *ratelimit.Manager
+
+	// grantlessLWAClient Grantless操作的LWA认证客户端（可选）
+	grantlessLWAClient *auth.Client
+
+	// grantlessSigner Grantless操作的签名器（可选）
+	grantlessSigner signer.Signer
 }
 
 // NewFacade 创建核心门面实例。
@@ -52,6 +58,29 @@ func NewFacade(
 	}
 }
 
+// SetGrantless 设置 Grantless 操作使用的认证客户端和签名器。
+//
+// 同时持有卖家授权凭据和 Grantless 凭据的客户端使用此方法注册
+// 第二套凭据。
+//
+// 参数:
+//   - lwaClient: 使用 client_credentials 授权的LWA认证客户端
+//   - signer: 使用 lwaClient 的签名器
+func (f *Facade) SetGrantless(lwaClient *auth.Client, signer signer.Signer) {
+	f.grantlessLWAClient = lwaClient
+	f.grantlessSigner = signer
+}
+
+// GetGrantlessLWAClient 返回 Grantless 操作的LWA认证客户端，未设置时返回 nil。
+func (f *Facade) GetGrantlessLWAClient() *auth.Client {
+	return f.grantlessLWAClient
+}
+
+// GetGrantlessSigner 返回 Grantless 操作的签名器，未设置时返回 nil。
+func (f *Facade) GetGrantlessSigner() signer.Signer {
+	return f.grantlessSigner
+}
+
 // GetLWAClient 返回LWA认证客户端。
 func (f *Facade) GetLWAClient() *auth.Client {
 	return f.lwaClient
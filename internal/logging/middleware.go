@@ -25,6 +25,8 @@ import (
 	"io"
 	"net/http"
 	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/redact"
 )
 
 // Options 日志中间件选项
@@ -38,7 +40,8 @@ type Options struct {
 	// MaxBodySize 记录的最大 body 大小（字节）
 	MaxBodySize int
 
-	// RedactHeaders 需要脱敏的 HTTP 头（如 token）
+	// RedactHeaders 需要脱敏的 HTTP 头（如 token）。
+	// URL 查询参数和错误消息始终按内置规则脱敏（见 internal/redact）
 	RedactHeaders []string
 
 	// RedactFields 需要脱敏的 JSON 字段
@@ -112,7 +115,7 @@ func (m *LoggingMiddleware) Wrap(next func(context.Context, *http.Request) (*htt
 func (m *LoggingMiddleware) logRequest(req *http.Request) {
 	fields := []Field{
 		String("method", req.Method),
-		String("url", req.URL.String()),
+		String("url", redact.Default().URL(req.URL)),
 		String("host", req.Host),
 	}
 
@@ -128,13 +131,13 @@ func (m *LoggingMiddleware) logRequest(req *http.Request) {
 func (m *LoggingMiddleware) logResponse(req *http.Request, resp *http.Response, err error, duration time.Duration) {
 	fields := []Field{
 		String("method", req.Method),
-		String("url", req.URL.String()),
+		String("url", redact.Default().URL(req.URL)),
 		Duration("duration", duration),
 	}
 
 	if err != nil {
 		// 请求失败
-		fields = append(fields, Error(err))
+		fields = append(fields, String("error", redact.Default().Text(err.Error())))
 		m.logger.Error("HTTP Request Failed", fields...)
 		return
 	}
@@ -113,6 +113,18 @@ const (
 
 	// MetricRateLimitActive 是活跃的速率限制器数量指标。
 	MetricRateLimitActive = "spapi_ratelimit_active_limiters"
+
+	// MetricRateLimitQueueDepth 是按优先级排队等待速率限制的请求数指标。
+	MetricRateLimitQueueDepth = "spapi_ratelimit_queue_depth"
+
+	// MetricRateLimitRate 是自适应调整后的实际速率指标（请求数/秒）。
+	MetricRateLimitRate = "spapi_ratelimit_rate"
+
+	// MetricRateLimitCeiling 是速率上限指标（响应头或静态配置的速率）。
+	MetricRateLimitCeiling = "spapi_ratelimit_ceiling"
+
+	// MetricRateLimitAdjustments 是自适应速率调整次数指标。
+	MetricRateLimitAdjustments = "spapi_ratelimit_adjustments_total"
 )
 
 // 预定义的标签键常量。
@@ -126,6 +138,9 @@ const (
 	// LabelMarketplace 是市场标签键。
 	LabelMarketplace = "marketplace"
 
+	// LabelMethod 是 HTTP 方法标签键。
+	LabelMethod = "method"
+
 	// LabelStatusCode 是 HTTP 状态码标签键。
 	LabelStatusCode = "status_code"
 
@@ -134,4 +149,10 @@ const (
 
 	// LabelGrantType 是授权类型标签键（refresh_token / client_credentials）。
 	LabelGrantType = "grant_type"
+
+	// LabelPriority 是请求优先级标签键（low / normal / high）。
+	LabelPriority = "priority"
+
+	// LabelDirection 是速率调整方向标签键（decrease / increase）。
+	LabelDirection = "direction"
 )
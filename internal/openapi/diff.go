@@ -0,0 +1,483 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package openapi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ChangeKind 表示语义变更的类型。
+type ChangeKind string
+
+// 语义变更类型。
+const (
+	// APIAdded 表示新增了 API 模型文件
+	APIAdded ChangeKind = "api-added"
+
+	// APIRemoved 表示 API 模型文件被删除
+	APIRemoved ChangeKind = "api-removed"
+
+	// OperationAdded 表示新增了操作
+	OperationAdded ChangeKind = "operation-added"
+
+	// OperationRemoved 表示操作被删除
+	OperationRemoved ChangeKind = "operation-removed"
+
+	// OperationDeprecated 表示操作被标记为废弃
+	OperationDeprecated ChangeKind = "operation-deprecated"
+
+	// ParameterAdded 表示新增了可选参数
+	ParameterAdded ChangeKind = "parameter-added"
+
+	// RequiredParameterAdded 表示新增了必需参数（包括必需的请求体）
+	RequiredParameterAdded ChangeKind = "required-parameter-added"
+
+	// ParameterRequired 表示已有参数变为必需
+	ParameterRequired ChangeKind = "parameter-required"
+
+	// ParameterRemoved 表示参数被删除
+	ParameterRemoved ChangeKind = "parameter-removed"
+
+	// RequestFieldAdded 表示请求体新增了可选字段
+	RequestFieldAdded ChangeKind = "request-field-added"
+
+	// RequiredFieldAdded 表示请求体新增了必需字段，或已有字段变为必需
+	RequiredFieldAdded ChangeKind = "required-field-added"
+
+	// RequestFieldRemoved 表示请求体字段被删除
+	RequestFieldRemoved ChangeKind = "request-field-removed"
+
+	// ResponseFieldAdded 表示响应新增了字段
+	ResponseFieldAdded ChangeKind = "response-field-added"
+
+	// ResponseFieldRemoved 表示响应字段被删除
+	ResponseFieldRemoved ChangeKind = "response-field-removed"
+
+	// EnumValueAdded 表示枚举新增了取值
+	EnumValueAdded ChangeKind = "enum-value-added"
+
+	// EnumValueRemoved 表示枚举取值被删除
+	EnumValueRemoved ChangeKind = "enum-value-removed"
+
+	// TypeChanged 表示字段或参数的类型发生变化
+	TypeChanged ChangeKind = "type-changed"
+
+	// RateLimitChanged 表示操作描述中的速率限制发生变化
+	RateLimitChanged ChangeKind = "rate-limit-changed"
+)
+
+// Change 表示一项语义变更。
+type Change struct {
+	// Kind 是变更类型
+	Kind ChangeKind `json:"kind"`
+
+	// Breaking 表示变更是否可能破坏现有调用方
+	Breaking bool `json:"breaking"`
+
+	// Operation 是相关操作的 operationId（定义级变更为空）
+	Operation string `json:"operation,omitempty"`
+
+	// Location 是变更位置，如 "GET /orders/v0/orders" 或 "Order.OrderStatus"
+	Location string `json:"location"`
+
+	// Message 是变更说明
+	Message string `json:"message"`
+}
+
+// schemaMode 区分请求和响应方向，两者对字段增删的兼容性判断不同。
+type schemaMode string
+
+const (
+	modeRequest  schemaMode = "request"
+	modeResponse schemaMode = "response"
+)
+
+// differ 保存一次比较的状态。
+type differ struct {
+	old, new *Document
+	changes  []Change
+	seen     map[string]bool
+	visited  map[string]bool
+}
+
+// Diff 比较两个版本的 API 文档，返回语义变更列表。
+//
+// 只关心会影响调用方的变更，空白、描述文字和字段顺序的变化会被忽略：
+//   - 操作的增删和废弃
+//   - 参数的增删、变为必需及枚举变化
+//   - 请求体字段的增删和变为必需
+//   - 响应字段的增删
+//   - 枚举取值的增删、类型变化
+//   - Usage Plan 速率限制的变化
+//
+// 操作按 HTTP 方法和路径匹配；命名 schema 中的变更只报告一次，
+// 位置使用 schema 名称。结果先列出破坏性变更，再按类型和位置排序。
+//
+// 参数:
+//   - old: 旧版本文档
+//   - new: 新版本文档
+//
+// 返回值:
+//   - []Change: 语义变更列表（没有变更时为空）
+func Diff(old, new *Document) []Change {
+	d := &differ{
+		old:     old,
+		new:     new,
+		seen:    make(map[string]bool),
+		visited: make(map[string]bool),
+	}
+
+	oldOps := operationIndex(old)
+	newOps := operationIndex(new)
+
+	keys := make([]string, 0, len(oldOps)+len(newOps))
+	for key := range oldOps {
+		keys = append(keys, key)
+	}
+	for key := range newOps {
+		if _, ok := oldOps[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		o, n := oldOps[key], newOps[key]
+		switch {
+		case o == nil:
+			d.add(OperationAdded, false, n.ID, key, "operation added")
+		case n == nil:
+			d.add(OperationRemoved, true, o.ID, key, "operation removed")
+		default:
+			d.operation(key, o, n)
+		}
+	}
+
+	names := make([]string, 0, len(new.Definitions))
+	for name := range new.Definitions {
+		if _, ok := old.Definitions[name]; ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		d.enum(old.Resolve(old.Definitions[name]), new.Resolve(new.Definitions[name]), "", name)
+	}
+
+	sort.SliceStable(d.changes, func(i, j int) bool {
+		a, b := d.changes[i], d.changes[j]
+		if a.Breaking != b.Breaking {
+			return a.Breaking
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Location < b.Location
+	})
+
+	return d.changes
+}
+
+// HasBreaking 返回变更列表中是否包含破坏性变更。
+func HasBreaking(changes []Change) bool {
+	for _, change := range changes {
+		if change.Breaking {
+			return true
+		}
+	}
+	return false
+}
+
+// operationIndex 按 "METHOD path" 索引操作。
+func operationIndex(doc *Document) map[string]*Operation {
+	index := make(map[string]*Operation, len(doc.Operations))
+	for _, op := range doc.Operations {
+		index[op.Method+" "+op.Path] = op
+	}
+	return index
+}
+
+// add 记录一项变更（相同的变更只记录一次）。
+func (d *differ) add(kind ChangeKind, breaking bool, operation, location, format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	key := string(kind) + "|" + location + "|" + message
+	if d.seen[key] {
+		return
+	}
+	d.seen[key] = true
+
+	d.changes = append(d.changes, Change{
+		Kind:      kind,
+		Breaking:  breaking,
+		Operation: operation,
+		Location:  location,
+		Message:   message,
+	})
+}
+
+// operation 比较同一操作的两个版本。
+func (d *differ) operation(location string, o, n *Operation) {
+	id := n.ID
+
+	if n.Deprecated && !o.Deprecated {
+		d.add(OperationDeprecated, false, id, location, "operation deprecated")
+	}
+
+	oldParams := make(map[string]*Parameter, len(o.Parameters))
+	for _, param := range o.Parameters {
+		oldParams[param.In+":"+param.Name] = param
+	}
+	newParams := make(map[string]bool, len(n.Parameters))
+
+	for _, param := range n.Parameters {
+		key := param.In + ":" + param.Name
+		newParams[key] = true
+
+		before, ok := oldParams[key]
+		switch {
+		case !ok && param.Required:
+			d.add(RequiredParameterAdded, true, id, location, "required %s parameter %s added", param.In, param.Name)
+		case !ok:
+			d.add(ParameterAdded, false, id, location, "optional %s parameter %s added", param.In, param.Name)
+		default:
+			if param.Required && !before.Required {
+				d.add(ParameterRequired, true, id, location, "%s parameter %s is now required", param.In, param.Name)
+			}
+			paramLocation := location + " " + param.Name
+			d.typeChanged(d.old.Resolve(before.Schema), d.new.Resolve(param.Schema), id, paramLocation)
+			d.enum(d.old.Resolve(before.Schema), d.new.Resolve(param.Schema), id, paramLocation)
+		}
+	}
+	for _, param := range o.Parameters {
+		if !newParams[param.In+":"+param.Name] {
+			d.add(ParameterRemoved, true, id, location, "%s parameter %s removed", param.In, param.Name)
+		}
+	}
+
+	switch {
+	case o.Body == nil && n.Body != nil && n.BodyRequired:
+		d.add(RequiredParameterAdded, true, id, location, "required request body added")
+	case o.Body != nil && n.Body != nil:
+		if n.BodyRequired && !o.BodyRequired {
+			d.add(ParameterRequired, true, id, location, "request body is now required")
+		}
+		d.schema(o.Body, n.Body, id, location+" body", modeRequest, 0)
+	}
+
+	codes := make([]string, 0, len(n.Responses))
+	for code := range n.Responses {
+		if _, ok := o.Responses[code]; ok && strings.HasPrefix(code, "2") {
+			codes = append(codes, code)
+		}
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		d.schema(o.Responses[code].Schema, n.Responses[code].Schema, id, location+" "+code, modeResponse, 0)
+	}
+
+	oldLimit, oldOK := o.RateLimit()
+	newLimit, newOK := n.RateLimit()
+	switch {
+	case oldOK && newOK && oldLimit != newLimit:
+		breaking := newLimit.Rate < oldLimit.Rate || newLimit.Burst < oldLimit.Burst
+		d.add(RateLimitChanged, breaking, id, location, "rate limit changed from %s to %s", formatLimit(oldLimit), formatLimit(newLimit))
+	case oldOK && !newOK:
+		d.add(RateLimitChanged, false, id, location, "rate limit annotation %s removed", formatLimit(oldLimit))
+	case !oldOK && newOK:
+		d.add(RateLimitChanged, false, id, location, "rate limit annotation %s added", formatLimit(newLimit))
+	}
+}
+
+// schema 递归比较请求体或响应中的 schema。
+func (d *differ) schema(o, n *Schema, operation, location string, mode schemaMode, depth int) {
+	if o == nil || n == nil || depth > 32 {
+		return
+	}
+
+	// 命名 schema 以名称为位置，每个方向只比较一次（同时避免循环引用）
+	if o.Ref != "" && n.Ref != "" && RefName(o.Ref) == RefName(n.Ref) {
+		name := RefName(n.Ref)
+		key := string(mode) + ":" + name
+		if d.visited[key] {
+			return
+		}
+		d.visited[key] = true
+		location, operation = name, ""
+	}
+
+	o, n = d.old.Resolve(o), d.new.Resolve(n)
+	if o == nil || n == nil {
+		return
+	}
+
+	if d.typeChanged(o, n, operation, location) {
+		return
+	}
+	d.enum(o, n, operation, location)
+
+	oldProps, _ := properties(d.old, o)
+	newProps, newRequired := properties(d.new, n)
+	_, oldRequired := properties(d.old, o)
+
+	for _, name := range sortedNames(oldProps) {
+		if _, ok := newProps[name]; ok {
+			continue
+		}
+		if mode == modeResponse {
+			d.add(ResponseFieldRemoved, true, operation, location, "response field %s removed", name)
+		} else {
+			d.add(RequestFieldRemoved, true, operation, location, "request field %s removed", name)
+		}
+	}
+
+	for _, name := range sortedNames(newProps) {
+		before, ok := oldProps[name]
+		switch {
+		case !ok && mode == modeResponse:
+			d.add(ResponseFieldAdded, false, operation, location, "response field %s added", name)
+		case !ok && newRequired[name]:
+			d.add(RequiredFieldAdded, true, operation, location, "required request field %s added", name)
+		case !ok:
+			d.add(RequestFieldAdded, false, operation, location, "optional request field %s added", name)
+		default:
+			if mode == modeRequest && newRequired[name] && !oldRequired[name] {
+				d.add(RequiredFieldAdded, true, operation, location, "request field %s is now required", name)
+			}
+			d.schema(before, newProps[name], operation, location+"."+name, mode, depth+1)
+		}
+	}
+
+	d.schema(o.Items, n.Items, operation, location+"[]", mode, depth+1)
+	if o.AdditionalProperties != nil && n.AdditionalProperties != nil {
+		d.schema(o.AdditionalProperties.Schema, n.AdditionalProperties.Schema, operation, location+"{}", mode, depth+1)
+	}
+}
+
+// typeChanged 报告类型变化，返回是否发生了变化。
+func (d *differ) typeChanged(o, n *Schema, operation, location string) bool {
+	if o == nil || n == nil {
+		return false
+	}
+
+	before, after := schemaType(o), schemaType(n)
+	if before == "" || after == "" || before == after {
+		return false
+	}
+	d.add(TypeChanged, true, operation, location, "type changed from %s to %s", before, after)
+	return true
+}
+
+// enum 比较枚举取值。
+func (d *differ) enum(o, n *Schema, operation, location string) {
+	if o == nil || n == nil || len(o.Enum) == 0 || len(n.Enum) == 0 {
+		return
+	}
+
+	before := enumSet(o.Enum)
+	after := enumSet(n.Enum)
+
+	var added, removed []string
+	for value := range after {
+		if !before[value] {
+			added = append(added, value)
+		}
+	}
+	for value := range before {
+		if !after[value] {
+			removed = append(removed, value)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+
+	if len(removed) > 0 {
+		d.add(EnumValueRemoved, true, operation, location, "enum values removed: %s", strings.Join(removed, ", "))
+	}
+	if len(added) > 0 {
+		d.add(EnumValueAdded, false, operation, location, "enum values added: %s", strings.Join(added, ", "))
+	}
+}
+
+// properties 返回合并 allOf 后的属性和必需字段集合。
+func properties(doc *Document, schema *Schema) (map[string]*Schema, map[string]bool) {
+	props := make(map[string]*Schema)
+	required := make(map[string]bool)
+
+	var visit func(s *Schema, depth int)
+	visit = func(s *Schema, depth int) {
+		s = doc.Resolve(s)
+		if s == nil || depth > 16 {
+			return
+		}
+		for _, member := range s.AllOf {
+			visit(member, depth+1)
+		}
+		for name, prop := range s.Properties {
+			props[name] = prop
+		}
+		for _, name := range s.Required {
+			required[name] = true
+		}
+	}
+	visit(schema, 0)
+
+	return props, required
+}
+
+// schemaType 返回用于比较的类型描述（对象类型为空，不参与比较）。
+func schemaType(schema *Schema) string {
+	switch schema.Type {
+	case "", "object":
+		return ""
+	case "array":
+		return "array"
+	}
+	if schema.Format != "" {
+		return schema.Type + "(" + schema.Format + ")"
+	}
+	return schema.Type
+}
+
+// enumSet 将枚举取值转换为字符串集合。
+func enumSet(values []interface{}) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		set[fmt.Sprint(value)] = true
+	}
+	return set
+}
+
+// sortedNames 返回属性名的排序列表。
+func sortedNames(props map[string]*Schema) []string {
+	names := make([]string, 0, len(props))
+	for name := range props {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// formatLimit 格式化速率限制。
+func formatLimit(limit RateLimit) string {
+	return fmt.Sprintf("%g req/s burst %d", limit.Rate, limit.Burst)
+}
@@ -0,0 +1,256 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package openapi_test
+
+import (
+	"testing"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/openapi"
+)
+
+const diffOld = `{
+  "swagger": "2.0",
+  "info": {"title": "Orders", "version": "v0"},
+  "paths": {
+    "/orders/v0/orders": {
+      "get": {
+        "operationId": "getOrders",
+        "description": "Returns orders.\n\n**Usage Plan:**\n\n| Rate (requests per second) | Burst |\n| ---- | ---- |\n| 0.0167 | 20 |",
+        "parameters": [
+          {"name": "MarketplaceIds", "in": "query", "required": true, "type": "array", "items": {"type": "string"}},
+          {"name": "CreatedAfter", "in": "query", "type": "string"},
+          {"name": "LegacyFilter", "in": "query", "type": "string"},
+          {"name": "OrderStatuses", "in": "query", "type": "string", "enum": ["Pending", "Shipped"]}
+        ],
+        "responses": {"200": {"description": "OK", "schema": {"$ref": "#/definitions/GetOrdersResponse"}}}
+      }
+    },
+    "/orders/v0/orders/{orderId}": {
+      "get": {
+        "operationId": "getOrder",
+        "parameters": [{"name": "orderId", "in": "path", "required": true, "type": "string"}],
+        "responses": {"200": {"description": "OK", "schema": {"$ref": "#/definitions/Order"}}}
+      },
+      "post": {
+        "operationId": "updateOrder",
+        "parameters": [
+          {"name": "orderId", "in": "path", "required": true, "type": "string"},
+          {"name": "body", "in": "body", "required": true, "schema": {"$ref": "#/definitions/UpdateOrderRequest"}}
+        ],
+        "responses": {"204": {"description": "Updated"}}
+      }
+    },
+    "/orders/v0/orders/{orderId}/buyerInfo": {
+      "get": {
+        "operationId": "getOrderBuyerInfo",
+        "parameters": [{"name": "orderId", "in": "path", "required": true, "type": "string"}],
+        "responses": {"200": {"description": "OK"}}
+      }
+    }
+  },
+  "definitions": {
+    "GetOrdersResponse": {
+      "type": "object",
+      "properties": {"Orders": {"type": "array", "items": {"$ref": "#/definitions/Order"}}}
+    },
+    "Order": {
+      "type": "object",
+      "description": "Old description.",
+      "properties": {
+        "AmazonOrderId": {"type": "string"},
+        "BuyerEmail": {"type": "string"},
+        "NumberOfItems": {"type": "integer"},
+        "OrderStatus": {"$ref": "#/definitions/OrderStatus"}
+      }
+    },
+    "OrderStatus": {"type": "string", "enum": ["Pending", "Unshipped", "Shipped", "PendingAvailability"]},
+    "UpdateOrderRequest": {
+      "type": "object",
+      "properties": {
+        "note": {"type": "string"},
+        "legacy": {"type": "string"}
+      }
+    }
+  }
+}`
+
+const diffNew = `{
+  "swagger": "2.0",
+  "info": {"title": "Orders", "version": "v0"},
+  "paths": {
+    "/orders/v0/orders": {
+      "get": {
+        "operationId": "getOrders",
+        "description": "Returns orders.\n\n**Usage Plan:**\n\n| Rate (requests per second) | Burst |\n| ---- | ---- |\n| 0.0055 | 20 |",
+        "parameters": [
+          {"name": "MarketplaceIds", "in": "query", "required": true, "type": "array", "items": {"type": "string"}},
+          {"name": "CreatedAfter", "in": "query", "required": true, "type": "string"},
+          {"name": "OrderStatuses", "in": "query", "type": "string", "enum": ["Pending", "Shipped", "Canceled"]},
+          {"name": "SellerId", "in": "query", "required": true, "type": "string"},
+          {"name": "PageSize", "in": "query", "type": "integer"}
+        ],
+        "responses": {"200": {"description": "OK", "schema": {"$ref": "#/definitions/GetOrdersResponse"}}}
+      }
+    },
+    "/orders/v0/orders/{orderId}": {
+      "get": {
+        "operationId": "getOrder",
+        "deprecated": true,
+        "parameters": [{"name": "orderId", "in": "path", "required": true, "type": "string"}],
+        "responses": {"200": {"description": "OK", "schema": {"$ref": "#/definitions/Order"}}}
+      },
+      "post": {
+        "operationId": "updateOrder",
+        "parameters": [
+          {"name": "orderId", "in": "path", "required": true, "type": "string"},
+          {"name": "body", "in": "body", "required": true, "schema": {"$ref": "#/definitions/UpdateOrderRequest"}}
+        ],
+        "responses": {"204": {"description": "Updated"}}
+      }
+    },
+    "/orders/v0/orders/{orderId}/address": {
+      "get": {
+        "operationId": "getOrderAddress",
+        "parameters": [{"name": "orderId", "in": "path", "required": true, "type": "string"}],
+        "responses": {"200": {"description": "OK"}}
+      }
+    }
+  },
+  "definitions": {
+    "GetOrdersResponse": {
+      "type": "object",
+      "properties": {"Orders": {"type": "array", "items": {"$ref": "#/definitions/Order"}}}
+    },
+    "Order": {
+      "type": "object",
+      "description": "New description.",
+      "properties": {
+        "OrderStatus": {"$ref": "#/definitions/OrderStatus"},
+        "AmazonOrderId": {"type": "string"},
+        "NumberOfItems": {"type": "string"},
+        "IsPrime": {"type": "boolean"}
+      }
+    },
+    "OrderStatus": {"type": "string", "enum": ["Pending", "Unshipped", "Shipped", "Canceled"]},
+    "UpdateOrderRequest": {
+      "type": "object",
+      "required": ["note", "reason"],
+      "properties": {
+        "note": {"type": "string"},
+        "reason": {"type": "string"},
+        "tags": {"type": "array", "items": {"type": "string"}}
+      }
+    }
+  }
+}`
+
+func mustParse(t *testing.T, spec string) *openapi.Document {
+	t.Helper()
+	doc, err := openapi.Parse([]byte(spec))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	return doc
+}
+
+func TestDiff(t *testing.T) {
+	changes := openapi.Diff(mustParse(t, diffOld), mustParse(t, diffNew))
+
+	want := []openapi.Change{
+		{Kind: openapi.EnumValueRemoved, Breaking: true, Location: "OrderStatus", Message: "enum values removed: PendingAvailability"},
+		{Kind: openapi.OperationRemoved, Breaking: true, Operation: "getOrderBuyerInfo", Location: "GET /orders/v0/orders/{orderId}/buyerInfo", Message: "operation removed"},
+		{Kind: openapi.ParameterRemoved, Breaking: true, Operation: "getOrders", Location: "GET /orders/v0/orders", Message: "query parameter LegacyFilter removed"},
+		{Kind: openapi.ParameterRequired, Breaking: true, Operation: "getOrders", Location: "GET /orders/v0/orders", Message: "query parameter CreatedAfter is now required"},
+		{Kind: openapi.RateLimitChanged, Breaking: true, Operation: "getOrders", Location: "GET /orders/v0/orders", Message: "rate limit changed from 0.0167 req/s burst 20 to 0.0055 req/s burst 20"},
+		{Kind: openapi.RequestFieldRemoved, Breaking: true, Location: "UpdateOrderRequest", Message: "request field legacy removed"},
+		{Kind: openapi.RequiredFieldAdded, Breaking: true, Location: "UpdateOrderRequest", Message: "request field note is now required"},
+		{Kind: openapi.RequiredFieldAdded, Breaking: true, Location: "UpdateOrderRequest", Message: "required request field reason added"},
+		{Kind: openapi.RequiredParameterAdded, Breaking: true, Operation: "getOrders", Location: "GET /orders/v0/orders", Message: "required query parameter SellerId added"},
+		{Kind: openapi.ResponseFieldRemoved, Breaking: true, Location: "Order", Message: "response field BuyerEmail removed"},
+		{Kind: openapi.TypeChanged, Breaking: true, Location: "Order.NumberOfItems", Message: "type changed from integer to string"},
+		{Kind: openapi.EnumValueAdded, Operation: "getOrders", Location: "GET /orders/v0/orders OrderStatuses", Message: "enum values added: Canceled"},
+		{Kind: openapi.EnumValueAdded, Location: "OrderStatus", Message: "enum values added: Canceled"},
+		{Kind: openapi.OperationAdded, Operation: "getOrderAddress", Location: "GET /orders/v0/orders/{orderId}/address", Message: "operation added"},
+		{Kind: openapi.OperationDeprecated, Operation: "getOrder", Location: "GET /orders/v0/orders/{orderId}", Message: "operation deprecated"},
+		{Kind: openapi.ParameterAdded, Operation: "getOrders", Location: "GET /orders/v0/orders", Message: "optional query parameter PageSize added"},
+		{Kind: openapi.RequestFieldAdded, Location: "UpdateOrderRequest", Message: "optional request field tags added"},
+		{Kind: openapi.ResponseFieldAdded, Location: "Order", Message: "response field IsPrime added"},
+	}
+
+	if len(changes) != len(want) {
+		for _, change := range changes {
+			t.Logf("%+v", change)
+		}
+		t.Fatalf("Diff() returned %d changes, want %d", len(changes), len(want))
+	}
+	for i := range want {
+		if changes[i] != want[i] {
+			t.Errorf("change %d =\n  %+v\nwant\n  %+v", i, changes[i], want[i])
+		}
+	}
+
+	if !openapi.HasBreaking(changes) {
+		t.Error("HasBreaking() = false")
+	}
+}
+
+func TestDiff_NoSemanticChange(t *testing.T) {
+	// 描述、空白和属性顺序的变化不算语义变更
+	reformatted := `{"swagger":"2.0","info":{"title":"Orders","version":"v0","description":"changed"},
+	"paths":{"/orders/v0/orders/{orderId}":{"get":{"operationId":"getOrder","summary":"new summary",
+	"parameters":[{"name":"orderId","in":"path","required":true,"type":"string","description":"The order."}],
+	"responses":{"200":{"description":"Success","schema":{"$ref":"#/definitions/Order"}}}}}},
+	"definitions":{"Order":{"type":"object","properties":{"b":{"type":"string"},"a":{"type":"string"}}}}}`
+	original := `{
+	  "swagger": "2.0",
+	  "info": {"title": "Orders", "version": "v0"},
+	  "paths": {"/orders/v0/orders/{orderId}": {"get": {
+	    "operationId": "getOrder",
+	    "parameters": [{"name": "orderId", "in": "path", "required": true, "type": "string"}],
+	    "responses": {"200": {"description": "OK", "schema": {"$ref": "#/definitions/Order"}}}
+	  }}},
+	  "definitions": {"Order": {"type": "object", "properties": {"a": {"type": "string"}, "b": {"type": "string"}}}}
+	}`
+
+	if changes := openapi.Diff(mustParse(t, original), mustParse(t, reformatted)); len(changes) != 0 {
+		t.Errorf("Diff() = %+v, want no changes", changes)
+	}
+}
+
+func TestOperation_RateLimit(t *testing.T) {
+	tests := []struct {
+		description string
+		want        openapi.RateLimit
+		ok          bool
+	}{
+		{"| Rate (requests per second) | Burst |\n| ---- | ---- |\n| 0.5 | 30 |", openapi.RateLimit{Rate: 0.5, Burst: 30}, true},
+		{"**Usage Plan:**\r\n\r\n|Rate (requests per second)|Burst|\r\n|---|---|\r\n|2|5|", openapi.RateLimit{Rate: 2, Burst: 5}, true},
+		{"No usage plan.", openapi.RateLimit{}, false},
+	}
+
+	for _, tt := range tests {
+		op := &openapi.Operation{Description: tt.description}
+		got, ok := op.RateLimit()
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("RateLimit(%q) = %+v, %v; want %+v, %v", tt.description, got, ok, tt.want, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package openapi
+
+import (
+	"strconv"
+	"strings"
+)
+
+// RateLimit 是操作描述中 Usage Plan 表格给出的速率限制。
+type RateLimit struct {
+	// Rate 是每秒请求数
+	Rate float64 `json:"rate"`
+
+	// Burst 是突发容量
+	Burst int `json:"burst"`
+}
+
+// RateLimit 从操作描述中解析速率限制。
+//
+// SP-API 模型在操作描述中以 Markdown 表格给出默认速率限制：
+//
+//	| Rate (requests per second) | Burst |
+//	| ---- | ---- |
+//	| 0.0167 | 20 |
+//
+// 返回值:
+//   - RateLimit: 解析得到的速率限制
+//   - bool: 描述中没有可识别的表格时返回 false
+func (op *Operation) RateLimit() (RateLimit, bool) {
+	lines := strings.Split(strings.ReplaceAll(op.Description, "\r\n", "\n"), "\n")
+
+	for i, line := range lines {
+		lower := strings.ToLower(line)
+		if !strings.Contains(lower, "rate") || !strings.Contains(lower, "burst") || !strings.Contains(line, "|") {
+			continue
+		}
+
+		for _, row := range lines[i+1:] {
+			cells := tableCells(row)
+			if len(cells) < 2 {
+				break
+			}
+
+			rate, err := strconv.ParseFloat(cells[0], 64)
+			if err != nil {
+				// 分隔行（| ---- | ---- |）
+				continue
+			}
+			burst, err := strconv.Atoi(cells[1])
+			if err != nil {
+				break
+			}
+			return RateLimit{Rate: rate, Burst: burst}, true
+		}
+	}
+
+	return RateLimit{}, false
+}
+
+// tableCells 拆分 Markdown 表格行。
+func tableCells(row string) []string {
+	row = strings.TrimSpace(row)
+	if !strings.HasPrefix(row, "|") {
+		return nil
+	}
+
+	var cells []string
+	for _, cell := range strings.Split(strings.Trim(row, "|"), "|") {
+		cells = append(cells, strings.TrimSpace(cell))
+	}
+	return cells
+}
@@ -0,0 +1,730 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+// Package openapi 加载 SP-API 的 Swagger 2.0 / OpenAPI 3 模型文件。
+//
+// 官方仓库 selling-partner-api-models 中大部分 API 使用 Swagger 2.0，
+// 少数较新的 API 使用 OpenAPI 3。本包将两种格式统一规范化为 Document，
+// 供代码生成器（cmd/generator）和规范监控工具（cmd/api-monitor）使用：
+//   - OpenAPI 3 的 "#/components/schemas/X" 引用统一改写为 "#/definitions/X"
+//   - 参数、请求体、响应的 $ref 全部解析为内联对象
+//   - 非 body 参数的类型信息统一放入 Parameter.Schema
+//   - 操作按路径和方法排序，保证输出稳定
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// ErrUnsupportedVersion 表示文档既不是 Swagger 2.0 也不是 OpenAPI 3。
+var ErrUnsupportedVersion = errors.New("unsupported specification version")
+
+// definitionsPrefix 是规范化后的 schema 引用前缀。
+const definitionsPrefix = "#/definitions/"
+
+// httpMethods 是路径项中可能出现的 HTTP 方法（按输出顺序）。
+var httpMethods = []string{"get", "put", "post", "delete", "options", "head", "patch"}
+
+// Document 表示规范化后的 API 文档。
+type Document struct {
+	// Title 是 info.title
+	Title string
+
+	// Description 是 info.description
+	Description string
+
+	// Version 是 info.version
+	Version string
+
+	// Operations 是所有操作，按路径和方法排序
+	Operations []*Operation
+
+	// Definitions 是所有命名 schema（Swagger 2.0 的 definitions 或 OpenAPI 3 的 components.schemas）
+	Definitions map[string]*Schema
+}
+
+// Operation 表示一个 API 操作。
+type Operation struct {
+	// ID 是 operationId
+	ID string
+
+	// Method 是大写的 HTTP 方法
+	Method string
+
+	// Path 是路径模板（如 /orders/v0/orders/{orderId}）
+	Path string
+
+	// Summary 是操作摘要
+	Summary string
+
+	// Description 是操作描述（SP-API 在其中以表格形式给出速率限制）
+	Description string
+
+	// Deprecated 表示操作已废弃
+	Deprecated bool
+
+	// Parameters 是路径、查询和请求头参数（不含请求体）
+	Parameters []*Parameter
+
+	// Body 是请求体 schema（没有请求体时为 nil）
+	Body *Schema
+
+	// BodyRequired 表示请求体是否必需
+	BodyRequired bool
+
+	// Responses 是按状态码索引的响应
+	Responses map[string]*Response
+}
+
+// Parameter 表示一个非 body 参数。
+type Parameter struct {
+	// Name 是参数名
+	Name string
+
+	// In 是参数位置（path、query、header）
+	In string
+
+	// Description 是参数描述
+	Description string
+
+	// Required 表示参数是否必需
+	Required bool
+
+	// CollectionFormat 是数组参数的序列化格式（csv、multi、ssv、tsv、pipes），
+	// OpenAPI 3 的 style/explode 会被规范化为对应的格式，非数组参数为空
+	CollectionFormat string
+
+	// Schema 是参数类型
+	Schema *Schema
+}
+
+// Response 表示一个响应。
+type Response struct {
+	// Description 是响应描述
+	Description string
+
+	// Schema 是响应体 schema（没有响应体时为 nil）
+	Schema *Schema
+
+	// Sandbox 是 x-amzn-api-sandbox 声明的沙箱用例（静态用例在前）
+	Sandbox []*SandboxCase
+}
+
+// SandboxCase 表示 x-amzn-api-sandbox 中的一个沙箱用例。
+//
+// 请求参数与 Parameters 全部匹配时，沙箱返回 Response 作为响应体。
+type SandboxCase struct {
+	// Dynamic 表示用例来自 dynamic 列表
+	Dynamic bool
+
+	// Parameters 是参数名到期望值（原始 JSON）的映射
+	Parameters map[string]json.RawMessage
+
+	// Response 是响应体（原始 JSON）
+	Response json.RawMessage
+}
+
+// Schema 表示 JSON Schema 的子集。
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Enum                 []interface{}      `json:"enum,omitempty"`
+	AllOf                []*Schema          `json:"allOf,omitempty"`
+	OneOf                []*Schema          `json:"oneOf,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+	AdditionalProperties *Additional        `json:"additionalProperties,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+
+	// order 保存 properties 在文档中的原始顺序
+	order []string
+}
+
+// Additional 表示 additionalProperties，可以是布尔值或 schema。
+type Additional struct {
+	// Allowed 为 false 表示禁止额外属性
+	Allowed bool
+
+	// Schema 是额外属性的值类型（为 nil 时表示任意类型）
+	Schema *Schema
+}
+
+// UnmarshalJSON 同时接受布尔值和 schema 对象。
+func (a *Additional) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	switch string(data) {
+	case "true":
+		*a = Additional{Allowed: true}
+		return nil
+	case "false":
+		*a = Additional{}
+		return nil
+	}
+
+	var schema Schema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return err
+	}
+	*a = Additional{Allowed: true, Schema: &schema}
+	return nil
+}
+
+// MarshalJSON 输出布尔值或 schema 对象。
+func (a Additional) MarshalJSON() ([]byte, error) {
+	if a.Schema != nil {
+		return json.Marshal(a.Schema)
+	}
+	return json.Marshal(a.Allowed)
+}
+
+// UnmarshalJSON 解析 schema 并记录 properties 的原始顺序。
+func (s *Schema) UnmarshalJSON(data []byte) error {
+	type plain Schema
+	if err := json.Unmarshal(data, (*plain)(s)); err != nil {
+		return err
+	}
+	if len(s.Properties) == 0 {
+		return nil
+	}
+
+	var raw struct {
+		Properties json.RawMessage `json:"properties"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	order, err := objectKeys(raw.Properties)
+	if err != nil {
+		return err
+	}
+	s.order = order
+	return nil
+}
+
+// PropertyNames 返回属性名列表。
+//
+// 从 JSON 解析的 schema 保持文档中的顺序，其他情况按名称排序。
+func (s *Schema) PropertyNames() []string {
+	if len(s.order) == len(s.Properties) {
+		return s.order
+	}
+
+	names := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// IsRequired 返回属性是否在 required 列表中。
+func (s *Schema) IsRequired(name string) bool {
+	for _, required := range s.Required {
+		if required == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ModelDir 返回 API 模型在 selling-partner-api-models/models 下的目录名。
+//
+// 目录名为 "<name>-api-model"，名称本身以 "-model" 结尾的 API
+// （如 amazon-warehousing-and-distribution-model、easy-ship-model）除外。
+//
+// 示例:
+//
+//	openapi.ModelDir("orders")         // "orders-api-model"
+//	openapi.ModelDir("easy-ship-model") // "easy-ship-model"
+func ModelDir(name string) string {
+	if strings.HasSuffix(name, "-model") {
+		return name
+	}
+	return name + "-api-model"
+}
+
+// RefName 返回 $ref 引用的 schema 名称。
+//
+// 示例:
+//
+//	openapi.RefName("#/definitions/Order") // "Order"
+func RefName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+// Resolve 沿 $ref 链返回实际的 schema。
+//
+// 引用不存在时返回 nil。
+func (d *Document) Resolve(schema *Schema) *Schema {
+	for depth := 0; schema != nil && schema.Ref != ""; depth++ {
+		if depth > 32 {
+			return nil
+		}
+		schema = d.Definitions[RefName(schema.Ref)]
+	}
+	return schema
+}
+
+// Operation 按 operationId 查找操作。
+func (d *Document) Operation(id string) *Operation {
+	for _, op := range d.Operations {
+		if op.ID == id {
+			return op
+		}
+	}
+	return nil
+}
+
+// Load 从文件加载并规范化 API 文档。
+//
+// 参数:
+//   - path: Swagger 2.0 或 OpenAPI 3 JSON 文件路径
+//
+// 返回值:
+//   - *Document: 规范化后的文档
+//   - error: 读取或解析失败时返回错误
+func Load(path string) (*Document, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read specification: %w", err)
+	}
+
+	doc, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return doc, nil
+}
+
+// rawParameter 是参数的原始 JSON 形式（两种格式的并集）。
+type rawParameter struct {
+	Ref              string        `json:"$ref"`
+	Name             string        `json:"name"`
+	In               string        `json:"in"`
+	Description      string        `json:"description"`
+	Required         bool          `json:"required"`
+	Type             string        `json:"type"`
+	Format           string        `json:"format"`
+	Items            *Schema       `json:"items"`
+	Enum             []interface{} `json:"enum"`
+	CollectionFormat string        `json:"collectionFormat"`
+	Minimum          *float64      `json:"minimum"`
+	Maximum          *float64      `json:"maximum"`
+	MaxItems         *int          `json:"maxItems"`
+	MinItems         *int          `json:"minItems"`
+	Schema           *Schema       `json:"schema"`
+	Style            string        `json:"style"`
+	Explode          *bool         `json:"explode"`
+}
+
+// collectionFormat 返回数组参数规范化后的序列化格式。
+//
+// Swagger 2.0 未声明时默认为 csv；OpenAPI 3 按 style/explode 转换，
+// 查询参数默认的 form 风格 explode=true，即 multi，路径和头部参数默认为 csv。
+func (p *rawParameter) collectionFormat(schema *Schema) string {
+	if schema == nil || schema.Type != "array" {
+		return p.CollectionFormat
+	}
+	if p.Schema == nil {
+		if p.CollectionFormat == "" {
+			return "csv"
+		}
+		return p.CollectionFormat
+	}
+	switch p.Style {
+	case "spaceDelimited":
+		return "ssv"
+	case "pipeDelimited":
+		return "pipes"
+	}
+	explode := p.Style == "form" || (p.Style == "" && (p.In == "query" || p.In == "cookie"))
+	if p.Explode != nil {
+		explode = *p.Explode
+	}
+	if explode {
+		return "multi"
+	}
+	return "csv"
+}
+
+// rawMediaType 是 OpenAPI 3 的媒体类型对象。
+type rawMediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// rawResponse 是响应的原始 JSON 形式。
+type rawResponse struct {
+	Ref         string                  `json:"$ref"`
+	Description string                  `json:"description"`
+	Schema      *Schema                 `json:"schema"`
+	Content     map[string]rawMediaType `json:"content"`
+	Sandbox     *rawSandbox             `json:"x-amzn-api-sandbox"`
+}
+
+// rawSandbox 是 x-amzn-api-sandbox 扩展的原始 JSON 形式。
+type rawSandbox struct {
+	Static  []rawSandboxCase `json:"static"`
+	Dynamic []rawSandboxCase `json:"dynamic"`
+}
+
+// rawSandboxCase 是沙箱用例的原始 JSON 形式。
+type rawSandboxCase struct {
+	Request struct {
+		Parameters map[string]struct {
+			Value json.RawMessage `json:"value"`
+		} `json:"parameters"`
+	} `json:"request"`
+	Response json.RawMessage `json:"response"`
+}
+
+// rawRequestBody 是 OpenAPI 3 请求体的原始 JSON 形式。
+type rawRequestBody struct {
+	Ref      string                  `json:"$ref"`
+	Required bool                    `json:"required"`
+	Content  map[string]rawMediaType `json:"content"`
+}
+
+// rawOperation 是操作的原始 JSON 形式。
+type rawOperation struct {
+	OperationID string                  `json:"operationId"`
+	Summary     string                  `json:"summary"`
+	Description string                  `json:"description"`
+	Deprecated  bool                    `json:"deprecated"`
+	Parameters  []*rawParameter         `json:"parameters"`
+	RequestBody *rawRequestBody         `json:"requestBody"`
+	Responses   map[string]*rawResponse `json:"responses"`
+}
+
+// rawDocument 是文档的原始 JSON 形式。
+type rawDocument struct {
+	Swagger string `json:"swagger"`
+	OpenAPI string `json:"openapi"`
+	Info    struct {
+		Title       string `json:"title"`
+		Description string `json:"description"`
+		Version     string `json:"version"`
+	} `json:"info"`
+	Paths       map[string]map[string]json.RawMessage `json:"paths"`
+	Definitions map[string]*Schema                    `json:"definitions"`
+	Parameters  map[string]*rawParameter              `json:"parameters"`
+	Responses   map[string]*rawResponse               `json:"responses"`
+	Components  struct {
+		Schemas       map[string]*Schema         `json:"schemas"`
+		Parameters    map[string]*rawParameter   `json:"parameters"`
+		Responses     map[string]*rawResponse    `json:"responses"`
+		RequestBodies map[string]*rawRequestBody `json:"requestBodies"`
+	} `json:"components"`
+}
+
+// Parse 解析并规范化 API 文档。
+//
+// 参数:
+//   - data: Swagger 2.0 或 OpenAPI 3 JSON 内容
+//
+// 返回值:
+//   - *Document: 规范化后的文档
+//   - error: 解析失败或版本不受支持时返回错误
+func Parse(data []byte) (*Document, error) {
+	var raw rawDocument
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	openapi3 := strings.HasPrefix(raw.OpenAPI, "3.")
+	if raw.Swagger != "2.0" && !openapi3 {
+		return nil, fmt.Errorf("%w: swagger=%q openapi=%q", ErrUnsupportedVersion, raw.Swagger, raw.OpenAPI)
+	}
+
+	doc := &Document{
+		Title:       raw.Info.Title,
+		Description: raw.Info.Description,
+		Version:     raw.Info.Version,
+		Definitions: raw.Definitions,
+	}
+
+	parameters, responses := raw.Parameters, raw.Responses
+	if openapi3 {
+		doc.Definitions = raw.Components.Schemas
+		parameters, responses = raw.Components.Parameters, raw.Components.Responses
+	}
+	if doc.Definitions == nil {
+		doc.Definitions = make(map[string]*Schema)
+	}
+
+	for _, schema := range doc.Definitions {
+		normalizeRefs(schema)
+	}
+
+	paths := make([]string, 0, len(raw.Paths))
+	for path := range raw.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		item := raw.Paths[path]
+
+		var shared []*rawParameter
+		if data, ok := item["parameters"]; ok {
+			if err := json.Unmarshal(data, &shared); err != nil {
+				return nil, fmt.Errorf("path %s parameters: %w", path, err)
+			}
+		}
+
+		for _, method := range httpMethods {
+			data, ok := item[method]
+			if !ok {
+				continue
+			}
+
+			var rawOp rawOperation
+			if err := json.Unmarshal(data, &rawOp); err != nil {
+				return nil, fmt.Errorf("%s %s: %w", strings.ToUpper(method), path, err)
+			}
+
+			op, err := buildOperation(path, method, &rawOp, shared, parameters, responses, raw.Components.RequestBodies)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %w", strings.ToUpper(method), path, err)
+			}
+			doc.Operations = append(doc.Operations, op)
+		}
+	}
+
+	return doc, nil
+}
+
+// buildOperation 将原始操作转换为规范化的 Operation。
+func buildOperation(path, method string, raw *rawOperation, shared []*rawParameter,
+	parameters map[string]*rawParameter, responses map[string]*rawResponse,
+	requestBodies map[string]*rawRequestBody) (*Operation, error) {
+	op := &Operation{
+		ID:          raw.OperationID,
+		Method:      strings.ToUpper(method),
+		Path:        path,
+		Summary:     raw.Summary,
+		Description: raw.Description,
+		Deprecated:  raw.Deprecated,
+		Responses:   make(map[string]*Response),
+	}
+
+	// 操作级参数覆盖同名同位置的路径级参数
+	merged := make([]*rawParameter, 0, len(shared)+len(raw.Parameters))
+	seen := make(map[string]int)
+	for _, list := range [][]*rawParameter{shared, raw.Parameters} {
+		for _, param := range list {
+			resolved, err := resolveParameter(param, parameters)
+			if err != nil {
+				return nil, err
+			}
+			key := resolved.In + ":" + resolved.Name
+			if index, ok := seen[key]; ok {
+				merged[index] = resolved
+				continue
+			}
+			seen[key] = len(merged)
+			merged = append(merged, resolved)
+		}
+	}
+
+	for _, param := range merged {
+		if param.In == "body" {
+			op.Body = normalizeRefs(param.Schema)
+			op.BodyRequired = param.Required
+			continue
+		}
+		if param.In == "formData" {
+			continue
+		}
+
+		schema := param.Schema
+		if schema == nil {
+			schema = &Schema{
+				Type:     param.Type,
+				Format:   param.Format,
+				Items:    param.Items,
+				Enum:     param.Enum,
+				Minimum:  param.Minimum,
+				Maximum:  param.Maximum,
+				MinItems: param.MinItems,
+				MaxItems: param.MaxItems,
+			}
+		}
+
+		op.Parameters = append(op.Parameters, &Parameter{
+			Name:             param.Name,
+			In:               param.In,
+			Description:      param.Description,
+			Required:         param.Required || param.In == "path",
+			CollectionFormat: param.collectionFormat(schema),
+			Schema:           normalizeRefs(schema),
+		})
+	}
+
+	if body := raw.RequestBody; body != nil {
+		if body.Ref != "" {
+			resolved, ok := requestBodies[RefName(body.Ref)]
+			if !ok {
+				return nil, fmt.Errorf("unresolved request body %s", body.Ref)
+			}
+			body = resolved
+		}
+		op.Body = normalizeRefs(jsonSchema(body.Content))
+		op.BodyRequired = body.Required
+	}
+
+	for code, response := range raw.Responses {
+		if response.Ref != "" {
+			resolved, ok := responses[RefName(response.Ref)]
+			if !ok {
+				return nil, fmt.Errorf("unresolved response %s", response.Ref)
+			}
+			response = resolved
+		}
+
+		schema := response.Schema
+		if schema == nil {
+			schema = jsonSchema(response.Content)
+		}
+		op.Responses[code] = &Response{
+			Description: response.Description,
+			Schema:      normalizeRefs(schema),
+			Sandbox:     sandboxCases(response.Sandbox),
+		}
+	}
+
+	return op, nil
+}
+
+// sandboxCases 转换沙箱用例，静态用例在前。
+func sandboxCases(raw *rawSandbox) []*SandboxCase {
+	if raw == nil {
+		return nil
+	}
+
+	var cases []*SandboxCase
+	for i, list := range [][]rawSandboxCase{raw.Static, raw.Dynamic} {
+		for _, c := range list {
+			parameters := make(map[string]json.RawMessage, len(c.Request.Parameters))
+			for name, param := range c.Request.Parameters {
+				parameters[name] = param.Value
+			}
+			cases = append(cases, &SandboxCase{
+				Dynamic:    i == 1,
+				Parameters: parameters,
+				Response:   c.Response,
+			})
+		}
+	}
+	return cases
+}
+
+// resolveParameter 解析参数引用。
+func resolveParameter(param *rawParameter, parameters map[string]*rawParameter) (*rawParameter, error) {
+	if param.Ref == "" {
+		return param, nil
+	}
+	resolved, ok := parameters[RefName(param.Ref)]
+	if !ok {
+		return nil, fmt.Errorf("unresolved parameter %s", param.Ref)
+	}
+	return resolved, nil
+}
+
+// jsonSchema 从 OpenAPI 3 的 content 中选取 JSON 媒体类型的 schema。
+func jsonSchema(content map[string]rawMediaType) *Schema {
+	if media, ok := content["application/json"]; ok {
+		return media.Schema
+	}
+
+	types := make([]string, 0, len(content))
+	for mediaType := range content {
+		types = append(types, mediaType)
+	}
+	sort.Strings(types)
+	for _, mediaType := range types {
+		if strings.Contains(mediaType, "json") {
+			return content[mediaType].Schema
+		}
+	}
+	return nil
+}
+
+// objectKeys 按出现顺序返回 JSON 对象的键。
+func objectKeys(data []byte) ([]string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key.(string))
+	}
+	return keys, nil
+}
+
+// normalizeRefs 将 schema 树中的 OpenAPI 3 引用改写为 definitions 形式。
+func normalizeRefs(schema *Schema) *Schema {
+	if schema == nil {
+		return nil
+	}
+
+	if schema.Ref != "" {
+		schema.Ref = definitionsPrefix + RefName(schema.Ref)
+	}
+	for _, property := range schema.Properties {
+		normalizeRefs(property)
+	}
+	normalizeRefs(schema.Items)
+	for _, list := range [][]*Schema{schema.AllOf, schema.OneOf, schema.AnyOf} {
+		for _, item := range list {
+			normalizeRefs(item)
+		}
+	}
+	if schema.AdditionalProperties != nil {
+		normalizeRefs(schema.AdditionalProperties.Schema)
+	}
+	return schema
+}
@@ -0,0 +1,189 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package openapi_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/openapi"
+)
+
+const swagger2 = `{
+  "swagger": "2.0",
+  "info": {"title": "Orders", "version": "v0"},
+  "paths": {
+    "/orders/v0/orders/{orderId}": {
+      "parameters": [{"$ref": "#/parameters/OrderId"}],
+      "get": {
+        "operationId": "getOrder",
+        "parameters": [
+          {"name": "MarketplaceIds", "in": "query", "type": "array", "items": {"type": "string"}, "collectionFormat": "csv"}
+        ],
+        "responses": {"200": {"description": "OK", "schema": {"$ref": "#/definitions/Order"}}}
+      },
+      "post": {
+        "operationId": "updateOrder",
+        "parameters": [{"name": "body", "in": "body", "required": true, "schema": {"$ref": "#/definitions/Order"}}],
+        "responses": {"204": {"description": "Updated", "x-amzn-api-sandbox": {
+          "dynamic": [{"request": {"parameters": {"body": {"value": {"zeta": "b"}}}}, "response": {}}],
+          "static": [{"request": {"parameters": {"orderId": {"value": "TEST_CASE_204"}}}, "response": {}}]
+        }}}
+      }
+    }
+  },
+  "parameters": {"OrderId": {"name": "orderId", "in": "path", "type": "string"}},
+  "definitions": {
+    "Order": {
+      "type": "object",
+      "properties": {"zeta": {"type": "string"}, "alpha": {"type": "string"}, "mid": {"type": "integer"}},
+      "additionalProperties": false
+    },
+    "Attributes": {"type": "object", "additionalProperties": {"type": "string"}}
+  }
+}`
+
+const openapi3 = `{
+  "openapi": "3.0.1",
+  "info": {"title": "Gadgets", "version": "2024-01-01"},
+  "paths": {
+    "/gadgets": {
+      "get": {
+        "operationId": "listGadgets",
+        "parameters": [
+          {"name": "ids", "in": "query", "schema": {"type": "array", "items": {"type": "string"}}},
+          {"name": "tags", "in": "query", "explode": false, "schema": {"type": "array", "items": {"type": "string"}}},
+          {"name": "codes", "in": "query", "style": "pipeDelimited", "schema": {"type": "array", "items": {"type": "string"}}},
+          {"name": "pageSize", "in": "query", "schema": {"type": "integer"}}
+        ],
+        "responses": {"200": {"$ref": "#/components/responses/OK"}}
+      },
+      "put": {
+        "operationId": "putGadget",
+        "requestBody": {"$ref": "#/components/requestBodies/Body"},
+        "responses": {"200": {"$ref": "#/components/responses/OK"}}
+      }
+    }
+  },
+  "components": {
+    "requestBodies": {"Body": {"required": true, "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Gadget"}}}}},
+    "responses": {"OK": {"description": "OK", "content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Gadget"}}}}}},
+    "schemas": {"Gadget": {"type": "object", "properties": {"id": {"type": "string"}}}}
+  }
+}`
+
+func TestParse_Swagger2(t *testing.T) {
+	doc, err := openapi.Parse([]byte(swagger2))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if len(doc.Operations) != 2 || doc.Operations[0].Method != "GET" || doc.Operations[1].Method != "POST" {
+		t.Fatalf("Operations = %+v, want GET then POST", doc.Operations)
+	}
+
+	get := doc.Operation("getOrder")
+	if len(get.Parameters) != 2 {
+		t.Fatalf("getOrder parameters = %d, want 2", len(get.Parameters))
+	}
+	path := get.Parameters[0]
+	if path.Name != "orderId" || path.In != "path" || !path.Required {
+		t.Errorf("path parameter = %+v, want required orderId", path)
+	}
+	query := get.Parameters[1]
+	if query.Schema.Type != "array" || query.Schema.Items.Type != "string" || query.CollectionFormat != "csv" {
+		t.Errorf("query parameter = %+v, want csv array of strings", query)
+	}
+	if ref := get.Responses["200"].Schema.Ref; ref != "#/definitions/Order" {
+		t.Errorf("200 schema ref = %q", ref)
+	}
+
+	update := doc.Operation("updateOrder")
+	if update.Body == nil || !update.BodyRequired || update.Body.Ref != "#/definitions/Order" {
+		t.Errorf("updateOrder body = %+v required=%v", update.Body, update.BodyRequired)
+	}
+	sandbox := update.Responses["204"].Sandbox
+	if len(sandbox) != 2 || sandbox[0].Dynamic || !sandbox[1].Dynamic {
+		t.Fatalf("sandbox = %+v, want static case then dynamic case", sandbox)
+	}
+	if got := string(sandbox[0].Parameters["orderId"]); got != `"TEST_CASE_204"` {
+		t.Errorf("sandbox orderId = %s", got)
+	}
+
+	order := doc.Definitions["Order"]
+	if got := strings.Join(order.PropertyNames(), ","); got != "zeta,alpha,mid" {
+		t.Errorf("PropertyNames() = %s, want document order", got)
+	}
+	if order.AdditionalProperties == nil || order.AdditionalProperties.Allowed {
+		t.Errorf("additionalProperties false = %+v", order.AdditionalProperties)
+	}
+
+	attributes := doc.Definitions["Attributes"]
+	if attributes.AdditionalProperties == nil || attributes.AdditionalProperties.Schema.Type != "string" {
+		t.Errorf("additionalProperties schema = %+v", attributes.AdditionalProperties)
+	}
+}
+
+func TestParse_OpenAPI3(t *testing.T) {
+	doc, err := openapi.Parse([]byte(openapi3))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	op := doc.Operation("putGadget")
+	if op == nil {
+		t.Fatal("putGadget not found")
+	}
+	if op.Body == nil || !op.BodyRequired || op.Body.Ref != "#/definitions/Gadget" {
+		t.Errorf("body = %+v required=%v, want normalized ref", op.Body, op.BodyRequired)
+	}
+
+	response := op.Responses["200"]
+	if response == nil || response.Schema.Items.Ref != "#/definitions/Gadget" {
+		t.Errorf("response = %+v, want array of Gadget", response)
+	}
+
+	if doc.Resolve(op.Body) != doc.Definitions["Gadget"] {
+		t.Error("Resolve() did not follow the normalized ref")
+	}
+
+	var formats []string
+	for _, param := range doc.Operation("listGadgets").Parameters {
+		formats = append(formats, param.Name+"="+param.CollectionFormat)
+	}
+	if got := strings.Join(formats, ","); got != "ids=multi,tags=csv,codes=pipes,pageSize=" {
+		t.Errorf("collection formats = %s, want style/explode normalized", got)
+	}
+}
+
+func TestParse_UnsupportedVersion(t *testing.T) {
+	_, err := openapi.Parse([]byte(`{"swagger": "1.2"}`))
+	if !errors.Is(err, openapi.ErrUnsupportedVersion) {
+		t.Errorf("Parse() error = %v, want ErrUnsupportedVersion", err)
+	}
+}
+
+func TestRefName(t *testing.T) {
+	if got := openapi.RefName("#/components/schemas/Order"); got != "Order" {
+		t.Errorf("RefName() = %s, want Order", got)
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package ratelimit
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/metrics"
+)
+
+// AdaptiveConfig 配置根据 429 响应自适应调整速率（AIMD）。
+//
+// 限制器的实际速率是上限（x-amzn-RateLimit-Limit 头部或静态配置的速率）
+// 乘以一个调整系数：收到 429 时系数乘以 Decrease，连续 ProbeAfter 次成功后
+// 系数增加 Increase，系数不超过 1，因此实际速率不会超过上限。
+type AdaptiveConfig struct {
+	// Decrease 是收到 429 时系数的乘数（0-1，默认 0.5）。
+	Decrease float64
+
+	// Increase 是每次向上探测时系数的增量（默认 0.1，即上限的 10%）。
+	Increase float64
+
+	// ProbeAfter 是向上探测前需要的连续成功次数（默认 10）。
+	ProbeAfter int
+
+	// MinFactor 是系数的下限（默认 0.05），避免速率降为 0。
+	MinFactor float64
+}
+
+// DefaultAdaptiveConfig 返回默认的自适应配置。
+func DefaultAdaptiveConfig() *AdaptiveConfig {
+	return &AdaptiveConfig{
+		Decrease:   0.5,
+		Increase:   0.1,
+		ProbeAfter: 10,
+		MinFactor:  0.05,
+	}
+}
+
+// 速率调整方向，用作 direction 标签。
+const (
+	directionDecrease = "decrease"
+	directionIncrease = "increase"
+)
+
+// WithAdaptive 设置自适应速率调整的参数。
+//
+// 未设置的字段（零值或超出范围）使用 DefaultAdaptiveConfig 的值；
+// config 为 nil 时不自适应，限制器只使用响应头或静态配置的速率。
+//
+// 参数:
+//   - config: 自适应配置（默认 DefaultAdaptiveConfig）
+//
+// 示例:
+//
+//	// 收到 429 时速率减为 1/4，连续 20 次成功后增加上限的 5%
+//	manager := ratelimit.NewManager(ratelimit.WithAdaptive(&ratelimit.AdaptiveConfig{
+//	    Decrease:   0.25,
+//	    Increase:   0.05,
+//	    ProbeAfter: 20,
+//	}))
+func WithAdaptive(config *AdaptiveConfig) ManagerOption {
+	return func(m *Manager) {
+		if config == nil {
+			m.adaptive = nil
+			return
+		}
+
+		adaptive := DefaultAdaptiveConfig()
+		if config.Decrease > 0 && config.Decrease < 1 {
+			adaptive.Decrease = config.Decrease
+		}
+		if config.Increase > 0 {
+			adaptive.Increase = config.Increase
+		}
+		if config.ProbeAfter > 0 {
+			adaptive.ProbeAfter = config.ProbeAfter
+		}
+		if config.MinFactor > 0 && config.MinFactor <= 1 {
+			adaptive.MinFactor = config.MinFactor
+		}
+		m.adaptive = adaptive
+	}
+}
+
+// Observe 根据响应状态码调整速率。
+//
+// 429 使速率乘以 Decrease（每个令牌间隔内最多降低一次，
+// 避免同时发出的请求一起返回 429 时速率被连续降低）；
+// 2xx 和 3xx 计为成功，连续 ProbeAfter 次成功后速率向上探测，
+// 直到回到上限。其他状态码不影响速率。未启用自适应时不做任何事。
+//
+// 参数:
+//   - statusCode: HTTP 响应状态码
+//
+// 示例:
+//
+//	resp, err := httpClient.Do(req)
+//	if err == nil {
+//	    limiter.Observe(resp.StatusCode)
+//	}
+func (l *Limiter) Observe(statusCode int) {
+	if l.adaptive == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	switch {
+	case statusCode == http.StatusTooManyRequests:
+		l.successes = 0
+		rate, _ := l.bucket.GetRate()
+		if !l.lastDecrease.IsZero() && now.Sub(l.lastDecrease) < time.Duration(float64(time.Second)/rate) {
+			return
+		}
+		factor := max(l.factor*l.adaptive.Decrease, l.adaptive.MinFactor)
+		l.lastDecrease = now
+		if factor < l.factor {
+			l.factor = factor
+			l.applyLocked(directionDecrease)
+		}
+
+	case statusCode >= 200 && statusCode < 400:
+		if l.factor >= 1 {
+			return
+		}
+		l.successes++
+		if l.successes < l.adaptive.ProbeAfter {
+			return
+		}
+		l.successes = 0
+		l.factor = min(l.factor+l.adaptive.Increase, 1)
+		l.applyLocked(directionIncrease)
+	}
+}
+
+// Ceiling 返回速率上限（响应头或静态配置的速率）。
+//
+// 自适应调整后的实际速率见 GetRate，它不会超过上限。
+//
+// 返回值:
+//   - float64: 速率上限（请求数/秒）
+func (l *Limiter) Ceiling() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.ceiling
+}
+
+// applyLocked 把上限乘以系数后的速率写入令牌桶，并记录调整（已持锁）。
+//
+// direction 为空表示上限变化，不记录调整次数。
+func (l *Limiter) applyLocked(direction string) {
+	_, burst := l.bucket.GetRate()
+	rate := l.ceiling * l.factor
+	// 参数已在 SetRate 中校验，这里不会失败
+	_ = l.bucket.UpdateRate(rate, burst)
+
+	if l.metrics == nil {
+		return
+	}
+	l.metrics.RecordGauge(metrics.MetricRateLimitRate, rate, l.labels)
+	l.metrics.RecordGauge(metrics.MetricRateLimitCeiling, l.ceiling, l.labels)
+	if direction != "" {
+		labels := make(map[string]string, len(l.labels)+1)
+		for k, v := range l.labels {
+			labels[k] = v
+		}
+		labels[metrics.LabelDirection] = direction
+		l.metrics.RecordCounter(metrics.MetricRateLimitAdjustments, 1, labels)
+	}
+}
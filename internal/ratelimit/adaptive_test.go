@@ -0,0 +1,147 @@
+package ratelimit
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/metrics"
+)
+
+// adjustmentRecorder 记录速率调整次数和最新的速率。
+type adjustmentRecorder struct {
+	metrics.NoOpRecorder
+	mu          sync.Mutex
+	adjustments map[string]float64
+	gauges      map[string]float64
+}
+
+func (r *adjustmentRecorder) RecordCounter(name string, value float64, labels map[string]string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.adjustments[labels[metrics.LabelDirection]] += value
+}
+
+func (r *adjustmentRecorder) RecordGauge(name string, value float64, labels map[string]string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.gauges[name] = value
+}
+
+func TestLimiter_ObserveDecreasesOn429(t *testing.T) {
+	limiter, _ := NewLimiter(100, 10)
+
+	limiter.Observe(http.StatusTooManyRequests)
+	if rate, _ := limiter.GetRate(); rate != 50 {
+		t.Fatalf("rate after 429 = %v, want 50", rate)
+	}
+
+	// 同一个令牌间隔内的 429 不再降低速率
+	limiter.Observe(http.StatusTooManyRequests)
+	if rate, _ := limiter.GetRate(); rate != 50 {
+		t.Fatalf("rate after second 429 = %v, want 50", rate)
+	}
+
+	time.Sleep(25 * time.Millisecond)
+	limiter.Observe(http.StatusTooManyRequests)
+	if rate, _ := limiter.GetRate(); rate != 25 {
+		t.Fatalf("rate after third 429 = %v, want 25", rate)
+	}
+	if ceiling := limiter.Ceiling(); ceiling != 100 {
+		t.Errorf("Ceiling() = %v, want 100", ceiling)
+	}
+}
+
+func TestLimiter_ObserveProbesUpToCeiling(t *testing.T) {
+	limiter, _ := NewLimiter(10, 10)
+	limiter.Observe(http.StatusTooManyRequests)
+
+	// 不足 ProbeAfter 次成功时不探测；其他错误不计入也不降低
+	for range 9 {
+		limiter.Observe(http.StatusOK)
+	}
+	limiter.Observe(http.StatusInternalServerError)
+	if rate, _ := limiter.GetRate(); rate != 5 {
+		t.Fatalf("rate before probe = %v, want 5", rate)
+	}
+	limiter.Observe(http.StatusOK)
+	if rate, _ := limiter.GetRate(); rate != 6 {
+		t.Fatalf("rate after probe = %v, want 6", rate)
+	}
+
+	for range 100 {
+		limiter.Observe(http.StatusOK)
+	}
+	if rate, _ := limiter.GetRate(); rate != 10 {
+		t.Errorf("rate after sustained success = %v, want ceiling 10", rate)
+	}
+}
+
+func TestLimiter_ObserveKeepsFactorOnHeaderUpdate(t *testing.T) {
+	limiter, _ := NewLimiter(2, 5)
+
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	resp.Header.Set("x-amzn-RateLimit-Limit", "4")
+	if err := limiter.UpdateFromResponse(resp); err != nil {
+		t.Fatalf("UpdateFromResponse() error = %v", err)
+	}
+
+	// 新的上限乘以 429 后的系数
+	if rate, burst := limiter.GetRate(); rate != 2 || burst != 5 {
+		t.Errorf("GetRate() = %v, %v, want 2, 5", rate, burst)
+	}
+	if ceiling := limiter.Ceiling(); ceiling != 4 {
+		t.Errorf("Ceiling() = %v, want 4", ceiling)
+	}
+}
+
+func TestLimiter_ObserveMinFactor(t *testing.T) {
+	limiter, _ := NewLimiter(1000, 10)
+	for range 20 {
+		limiter.Observe(http.StatusTooManyRequests)
+		time.Sleep(25 * time.Millisecond)
+	}
+	if rate, _ := limiter.GetRate(); rate != 50 {
+		t.Errorf("rate = %v, want floor 50", rate)
+	}
+}
+
+func TestManager_WithAdaptive(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		manager := NewManager(WithDefaultRate(2, 5), WithAdaptive(nil))
+		manager.Observe("seller", "app", "ATVPDKIKX0DER", "getOrders", http.StatusTooManyRequests)
+
+		rate, _ := manager.GetOrCreateLimiter("seller", "app", "ATVPDKIKX0DER", "getOrders").GetRate()
+		if rate != 2 {
+			t.Errorf("rate = %v, want 2", rate)
+		}
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		recorder := &adjustmentRecorder{adjustments: map[string]float64{}, gauges: map[string]float64{}}
+		manager := NewManager(WithDefaultRate(8, 5), WithAdaptive(&AdaptiveConfig{Decrease: 0.25, ProbeAfter: 2}))
+		manager.SetMetrics(recorder)
+
+		manager.Observe("seller", "app", "ATVPDKIKX0DER", "getOrders", http.StatusTooManyRequests)
+		manager.Observe("seller", "app", "ATVPDKIKX0DER", "getOrders", http.StatusOK)
+		manager.Observe("seller", "app", "ATVPDKIKX0DER", "getOrders", http.StatusOK)
+
+		limiter := manager.GetOrCreateLimiter("seller", "app", "ATVPDKIKX0DER", "getOrders")
+		if rate, _ := limiter.GetRate(); rate < 2.79 || rate > 2.81 {
+			t.Errorf("rate = %v, want 2.8", rate)
+		}
+
+		recorder.mu.Lock()
+		defer recorder.mu.Unlock()
+		if recorder.adjustments[directionDecrease] != 1 || recorder.adjustments[directionIncrease] != 1 {
+			t.Errorf("adjustments = %v, want one decrease and one increase", recorder.adjustments)
+		}
+		if rate := recorder.gauges[metrics.MetricRateLimitRate]; rate < 2.79 || rate > 2.81 {
+			t.Errorf("%s = %v, want 2.8", metrics.MetricRateLimitRate, rate)
+		}
+		if ceiling := recorder.gauges[metrics.MetricRateLimitCeiling]; ceiling != 8 {
+			t.Errorf("%s = %v, want 8", metrics.MetricRateLimitCeiling, ceiling)
+		}
+	})
+}
@@ -108,6 +108,27 @@ func (b *Bucket) Take() (bool, time.Duration) {
 	return false, waitTime
 }
 
+// takeAbove 尝试取出一个令牌，并保证取出后桶中至少剩余 floor 个令牌。
+//
+// floor 为 0 时等同于 Take；否则用于为高优先级请求保留配额。
+func (b *Bucket) takeAbove(floor float64) (bool, time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refillLocked()
+
+	if b.tokens-1.0 >= floor {
+		b.tokens -= 1.0
+		return true, 0
+	}
+
+	waitTime := time.Duration((floor + 1.0 - b.tokens) / b.rate * float64(time.Second))
+	if waitTime < time.Millisecond {
+		waitTime = time.Millisecond
+	}
+	return false, waitTime
+}
+
 // TakeN 尝试从桶中取出 n 个令牌。
 //
 // 参数:
@@ -0,0 +1,128 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package ratelimit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// stateSize 是桶状态文件的大小：令牌数（float64）和上次补充时间（Unix 纳秒）。
+const stateSize = 16
+
+// FileStore 是基于本地文件的 Store，供同一主机上的多个进程共享配额。
+//
+// 每个桶是目录中的一个 16 字节文件，读写时持有排他文件锁（flock）。
+// 目录位于 tmpfs（如 Linux 的 /dev/shm）时，状态只在内存中，
+// 效果等同于共享内存。FileStore 不支持网络文件系统和非 Unix 平台。
+type FileStore struct {
+	dir string
+}
+
+// NewFileStore 创建文件令牌桶存储。
+//
+// 参数:
+//   - dir: 存放桶状态的目录（不存在时创建）
+//
+// 返回值:
+//   - *FileStore: 存储实例
+//   - error: 如果平台不支持文件锁或无法创建目录，返回错误
+//
+// 示例:
+//
+//	store, err := ratelimit.NewFileStore("/dev/shm/spapi-ratelimit")
+//	if err != nil {
+//	    return err
+//	}
+func NewFileStore(dir string) (*FileStore, error) {
+	if !fileLockSupported {
+		return nil, fmt.Errorf("file rate limit store: %w", errors.ErrUnsupported)
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("file rate limit store: %w", err)
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+// Take 实现 Store。
+func (s *FileStore) Take(ctx context.Context, key string, rate float64, burst, n int) (time.Duration, error) {
+	wait, _, err := s.update(ctx, key, rate, burst, n)
+	return wait, err
+}
+
+// Tokens 实现 Store。
+func (s *FileStore) Tokens(ctx context.Context, key string, rate float64, burst int) (float64, error) {
+	_, tokens, err := s.update(ctx, key, rate, burst, 0)
+	return tokens, err
+}
+
+// path 返回桶的状态文件路径。键包含卖家 ID 等任意字符，使用其哈希作为文件名。
+func (s *FileStore) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:16])+".bucket")
+}
+
+// update 在文件锁内读取、补充、扣减并写回桶状态。
+func (s *FileStore) update(ctx context.Context, key string, rate float64, burst, n int) (time.Duration, float64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, 0, err
+	}
+
+	f, err := os.OpenFile(s.path(key), os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return 0, 0, fmt.Errorf("open bucket: %w", err)
+	}
+	defer f.Close()
+
+	if err := lockFile(f); err != nil {
+		return 0, 0, fmt.Errorf("lock bucket: %w", err)
+	}
+	defer unlockFile(f)
+
+	now := time.Now()
+	tokens, elapsed := float64(burst), time.Duration(0)
+	var state [stateSize]byte
+	if _, err := io.ReadFull(f, state[:]); err == nil {
+		last := time.Unix(0, int64(binary.LittleEndian.Uint64(state[8:])))
+		if now.Sub(last) < stateTTL(rate, burst) {
+			tokens = math.Float64frombits(binary.LittleEndian.Uint64(state[:8]))
+			elapsed = now.Sub(last)
+		}
+	}
+
+	tokens, wait := takeTokens(tokens, elapsed, rate, burst, n)
+
+	binary.LittleEndian.PutUint64(state[:8], math.Float64bits(tokens))
+	binary.LittleEndian.PutUint64(state[8:], uint64(now.UnixNano()))
+	if _, err := f.WriteAt(state[:], 0); err != nil {
+		return 0, 0, fmt.Errorf("write bucket: %w", err)
+	}
+	return wait, tokens, nil
+}
@@ -13,13 +13,18 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/metrics"
 )
 
 // Limiter 表示速率限制器。
 //
 // 基于 Token Bucket 实现，提供高层次的速率限制接口。
 // 支持阻塞等待、非阻塞检查和从 API 响应头动态更新速率。
+// 阻塞等待的请求按优先级排队（见 WithPriority、WithAging、WithReservedShare）。
+// 启用自适应（见 WithAdaptive）时，速率根据 429 响应在上限以下自动调整。
 //
 // 速率限制器是并发安全的，可以在多个 goroutine 中共享使用。
 //
@@ -27,7 +32,53 @@ import (
 //   - https://developer-docs.amazon.com/sp-api/docs/usage-plans-and-rate-limits
 type Limiter struct {
 	// bucket 是底层的 Token Bucket
+	// 使用 store 时只保存速率和突发限制，令牌在 store 中
 	bucket *Bucket
+
+	// store 是共享的令牌桶存储（由 Manager 的 WithStore 设置）
+	store Store
+
+	// key 是 store 中桶的键
+	key string
+
+	// aging 是排队请求的老化间隔
+	aging time.Duration
+
+	// reservedShare 是为高优先级请求保留的突发配额比例
+	reservedShare float64
+
+	// metrics 记录队列深度（为 nil 时不记录）
+	metrics metrics.Recorder
+
+	// labels 是指标的 operation 和 marketplace 标签
+	labels map[string]string
+
+	// adaptive 是自适应调整的参数（为 nil 时不自适应）
+	adaptive *AdaptiveConfig
+
+	// mu 保护等待队列和自适应状态
+	mu sync.Mutex
+
+	// ceiling 是速率上限（响应头或静态配置的速率）
+	ceiling float64
+
+	// factor 是自适应系数，实际速率为 ceiling * factor
+	factor float64
+
+	// successes 是上次调整后的连续成功次数
+	successes int
+
+	// lastDecrease 是上次因 429 降低速率的时间
+	lastDecrease time.Time
+
+	// waiters 是排队等待令牌的请求
+	waiters []*waiter
+
+	// changed 在队列变化时关闭，唤醒排队的请求
+	changed chan struct{}
+
+	// seq 是入队序号，相同有效优先级时先入队者优先
+	seq uint64
 }
 
 // NewLimiter 创建新的速率限制器。
@@ -57,7 +108,12 @@ func NewLimiter(rate float64, burst int) (*Limiter, error) {
 	}
 
 	return &Limiter{
-		bucket: bucket,
+		bucket:   bucket,
+		aging:    DefaultAging,
+		adaptive: DefaultAdaptiveConfig(),
+		ceiling:  rate,
+		factor:   1,
+		changed:  make(chan struct{}),
 	}, nil
 }
 
@@ -66,41 +122,61 @@ func NewLimiter(rate float64, burst int) (*Limiter, error) {
 // 此方法会阻塞当前 goroutine，直到有可用的令牌。
 // 如果 context 被取消，方法会立即返回错误。
 //
+// 排队的请求按优先级（见 WithPriority）获得令牌，相同优先级先到先得；
+// 排队每满一个老化间隔，有效优先级提高一级，避免低优先级请求饿死。
+//
 // 参数:
-//   - ctx: 请求上下文
+//   - ctx: 请求上下文（可以携带 WithPriority 设置的优先级）
 //
 // 返回值:
-//   - error: 如果 context 被取消，返回错误；否则返回 nil
+//   - error: 如果 context 被取消或共享存储（Store）出错，返回错误；否则返回 nil
 //
 // 示例:
 //
-//	ctx := context.Background()
+//	ctx := ratelimit.WithPriority(context.Background(), ratelimit.PriorityHigh)
 //	if err := limiter.Wait(ctx); err != nil {
 //	    log.Printf("rate limit wait failed: %v", err)
 //	    return err
 //	}
 //	// 发送 API 请求
 func (l *Limiter) Wait(ctx context.Context) error {
+	// 检查 context 是否已取消
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	w := l.enqueue(PriorityFromContext(ctx))
+	defer l.dequeue(w)
+
 	for {
-		// 检查 context 是否已取消
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		default:
-		}
+		l.mu.Lock()
+		now := time.Now()
+		head := l.headLocked(now) == w
+		priority := w.effective(now, l.aging)
+		changed := l.changed
+		l.mu.Unlock()
 
-		// 尝试获取令牌
-		ok, waitTime := l.bucket.Take()
-		if ok {
-			return nil
+		// 只有队首请求尝试获取令牌（按老化后的有效优先级使用保留配额）；
+		// 其他请求等待队列变化，或等待一个老化间隔后重新比较有效优先级
+		var timer <-chan time.Time
+		if head {
+			waitTime, err := l.take(ctx, priority)
+			if err != nil {
+				return err
+			}
+			if waitTime == 0 {
+				return nil
+			}
+			timer = time.After(waitTime)
+		} else if l.aging > 0 {
+			timer = time.After(l.aging)
 		}
 
-		// 等待一段时间后重试
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case <-time.After(waitTime):
-			// 继续循环
+		case <-changed:
+		case <-timer:
 		}
 	}
 }
@@ -108,7 +184,8 @@ func (l *Limiter) Wait(ctx context.Context) error {
 // Allow 检查是否允许当前请求。
 //
 // 此方法是非阻塞的，如果当前有可用令牌则返回 true 并消耗一个令牌，
-// 否则返回 false。
+// 否则返回 false。有请求在 Wait 中排队或共享存储（Store）出错时返回 false。
+// 请求按普通优先级处理，不能使用为高优先级保留的配额。
 //
 // 返回值:
 //   - bool: 如果允许请求返回 true，否则返回 false
@@ -121,14 +198,21 @@ func (l *Limiter) Wait(ctx context.Context) error {
 //	    // 等待或稍后重试
 //	}
 func (l *Limiter) Allow() bool {
-	ok, _ := l.bucket.Take()
-	return ok
+	l.mu.Lock()
+	queued := len(l.waiters) > 0
+	l.mu.Unlock()
+	if queued {
+		return false
+	}
+
+	waitTime, err := l.take(context.Background(), PriorityNormal)
+	return err == nil && waitTime == 0
 }
 
 // Reserve 预留令牌并返回等待时间。
 //
 // 此方法不会阻塞，而是返回需要等待的时间。
-// 如果当前有可用令牌，等待时间为 0。
+// 如果当前有可用令牌，等待时间为 0。共享存储（Store）出错时使用进程内的令牌桶。
 //
 // 返回值:
 //   - time.Duration: 需要等待的时间
@@ -141,16 +225,55 @@ func (l *Limiter) Allow() bool {
 //	}
 //	// 发送 API 请求
 func (l *Limiter) Reserve() time.Duration {
-	ok, waitTime := l.bucket.Take()
-	if ok {
+	waitTime, err := l.take(context.Background(), PriorityNormal)
+	if err != nil {
+		// store 不可用时退回进程内的令牌桶
+		if ok, localWait := l.bucket.Take(); !ok {
+			return localWait
+		}
 		return 0
 	}
 	return waitTime
 }
 
-// UpdateFromResponse 从 HTTP 响应头更新速率限制。
+// take 尝试取出一个令牌，返回 0 表示成功，否则返回需要等待的时间。
+//
+// 非高优先级请求不能使用为高优先级保留的令牌。保留数量不超过 burst-1，
+// 否则 burst*(1-reservedShare) < 1 时其他请求永远无法获得令牌。
+func (l *Limiter) take(ctx context.Context, priority Priority) (time.Duration, error) {
+	rate, burst := l.bucket.GetRate()
+	floor := 0.0
+	if priority < PriorityHigh {
+		floor = max(min(l.reservedShare*float64(burst), float64(burst-1)), 0)
+	}
+
+	if l.store == nil {
+		if ok, waitTime := l.bucket.takeAbove(floor); !ok {
+			return waitTime, nil
+		}
+		return 0, nil
+	}
+
+	if floor > 0 {
+		tokens, err := l.store.Tokens(ctx, l.key, rate, burst)
+		if err != nil {
+			return 0, fmt.Errorf("rate limit store: %w", err)
+		}
+		if tokens-1 < floor {
+			return max(time.Duration((floor+1-tokens)/rate*float64(time.Second)), time.Millisecond), nil
+		}
+	}
+	waitTime, err := l.store.Take(ctx, l.key, rate, burst, 1)
+	if err != nil {
+		return 0, fmt.Errorf("rate limit store: %w", err)
+	}
+	return waitTime, nil
+}
+
+// UpdateFromResponse 从 HTTP 响应更新速率限制。
 //
-// 此方法解析 x-amzn-RateLimit-Limit 响应头并更新速率配置。
+// 此方法解析 x-amzn-RateLimit-Limit 响应头并更新速率上限，
+// 再按响应状态码自适应调整速率（见 Observe）。
 //
 // 根据官方 SP-API 文档：
 //   - 响应头格式：x-amzn-RateLimit-Limit: rate
@@ -179,6 +302,8 @@ func (l *Limiter) Reserve() time.Duration {
 // 官方文档:
 //   - https://developer-docs.amazon.com/sp-api/docs/usage-plans-and-rate-limits#how-to-find-your-usage-plan
 func (l *Limiter) UpdateFromResponse(resp *http.Response) error {
+	l.Observe(resp.StatusCode)
+
 	// 获取 x-amzn-RateLimit-Limit 头部
 	rateLimitHeader := resp.Header.Get("x-amzn-RateLimit-Limit")
 	if rateLimitHeader == "" {
@@ -195,13 +320,14 @@ func (l *Limiter) UpdateFromResponse(resp *http.Response) error {
 	// 获取当前的突发限制
 	_, burst := l.bucket.GetRate()
 
-	// 更新速率（保持原有的突发限制）
-	return l.bucket.UpdateRate(rate, burst)
+	// 更新速率上限（保持原有的突发限制）
+	return l.SetRate(rate, burst)
 }
 
 // SetRate 动态更新速率限制。
 //
-// 此方法允许在运行时更改速率限制配置。
+// 此方法允许在运行时更改速率限制配置。rate 是速率上限，
+// 自适应调整后的实际速率（见 GetRate）保持当前的调整系数。
 //
 // 参数:
 //   - rate: 新的请求速率（请求数/秒）
@@ -217,7 +343,15 @@ func (l *Limiter) UpdateFromResponse(resp *http.Response) error {
 //	    log.Printf("failed to update rate limit: %v", err)
 //	}
 func (l *Limiter) SetRate(rate float64, burst int) error {
-	return l.bucket.UpdateRate(rate, burst)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := l.bucket.UpdateRate(rate*l.factor, burst); err != nil {
+		return err
+	}
+	l.ceiling = rate
+	l.applyLocked("")
+	return nil
 }
 
 // GetTokens 获取当前可用的令牌数。
@@ -230,13 +364,19 @@ func (l *Limiter) SetRate(rate float64, burst int) error {
 //	tokens := limiter.GetTokens()
 //	log.Printf("Available tokens: %.2f", tokens)
 func (l *Limiter) GetTokens() float64 {
+	if l.store != nil {
+		rate, burst := l.bucket.GetRate()
+		if tokens, err := l.store.Tokens(context.Background(), l.key, rate, burst); err == nil {
+			return tokens
+		}
+	}
 	return l.bucket.Available()
 }
 
 // GetRate 获取当前的速率配置。
 //
 // 返回值:
-//   - float64: 请求速率（请求数/秒）
+//   - float64: 请求速率（请求数/秒，自适应调整后的实际速率）
 //   - int: 突发请求数
 //
 // 示例:
@@ -6,6 +6,9 @@ import (
 	"net/http"
 	"strings"
 	"sync"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/metrics"
 )
 
 // Manager 管理多个速率限制器。
@@ -33,6 +36,21 @@ type Manager struct {
 
 	// defaultBurst 默认突发限制
 	defaultBurst int
+
+	// store 是共享的令牌桶存储（为 nil 时使用进程内的令牌桶）
+	store Store
+
+	// aging 是排队请求的老化间隔
+	aging time.Duration
+
+	// reservedShare 是为高优先级请求保留的突发配额比例
+	reservedShare float64
+
+	// adaptive 是自适应调整的参数（为 nil 时不自适应）
+	adaptive *AdaptiveConfig
+
+	// metrics 记录队列深度和自适应速率
+	metrics metrics.Recorder
 }
 
 // ManagerOption 表示管理器选项。
@@ -81,6 +99,9 @@ func NewManager(opts ...ManagerOption) *Manager {
 		limiters:     make(map[string]*Limiter),
 		defaultRate:  1.0, // 默认每秒 1 个请求
 		defaultBurst: 5,   // 默认突发 5 个
+		aging:        DefaultAging,
+		adaptive:     DefaultAdaptiveConfig(),
+		metrics:      metrics.DefaultRecorder,
 	}
 
 	for _, opt := range opts {
@@ -173,6 +194,14 @@ func (m *Manager) GetOrCreateLimiter(sellerID, appID, marketplace, operation str
 
 	// 创建新的限制器
 	limiter, _ = NewLimiter(m.defaultRate, m.defaultBurst)
+	limiter.store, limiter.key = m.store, key
+	limiter.aging, limiter.reservedShare = m.aging, m.reservedShare
+	limiter.adaptive = m.adaptive
+	limiter.metrics = m.metrics
+	limiter.labels = map[string]string{
+		metrics.LabelOperation:   operation,
+		metrics.LabelMarketplace: marketplace,
+	}
 	m.limiters[key] = limiter
 
 	return limiter
@@ -200,10 +229,11 @@ func (m *Manager) UpdateRate(sellerID, appID, marketplace, operation string, rat
 	return limiter.SetRate(rate, burst)
 }
 
-// UpdateFromResponse 从 HTTP 响应头更新指定维度的速率限制。
+// UpdateFromResponse 从 HTTP 响应更新指定维度的速率限制。
 //
 // SP-API 在响应头中返回 `x-amzn-RateLimit-Limit`，指示当前操作的速率限制。
-// 此方法会解析该头部并自动更新对应的限制器。
+// 此方法会解析该头部更新对应限制器的速率上限，并按响应状态码自适应调整速率
+// （见 Limiter.Observe）。
 //
 // 参数:
 //   - sellerID: 卖家 ID
@@ -231,6 +261,26 @@ func (m *Manager) UpdateFromResponse(sellerID, appID, marketplace, operation str
 	return limiter.UpdateFromResponse(resp)
 }
 
+// Observe 根据响应状态码自适应调整指定维度的速率。
+//
+// 用于没有 x-amzn-RateLimit-Limit 头部或只知道状态码的场景，
+// 调整规则见 Limiter.Observe。
+//
+// 参数:
+//   - sellerID: 卖家 ID
+//   - appID: 应用 ID
+//   - marketplace: 市场 ID
+//   - operation: 操作名称
+//   - statusCode: HTTP 响应状态码
+//
+// 示例:
+//
+//	manager.Observe("seller123", "app456", "ATVPDKIKX0DER", "getOrders", resp.StatusCode)
+func (m *Manager) Observe(sellerID, appID, marketplace, operation string, statusCode int) {
+	limiter := m.GetOrCreateLimiter(sellerID, appID, marketplace, operation)
+	limiter.Observe(statusCode)
+}
+
 // RemoveLimiter 移除指定维度的限制器。
 //
 // 用于释放不再使用的限制器，避免内存泄漏。
@@ -281,6 +331,82 @@ func (m *Manager) Count() int {
 	return len(m.limiters)
 }
 
+// SetMetrics 设置指标记录器，用于记录各优先级的队列深度和自适应速率。
+//
+// 只影响之后创建的限制器。
+//
+// 参数:
+//   - recorder: 指标记录器实现
+func (m *Manager) SetMetrics(recorder metrics.Recorder) {
+	if recorder == nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.metrics = recorder
+}
+
+// QueueDepth 返回所有限制器中各优先级正在排队等待令牌的请求数。
+//
+// 返回值:
+//   - map[Priority]int: 优先级到排队请求数
+//
+// 示例:
+//
+//	depth := manager.QueueDepth()
+//	fmt.Printf("high=%d normal=%d low=%d\n",
+//	    depth[ratelimit.PriorityHigh], depth[ratelimit.PriorityNormal], depth[ratelimit.PriorityLow])
+func (m *Manager) QueueDepth() map[Priority]int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	depth := make(map[Priority]int)
+	for _, limiter := range m.limiters {
+		for priority, n := range limiter.QueueDepth() {
+			depth[priority] += n
+		}
+	}
+	return depth
+}
+
+// Saturation 返回所有限制器中令牌桶的最高占用比例（1 - 可用令牌/突发限制）
+// 和正在排队等待令牌的请求总数。
+//
+// 占用比例为 1 表示至少一个操作已用完令牌，新请求需要等待令牌补充。
+//
+// 返回值:
+//   - float64: 最高占用比例（0.0-1.0），没有限制器时为 0
+//   - int: 排队请求总数
+//
+// 示例:
+//
+//	saturation, queued := manager.Saturation()
+//	fmt.Printf("saturation=%.2f queued=%d\n", saturation, queued)
+func (m *Manager) Saturation() (float64, int) {
+	m.mu.RLock()
+	limiters := make([]*Limiter, 0, len(m.limiters))
+	for _, limiter := range m.limiters {
+		limiters = append(limiters, limiter)
+	}
+	m.mu.RUnlock()
+
+	var saturation float64
+	var queued int
+	for _, limiter := range limiters {
+		_, burst := limiter.GetRate()
+		if burst > 0 {
+			used := 1 - limiter.GetTokens()/float64(burst)
+			saturation = max(saturation, min(max(used, 0), 1))
+		}
+		for _, n := range limiter.QueueDepth() {
+			queued += n
+		}
+	}
+	return saturation, queued
+}
+
 // buildLimiterKey 构建限制器键。
 //
 // 格式: "sellerID:appID:marketplace:operation"
@@ -215,6 +215,29 @@ func TestManager_Count(t *testing.T) {
 	}
 }
 
+func TestManager_Saturation(t *testing.T) {
+	manager := NewManager(WithDefaultRate(0.001, 4))
+
+	if saturation, queued := manager.Saturation(); saturation != 0 || queued != 0 {
+		t.Errorf("Saturation() = %v, %v, want 0, 0", saturation, queued)
+	}
+
+	// op1 用掉一半令牌，op2 用完全部令牌
+	manager.Allow("seller1", "app1", "market1", "op1")
+	manager.Allow("seller1", "app1", "market1", "op1")
+	for range 4 {
+		manager.Allow("seller1", "app1", "market1", "op2")
+	}
+
+	saturation, queued := manager.Saturation()
+	if saturation < 0.99 {
+		t.Errorf("Saturation() = %v, want 1", saturation)
+	}
+	if queued != 0 {
+		t.Errorf("queued = %v, want 0", queued)
+	}
+}
+
 func TestManager_ConcurrentAccess(t *testing.T) {
 	manager := NewManager(WithDefaultRate(100, 50))
 
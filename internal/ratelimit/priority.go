@@ -0,0 +1,231 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/metrics"
+)
+
+// Priority 是请求在速率限制队列中的优先级。
+//
+// 零值是 PriorityNormal，未标记优先级的请求按普通优先级排队。
+type Priority int
+
+// 预定义的优先级。
+const (
+	// PriorityLow 用于可以延后的后台任务（如夜间回填）。
+	PriorityLow Priority = -1
+
+	// PriorityNormal 是默认优先级。
+	PriorityNormal Priority = 0
+
+	// PriorityHigh 用于面向用户的请求，可以使用为高优先级保留的配额。
+	PriorityHigh Priority = 1
+)
+
+// DefaultAging 是默认的老化间隔：排队每满一个间隔，有效优先级提高一级。
+const DefaultAging = 10 * time.Second
+
+// String 返回优先级的名称，用作指标的 priority 标签。
+func (p Priority) String() string {
+	switch {
+	case p <= PriorityLow:
+		return "low"
+	case p >= PriorityHigh:
+		return "high"
+	default:
+		return "normal"
+	}
+}
+
+// priorityKey 是 context 中优先级的键。
+type priorityKey struct{}
+
+// WithPriority 返回携带请求优先级的 context。
+//
+// 参数:
+//   - ctx: 父 context
+//   - priority: 请求优先级
+//
+// 示例:
+//
+//	ctx = ratelimit.WithPriority(ctx, ratelimit.PriorityHigh)
+//	err := manager.Wait(ctx, "seller123", "app456", "ATVPDKIKX0DER", "getOrders")
+func WithPriority(ctx context.Context, priority Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, priority)
+}
+
+// PriorityFromContext 返回 context 中的请求优先级，未设置时返回 PriorityNormal。
+func PriorityFromContext(ctx context.Context) Priority {
+	priority, _ := ctx.Value(priorityKey{}).(Priority)
+	return priority
+}
+
+// WithAging 设置排队请求的老化间隔。
+//
+// 排队每满一个间隔，请求的有效优先级提高一级，使低优先级请求
+// 在持续的高优先级流量下也能被服务。为 0 时不老化。
+//
+// 参数:
+//   - interval: 老化间隔（默认 DefaultAging）
+//
+// 示例:
+//
+//	manager := ratelimit.NewManager(ratelimit.WithAging(30 * time.Second))
+func WithAging(interval time.Duration) ManagerOption {
+	return func(m *Manager) {
+		m.aging = interval
+	}
+}
+
+// WithReservedShare 为高优先级请求保留一部分突发配额。
+//
+// 非高优先级请求只在取出令牌后仍剩余 share*burst 个令牌时才能发送，
+// 保留的令牌只供 PriorityHigh 请求使用（包括老化到 high 的请求）。
+// 保留数量不超过 burst-1，其他请求至少可以使用一个令牌；burst 为 1 时不保留。
+// 使用共享存储（Store）时，检查和取出不是一次原子操作，多个进程间的保留是近似的。
+//
+// 参数:
+//   - share: 保留比例（0.0-1.0，默认 0 表示不保留）
+//
+// 示例:
+//
+//	// 保留 20% 的突发配额给交互式请求
+//	manager := ratelimit.NewManager(ratelimit.WithReservedShare(0.2))
+func WithReservedShare(share float64) ManagerOption {
+	return func(m *Manager) {
+		m.reservedShare = min(max(share, 0), 1)
+	}
+}
+
+// waiter 是排队等待令牌的请求。
+type waiter struct {
+	priority Priority
+	enqueued time.Time
+	seq      uint64
+}
+
+// effective 返回 waiter 在 now 时的有效优先级（包括老化）。
+func (w *waiter) effective(now time.Time, aging time.Duration) Priority {
+	if aging <= 0 {
+		return w.priority
+	}
+	return w.priority + Priority(now.Sub(w.enqueued)/aging)
+}
+
+// enqueue 把请求加入队列并通知其他排队的请求。
+// 优先级限制在 PriorityLow 到 PriorityHigh 之间。
+func (l *Limiter) enqueue(priority Priority) *waiter {
+	priority = min(max(priority, PriorityLow), PriorityHigh)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.seq++
+	w := &waiter{priority: priority, enqueued: time.Now(), seq: l.seq}
+	l.waiters = append(l.waiters, w)
+	l.changedLocked()
+	l.recordDepthLocked(priority)
+	return w
+}
+
+// dequeue 把请求移出队列并通知其他排队的请求。
+func (l *Limiter) dequeue(w *waiter) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for i, queued := range l.waiters {
+		if queued == w {
+			l.waiters = append(l.waiters[:i], l.waiters[i+1:]...)
+			break
+		}
+	}
+	l.changedLocked()
+	l.recordDepthLocked(w.priority)
+}
+
+// changedLocked 唤醒等待队列变化的请求（已持锁）。
+func (l *Limiter) changedLocked() {
+	if l.changed != nil {
+		close(l.changed)
+	}
+	l.changed = make(chan struct{})
+}
+
+// headLocked 返回队首的请求：有效优先级最高者，相同时先入队者（已持锁）。
+func (l *Limiter) headLocked(now time.Time) *waiter {
+	var head *waiter
+	var headPriority Priority
+	for _, w := range l.waiters {
+		p := w.effective(now, l.aging)
+		if head == nil || p > headPriority || (p == headPriority && w.seq < head.seq) {
+			head, headPriority = w, p
+		}
+	}
+	return head
+}
+
+// depthLocked 返回指定优先级的排队请求数（已持锁）。
+func (l *Limiter) depthLocked(priority Priority) int {
+	depth := 0
+	for _, w := range l.waiters {
+		if w.priority == priority {
+			depth++
+		}
+	}
+	return depth
+}
+
+// recordDepthLocked 记录指定优先级的队列深度指标（已持锁）。
+func (l *Limiter) recordDepthLocked(priority Priority) {
+	if l.metrics == nil {
+		return
+	}
+	labels := make(map[string]string, len(l.labels)+1)
+	for k, v := range l.labels {
+		labels[k] = v
+	}
+	labels[metrics.LabelPriority] = priority.String()
+	l.metrics.RecordGauge(metrics.MetricRateLimitQueueDepth, float64(l.depthLocked(priority)), labels)
+}
+
+// QueueDepth 返回各优先级正在排队等待令牌的请求数。
+//
+// 返回值:
+//   - map[Priority]int: 优先级到排队请求数（不包含没有排队请求的优先级）
+//
+// 示例:
+//
+//	depth := limiter.QueueDepth()
+//	log.Printf("high=%d low=%d", depth[ratelimit.PriorityHigh], depth[ratelimit.PriorityLow])
+func (l *Limiter) QueueDepth() map[Priority]int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	depth := make(map[Priority]int)
+	for _, w := range l.waiters {
+		depth[w.priority]++
+	}
+	return depth
+}
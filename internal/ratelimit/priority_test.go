@@ -0,0 +1,201 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/metrics"
+)
+
+// gaugeRecorder 记录最新的仪表盘指标值。
+type gaugeRecorder struct {
+	metrics.NoOpRecorder
+	mu     sync.Mutex
+	gauges map[string]float64
+}
+
+func (r *gaugeRecorder) RecordGauge(name string, value float64, labels map[string]string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.gauges[name+"{"+labels[metrics.LabelOperation]+","+labels[metrics.LabelMarketplace]+","+labels[metrics.LabelPriority]+"}"] = value
+}
+
+func (r *gaugeRecorder) get(key string) float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.gauges[key]
+}
+
+// waitInOrder 依次（间隔 step）以不同优先级调用 Wait，返回完成顺序。
+func waitInOrder(t *testing.T, limiter *Limiter, step time.Duration, priorities ...Priority) []Priority {
+	t.Helper()
+
+	var mu sync.Mutex
+	var order []Priority
+	var wg sync.WaitGroup
+	for _, priority := range priorities {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := limiter.Wait(WithPriority(context.Background(), priority)); err != nil {
+				t.Errorf("Wait(%s) error = %v", priority, err)
+				return
+			}
+			mu.Lock()
+			order = append(order, priority)
+			mu.Unlock()
+		}()
+		time.Sleep(step)
+	}
+	wg.Wait()
+	return order
+}
+
+// TestLimiter_PriorityOrder 测试排队请求按优先级获得令牌。
+func TestLimiter_PriorityOrder(t *testing.T) {
+	limiter, _ := NewLimiter(5, 1)
+	limiter.Allow() // 清空桶
+
+	order := waitInOrder(t, limiter, 5*time.Millisecond, PriorityLow, PriorityNormal, PriorityHigh)
+	want := []Priority{PriorityHigh, PriorityNormal, PriorityLow}
+	for i := range want {
+		if i >= len(order) || order[i] != want[i] {
+			t.Fatalf("order = %v, want %v", order, want)
+		}
+	}
+}
+
+// TestLimiter_Aging 测试老化使排队较久的低优先级请求先于新的高优先级请求。
+func TestLimiter_Aging(t *testing.T) {
+	limiter, _ := NewLimiter(10, 1)
+	limiter.aging = 20 * time.Millisecond
+	limiter.Allow()
+
+	// 低优先级请求排队 50ms 后有效优先级为 high，且先入队
+	order := waitInOrder(t, limiter, 50*time.Millisecond, PriorityLow, PriorityHigh)
+	if len(order) != 2 || order[0] != PriorityLow {
+		t.Errorf("order = %v, want low first after aging", order)
+	}
+
+	// 不老化时高优先级请求先获得令牌
+	limiter.aging = 0
+	limiter.Allow()
+	order = waitInOrder(t, limiter, 50*time.Millisecond, PriorityLow, PriorityHigh)
+	if len(order) != 2 || order[0] != PriorityHigh {
+		t.Errorf("order without aging = %v, want high first", order)
+	}
+}
+
+// TestManager_ReservedShare 测试为高优先级保留的配额。
+func TestManager_ReservedShare(t *testing.T) {
+	manager := NewManager(WithDefaultRate(0.01, 5), WithReservedShare(0.4))
+
+	// 保留 2 个令牌：普通请求只能使用 3 个
+	allowed := 0
+	for range 5 {
+		if manager.Allow("s", "a", "m", "op") {
+			allowed++
+		}
+	}
+	if allowed != 3 {
+		t.Errorf("normal allowed = %d, want 3", allowed)
+	}
+
+	high := WithPriority(context.Background(), PriorityHigh)
+	for i := range 2 {
+		ctx, cancel := context.WithTimeout(high, 50*time.Millisecond)
+		if err := manager.Wait(ctx, "s", "a", "m", "op"); err != nil {
+			t.Errorf("high Wait() #%d error = %v, want reserved token", i, err)
+		}
+		cancel()
+	}
+
+	ctx, cancel := context.WithTimeout(high, 50*time.Millisecond)
+	defer cancel()
+	if err := manager.Wait(ctx, "s", "a", "m", "op"); err != context.DeadlineExceeded {
+		t.Errorf("high Wait() on empty bucket error = %v, want DeadlineExceeded", err)
+	}
+}
+
+// TestManager_QueueDepth 测试队列深度和指标。
+func TestManager_QueueDepth(t *testing.T) {
+	recorder := &gaugeRecorder{gauges: make(map[string]float64)}
+	manager := NewManager(WithDefaultRate(0.01, 1))
+	manager.SetMetrics(recorder)
+	manager.Allow("s", "a", "ATVPDKIKX0DER", "orders-v0:getOrders")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	for _, priority := range []Priority{PriorityLow, PriorityLow, PriorityHigh} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			manager.Wait(WithPriority(ctx, priority), "s", "a", "ATVPDKIKX0DER", "orders-v0:getOrders")
+		}()
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for manager.QueueDepth()[PriorityLow] != 2 || manager.QueueDepth()[PriorityHigh] != 1 {
+		if time.Now().After(deadline) {
+			t.Fatalf("QueueDepth() = %v, want low=2 high=1", manager.QueueDepth())
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if got := recorder.get(metrics.MetricRateLimitQueueDepth + "{orders-v0:getOrders,ATVPDKIKX0DER,low}"); got != 2 {
+		t.Errorf("queue depth gauge (low) = %v, want 2", got)
+	}
+	if got := recorder.get(metrics.MetricRateLimitQueueDepth + "{orders-v0:getOrders,ATVPDKIKX0DER,high}"); got != 1 {
+		t.Errorf("queue depth gauge (high) = %v, want 1", got)
+	}
+	if manager.Allow("s", "a", "ATVPDKIKX0DER", "orders-v0:getOrders") {
+		t.Error("Allow() = true while requests are queued")
+	}
+
+	cancel()
+	wg.Wait()
+	if depth := manager.QueueDepth(); len(depth) != 0 {
+		t.Errorf("QueueDepth() after cancel = %v, want empty", depth)
+	}
+	if got := recorder.get(metrics.MetricRateLimitQueueDepth + "{orders-v0:getOrders,ATVPDKIKX0DER,low}"); got != 0 {
+		t.Errorf("queue depth gauge (low) after cancel = %v, want 0", got)
+	}
+}
+
+// TestManager_ReservedShare_BurstOne 测试 burst 为 1 时普通请求不会因保留配额而饿死。
+func TestManager_ReservedShare_BurstOne(t *testing.T) {
+	manager := NewManager(WithDefaultRate(10, 1), WithReservedShare(0.2))
+
+	for i := range 3 {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		if err := manager.Wait(ctx, "s", "a", "m", "op"); err != nil {
+			t.Errorf("normal Wait() #%d error = %v", i, err)
+		}
+		cancel()
+	}
+}
+
+// TestLimiter_ReservedShare_Aging 测试老化到 high 的请求可以使用保留配额，不阻塞后来的高优先级请求。
+func TestLimiter_ReservedShare_Aging(t *testing.T) {
+	limiter, _ := NewLimiter(20, 2)
+	limiter.aging = 20 * time.Millisecond
+	limiter.reservedShare = 0.5
+
+	// 清空桶后，保留 1 个令牌：低优先级请求老化到 high 之前只能等待
+	for limiter.Allow() {
+	}
+	done := make(chan []Priority)
+	go func() {
+		done <- waitInOrder(t, limiter, 60*time.Millisecond, PriorityLow, PriorityHigh, PriorityHigh)
+	}()
+
+	select {
+	case order := <-done:
+		if len(order) != 3 {
+			t.Errorf("order = %v, want 3 requests served", order)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Wait() deadlocked: aged low-priority head blocked the queue")
+	}
+}
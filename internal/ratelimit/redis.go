@@ -0,0 +1,156 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// DefaultRedisKeyPrefix 是 RedisStore 的默认键前缀。
+const DefaultRedisKeyPrefix = "spapi:ratelimit:"
+
+// tokenBucketScript 在 Redis 中原子地补充并扣减令牌桶。
+//
+// 桶保存为哈希（tokens、ts），时间使用 Redis 服务器时钟（TIME），
+// 避免各 Pod 的时钟偏差。KEYS[1] 是桶的键，ARGV 依次是 rate、burst、
+// n 和状态的过期时间（毫秒）。返回 {等待微秒数, 剩余令牌数}。
+const tokenBucketScript = `
+redis.replicate_commands()
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local n = tonumber(ARGV[3])
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000000 + tonumber(t[2])
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(state[1])
+local ts = tonumber(state[2])
+if tokens == nil or ts == nil then
+  tokens = burst
+  ts = now
+end
+if now > ts then
+  tokens = tokens + (now - ts) * rate / 1000000
+end
+if tokens > burst then
+  tokens = burst
+end
+local wait = 0
+if tokens >= n then
+  tokens = tokens - n
+else
+  wait = math.ceil((n - tokens) / rate * 1000000)
+end
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', tostring(now))
+redis.call('PEXPIRE', KEYS[1], ARGV[4])
+return {wait, tostring(tokens)}
+`
+
+// RedisScripter 执行 Redis Lua 脚本（EVAL）。
+//
+// 返回值是脚本返回值的 Go 表示：整数为 int64，字符串为 string，
+// 数组为 []interface{}（与 go-redis 的 Cmd.Result 一致）。
+type RedisScripter interface {
+	Eval(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error)
+}
+
+// RedisEvalFunc 把函数适配为 RedisScripter。
+type RedisEvalFunc func(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error)
+
+// Eval 实现 RedisScripter。
+func (f RedisEvalFunc) Eval(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error) {
+	return f(ctx, script, keys, args...)
+}
+
+// RedisStore 是基于 Redis（或兼容 EVAL 的存储，如 Valkey、KeyDB）的 Store。
+//
+// 每个桶是一个带过期时间的哈希，补充和扣减在一个 Lua 脚本中原子完成，
+// 因此任意数量的进程可以共享同一个桶。
+type RedisStore struct {
+	client RedisScripter
+	prefix string
+}
+
+// NewRedisStore 创建 Redis 令牌桶存储。
+//
+// 参数:
+//   - client: 执行 EVAL 的 Redis 客户端
+//   - keyPrefix: 桶键的前缀（为空时使用 DefaultRedisKeyPrefix）
+//
+// 返回值:
+//   - *RedisStore: 存储实例
+//
+// 示例:
+//
+//	rdb := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+//	store := ratelimit.NewRedisStore(ratelimit.RedisEvalFunc(func(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error) {
+//	    return rdb.Eval(ctx, script, keys, args...).Result()
+//	}), "")
+func NewRedisStore(client RedisScripter, keyPrefix string) *RedisStore {
+	if keyPrefix == "" {
+		keyPrefix = DefaultRedisKeyPrefix
+	}
+	return &RedisStore{client: client, prefix: keyPrefix}
+}
+
+// Take 实现 Store。
+func (s *RedisStore) Take(ctx context.Context, key string, rate float64, burst, n int) (time.Duration, error) {
+	wait, _, err := s.eval(ctx, key, rate, burst, n)
+	return wait, err
+}
+
+// Tokens 实现 Store。
+func (s *RedisStore) Tokens(ctx context.Context, key string, rate float64, burst int) (float64, error) {
+	_, tokens, err := s.eval(ctx, key, rate, burst, 0)
+	return tokens, err
+}
+
+// eval 执行令牌桶脚本并解析返回值。
+func (s *RedisStore) eval(ctx context.Context, key string, rate float64, burst, n int) (time.Duration, float64, error) {
+	ttl := stateTTL(rate, burst).Milliseconds()
+	reply, err := s.client.Eval(ctx, tokenBucketScript, []string{s.prefix + key},
+		strconv.FormatFloat(rate, 'f', -1, 64), burst, n, ttl)
+	if err != nil {
+		return 0, 0, fmt.Errorf("redis eval: %w", err)
+	}
+
+	values, ok := reply.([]interface{})
+	if !ok || len(values) != 2 {
+		return 0, 0, fmt.Errorf("redis eval: unexpected reply %v", reply)
+	}
+	waitMicros, ok := values[0].(int64)
+	if !ok {
+		return 0, 0, fmt.Errorf("redis eval: unexpected wait %v", values[0])
+	}
+	tokensText, _ := values[1].(string)
+	tokens, err := strconv.ParseFloat(tokensText, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("redis eval: parse tokens: %w", err)
+	}
+
+	wait := time.Duration(waitMicros) * time.Microsecond
+	if wait > 0 && wait < time.Millisecond {
+		wait = time.Millisecond
+	}
+	return wait, tokens, nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// Store 存储令牌桶的状态，使多个进程（如同一卖家和应用的多个 Pod）
+// 共享同一份速率限制配额。
+//
+// 桶的键由 Manager 按 "sellerID:appID:marketplace:operation" 构建，
+// 速率和突发限制由每个进程的 Limiter 传入（通常来自 x-amzn-RateLimit-Limit），
+// Store 只保存令牌数和上次补充的时间。
+//
+// 实现必须保证 Take 的补充和扣减是原子的。内置实现:
+//   - RedisStore: 通过 Lua 脚本在 Redis 中原子执行
+//   - FileStore: 通过文件锁在同一主机的多个进程间共享
+type Store interface {
+	// Take 按 rate 和 burst 补充 key 的桶，并尝试取出 n 个令牌。
+	// 返回 0 表示已取出；否则返回需要等待的时间，不取出任何令牌。
+	Take(ctx context.Context, key string, rate float64, burst, n int) (time.Duration, error)
+
+	// Tokens 返回 key 的桶当前可用的令牌数，不取出令牌。
+	Tokens(ctx context.Context, key string, rate float64, burst int) (float64, error)
+}
+
+// WithStore 设置共享的令牌桶存储。
+//
+// 设置后，Limiter 的 Wait、Allow、Reserve 和 GetTokens 通过 Store 读写令牌，
+// 速率和突发限制仍在每个进程中维护（UpdateRate、UpdateFromResponse）。
+// RemoveLimiter 和 Clear 只移除本进程的 Limiter，不删除 Store 中的状态。
+//
+// 参数:
+//   - store: 令牌桶存储（为 nil 时使用进程内的令牌桶）
+//
+// 示例:
+//
+//	store, err := ratelimit.NewFileStore("/dev/shm/spapi-ratelimit")
+//	if err != nil {
+//	    return err
+//	}
+//	manager := ratelimit.NewManager(ratelimit.WithStore(store))
+func WithStore(store Store) ManagerOption {
+	return func(m *Manager) {
+		m.store = store
+	}
+}
+
+// takeTokens 是 Store 实现共用的令牌桶算法（与 Bucket 相同）。
+//
+// 参数:
+//   - tokens: 上次保存的令牌数
+//   - elapsed: 距上次保存经过的时间
+//   - rate, burst: 速率和突发限制
+//   - n: 要取出的令牌数（0 表示只读取）
+//
+// 返回值:
+//   - float64: 补充并扣减后的令牌数
+//   - time.Duration: 令牌不足时需要等待的时间，否则为 0
+func takeTokens(tokens float64, elapsed time.Duration, rate float64, burst, n int) (float64, time.Duration) {
+	if elapsed > 0 {
+		tokens += elapsed.Seconds() * rate
+	}
+	tokens = math.Min(tokens, float64(burst))
+
+	if tokens >= float64(n) {
+		return tokens - float64(n), 0
+	}
+
+	wait := time.Duration((float64(n) - tokens) / rate * float64(time.Second))
+	if wait < time.Millisecond {
+		wait = time.Millisecond
+	}
+	return tokens, wait
+}
+
+// stateTTL 返回桶状态的保留时间：空桶补满所需时间的两倍，至少 1 秒。
+// 过期的桶按满桶处理，与重新创建的 Limiter 一致。
+func stateTTL(rate float64, burst int) time.Duration {
+	ttl := time.Duration(2 * float64(burst) / rate * float64(time.Second))
+	return max(ttl, time.Second)
+}
@@ -0,0 +1,271 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeRedis 是进程内的 Redis 替身，按 tokenBucketScript 的语义执行 EVAL。
+//
+// 与 Redis 一样，每次 EVAL 原子执行；时间取自可调整的服务器时钟。
+type fakeRedis struct {
+	mu      sync.Mutex
+	now     time.Time
+	buckets map[string]fakeBucket
+	evals   []fakeEval
+	err     error
+}
+
+type fakeBucket struct {
+	tokens   string
+	ts       int64
+	expireAt time.Time
+}
+
+type fakeEval struct {
+	key  string
+	args []interface{}
+}
+
+func newFakeRedis() *fakeRedis {
+	return &fakeRedis{now: time.Unix(1700000000, 0), buckets: make(map[string]fakeBucket)}
+}
+
+func (r *fakeRedis) advance(d time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.now = r.now.Add(d)
+}
+
+func (r *fakeRedis) Eval(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.err != nil {
+		return nil, r.err
+	}
+	if script != tokenBucketScript || len(keys) != 1 || len(args) != 4 {
+		return nil, fmt.Errorf("ERR unexpected script call")
+	}
+	r.evals = append(r.evals, fakeEval{key: keys[0], args: args})
+
+	rate, _ := strconv.ParseFloat(fmt.Sprint(args[0]), 64)
+	burst, _ := strconv.ParseFloat(fmt.Sprint(args[1]), 64)
+	n, _ := strconv.ParseFloat(fmt.Sprint(args[2]), 64)
+	ttl, _ := strconv.ParseInt(fmt.Sprint(args[3]), 10, 64)
+
+	now := r.now.UnixMicro()
+	bucket, ok := r.buckets[keys[0]]
+	if ok && !r.now.Before(bucket.expireAt) {
+		ok = false
+	}
+	tokens, ts := burst, now
+	if ok {
+		tokens, _ = strconv.ParseFloat(bucket.tokens, 64)
+		ts = bucket.ts
+	}
+	if now > ts {
+		tokens += float64(now-ts) * rate / 1000000
+	}
+	tokens = math.Min(tokens, burst)
+	var wait int64
+	if tokens >= n {
+		tokens -= n
+	} else {
+		wait = int64(math.Ceil((n - tokens) / rate * 1000000))
+	}
+
+	text := strconv.FormatFloat(tokens, 'g', 14, 64)
+	r.buckets[keys[0]] = fakeBucket{tokens: text, ts: now, expireAt: r.now.Add(time.Duration(ttl) * time.Millisecond)}
+	return []interface{}{wait, text}, nil
+}
+
+// TestRedisStore_SharedQuota 测试两个 Manager（模拟两个 Pod）共享同一个桶。
+func TestRedisStore_SharedQuota(t *testing.T) {
+	redis := newFakeRedis()
+	pods := []*Manager{
+		NewManager(WithDefaultRate(1.0, 5), WithStore(NewRedisStore(redis, ""))),
+		NewManager(WithDefaultRate(1.0, 5), WithStore(NewRedisStore(redis, ""))),
+	}
+
+	allowed := 0
+	for i := range 10 {
+		if pods[i%2].Allow("seller", "app", "ATVPDKIKX0DER", "orders-v0:getOrders") {
+			allowed++
+		}
+	}
+	if allowed != 5 {
+		t.Errorf("allowed = %d across pods, want burst 5", allowed)
+	}
+
+	// 其他维度使用独立的桶
+	if !pods[0].Allow("seller", "app", "A1PA6795UKMFR9", "orders-v0:getOrders") {
+		t.Error("Allow() on another marketplace = false, want true")
+	}
+
+	// 服务器时钟前进 2 秒后补充 2 个令牌
+	redis.advance(2 * time.Second)
+	limiter := pods[1].GetOrCreateLimiter("seller", "app", "ATVPDKIKX0DER", "orders-v0:getOrders")
+	if tokens := limiter.GetTokens(); tokens != 2 {
+		t.Errorf("GetTokens() = %v, want 2", tokens)
+	}
+	if wait := limiter.Reserve(); wait != 0 {
+		t.Errorf("Reserve() = %v, want 0", wait)
+	}
+	limiter.Reserve()
+	if wait := limiter.Reserve(); wait != time.Second {
+		t.Errorf("Reserve() on empty bucket = %v, want 1s", wait)
+	}
+
+	eval := redis.evals[0]
+	if eval.key != DefaultRedisKeyPrefix+"seller:app:ATVPDKIKX0DER:orders-v0:getOrders" {
+		t.Errorf("key = %q, want limiter key with default prefix", eval.key)
+	}
+	if ttl := eval.args[3]; ttl != int64(10000) {
+		t.Errorf("ttl = %v, want 10000 (twice the time to refill burst 5 at 1/s)", ttl)
+	}
+}
+
+// TestRedisStore_RateFromResponse 测试每个进程的速率更新传给共享桶。
+func TestRedisStore_RateFromResponse(t *testing.T) {
+	redis := newFakeRedis()
+	manager := NewManager(WithDefaultRate(1.0, 1), WithStore(NewRedisStore(redis, "test:")))
+	if err := manager.UpdateRate("s", "a", "m", "op", 10, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	limiter := manager.GetOrCreateLimiter("s", "a", "m", "op")
+	limiter.Reserve()
+	if wait := limiter.Reserve(); wait != 100*time.Millisecond {
+		t.Errorf("Reserve() = %v, want 100ms at rate 10", wait)
+	}
+	if got := redis.evals[0].key; got != "test:s:a:m:op" {
+		t.Errorf("key = %q, want test:s:a:m:op", got)
+	}
+
+	// 过期的桶按满桶处理
+	redis.advance(time.Hour)
+	if tokens := limiter.GetTokens(); tokens != 1 {
+		t.Errorf("GetTokens() after expiry = %v, want 1", tokens)
+	}
+}
+
+// TestRedisStore_Error 测试存储不可用时的行为。
+func TestRedisStore_Error(t *testing.T) {
+	redis := newFakeRedis()
+	redis.err = errors.New("connection refused")
+	manager := NewManager(WithDefaultRate(1.0, 1), WithStore(NewRedisStore(redis, "")))
+
+	err := manager.Wait(context.Background(), "s", "a", "m", "op")
+	if err == nil || !errors.Is(err, redis.err) || !strings.Contains(err.Error(), "rate limit store") {
+		t.Errorf("Wait() error = %v, want wrapped store error", err)
+	}
+	if manager.Allow("s", "a", "m", "op") {
+		t.Error("Allow() = true, want false when store fails")
+	}
+
+	// Reserve 退回进程内的令牌桶
+	limiter := manager.GetOrCreateLimiter("s", "a", "m", "op")
+	if wait := limiter.Reserve(); wait != 0 {
+		t.Errorf("Reserve() = %v, want 0 from local bucket", wait)
+	}
+	if wait := limiter.Reserve(); wait == 0 {
+		t.Error("Reserve() = 0, want wait from empty local bucket")
+	}
+}
+
+// TestFileStore_SharedQuota 测试多个 FileStore 和 goroutine 共享同一个桶。
+func TestFileStore_SharedQuota(t *testing.T) {
+	dir := t.TempDir()
+	var managers []*Manager
+	for range 3 {
+		store, err := NewFileStore(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		managers = append(managers, NewManager(WithDefaultRate(0.01, 10), WithStore(store)))
+	}
+
+	var allowed atomic.Int32
+	var wg sync.WaitGroup
+	for i := range 30 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if managers[i%3].Allow("seller", "app", "ATVPDKIKX0DER", "orders-v0:getOrders") {
+				allowed.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := allowed.Load(); got != 10 {
+		t.Errorf("allowed = %d, want burst 10", got)
+	}
+	if tokens := managers[0].GetOrCreateLimiter("seller", "app", "ATVPDKIKX0DER", "orders-v0:getOrders").GetTokens(); tokens >= 1 {
+		t.Errorf("GetTokens() = %v, want < 1", tokens)
+	}
+}
+
+// TestFileStore_MultiProcess 测试多个进程共享同一个桶。
+func TestFileStore_MultiProcess(t *testing.T) {
+	if dir := os.Getenv("RATELIMIT_FILE_STORE_DIR"); dir != "" {
+		store, err := NewFileStore(dir)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		manager := NewManager(WithDefaultRate(0.01, 8), WithStore(store))
+		allowed := 0
+		for range 8 {
+			if manager.Allow("seller", "app", "ATVPDKIKX0DER", "op") {
+				allowed++
+			}
+		}
+		fmt.Printf("allowed=%d\n", allowed)
+		os.Exit(0)
+	}
+	if _, err := NewFileStore(t.TempDir()); errors.Is(err, errors.ErrUnsupported) {
+		t.Skip(err)
+	}
+
+	dir := t.TempDir()
+	outputs := make([][]byte, 4)
+	errs := make([]error, 4)
+	var wg sync.WaitGroup
+	for i := range outputs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cmd := exec.Command(os.Args[0], "-test.run=^TestFileStore_MultiProcess$")
+			cmd.Env = append(os.Environ(), "RATELIMIT_FILE_STORE_DIR="+dir)
+			outputs[i], errs[i] = cmd.Output()
+		}()
+	}
+	wg.Wait()
+
+	total := 0
+	for i, out := range outputs {
+		if errs[i] != nil {
+			t.Fatalf("process %d: %v: %s", i, errs[i], out)
+		}
+		var allowed int
+		if _, err := fmt.Sscanf(string(out), "allowed=%d", &allowed); err != nil {
+			t.Fatalf("process %d output %q: %v", i, out, err)
+		}
+		total += allowed
+	}
+	if total != 8 {
+		t.Errorf("allowed = %d across 4 processes, want burst 8", total)
+	}
+}
@@ -0,0 +1,419 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+//
+// Package redact 脱敏日志、追踪和调试输出中的个人信息（PII）和凭据。
+//
+// SP-API 响应包含买家姓名、邮箱和收货地址等受限数据（通常需要
+// Restricted Data Token 才能获取），Amazon 数据保护政策（DPP）
+// 禁止将其写入日志。Redactor 按 JSON 路径规则替换这些字段中的字符串，
+// 并脱敏 HTTP 头、查询参数、表单字段和自由文本中的邮箱与 LWA 令牌。
+//
+// 规则语法是 JSONPath 的子集（字段名不区分大小写）：
+//
+//	$.payload.BuyerInfo   从根开始的字段路径
+//	$..ShippingAddress    任意深度的字段
+//	$..Buyer*             字段名中的 * 匹配任意字符
+//	$.payload.Orders[*]   数组元素（数组对路径透明，[*] 可以省略）
+//
+// 匹配的字段整体脱敏：其中所有字符串替换为 Placeholder，保留 JSON 结构。
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Placeholder 是脱敏后的占位值。
+const Placeholder = "REDACTED"
+
+// ErrInvalidRule 表示 JSON 路径规则无法解析。
+var ErrInvalidRule = errors.New("invalid redaction rule")
+
+// DefaultRules 是内置的 JSON 路径规则。
+//
+// 查询参数和表单字段按字段名匹配任意深度的规则（如 "$..BuyerEmail"）。
+var DefaultRules = []string{
+	// LWA 凭据、访问令牌和 Restricted Data Token
+	"$..refresh_token",
+	"$..refreshToken",
+	"$..access_token",
+	"$..accessToken",
+	"$..client_secret",
+	"$..clientSecret",
+	"$..restrictedDataToken",
+
+	// 买家信息（BuyerInfo、BuyerName、BuyerEmail、BuyerTaxInfo 等）
+	"$..Buyer*",
+
+	// 地址、邮箱和电话（ShippingAddress、BillingAddress、Email、Phone 等）
+	"$..*Address",
+	"$..*Email",
+	"$..*Phone",
+
+	// Vendor Direct Fulfillment 订单的收货方和账单方
+	"$..shipToParty",
+	"$..billToParty",
+}
+
+// DefaultHeaders 是默认脱敏的 HTTP 头。
+var DefaultHeaders = []string{
+	"Authorization",
+	"X-Amz-Access-Token",
+	"X-Amz-Security-Token",
+	"Cookie",
+	"Set-Cookie",
+}
+
+var (
+	// emailPattern 匹配自由文本中的邮箱地址。
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+
+	// tokenPattern 匹配 LWA 访问令牌、刷新令牌和 Restricted Data Token
+	// （Atza|...、Atzr|...、Atz.sprdt|...）。
+	tokenPattern = regexp.MustCompile(`Atz(?:[ar]|\.sprdt)\|[^\s"'&,;]+`)
+)
+
+// Redactor 按规则脱敏 JSON、HTTP 头、URL 和文本。
+//
+// Redactor 是并发安全的。
+type Redactor struct {
+	rules   []rule
+	headers map[string]struct{}
+}
+
+// rule 是解析后的 JSON 路径规则。
+type rule []step
+
+// step 是 JSON 路径中的一个字段。
+type step struct {
+	// pattern 是小写的字段名，可以包含 *
+	pattern string
+
+	// deep 表示字段可以出现在任意深度（..）
+	deep bool
+}
+
+var (
+	defaultOnce     sync.Once
+	defaultRedactor *Redactor
+)
+
+// Default 返回只使用内置规则的 Redactor。
+func Default() *Redactor {
+	defaultOnce.Do(func() {
+		r, err := New()
+		if err != nil {
+			panic(err)
+		}
+		defaultRedactor = r
+	})
+	return defaultRedactor
+}
+
+// New 创建 Redactor，使用内置规则和额外的规则。
+//
+// 参数:
+//   - rules: 额外的 JSON 路径规则（如 "$.payload.Orders.SellerOrderId"）
+//
+// 返回值:
+//   - *Redactor: Redactor 实例
+//   - error: 如果规则无法解析，返回 ErrInvalidRule
+//
+// 示例:
+//
+//	r, err := redact.New("$..PurchaseOrderNumber")
+//	if err != nil {
+//	    return err
+//	}
+//	log.Println(string(r.JSON(body)))
+func New(rules ...string) (*Redactor, error) {
+	r := &Redactor{headers: make(map[string]struct{}, len(DefaultHeaders))}
+	for _, name := range DefaultHeaders {
+		r.headers[http.CanonicalHeaderKey(name)] = struct{}{}
+	}
+	for _, path := range append(append([]string(nil), DefaultRules...), rules...) {
+		parsed, err := parseRule(path)
+		if err != nil {
+			return nil, err
+		}
+		r.rules = append(r.rules, parsed)
+	}
+	return r, nil
+}
+
+// parseRule 解析 JSON 路径规则。
+func parseRule(path string) (rule, error) {
+	rest, ok := strings.CutPrefix(strings.TrimSpace(path), "$")
+	if !ok || rest == "" {
+		return nil, fmt.Errorf("%w: %q must start with $ and select a field", ErrInvalidRule, path)
+	}
+	rest = strings.ReplaceAll(rest, "[*]", "")
+
+	var parsed rule
+	for rest != "" {
+		var s step
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			s.deep, rest = true, rest[2:]
+		case strings.HasPrefix(rest, "."):
+			rest = rest[1:]
+		default:
+			return nil, fmt.Errorf("%w: %q: unexpected %q", ErrInvalidRule, path, rest)
+		}
+
+		end := strings.IndexAny(rest, ".[]")
+		if end < 0 {
+			end = len(rest)
+		}
+		if end == 0 {
+			return nil, fmt.Errorf("%w: %q: empty field name", ErrInvalidRule, path)
+		}
+		s.pattern, rest = strings.ToLower(rest[:end]), rest[end:]
+		parsed = append(parsed, s)
+	}
+	if len(parsed) == 0 {
+		return nil, fmt.Errorf("%w: %q selects no field", ErrInvalidRule, path)
+	}
+	return parsed, nil
+}
+
+// matches 检查字段路径（不含数组下标）是否匹配规则。
+func (r rule) matches(path []string) bool {
+	if len(r) == 0 {
+		return len(path) == 0
+	}
+	first := r[0]
+	if !first.deep {
+		return len(path) > 0 && wildcard(first.pattern, path[0]) && r[1:].matches(path[1:])
+	}
+	for i := range path {
+		if wildcard(first.pattern, path[i]) && r[1:].matches(path[i+1:]) {
+			return true
+		}
+	}
+	return false
+}
+
+// wildcard 不区分大小写地匹配字段名，pattern 中的 * 匹配任意字符。
+func wildcard(pattern, name string) bool {
+	name = strings.ToLower(name)
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == name
+	}
+	if !strings.HasPrefix(name, parts[0]) {
+		return false
+	}
+	name = name[len(parts[0]):]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(name, part)
+		if i < 0 {
+			return false
+		}
+		name = name[i+len(part):]
+	}
+	return strings.HasSuffix(name, parts[len(parts)-1])
+}
+
+// matchPath 检查字段路径是否匹配任意规则。
+func (r *Redactor) matchPath(path []string) bool {
+	for _, rule := range r.rules {
+		if rule.matches(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// Field 检查名为 name 的查询参数、表单字段、日志字段或 span 属性是否需要脱敏。
+func (r *Redactor) Field(name string) bool {
+	return r.matchPath([]string{name})
+}
+
+// JSON 脱敏 JSON 文档。如果 data 不是 JSON，按文本脱敏。
+//
+// 匹配规则的字段中的字符串替换为 Placeholder，
+// 其余字符串中的邮箱和 LWA 令牌也会被替换。
+func (r *Redactor) JSON(data []byte) []byte {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil || decoder.More() {
+		return []byte(r.Text(string(data)))
+	}
+
+	redacted, err := json.Marshal(r.value(value, nil, false))
+	if err != nil {
+		return []byte(r.Text(string(data)))
+	}
+	return redacted
+}
+
+// value 递归脱敏 JSON 值。all 为 true 时替换所有字符串。
+func (r *Redactor) value(value interface{}, path []string, all bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			childPath := append(path[:len(path):len(path)], key)
+			v[key] = r.value(child, childPath, all || r.matchPath(childPath))
+		}
+		return v
+	case []interface{}:
+		for i, child := range v {
+			v[i] = r.value(child, path, all)
+		}
+		return v
+	case string:
+		if all {
+			return Placeholder
+		}
+		return scrub(v)
+	default:
+		return v
+	}
+}
+
+// Body 脱敏 HTTP 消息体：表单按字段名脱敏，其余按 JSON 脱敏。
+//
+// 参数:
+//   - body: 消息体
+//   - contentType: Content-Type 头（可以为空）
+func (r *Redactor) Body(body []byte, contentType string) []byte {
+	if len(body) == 0 {
+		return body
+	}
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return []byte(r.Text(string(body)))
+		}
+		return []byte(r.Values(form).Encode())
+	}
+	return r.JSON(body)
+}
+
+// Header 返回脱敏后的 HTTP 头副本。
+func (r *Redactor) Header(header http.Header) http.Header {
+	if header == nil {
+		return nil
+	}
+	redacted := make(http.Header, len(header))
+	for key, values := range header {
+		if _, ok := r.headers[http.CanonicalHeaderKey(key)]; ok {
+			redacted[key] = []string{Placeholder}
+			continue
+		}
+		redacted[key] = append([]string(nil), values...)
+	}
+	return redacted
+}
+
+// Values 返回脱敏后的查询参数或表单副本。
+func (r *Redactor) Values(values url.Values) url.Values {
+	redacted := make(url.Values, len(values))
+	for key, vals := range values {
+		if r.Field(key) {
+			redacted[key] = []string{Placeholder}
+			continue
+		}
+		redacted[key] = append([]string(nil), vals...)
+	}
+	return redacted
+}
+
+// URL 返回脱敏查询参数和密码后的 URL 字符串。
+func (r *Redactor) URL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	redacted := *u
+	if _, ok := u.User.Password(); ok {
+		redacted.User = url.UserPassword(u.User.Username(), Placeholder)
+	}
+	if u.RawQuery != "" {
+		redacted.RawQuery = r.Values(u.Query()).Encode()
+	}
+	return redacted.String()
+}
+
+// Text 脱敏自由文本：JSON 文档按规则脱敏，URL 脱敏查询参数，
+// 其余文本替换其中的邮箱和 LWA 令牌。
+func (r *Redactor) Text(s string) string {
+	trimmed := strings.TrimSpace(s)
+	switch {
+	case strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "["):
+		if json.Valid([]byte(trimmed)) {
+			return string(r.JSON([]byte(trimmed)))
+		}
+	case strings.HasPrefix(trimmed, "https://") || strings.HasPrefix(trimmed, "http://"):
+		if u, err := url.Parse(trimmed); err == nil {
+			return scrub(r.URL(u))
+		}
+	}
+	return scrub(s)
+}
+
+// Value 脱敏名为 name 的日志字段的值。
+//
+// 名称匹配规则的字段（如 "buyer_email"）整体替换为 Placeholder，
+// 否则按 Any 脱敏。
+func (r *Redactor) Value(name string, value interface{}) interface{} {
+	if r.Field(name) {
+		return Placeholder
+	}
+	return r.Any(value)
+}
+
+// Any 按值的类型脱敏：字符串和错误按 Text，[]byte 按 JSON，
+// *url.URL、http.Header 和 url.Values 使用对应的方法，其他类型原样返回。
+//
+// span 属性使用 Any 而不是 Value：语义约定的属性名（如 server.address）
+// 不是 PII 字段名。
+func (r *Redactor) Any(value interface{}) interface{} {
+	switch v := value.(type) {
+	case string:
+		return r.Text(v)
+	case []byte:
+		return string(r.JSON(v))
+	case error:
+		return r.Text(v.Error())
+	case *url.URL:
+		return r.URL(v)
+	case http.Header:
+		return r.Header(v)
+	case url.Values:
+		return r.Values(v)
+	default:
+		return value
+	}
+}
+
+// scrub 替换文本中的邮箱和 LWA 令牌。
+func scrub(s string) string {
+	s = tokenPattern.ReplaceAllString(s, Placeholder)
+	return emailPattern.ReplaceAllString(s, Placeholder)
+}
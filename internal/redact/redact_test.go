@@ -0,0 +1,154 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package redact_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/redact"
+)
+
+// order 是包含 PII 的订单响应。
+const order = `{
+	"payload": {
+		"AmazonOrderId": "902-3159896-1390916",
+		"OrderStatus": "Shipped",
+		"NumberOfItemsShipped": 2,
+		"BuyerInfo": {"BuyerEmail": "jane@marketplace.amazon.com", "BuyerName": "Jane Doe"},
+		"ShippingAddress": {"Name": "Jane Doe", "AddressLine1": "410 Terry Ave N", "City": "Seattle"},
+		"OrderItems": [{"SellerSKU": "SKU-1", "BuyerRequestedCancel": {"BuyerCancelReason": "late"}}],
+		"Notes": "contact jane@example.com"
+	}
+}`
+
+// decode 解析 JSON 对象。
+func decode(t *testing.T, data []byte) map[string]interface{} {
+	t.Helper()
+	var v map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &v))
+	return v
+}
+
+// TestRedactor_JSON 测试内置 PII 规则。
+func TestRedactor_JSON(t *testing.T) {
+	out := redact.Default().JSON([]byte(order))
+	assert.NotContains(t, string(out), "Jane")
+	assert.NotContains(t, string(out), "Terry")
+	assert.NotContains(t, string(out), "@")
+
+	payload := decode(t, out)["payload"].(map[string]interface{})
+	assert.Equal(t, "902-3159896-1390916", payload["AmazonOrderId"])
+	assert.Equal(t, "Shipped", payload["OrderStatus"])
+	assert.EqualValues(t, 2, payload["NumberOfItemsShipped"])
+	assert.Equal(t, map[string]interface{}{"BuyerEmail": redact.Placeholder, "BuyerName": redact.Placeholder}, payload["BuyerInfo"])
+	assert.Equal(t, redact.Placeholder, payload["ShippingAddress"].(map[string]interface{})["City"])
+	assert.Equal(t, "contact REDACTED", payload["Notes"])
+
+	item := payload["OrderItems"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "SKU-1", item["SellerSKU"])
+	assert.Equal(t, redact.Placeholder, item["BuyerRequestedCancel"].(map[string]interface{})["BuyerCancelReason"])
+}
+
+// TestRedactor_CustomRules 测试自定义 JSON 路径规则。
+func TestRedactor_CustomRules(t *testing.T) {
+	r, err := redact.New("$.payload.OrderItems[*].SellerSKU", "$..Order*Status")
+	require.NoError(t, err)
+
+	payload := decode(t, r.JSON([]byte(order)))["payload"].(map[string]interface{})
+	assert.Equal(t, redact.Placeholder, payload["OrderStatus"])
+	assert.Equal(t, "902-3159896-1390916", payload["AmazonOrderId"])
+	item := payload["OrderItems"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, redact.Placeholder, item["SellerSKU"])
+
+	// 根路径规则不匹配其他位置的同名字段
+	nested := decode(t, r.JSON([]byte(`{"other": {"payload": {"OrderItems": [{"SellerSKU": "SKU-1"}]}}}`)))
+	assert.Equal(t, "SKU-1", nested["other"].(map[string]interface{})["payload"].(map[string]interface{})["OrderItems"].([]interface{})[0].(map[string]interface{})["SellerSKU"])
+}
+
+// TestNew_InvalidRule 测试无效规则。
+func TestNew_InvalidRule(t *testing.T) {
+	for _, rule := range []string{"", "payload.BuyerInfo", "$", "$.", "$..", "$.payload..", "$.payload[0]"} {
+		_, err := redact.New(rule)
+		assert.True(t, errors.Is(err, redact.ErrInvalidRule), "rule %q: %v", rule, err)
+	}
+}
+
+// TestRedactor_Tokens 测试令牌和 Restricted Data Token 的脱敏。
+func TestRedactor_Tokens(t *testing.T) {
+	r := redact.Default()
+
+	lwa := r.JSON([]byte(`{"access_token":"Atza|abc","refresh_token":"Atzr|def","token_type":"bearer","expires_in":3600}`))
+	assert.Equal(t, `{"access_token":"REDACTED","expires_in":3600,"refresh_token":"REDACTED","token_type":"bearer"}`, string(lwa))
+
+	rdt := r.JSON([]byte(`{"restrictedDataToken":"Atz.sprdt|xyz","expiresIn":3600}`))
+	assert.NotContains(t, string(rdt), "xyz")
+
+	form := r.Body([]byte("grant_type=refresh_token&refresh_token=Atzr%7Cdef&client_id=id&client_secret=secret"), "application/x-www-form-urlencoded;charset=UTF-8")
+	values, err := url.ParseQuery(string(form))
+	require.NoError(t, err)
+	assert.Equal(t, "refresh_token", values.Get("grant_type"))
+	assert.Equal(t, "id", values.Get("client_id"))
+	assert.Equal(t, redact.Placeholder, values.Get("refresh_token"))
+	assert.Equal(t, redact.Placeholder, values.Get("client_secret"))
+
+	header := r.Header(http.Header{
+		"X-Amz-Access-Token": {"Atz.sprdt|xyz"},
+		"Content-Type":       {"application/json"},
+	})
+	assert.Equal(t, redact.Placeholder, header.Get("X-Amz-Access-Token"))
+	assert.Equal(t, "application/json", header.Get("Content-Type"))
+
+	assert.Equal(t, "token REDACTED expired", r.Text("token Atza|IwEBIA expired"))
+	assert.Equal(t, "rdt REDACTED", r.Text("rdt Atz.sprdt|AYABeKvW"))
+}
+
+// TestRedactor_URL 测试 URL 查询参数的脱敏。
+func TestRedactor_URL(t *testing.T) {
+	u, err := url.Parse("https://sellingpartnerapi-na.amazon.com/orders/v0/orders?BuyerEmail=jane%40example.com&MarketplaceIds=ATVPDKIKX0DER")
+	require.NoError(t, err)
+
+	got := redact.Default().URL(u)
+	assert.NotContains(t, got, "jane")
+	assert.True(t, strings.HasPrefix(got, "https://sellingpartnerapi-na.amazon.com/orders/v0/orders?"))
+	assert.Contains(t, got, "MarketplaceIds=ATVPDKIKX0DER")
+	assert.Equal(t, got, redact.Default().Text(u.String()))
+}
+
+// TestRedactor_Value 测试日志字段和 span 属性的脱敏。
+func TestRedactor_Value(t *testing.T) {
+	r := redact.Default()
+	assert.Equal(t, redact.Placeholder, r.Value("buyer_email", "x"))
+	assert.Equal(t, redact.Placeholder, r.Value("ShippingAddress", map[string]string{"City": "Seattle"}))
+	assert.Equal(t, 200, r.Value("status", 200))
+	assert.Equal(t, "send to REDACTED failed", r.Value("error", errors.New("send to jane@example.com failed")))
+
+	// span 属性按值脱敏，语义约定的属性名不受字段规则影响
+	assert.Equal(t, "sellingpartnerapi-na.amazon.com", r.Any("sellingpartnerapi-na.amazon.com"))
+	assert.Equal(t, `{"BuyerName":"REDACTED"}`, r.Any([]byte(`{"BuyerName":"Jane"}`)))
+}
@@ -25,11 +25,14 @@ import (
 	"log"
 	"net/http"
 	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/redact"
 )
 
 // LoggingMiddleware 创建日志记录中间件。
 //
 // 此中间件记录每个请求的方法、URL 和执行时间。
+// URL 查询参数和错误消息按内置规则脱敏（见 internal/redact）。
 //
 // 返回值:
 //   - Middleware: 日志中间件
@@ -41,9 +44,11 @@ func LoggingMiddleware() Middleware {
 	return func(next Handler) Handler {
 		return func(ctx context.Context, req *http.Request) (*http.Response, error) {
 			start := time.Now()
+			redactor := redact.Default()
+			target := redactor.URL(req.URL)
 
 			// 记录请求
-			log.Printf("[HTTP] --> %s %s", req.Method, req.URL.String())
+			log.Printf("[HTTP] --> %s %s", req.Method, target)
 
 			// 执行请求
 			resp, err := next(ctx, req)
@@ -51,11 +56,11 @@ func LoggingMiddleware() Middleware {
 			// 记录响应
 			duration := time.Since(start)
 			if err != nil {
-				log.Printf("[HTTP] <-- %s %s - Error: %v (took %v)",
-					req.Method, req.URL.String(), err, duration)
+				log.Printf("[HTTP] <-- %s %s - Error: %s (took %v)",
+					req.Method, target, redactor.Text(err.Error()), duration)
 			} else {
 				log.Printf("[HTTP] <-- %s %s - %d (took %v)",
-					req.Method, req.URL.String(), resp.StatusCode, duration)
+					req.Method, target, resp.StatusCode, duration)
 			}
 
 			return resp, err
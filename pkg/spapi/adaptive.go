@@ -0,0 +1,99 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapi
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/ratelimit"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/transport"
+)
+
+// AdaptiveRateLimitConfig 配置根据 429 响应自适应调整速率（AIMD）。
+//
+// 有些操作不返回 x-amzn-RateLimit-Limit，动态用量计划也会随时间变化。
+// 客户端把每个操作的实际速率维持在上限（响应头或默认速率）乘以一个系数：
+// 收到 429 时系数乘以 Decrease，连续 ProbeAfter 次成功后系数增加 Increase，
+// 系数不超过 1，因此实际速率不会超过上限。
+//
+// 零值字段使用默认值（见 DefaultAdaptiveRateLimitConfig）。
+type AdaptiveRateLimitConfig struct {
+	// Decrease 是收到 429 时速率的乘数（0-1，默认 0.5）。
+	Decrease float64
+
+	// Increase 是每次向上探测时增加的速率，占上限的比例（默认 0.1）。
+	Increase float64
+
+	// ProbeAfter 是向上探测前需要的连续成功次数（默认 10）。
+	ProbeAfter int
+
+	// MinFactor 是实际速率占上限的最小比例（默认 0.05）。
+	MinFactor float64
+}
+
+// DefaultAdaptiveRateLimitConfig 返回默认的自适应速率配置。
+func DefaultAdaptiveRateLimitConfig() *AdaptiveRateLimitConfig {
+	defaults := ratelimit.DefaultAdaptiveConfig()
+	return &AdaptiveRateLimitConfig{
+		Decrease:   defaults.Decrease,
+		Increase:   defaults.Increase,
+		ProbeAfter: defaults.ProbeAfter,
+		MinFactor:  defaults.MinFactor,
+	}
+}
+
+// adaptiveOption 把配置转换为速率限制管理器选项。
+func adaptiveOption(config *Config) ratelimit.ManagerOption {
+	if config.DisableAdaptiveRateLimit {
+		return ratelimit.WithAdaptive(nil)
+	}
+	adaptive := config.AdaptiveRateLimit
+	if adaptive == nil {
+		adaptive = DefaultAdaptiveRateLimitConfig()
+	}
+	return ratelimit.WithAdaptive(&ratelimit.AdaptiveConfig{
+		Decrease:   adaptive.Decrease,
+		Increase:   adaptive.Increase,
+		ProbeAfter: adaptive.ProbeAfter,
+		MinFactor:  adaptive.MinFactor,
+	})
+}
+
+// rateLimitFeedbackMiddleware 把每次实际收到的响应反馈给速率限制器。
+//
+// 它是内层的传输中间件，在重试中间件内侧，因此被重试掩盖的 429
+// 也会降低速率；响应头中的 x-amzn-RateLimit-Limit 同时更新速率上限。
+func (c *Client) rateLimitFeedbackMiddleware() transport.Middleware {
+	return func(next transport.Handler) transport.Handler {
+		return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			resp, err := next(ctx, req)
+			if err != nil || resp == nil {
+				return resp, err
+			}
+
+			operation := c.extractOperationName(req.Method, req.URL.Path)
+			marketplace := c.extractMarketplaceID(req.URL.Query())
+			c.updateRateLimitFromResponse(resp, c.extractSellerID(), c.config.ClientID, marketplace, operation)
+			return resp, nil
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapi_test
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/spapitest"
+)
+
+// newThrottlingServer 返回第一次请求返回 429、之后成功的测试服务器，
+// 两种响应都带有 x-amzn-RateLimit-Limit: 2。
+func newThrottlingServer() *spapitest.Server {
+	srv := spapitest.NewServer()
+	var calls atomic.Int32
+	srv.Handle(http.MethodGet, "/orders/v0/orders/{orderId}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("x-amzn-RateLimit-Limit", "2")
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"errors":[{"code":"QuotaExceeded","message":"You exceeded your quota for the requested resource."}]}`))
+			return
+		}
+		w.Write([]byte(`{}`))
+	})
+	return srv
+}
+
+// TestAdaptiveRateLimit 测试被重试掩盖的 429 也会降低速率，且不超过响应头的上限。
+func TestAdaptiveRateLimit(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []spapi.ClientOption
+		wantRate float64
+	}{
+		{"default", nil, 1},
+		{"custom", []spapi.ClientOption{spapi.WithAdaptiveRateLimit(&spapi.AdaptiveRateLimitConfig{Decrease: 0.25})}, 0.5},
+		{"disabled", []spapi.ClientOption{spapi.WithoutAdaptiveRateLimit()}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newThrottlingServer()
+			defer srv.Close()
+
+			client, err := srv.NewClient(append(tt.opts, spapi.WithSellerID("SELLER1"), spapi.WithMaxRetries(1))...)
+			if err != nil {
+				t.Fatalf("NewClient() error = %v", err)
+			}
+			if err := client.Get(context.Background(), "/orders/v0/orders/123", nil, nil); err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			if n := len(srv.Requests()); n != 2 {
+				t.Fatalf("requests = %d, want 2 (429 retried)", n)
+			}
+
+			limiter := client.RateLimitManager().GetOrCreateLimiter("SELLER1", client.Config().ClientID, "global", "orders-v0:getOrder")
+			if rate, _ := limiter.GetRate(); rate != tt.wantRate {
+				t.Errorf("rate = %v, want %v", rate, tt.wantRate)
+			}
+			if ceiling := limiter.Ceiling(); ceiling != 2 {
+				t.Errorf("ceiling = %v, want 2", ceiling)
+			}
+		})
+	}
+}
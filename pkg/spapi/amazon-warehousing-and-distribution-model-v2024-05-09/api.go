@@ -0,0 +1,81 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package amazon_warehousing_and_distribution_model_v2024_05_09
+
+import (
+	"context"
+	"iter"
+)
+
+// API 是 amazon-warehousing-and-distribution-model API v2024-05-09 客户端的全部方法，包括分页迭代器等辅助方法。
+//
+// 业务代码依赖 API 而不是 *Client，即可在单元测试中用 Fake 替换真实客户端。
+type API interface {
+	// CancelInbound
+	// Method: POST | Path: /awd/2024-05-09/inboundOrders/{orderId}/cancellation
+	CancelInbound(ctx context.Context, orderId string, body interface{}) (interface{}, error)
+
+	// CheckInboundEligibility
+	// Method: POST | Path: /awd/2024-05-09/inboundEligibility
+	CheckInboundEligibility(ctx context.Context, body interface{}) (interface{}, error)
+
+	// ConfirmInbound
+	// Method: POST | Path: /awd/2024-05-09/inboundOrders/{orderId}/confirmation
+	ConfirmInbound(ctx context.Context, orderId string, body interface{}) (interface{}, error)
+
+	// CreateInbound
+	// Method: POST | Path: /awd/2024-05-09/inboundOrders
+	CreateInbound(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetInbound
+	// Method: GET | Path: /awd/2024-05-09/inboundOrders/{orderId}
+	GetInbound(ctx context.Context, orderId string, query map[string]string) (interface{}, error)
+
+	// GetInboundShipment
+	// Method: GET | Path: /awd/2024-05-09/inboundShipments/{shipmentId}
+	GetInboundShipment(ctx context.Context, shipmentId string, query map[string]string) (interface{}, error)
+
+	// GetInboundShipmentLabels
+	// Method: GET | Path: /awd/2024-05-09/inboundShipments/{shipmentId}/labels
+	GetInboundShipmentLabels(ctx context.Context, shipmentId string, query map[string]string) (interface{}, error)
+
+	// IterateInboundShipments 返回入库货件迭代器，自动处理分页。
+	IterateInboundShipments(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// ListInboundShipments
+	// Method: GET | Path: /awd/2024-05-09/inboundShipments
+	ListInboundShipments(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// ListInventory
+	// Method: GET | Path: /awd/2024-05-09/inventory
+	ListInventory(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// UpdateInbound
+	// Method: PUT | Path: /awd/2024-05-09/inboundOrders/{orderId}
+	UpdateInbound(ctx context.Context, orderId string, body interface{}) (interface{}, error)
+
+	// UpdateInboundShipmentTransportDetails
+	// Method: PUT | Path: /awd/2024-05-09/inboundShipments/{shipmentId}/transport
+	UpdateInboundShipmentTransportDetails(ctx context.Context, shipmentId string, body interface{}) (interface{}, error)
+}
+
+// 确保 Client 实现了 API。
+var _ API = (*Client)(nil)
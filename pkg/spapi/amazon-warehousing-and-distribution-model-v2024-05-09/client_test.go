@@ -2,6 +2,7 @@
 package amazon_warehousing_and_distribution_model_v2024_05_09_test
 
 import (
+	"context"
 	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
 	api "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/amazon-warehousing-and-distribution-model-v2024-05-09"
 	"testing"
@@ -15,7 +16,7 @@ func TestNewClient(t *testing.T) {
 	if err != nil {
 		t.Fatalf("create base client: %v", err)
 	}
-	defer baseClient.Close()
+	defer baseClient.Close(context.Background())
 
 	client := api.NewClient(baseClient)
 	if client == nil {
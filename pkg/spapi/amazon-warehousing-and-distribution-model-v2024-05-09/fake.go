@@ -0,0 +1,190 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package amazon_warehousing_and_distribution_model_v2024_05_09
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。
+//
+// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回
+// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，
+// 可以通过 Calls 和 CallsTo 查看。
+type Fake struct {
+	spapi.FakeRecorder
+
+	// CancelInboundFunc 实现 CancelInbound。
+	CancelInboundFunc func(ctx context.Context, orderId string, body interface{}) (interface{}, error)
+
+	// CheckInboundEligibilityFunc 实现 CheckInboundEligibility。
+	CheckInboundEligibilityFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// ConfirmInboundFunc 实现 ConfirmInbound。
+	ConfirmInboundFunc func(ctx context.Context, orderId string, body interface{}) (interface{}, error)
+
+	// CreateInboundFunc 实现 CreateInbound。
+	CreateInboundFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetInboundFunc 实现 GetInbound。
+	GetInboundFunc func(ctx context.Context, orderId string, query map[string]string) (interface{}, error)
+
+	// GetInboundShipmentFunc 实现 GetInboundShipment。
+	GetInboundShipmentFunc func(ctx context.Context, shipmentId string, query map[string]string) (interface{}, error)
+
+	// GetInboundShipmentLabelsFunc 实现 GetInboundShipmentLabels。
+	GetInboundShipmentLabelsFunc func(ctx context.Context, shipmentId string, query map[string]string) (interface{}, error)
+
+	// IterateInboundShipmentsFunc 实现 IterateInboundShipments。
+	IterateInboundShipmentsFunc func(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// ListInboundShipmentsFunc 实现 ListInboundShipments。
+	ListInboundShipmentsFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// ListInventoryFunc 实现 ListInventory。
+	ListInventoryFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// UpdateInboundFunc 实现 UpdateInbound。
+	UpdateInboundFunc func(ctx context.Context, orderId string, body interface{}) (interface{}, error)
+
+	// UpdateInboundShipmentTransportDetailsFunc 实现 UpdateInboundShipmentTransportDetails。
+	UpdateInboundShipmentTransportDetailsFunc func(ctx context.Context, shipmentId string, body interface{}) (interface{}, error)
+}
+
+// NewFake 创建未编程任何响应的 Fake。
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// CancelInbound 记录调用并执行 CancelInboundFunc。
+func (f *Fake) CancelInbound(ctx context.Context, orderId string, body interface{}) (interface{}, error) {
+	f.Record("CancelInbound", orderId, body)
+	if f.CancelInboundFunc == nil {
+		return nil, fmt.Errorf("CancelInbound: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CancelInboundFunc(ctx, orderId, body)
+}
+
+// CheckInboundEligibility 记录调用并执行 CheckInboundEligibilityFunc。
+func (f *Fake) CheckInboundEligibility(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("CheckInboundEligibility", body)
+	if f.CheckInboundEligibilityFunc == nil {
+		return nil, fmt.Errorf("CheckInboundEligibility: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CheckInboundEligibilityFunc(ctx, body)
+}
+
+// ConfirmInbound 记录调用并执行 ConfirmInboundFunc。
+func (f *Fake) ConfirmInbound(ctx context.Context, orderId string, body interface{}) (interface{}, error) {
+	f.Record("ConfirmInbound", orderId, body)
+	if f.ConfirmInboundFunc == nil {
+		return nil, fmt.Errorf("ConfirmInbound: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ConfirmInboundFunc(ctx, orderId, body)
+}
+
+// CreateInbound 记录调用并执行 CreateInboundFunc。
+func (f *Fake) CreateInbound(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("CreateInbound", body)
+	if f.CreateInboundFunc == nil {
+		return nil, fmt.Errorf("CreateInbound: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateInboundFunc(ctx, body)
+}
+
+// GetInbound 记录调用并执行 GetInboundFunc。
+func (f *Fake) GetInbound(ctx context.Context, orderId string, query map[string]string) (interface{}, error) {
+	f.Record("GetInbound", orderId, query)
+	if f.GetInboundFunc == nil {
+		return nil, fmt.Errorf("GetInbound: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetInboundFunc(ctx, orderId, query)
+}
+
+// GetInboundShipment 记录调用并执行 GetInboundShipmentFunc。
+func (f *Fake) GetInboundShipment(ctx context.Context, shipmentId string, query map[string]string) (interface{}, error) {
+	f.Record("GetInboundShipment", shipmentId, query)
+	if f.GetInboundShipmentFunc == nil {
+		return nil, fmt.Errorf("GetInboundShipment: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetInboundShipmentFunc(ctx, shipmentId, query)
+}
+
+// GetInboundShipmentLabels 记录调用并执行 GetInboundShipmentLabelsFunc。
+func (f *Fake) GetInboundShipmentLabels(ctx context.Context, shipmentId string, query map[string]string) (interface{}, error) {
+	f.Record("GetInboundShipmentLabels", shipmentId, query)
+	if f.GetInboundShipmentLabelsFunc == nil {
+		return nil, fmt.Errorf("GetInboundShipmentLabels: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetInboundShipmentLabelsFunc(ctx, shipmentId, query)
+}
+
+// IterateInboundShipments 记录调用并执行 IterateInboundShipmentsFunc。
+func (f *Fake) IterateInboundShipments(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+	f.Record("IterateInboundShipments", query)
+	if f.IterateInboundShipmentsFunc == nil {
+		return spapi.FakeSeq[map[string]interface{}](nil, fmt.Errorf("IterateInboundShipments: %w", spapi.ErrFakeNotConfigured))
+	}
+	return f.IterateInboundShipmentsFunc(ctx, query)
+}
+
+// ListInboundShipments 记录调用并执行 ListInboundShipmentsFunc。
+func (f *Fake) ListInboundShipments(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("ListInboundShipments", query)
+	if f.ListInboundShipmentsFunc == nil {
+		return nil, fmt.Errorf("ListInboundShipments: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListInboundShipmentsFunc(ctx, query)
+}
+
+// ListInventory 记录调用并执行 ListInventoryFunc。
+func (f *Fake) ListInventory(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("ListInventory", query)
+	if f.ListInventoryFunc == nil {
+		return nil, fmt.Errorf("ListInventory: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListInventoryFunc(ctx, query)
+}
+
+// UpdateInbound 记录调用并执行 UpdateInboundFunc。
+func (f *Fake) UpdateInbound(ctx context.Context, orderId string, body interface{}) (interface{}, error) {
+	f.Record("UpdateInbound", orderId, body)
+	if f.UpdateInboundFunc == nil {
+		return nil, fmt.Errorf("UpdateInbound: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.UpdateInboundFunc(ctx, orderId, body)
+}
+
+// UpdateInboundShipmentTransportDetails 记录调用并执行 UpdateInboundShipmentTransportDetailsFunc。
+func (f *Fake) UpdateInboundShipmentTransportDetails(ctx context.Context, shipmentId string, body interface{}) (interface{}, error) {
+	f.Record("UpdateInboundShipmentTransportDetails", shipmentId, body)
+	if f.UpdateInboundShipmentTransportDetailsFunc == nil {
+		return nil, fmt.Errorf("UpdateInboundShipmentTransportDetails: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.UpdateInboundShipmentTransportDetailsFunc(ctx, shipmentId, body)
+}
+
+// 确保 Fake 实现了 API。
+var _ API = (*Fake)(nil)
@@ -0,0 +1,77 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package aplus_content_v2020_11_01
+
+import (
+	"context"
+	"iter"
+)
+
+// API 是 aplus-content API v2020-11-01 客户端的全部方法，包括分页迭代器等辅助方法。
+//
+// 业务代码依赖 API 而不是 *Client，即可在单元测试中用 Fake 替换真实客户端。
+type API interface {
+	// CreateContentDocument
+	// Method: POST | Path: /aplus/2020-11-01/contentDocuments
+	CreateContentDocument(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetContentDocument
+	// Method: GET | Path: /aplus/2020-11-01/contentDocuments/{contentReferenceKey}
+	GetContentDocument(ctx context.Context, contentReferenceKey string, query map[string]string) (interface{}, error)
+
+	// IterateContentDocuments 返回 A+ 内容文档迭代器，自动处理分页。
+	IterateContentDocuments(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// ListContentDocumentAsinRelations
+	// Method: GET | Path: /aplus/2020-11-01/contentDocuments/{contentReferenceKey}/asins
+	ListContentDocumentAsinRelations(ctx context.Context, contentReferenceKey string, query map[string]string) (interface{}, error)
+
+	// PostContentDocumentApprovalSubmission
+	// Method: POST | Path: /aplus/2020-11-01/contentDocuments/{contentReferenceKey}/approvalSubmissions
+	PostContentDocumentApprovalSubmission(ctx context.Context, contentReferenceKey string, body interface{}) (interface{}, error)
+
+	// PostContentDocumentAsinRelations
+	// Method: POST | Path: /aplus/2020-11-01/contentDocuments/{contentReferenceKey}/asins
+	PostContentDocumentAsinRelations(ctx context.Context, contentReferenceKey string, body interface{}) (interface{}, error)
+
+	// PostContentDocumentSuspendSubmission
+	// Method: POST | Path: /aplus/2020-11-01/contentDocuments/{contentReferenceKey}/suspendSubmissions
+	PostContentDocumentSuspendSubmission(ctx context.Context, contentReferenceKey string, body interface{}) (interface{}, error)
+
+	// SearchContentDocuments
+	// Method: GET | Path: /aplus/2020-11-01/contentDocuments
+	SearchContentDocuments(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// SearchContentPublishRecords
+	// Method: GET | Path: /aplus/2020-11-01/contentPublishRecords
+	SearchContentPublishRecords(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// UpdateContentDocument
+	// Method: POST | Path: /aplus/2020-11-01/contentDocuments/{contentReferenceKey}
+	UpdateContentDocument(ctx context.Context, contentReferenceKey string, body interface{}) (interface{}, error)
+
+	// ValidateContentDocumentAsinRelations
+	// Method: POST | Path: /aplus/2020-11-01/contentAsinValidations
+	ValidateContentDocumentAsinRelations(ctx context.Context, body interface{}) (interface{}, error)
+}
+
+// 确保 Client 实现了 API。
+var _ API = (*Client)(nil)
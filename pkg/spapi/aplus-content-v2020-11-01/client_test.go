@@ -2,6 +2,7 @@
 package aplus_content_v2020_11_01_test
 
 import (
+	"context"
 	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
 	api "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/aplus-content-v2020-11-01"
 	"testing"
@@ -15,7 +16,7 @@ func TestNewClient(t *testing.T) {
 	if err != nil {
 		t.Fatalf("create base client: %v", err)
 	}
-	defer baseClient.Close()
+	defer baseClient.Close(context.Background())
 
 	client := api.NewClient(baseClient)
 	if client == nil {
@@ -0,0 +1,178 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package aplus_content_v2020_11_01
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。
+//
+// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回
+// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，
+// 可以通过 Calls 和 CallsTo 查看。
+type Fake struct {
+	spapi.FakeRecorder
+
+	// CreateContentDocumentFunc 实现 CreateContentDocument。
+	CreateContentDocumentFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetContentDocumentFunc 实现 GetContentDocument。
+	GetContentDocumentFunc func(ctx context.Context, contentReferenceKey string, query map[string]string) (interface{}, error)
+
+	// IterateContentDocumentsFunc 实现 IterateContentDocuments。
+	IterateContentDocumentsFunc func(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// ListContentDocumentAsinRelationsFunc 实现 ListContentDocumentAsinRelations。
+	ListContentDocumentAsinRelationsFunc func(ctx context.Context, contentReferenceKey string, query map[string]string) (interface{}, error)
+
+	// PostContentDocumentApprovalSubmissionFunc 实现 PostContentDocumentApprovalSubmission。
+	PostContentDocumentApprovalSubmissionFunc func(ctx context.Context, contentReferenceKey string, body interface{}) (interface{}, error)
+
+	// PostContentDocumentAsinRelationsFunc 实现 PostContentDocumentAsinRelations。
+	PostContentDocumentAsinRelationsFunc func(ctx context.Context, contentReferenceKey string, body interface{}) (interface{}, error)
+
+	// PostContentDocumentSuspendSubmissionFunc 实现 PostContentDocumentSuspendSubmission。
+	PostContentDocumentSuspendSubmissionFunc func(ctx context.Context, contentReferenceKey string, body interface{}) (interface{}, error)
+
+	// SearchContentDocumentsFunc 实现 SearchContentDocuments。
+	SearchContentDocumentsFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// SearchContentPublishRecordsFunc 实现 SearchContentPublishRecords。
+	SearchContentPublishRecordsFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// UpdateContentDocumentFunc 实现 UpdateContentDocument。
+	UpdateContentDocumentFunc func(ctx context.Context, contentReferenceKey string, body interface{}) (interface{}, error)
+
+	// ValidateContentDocumentAsinRelationsFunc 实现 ValidateContentDocumentAsinRelations。
+	ValidateContentDocumentAsinRelationsFunc func(ctx context.Context, body interface{}) (interface{}, error)
+}
+
+// NewFake 创建未编程任何响应的 Fake。
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// CreateContentDocument 记录调用并执行 CreateContentDocumentFunc。
+func (f *Fake) CreateContentDocument(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("CreateContentDocument", body)
+	if f.CreateContentDocumentFunc == nil {
+		return nil, fmt.Errorf("CreateContentDocument: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateContentDocumentFunc(ctx, body)
+}
+
+// GetContentDocument 记录调用并执行 GetContentDocumentFunc。
+func (f *Fake) GetContentDocument(ctx context.Context, contentReferenceKey string, query map[string]string) (interface{}, error) {
+	f.Record("GetContentDocument", contentReferenceKey, query)
+	if f.GetContentDocumentFunc == nil {
+		return nil, fmt.Errorf("GetContentDocument: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetContentDocumentFunc(ctx, contentReferenceKey, query)
+}
+
+// IterateContentDocuments 记录调用并执行 IterateContentDocumentsFunc。
+func (f *Fake) IterateContentDocuments(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+	f.Record("IterateContentDocuments", query)
+	if f.IterateContentDocumentsFunc == nil {
+		return spapi.FakeSeq[map[string]interface{}](nil, fmt.Errorf("IterateContentDocuments: %w", spapi.ErrFakeNotConfigured))
+	}
+	return f.IterateContentDocumentsFunc(ctx, query)
+}
+
+// ListContentDocumentAsinRelations 记录调用并执行 ListContentDocumentAsinRelationsFunc。
+func (f *Fake) ListContentDocumentAsinRelations(ctx context.Context, contentReferenceKey string, query map[string]string) (interface{}, error) {
+	f.Record("ListContentDocumentAsinRelations", contentReferenceKey, query)
+	if f.ListContentDocumentAsinRelationsFunc == nil {
+		return nil, fmt.Errorf("ListContentDocumentAsinRelations: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListContentDocumentAsinRelationsFunc(ctx, contentReferenceKey, query)
+}
+
+// PostContentDocumentApprovalSubmission 记录调用并执行 PostContentDocumentApprovalSubmissionFunc。
+func (f *Fake) PostContentDocumentApprovalSubmission(ctx context.Context, contentReferenceKey string, body interface{}) (interface{}, error) {
+	f.Record("PostContentDocumentApprovalSubmission", contentReferenceKey, body)
+	if f.PostContentDocumentApprovalSubmissionFunc == nil {
+		return nil, fmt.Errorf("PostContentDocumentApprovalSubmission: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.PostContentDocumentApprovalSubmissionFunc(ctx, contentReferenceKey, body)
+}
+
+// PostContentDocumentAsinRelations 记录调用并执行 PostContentDocumentAsinRelationsFunc。
+func (f *Fake) PostContentDocumentAsinRelations(ctx context.Context, contentReferenceKey string, body interface{}) (interface{}, error) {
+	f.Record("PostContentDocumentAsinRelations", contentReferenceKey, body)
+	if f.PostContentDocumentAsinRelationsFunc == nil {
+		return nil, fmt.Errorf("PostContentDocumentAsinRelations: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.PostContentDocumentAsinRelationsFunc(ctx, contentReferenceKey, body)
+}
+
+// PostContentDocumentSuspendSubmission 记录调用并执行 PostContentDocumentSuspendSubmissionFunc。
+func (f *Fake) PostContentDocumentSuspendSubmission(ctx context.Context, contentReferenceKey string, body interface{}) (interface{}, error) {
+	f.Record("PostContentDocumentSuspendSubmission", contentReferenceKey, body)
+	if f.PostContentDocumentSuspendSubmissionFunc == nil {
+		return nil, fmt.Errorf("PostContentDocumentSuspendSubmission: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.PostContentDocumentSuspendSubmissionFunc(ctx, contentReferenceKey, body)
+}
+
+// SearchContentDocuments 记录调用并执行 SearchContentDocumentsFunc。
+func (f *Fake) SearchContentDocuments(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("SearchContentDocuments", query)
+	if f.SearchContentDocumentsFunc == nil {
+		return nil, fmt.Errorf("SearchContentDocuments: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.SearchContentDocumentsFunc(ctx, query)
+}
+
+// SearchContentPublishRecords 记录调用并执行 SearchContentPublishRecordsFunc。
+func (f *Fake) SearchContentPublishRecords(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("SearchContentPublishRecords", query)
+	if f.SearchContentPublishRecordsFunc == nil {
+		return nil, fmt.Errorf("SearchContentPublishRecords: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.SearchContentPublishRecordsFunc(ctx, query)
+}
+
+// UpdateContentDocument 记录调用并执行 UpdateContentDocumentFunc。
+func (f *Fake) UpdateContentDocument(ctx context.Context, contentReferenceKey string, body interface{}) (interface{}, error) {
+	f.Record("UpdateContentDocument", contentReferenceKey, body)
+	if f.UpdateContentDocumentFunc == nil {
+		return nil, fmt.Errorf("UpdateContentDocument: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.UpdateContentDocumentFunc(ctx, contentReferenceKey, body)
+}
+
+// ValidateContentDocumentAsinRelations 记录调用并执行 ValidateContentDocumentAsinRelationsFunc。
+func (f *Fake) ValidateContentDocumentAsinRelations(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("ValidateContentDocumentAsinRelations", body)
+	if f.ValidateContentDocumentAsinRelationsFunc == nil {
+		return nil, fmt.Errorf("ValidateContentDocumentAsinRelations: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ValidateContentDocumentAsinRelationsFunc(ctx, body)
+}
+
+// 确保 Fake 实现了 API。
+var _ API = (*Fake)(nil)
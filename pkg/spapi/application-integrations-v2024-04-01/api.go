@@ -0,0 +1,45 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package application_integrations_v2024_04_01
+
+import (
+	"context"
+)
+
+// API 是 application-integrations API v2024-04-01 客户端的全部方法，包括分页迭代器等辅助方法。
+//
+// 业务代码依赖 API 而不是 *Client，即可在单元测试中用 Fake 替换真实客户端。
+type API interface {
+	// CreateNotification
+	// Method: POST | Path: /appIntegrations/2024-04-01/notifications
+	CreateNotification(ctx context.Context, body interface{}) (interface{}, error)
+
+	// DeleteNotifications
+	// Method: POST | Path: /appIntegrations/2024-04-01/notifications/deletion
+	DeleteNotifications(ctx context.Context, body interface{}) (interface{}, error)
+
+	// RecordActionFeedback
+	// Method: POST | Path: /appIntegrations/2024-04-01/notifications/{notificationId}/feedback
+	RecordActionFeedback(ctx context.Context, notificationId string, body interface{}) (interface{}, error)
+}
+
+// 确保 Client 实现了 API。
+var _ API = (*Client)(nil)
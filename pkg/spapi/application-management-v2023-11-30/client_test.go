@@ -2,6 +2,7 @@
 package application_management_v2023_11_30_test
 
 import (
+	"context"
 	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
 	api "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/application-management-v2023-11-30"
 	"testing"
@@ -15,7 +16,7 @@ func TestNewClient(t *testing.T) {
 	if err != nil {
 		t.Fatalf("create base client: %v", err)
 	}
-	defer baseClient.Close()
+	defer baseClient.Close(context.Background())
 
 	client := api.NewClient(baseClient)
 	if client == nil {
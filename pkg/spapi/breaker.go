@@ -0,0 +1,108 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/circuit"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/transport"
+)
+
+// CircuitBreakerConfig 配置客户端的熔断器。
+//
+// 连续 MaxFailures 个请求因网络错误或 5xx 响应失败（重试之后）时熔断：
+// 之后 Timeout 内的请求直接返回 ErrCircuitOpen，不再发送。Timeout 过后
+// 放行请求试探，成功则恢复，失败则继续熔断。429 和其他 4xx 响应不计为失败。
+type CircuitBreakerConfig struct {
+	// MaxFailures 是触发熔断的连续失败次数（默认 5）。
+	MaxFailures int `validate:"min=0"`
+
+	// Timeout 是熔断持续时间（默认 60 秒）。
+	Timeout time.Duration `validate:"min=0"`
+}
+
+// 熔断器状态，见 HealthStatus.CircuitBreaker。
+const (
+	CircuitBreakerDisabled = "disabled"
+	CircuitBreakerClosed   = "closed"
+	CircuitBreakerOpen     = "open"
+	CircuitBreakerHalfOpen = "half-open"
+)
+
+// errBreakerFailure 标记计为熔断失败的 5xx 响应。
+var errBreakerFailure = errors.New("server error")
+
+// newBreaker 根据配置创建熔断器，配置为 nil 时返回 nil。
+func newBreaker(config *CircuitBreakerConfig, logger Logger) *circuit.Breaker {
+	if config == nil {
+		return nil
+	}
+	return circuit.NewBreaker(&circuit.Config{
+		MaxFailures: config.MaxFailures,
+		Timeout:     config.Timeout,
+		OnStateChange: func(from, to circuit.State) {
+			logger.Warn(EventCircuitBreakerStateChanged,
+				Field{LogKeyPreviousState, from.String()},
+				Field{LogKeyState, to.String()},
+			)
+		},
+	})
+}
+
+// breakerMiddleware 在熔断时拒绝请求，并把每个请求的结果记录到熔断器。
+//
+// 它在重试中间件外侧，一次 API 调用（包括全部重试）只记录一次结果。
+// 调用方取消的请求不计为失败。
+func breakerMiddleware(breaker *circuit.Breaker) transport.Middleware {
+	return func(next transport.Handler) transport.Handler {
+		return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			var resp *http.Response
+			var err error
+			breakerErr := breaker.Execute(func() error {
+				resp, err = next(ctx, req)
+				switch {
+				case err != nil && ctx.Err() == nil:
+					return err
+				case err == nil && resp.StatusCode >= 500:
+					return errBreakerFailure
+				}
+				return nil
+			})
+			if errors.Is(breakerErr, circuit.ErrCircuitOpen) {
+				return nil, fmt.Errorf("%w: %s %s", ErrCircuitOpen, req.Method, req.URL.Path)
+			}
+			return resp, err
+		}
+	}
+}
+
+// breakerState 返回熔断器状态，未启用熔断器时返回 CircuitBreakerDisabled。
+func breakerState(breaker *circuit.Breaker) string {
+	if breaker == nil {
+		return CircuitBreakerDisabled
+	}
+	return breaker.State().String()
+}
@@ -0,0 +1,564 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+// Package cassette 提供 HTTP 录制/回放中间件，用于确定性地测试 SP-API 调用。
+//
+// 录制模式下，中间件将真实的请求和响应写入 cassette 文件（JSON），
+// 写入前脱敏访问令牌、刷新令牌和 PII 字段（买家姓名、邮箱、地址等）；
+// 回放模式下，中间件按方法、路径模板和规范化的查询参数匹配请求，
+// 直接返回录制的响应而不访问网络。
+//
+// 中间件作用于 SP-API 请求（transport.Client），不包括 LWA 令牌交换。
+// 回放时可以把区域的 LWAEndpoint 指向 spapitest 服务器，使测试完全离线。
+//
+// 示例:
+//
+//	c, err := cassette.New("testdata/orders.json", cassette.ModeRecordIfMissing)
+//	if err != nil {
+//	    t.Fatal(err)
+//	}
+//	client.HTTPClient().Use(c.Middleware())
+package cassette
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/redact"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/transport"
+)
+
+// Redacted 是脱敏后的占位值。
+const Redacted = redact.Placeholder
+
+// formatVersion 是 cassette 文件格式版本。
+const formatVersion = 1
+
+// ErrNoInteraction 表示回放时没有录制的交互与请求匹配。
+var ErrNoInteraction = errors.New("cassette: no recorded interaction matches request")
+
+// Mode 是 cassette 的工作模式。
+type Mode int
+
+const (
+	// ModeReplay 只回放录制的交互，未匹配的请求返回 ErrNoInteraction
+	ModeReplay Mode = iota
+
+	// ModeRecord 发送所有请求并重新录制（覆盖已有文件）
+	ModeRecord
+
+	// ModeRecordIfMissing 回放已录制的交互，未匹配的请求发送并追加录制
+	ModeRecordIfMissing
+)
+
+// String 返回模式名称。
+func (m Mode) String() string {
+	switch m {
+	case ModeReplay:
+		return "replay"
+	case ModeRecord:
+		return "record"
+	case ModeRecordIfMissing:
+		return "record-if-missing"
+	default:
+		return fmt.Sprintf("Mode(%d)", int(m))
+	}
+}
+
+// ParseMode 解析模式名称（replay、record、record-if-missing）。
+//
+// 便于通过环境变量切换模式，例如 SPAPI_CASSETTE=record。
+func ParseMode(s string) (Mode, error) {
+	for _, m := range []Mode{ModeReplay, ModeRecord, ModeRecordIfMissing} {
+		if s == m.String() {
+			return m, nil
+		}
+	}
+	return ModeReplay, fmt.Errorf("cassette: unknown mode %q", s)
+}
+
+// Cassette 是录制的 HTTP 交互集合。
+//
+// Cassette 是并发安全的。
+type Cassette struct {
+	path     string
+	mode     Mode
+	settings settings
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	used         []bool
+}
+
+// New 创建 cassette。
+//
+// ModeReplay 要求文件存在；ModeRecordIfMissing 在文件存在时加载它；
+// ModeRecord 从空白开始，第一次录制时覆盖文件。
+//
+// 参数:
+//   - path: cassette 文件路径
+//   - mode: 工作模式
+//   - opts: 配置选项
+//
+// 返回值:
+//   - *Cassette: cassette 实例
+//   - error: 如果加载失败，返回错误
+func New(path string, mode Mode, opts ...Option) (*Cassette, error) {
+	c := &Cassette{
+		path:     path,
+		mode:     mode,
+		settings: defaultSettings(),
+	}
+	for _, opt := range opts {
+		opt(&c.settings)
+	}
+	if err := c.settings.newRedactor(); err != nil {
+		return nil, fmt.Errorf("cassette: %w", err)
+	}
+
+	if mode == ModeRecord {
+		return c, nil
+	}
+
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist) && mode == ModeRecordIfMissing:
+		return c, nil
+	case err != nil:
+		return nil, fmt.Errorf("cassette: %w", err)
+	}
+
+	var f file
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("cassette: parse %s: %w", path, err)
+	}
+	if f.Version != formatVersion {
+		return nil, fmt.Errorf("cassette: %s: unsupported version %d", path, f.Version)
+	}
+
+	c.interactions = f.Interactions
+	c.used = make([]bool, len(f.Interactions))
+	return c, nil
+}
+
+// Mode 返回 cassette 的工作模式。
+func (c *Cassette) Mode() Mode {
+	return c.mode
+}
+
+// Interactions 返回录制的交互。
+func (c *Cassette) Interactions() []*Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]*Interaction(nil), c.interactions...)
+}
+
+// Middleware 返回录制/回放中间件。
+//
+// 中间件应添加在重试中间件之后（client.HTTPClient().Use 即是如此），
+// 使每次重试尝试都被单独录制和回放。
+//
+// 返回值:
+//   - transport.Middleware: 录制/回放中间件
+func (c *Cassette) Middleware() transport.Middleware {
+	return func(next transport.Handler) transport.Handler {
+		return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			request, err := c.recordRequest(req)
+			if err != nil {
+				return nil, err
+			}
+
+			if c.mode != ModeRecord {
+				interaction, mismatch := c.match(request)
+				if interaction != nil {
+					return interaction.Response.toHTTP(req), nil
+				}
+				if c.mode == ModeReplay {
+					return nil, mismatch
+				}
+			}
+
+			resp, err := next(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+
+			response, err := c.recordResponse(resp)
+			if err != nil {
+				return nil, err
+			}
+			if err := c.add(&Interaction{Request: *request, Response: *response}); err != nil {
+				return nil, err
+			}
+			return resp, nil
+		}
+	}
+}
+
+// recordRequest 读取请求并转换为脱敏后的录制形式，请求体会被恢复。
+func (c *Cassette) recordRequest(req *http.Request) (*Request, error) {
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, fmt.Errorf("cassette: read request body: %w", err)
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	query := c.settings.redactQuery(req.URL.Query())
+	return &Request{
+		Method:   req.Method,
+		Path:     req.URL.Path,
+		Template: c.settings.template(req.URL.Path),
+		Query:    query,
+		Header:   c.settings.redactHeader(req.Header),
+		Body:     newBody(c.settings.redactBody(body, req.Header.Get("Content-Type"))),
+	}, nil
+}
+
+// recordResponse 读取响应并转换为脱敏后的录制形式，响应体会被恢复。
+func (c *Cassette) recordResponse(resp *http.Response) (*Response, error) {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("cassette: read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	// 脱敏会改变响应体长度，回放时重新计算
+	header := c.settings.redactHeader(resp.Header)
+	delete(header, "Content-Length")
+
+	return &Response{
+		Status: resp.StatusCode,
+		Header: header,
+		Body:   newBody(c.settings.redactBody(body, resp.Header.Get("Content-Type"))),
+	}, nil
+}
+
+// add 追加录制的交互并写入文件。
+func (c *Cassette) add(interaction *Interaction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.interactions = append(c.interactions, interaction)
+	c.used = append(c.used, true)
+	return c.save()
+}
+
+// save 原子地写入 cassette 文件。调用方需持有锁。
+func (c *Cassette) save() error {
+	data, err := json.MarshalIndent(file{Version: formatVersion, Interactions: c.interactions}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("cassette: encode: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return fmt.Errorf("cassette: %w", err)
+	}
+	tmp := c.path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("cassette: %w", err)
+	}
+	if err := os.Rename(tmp, c.path); err != nil {
+		return fmt.Errorf("cassette: %w", err)
+	}
+	return nil
+}
+
+// match 查找并占用第一个与请求匹配且未回放过的交互。
+//
+// 优先选择路径完全相同的交互。没有匹配时返回描述最接近交互差异的错误。
+func (c *Cassette) match(request *Request) (*Interaction, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	best, exhausted := -1, 0
+	for i, interaction := range c.interactions {
+		if !sameRequest(&interaction.Request, request) {
+			continue
+		}
+		if c.used[i] {
+			exhausted++
+			continue
+		}
+		if best == -1 || (interaction.Request.Path == request.Path && c.interactions[best].Request.Path != request.Path) {
+			best = i
+		}
+	}
+
+	if best >= 0 {
+		c.used[best] = true
+		return c.interactions[best], nil
+	}
+	return nil, c.mismatch(request, exhausted)
+}
+
+// sameRequest 比较方法、路径模板和规范化的查询参数。
+func sameRequest(recorded, request *Request) bool {
+	return recorded.Method == request.Method &&
+		recorded.Template == request.Template &&
+		normalizeQuery(recorded.Query) == normalizeQuery(request.Query)
+}
+
+// mismatch 构造未匹配错误，包含与最接近的录制交互的差异。调用方需持有锁。
+func (c *Cassette) mismatch(request *Request, exhausted int) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s", request.Method, request.Path)
+
+	if exhausted > 0 {
+		fmt.Fprintf(&b, "\n  all %d matching interactions were already replayed", exhausted)
+		return fmt.Errorf("%w: %s", ErrNoInteraction, b.String())
+	}
+
+	closest, score := -1, -1
+	for i, interaction := range c.interactions {
+		s := similarity(&interaction.Request, request)
+		if s > score {
+			closest, score = i, s
+		}
+	}
+
+	if closest == -1 {
+		b.WriteString("\n  cassette has no interactions")
+		return fmt.Errorf("%w: %s", ErrNoInteraction, b.String())
+	}
+
+	recorded := &c.interactions[closest].Request
+	fmt.Fprintf(&b, "\n  closest: interaction %d, %s %s", closest+1, recorded.Method, recorded.Path)
+	for _, line := range requestDiff(recorded, request) {
+		b.WriteString("\n  ")
+		b.WriteString(line)
+	}
+	return fmt.Errorf("%w: %s", ErrNoInteraction, b.String())
+}
+
+// similarity 计算录制请求与请求的相似度，用于选择最接近的交互。
+func similarity(recorded, request *Request) int {
+	score := 0
+	if recorded.Method == request.Method {
+		score += 100
+	}
+	if recorded.Template == request.Template {
+		score += 50
+	}
+	for key, values := range request.Query {
+		if strings.Join(recorded.Query[key], ",") == strings.Join(values, ",") {
+			score++
+		}
+	}
+	return score
+}
+
+// requestDiff 列出录制请求与请求在匹配字段上的差异。
+func requestDiff(recorded, request *Request) []string {
+	var lines []string
+	if recorded.Method != request.Method {
+		lines = append(lines, fmt.Sprintf("- method: %s", recorded.Method), fmt.Sprintf("+ method: %s", request.Method))
+	}
+	if recorded.Template != request.Template {
+		lines = append(lines, fmt.Sprintf("- path: %s", recorded.Template), fmt.Sprintf("+ path: %s", request.Template))
+	}
+
+	keys := make(map[string]struct{})
+	for key := range recorded.Query {
+		keys[key] = struct{}{}
+	}
+	for key := range request.Query {
+		keys[key] = struct{}{}
+	}
+	for _, key := range sortedKeys(keys) {
+		old, new := normalizeValues(recorded.Query[key]), normalizeValues(request.Query[key])
+		if old == new {
+			continue
+		}
+		if _, ok := recorded.Query[key]; ok {
+			lines = append(lines, fmt.Sprintf("- query %s=%s", key, old))
+		}
+		if _, ok := request.Query[key]; ok {
+			lines = append(lines, fmt.Sprintf("+ query %s=%s", key, new))
+		}
+	}
+	return lines
+}
+
+// normalizeQuery 返回与参数顺序和逗号分隔值顺序无关的查询字符串。
+func normalizeQuery(query map[string][]string) string {
+	keys := make(map[string]struct{}, len(query))
+	for key := range query {
+		keys[key] = struct{}{}
+	}
+
+	parts := make([]string, 0, len(query))
+	for _, key := range sortedKeys(keys) {
+		parts = append(parts, key+"="+normalizeValues(query[key]))
+	}
+	return strings.Join(parts, "&")
+}
+
+// normalizeValues 拆分逗号分隔的值并排序。
+func normalizeValues(values []string) string {
+	var all []string
+	for _, value := range values {
+		all = append(all, strings.Split(value, ",")...)
+	}
+	sort.Strings(all)
+	return strings.Join(all, ",")
+}
+
+// sortedKeys 返回排序后的键。
+func sortedKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// file 是 cassette 文件的 JSON 形式。
+type file struct {
+	Version      int            `json:"version"`
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction 是一次录制的请求和响应。
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request 是录制的请求。
+type Request struct {
+	// Method 是 HTTP 方法
+	Method string `json:"method"`
+
+	// Path 是录制时的请求路径
+	Path string `json:"path"`
+
+	// Template 是路径模板，回放时用于匹配
+	Template string `json:"template"`
+
+	// Query 是脱敏后的查询参数
+	Query map[string][]string `json:"query,omitempty"`
+
+	// Header 是脱敏后的请求头
+	Header map[string][]string `json:"header,omitempty"`
+
+	// Body 是脱敏后的请求体
+	Body Body `json:"body,omitzero"`
+}
+
+// Response 是录制的响应。
+type Response struct {
+	// Status 是 HTTP 状态码
+	Status int `json:"status"`
+
+	// Header 是脱敏后的响应头
+	Header map[string][]string `json:"header,omitempty"`
+
+	// Body 是脱敏后的响应体
+	Body Body `json:"body,omitzero"`
+}
+
+// toHTTP 将录制的响应转换为 HTTP 响应。
+func (r *Response) toHTTP(req *http.Request) *http.Response {
+	body := r.Body.Bytes()
+	header := make(http.Header, len(r.Header))
+	for key, values := range r.Header {
+		header[key] = append([]string(nil), values...)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.Status, http.StatusText(r.Status)),
+		StatusCode:    r.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// Body 是录制的消息体。
+//
+// JSON 内容原样保存便于阅读和修改，其他 UTF-8 文本保存为字符串，
+// 二进制内容保存为 Base64。
+type Body struct {
+	JSON   json.RawMessage `json:"json,omitempty"`
+	Text   string          `json:"text,omitempty"`
+	Base64 string          `json:"base64,omitempty"`
+}
+
+// newBody 按内容选择消息体的保存形式。
+func newBody(data []byte) Body {
+	switch {
+	case len(data) == 0:
+		return Body{}
+	case json.Valid(data):
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, data); err == nil {
+			return Body{JSON: compact.Bytes()}
+		}
+		return Body{JSON: data}
+	case utf8.Valid(data):
+		return Body{Text: string(data)}
+	default:
+		return Body{Base64: base64.StdEncoding.EncodeToString(data)}
+	}
+}
+
+// Bytes 返回消息体内容。
+func (b Body) Bytes() []byte {
+	switch {
+	case len(b.JSON) > 0:
+		return b.JSON
+	case b.Text != "":
+		return []byte(b.Text)
+	case b.Base64 != "":
+		data, _ := base64.StdEncoding.DecodeString(b.Base64)
+		return data
+	default:
+		return nil
+	}
+}
+
+// IsZero 报告消息体是否为空（用于 omitzero）。
+func (b Body) IsZero() bool {
+	return len(b.JSON) == 0 && b.Text == "" && b.Base64 == ""
+}
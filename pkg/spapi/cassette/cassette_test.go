@@ -0,0 +1,363 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package cassette_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/cassette"
+	orders "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/orders-v0"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/spapitest"
+)
+
+// newServer 启动返回包含 PII 的订单的模拟服务器。
+func newServer(t *testing.T) *spapitest.Server {
+	t.Helper()
+
+	srv := spapitest.NewServer()
+	t.Cleanup(srv.Close)
+
+	srv.Handle(http.MethodGet, "/orders/v0/orders/{orderId}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("x-amzn-RateLimit-Limit", "0.5")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"payload": map[string]interface{}{
+				"AmazonOrderId": r.PathValue("orderId"),
+				"OrderStatus":   "Shipped",
+				"BuyerInfo": map[string]interface{}{
+					"BuyerEmail": "jane@marketplace.amazon.com",
+					"BuyerName":  "Jane Doe",
+				},
+				"ShippingAddress": map[string]interface{}{
+					"Name":         "Jane Doe",
+					"AddressLine1": "410 Terry Ave N",
+					"City":         "Seattle",
+				},
+				"NumberOfItemsShipped": 2,
+			},
+		})
+	})
+	srv.Handle(http.MethodGet, "/orders/v0/orders", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"payload": map[string]interface{}{
+				"Orders": []interface{}{map[string]interface{}{"AmazonOrderId": "1", "MarketplaceId": r.URL.Query().Get("MarketplaceIds")}},
+			},
+		})
+	})
+	return srv
+}
+
+// newClient 创建使用 cassette 的订单客户端。
+func newClient(t *testing.T, srv *spapitest.Server, c *cassette.Cassette) *orders.Client {
+	t.Helper()
+
+	client, err := srv.NewClient(spapi.WithMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.HTTPClient().Use(c.Middleware())
+	return orders.NewClient(client)
+}
+
+// record 录制一次 GetOrder 和一次 GetOrders，返回 cassette 路径。
+func record(t *testing.T) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "testdata", "orders.json")
+	c, err := cassette.New(path, cassette.ModeRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	api := newClient(t, newServer(t), c)
+	ctx := context.Background()
+	if _, err := api.GetOrder(ctx, "902-3159896-1390916", nil); err != nil {
+		t.Fatalf("GetOrder() error = %v", err)
+	}
+	if _, err := api.GetOrders(ctx, map[string]string{"MarketplaceIds": "ATVPDKIKX0DER,A2EUQ1WTGCTBG2"}); err != nil {
+		t.Fatalf("GetOrders() error = %v", err)
+	}
+	return path
+}
+
+func TestRecord_Redaction(t *testing.T) {
+	path := record(t)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := string(data)
+
+	for _, secret := range []string{"Atza|", "jane@marketplace.amazon.com", "Jane Doe", "410 Terry Ave N"} {
+		if strings.Contains(content, secret) {
+			t.Errorf("cassette contains %q", secret)
+		}
+	}
+
+	c, err := cassette.New(path, cassette.ModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	interactions := c.Interactions()
+	if len(interactions) != 2 {
+		t.Fatalf("len(Interactions()) = %d, want 2", len(interactions))
+	}
+
+	get := interactions[0]
+	if get.Request.Template != "/orders/v0/orders/{}" {
+		t.Errorf("Template = %q", get.Request.Template)
+	}
+	if got := get.Request.Header["X-Amz-Access-Token"]; len(got) != 1 || got[0] != cassette.Redacted {
+		t.Errorf("x-amz-access-token = %v, want redacted", got)
+	}
+
+	var body struct {
+		Payload struct {
+			AmazonOrderID        string            `json:"AmazonOrderId"`
+			BuyerInfo            map[string]string `json:"BuyerInfo"`
+			ShippingAddress      map[string]string `json:"ShippingAddress"`
+			NumberOfItemsShipped int               `json:"NumberOfItemsShipped"`
+		} `json:"payload"`
+	}
+	if err := json.Unmarshal(get.Response.Body.JSON, &body); err != nil {
+		t.Fatal(err)
+	}
+	if body.Payload.AmazonOrderID != "902-3159896-1390916" || body.Payload.NumberOfItemsShipped != 2 {
+		t.Errorf("non-PII fields changed: %+v", body.Payload)
+	}
+	if body.Payload.BuyerInfo["BuyerEmail"] != cassette.Redacted || body.Payload.ShippingAddress["City"] != cassette.Redacted {
+		t.Errorf("PII not redacted: %+v", body.Payload)
+	}
+	if get.Response.Header["X-Amzn-Ratelimit-Limit"][0] != "0.5" {
+		t.Errorf("response header = %v", get.Response.Header)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	path := record(t)
+
+	c, err := cassette.New(path, cassette.ModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 回放时服务器只提供 LWA 令牌
+	srv := spapitest.NewServer()
+	defer srv.Close()
+	api := newClient(t, srv, c)
+	ctx := context.Background()
+
+	// 查询参数的顺序不影响匹配
+	result, err := api.GetOrders(ctx, map[string]string{"MarketplaceIds": "A2EUQ1WTGCTBG2,ATVPDKIKX0DER"})
+	if err != nil {
+		t.Fatalf("GetOrders() error = %v", err)
+	}
+	if id := result.(map[string]interface{})["payload"].(map[string]interface{})["Orders"].([]interface{})[0].(map[string]interface{})["AmazonOrderId"]; id != "1" {
+		t.Errorf("AmazonOrderId = %v", id)
+	}
+
+	// 按路径模板匹配
+	result, err = api.GetOrder(ctx, "111-0000000-0000000", nil)
+	if err != nil {
+		t.Fatalf("GetOrder() error = %v", err)
+	}
+	if id := result.(map[string]interface{})["payload"].(map[string]interface{})["AmazonOrderId"]; id != "902-3159896-1390916" {
+		t.Errorf("AmazonOrderId = %v", id)
+	}
+
+	if requests := srv.Requests(); len(requests) != 0 {
+		t.Errorf("replay sent %d requests to the server", len(requests))
+	}
+
+	// 每个交互只回放一次
+	_, err = api.GetOrder(ctx, "902-3159896-1390916", nil)
+	if !errors.Is(err, cassette.ErrNoInteraction) || !strings.Contains(err.Error(), "already replayed") {
+		t.Errorf("GetOrder() error = %v, want exhausted ErrNoInteraction", err)
+	}
+}
+
+func TestReplay_Mismatch(t *testing.T) {
+	path := record(t)
+
+	c, err := cassette.New(path, cassette.ModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := spapitest.NewServer()
+	defer srv.Close()
+
+	_, err = newClient(t, srv, c).GetOrders(context.Background(), map[string]string{"MarketplaceIds": "A1F83G8C2ARO7P"})
+	if !errors.Is(err, cassette.ErrNoInteraction) {
+		t.Fatalf("GetOrders() error = %v, want ErrNoInteraction", err)
+	}
+	for _, want := range []string{
+		"GET /orders/v0/orders",
+		"closest: interaction 2",
+		"- query MarketplaceIds=A2EUQ1WTGCTBG2,ATVPDKIKX0DER",
+		"+ query MarketplaceIds=A1F83G8C2ARO7P",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error missing %q:\n%v", want, err)
+		}
+	}
+}
+
+func TestRecordIfMissing(t *testing.T) {
+	path := record(t)
+
+	c, err := cassette.New(path, cassette.ModeRecordIfMissing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := newServer(t)
+	api := newClient(t, srv, c)
+	ctx := context.Background()
+
+	if _, err := api.GetOrder(ctx, "902-3159896-1390916", nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := api.GetOrders(ctx, map[string]string{"MarketplaceIds": "A1F83G8C2ARO7P"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if requests := srv.Requests(); len(requests) != 1 || requests[0].Query.Get("MarketplaceIds") != "A1F83G8C2ARO7P" {
+		t.Errorf("server requests = %+v, want only the missing interaction", requests)
+	}
+
+	reloaded, err := cassette.New(path, cassette.ModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(reloaded.Interactions()); n != 3 {
+		t.Errorf("len(Interactions()) = %d, want 3", n)
+	}
+}
+
+func TestNew_Errors(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "missing.json")
+	if _, err := cassette.New(missing, cassette.ModeReplay); err == nil {
+		t.Error("New(ModeReplay) on missing file expected error")
+	}
+	if _, err := cassette.New(missing, cassette.ModeRecordIfMissing); err != nil {
+		t.Errorf("New(ModeRecordIfMissing) on missing file error = %v", err)
+	}
+
+	if _, err := cassette.ParseMode("rewind"); err == nil {
+		t.Error("ParseMode() expected error")
+	}
+	if mode, err := cassette.ParseMode("record-if-missing"); err != nil || mode != cassette.ModeRecordIfMissing {
+		t.Errorf("ParseMode() = %v, %v", mode, err)
+	}
+}
+
+func TestMiddleware_FormRedaction(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lwa.json")
+	c, err := cassette.New(path, cassette.ModeRecord,
+		cassette.WithIgnoredQueryParams("CreatedAfter"),
+		cassette.WithPathTemplates("/listings/2021-08-01/items/{sellerId}/{sku}"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	next := func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body:       http.NoBody,
+		}, nil
+	}
+	handler := c.Middleware()(next)
+
+	form := url.Values{"grant_type": {"refresh_token"}, "refresh_token": {"Atzr|secret"}, "client_id": {"app"}}
+	req, _ := http.NewRequest(http.MethodPost, "https://example.com/listings/2021-08-01/items/A1SELLER/my-sku?CreatedAfter=2025-01-01", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if _, err := handler(context.Background(), req); err != nil {
+		t.Fatal(err)
+	}
+
+	recorded := c.Interactions()[0].Request
+	if recorded.Template != "/listings/2021-08-01/items/{sellerId}/{sku}" {
+		t.Errorf("Template = %q", recorded.Template)
+	}
+	if len(recorded.Query) != 0 {
+		t.Errorf("Query = %v, want ignored parameter dropped", recorded.Query)
+	}
+	got, _ := url.ParseQuery(recorded.Body.Text)
+	if got.Get("refresh_token") != cassette.Redacted || got.Get("client_id") != "app" {
+		t.Errorf("form body = %q", recorded.Body.Text)
+	}
+}
+
+func TestMiddleware_RestrictedDataToken(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tokens.json")
+	c, err := cassette.New(path, cassette.ModeRecord, cassette.WithRedaction("$..PurchaseOrderNumber"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	next := func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"restrictedDataToken":"Atz.sprdt|AYABeKvW","expiresIn":3600,"note":"refreshed with Atzr|IwEBIA"}`)),
+		}, nil
+	}
+	handler := c.Middleware()(next)
+
+	body := `{"restrictedResources":[{"method":"GET","path":"/orders/v0/orders/123","dataElements":["buyerInfo"]}],"PurchaseOrderNumber":"PO-1"}`
+	req, _ := http.NewRequest(http.MethodPost, "https://example.com/tokens/2021-03-01/restrictedDataToken", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if _, err := handler(context.Background(), req); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"Atz.sprdt|", "Atzr|", "PO-1"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("cassette contains %q:\n%s", secret, data)
+		}
+	}
+	if !strings.Contains(string(data), "/orders/v0/orders/123") {
+		t.Errorf("cassette lost non-PII request fields:\n%s", data)
+	}
+}
+
+func TestNew_InvalidRedaction(t *testing.T) {
+	if _, err := cassette.New(filepath.Join(t.TempDir(), "c.json"), cassette.ModeRecord, cassette.WithRedaction("payload")); err == nil {
+		t.Error("New() error = nil, want invalid rule error")
+	}
+}
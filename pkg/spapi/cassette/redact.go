@@ -0,0 +1,280 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package cassette
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/redact"
+)
+
+// DefaultRedactedHeaders 是默认脱敏的 HTTP 头，与客户端日志和追踪使用的规则相同。
+var DefaultRedactedHeaders = redact.DefaultHeaders
+
+// versionSegment 匹配 API 版本路径段（如 v0、2021-06-30）。
+var versionSegment = regexp.MustCompile(`^(v\d+|\d{4}-\d{2}-\d{2})$`)
+
+// settings 保存 cassette 配置。
+type settings struct {
+	// redactor 按内置规则和 rules 脱敏，在 New 中创建
+	redactor *redact.Redactor
+	rules    []string
+
+	// headers 和 redact 是在 redactor 之外额外脱敏的头和字段
+	headers map[string]struct{}
+	redact  func(field string) bool
+
+	ignored   map[string]struct{}
+	templates []string
+}
+
+// Option 定义 cassette 配置选项函数。
+type Option func(*settings)
+
+// defaultSettings 返回默认配置。
+func defaultSettings() settings {
+	return settings{
+		headers: make(map[string]struct{}),
+		ignored: make(map[string]struct{}),
+	}
+}
+
+// newRedactor 创建按内置规则和 rules 脱敏的 Redactor。
+func (s *settings) newRedactor() error {
+	if len(s.rules) == 0 {
+		s.redactor = redact.Default()
+		return nil
+	}
+	redactor, err := redact.New(s.rules...)
+	if err != nil {
+		return err
+	}
+	s.redactor = redactor
+	return nil
+}
+
+// WithRedaction 添加 JSON 路径脱敏规则，语法与 spapi.WithRedaction 相同。
+//
+// 内置规则（买家信息、地址、邮箱、电话、LWA 令牌和 restrictedDataToken）
+// 始终生效；无效的规则使 New 返回错误。
+//
+// 示例:
+//
+//	cassette.New(path, cassette.ModeRecordIfMissing,
+//	    cassette.WithRedaction("$..PurchaseOrderNumber"))
+func WithRedaction(rules ...string) Option {
+	return func(s *settings) {
+		s.rules = append(s.rules, rules...)
+	}
+}
+
+// WithRedactedHeaders 添加需要脱敏的 HTTP 头（请求和响应）。
+func WithRedactedHeaders(names ...string) Option {
+	return func(s *settings) {
+		for _, name := range names {
+			s.headers[http.CanonicalHeaderKey(name)] = struct{}{}
+		}
+	}
+}
+
+// WithRedactedFields 设置额外判断 JSON 字段、表单字段和查询参数是否需要脱敏的函数。
+//
+// 内置规则和 WithRedaction 的规则始终生效，函数只能增加脱敏的字段：
+//
+//	cassette.WithRedactedFields(func(field string) bool {
+//	    return field == "SellerSKU"
+//	})
+func WithRedactedFields(redact func(field string) bool) Option {
+	return func(s *settings) {
+		s.redact = redact
+	}
+}
+
+// WithIgnoredQueryParams 设置匹配时忽略的查询参数（如每次运行都不同的时间戳）。
+//
+// 被忽略的参数不会被录制。
+func WithIgnoredQueryParams(names ...string) Option {
+	return func(s *settings) {
+		for _, name := range names {
+			s.ignored[name] = struct{}{}
+		}
+	}
+}
+
+// WithPathTemplates 设置用于匹配的路径模板（如 "/listings/2021-08-01/items/{sellerId}/{sku}"）。
+//
+// 请求路径优先按这些模板归一化；未匹配任何模板时，包含数字的路径段
+// （版本段除外）视为路径参数。
+func WithPathTemplates(templates ...string) Option {
+	return func(s *settings) {
+		s.templates = append(s.templates, templates...)
+	}
+}
+
+// DefaultRedactField 报告字段名是否匹配内置脱敏规则（不区分大小写）。
+//
+// 保留用于兼容；cassette 已按内置规则脱敏，不需要再传给 WithRedactedFields。
+func DefaultRedactField(field string) bool {
+	return redact.Default().Field(field)
+}
+
+// template 返回请求路径的模板。
+func (s *settings) template(path string) string {
+	for _, template := range s.templates {
+		if matchTemplate(template, path) {
+			return template
+		}
+	}
+
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if segment != "" && !versionSegment.MatchString(segment) && strings.ContainsAny(segment, "0123456789") {
+			segments[i] = "{}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// matchTemplate 检查路径是否匹配模板。
+func matchTemplate(template, path string) bool {
+	want := strings.Split(strings.Trim(template, "/"), "/")
+	got := strings.Split(strings.Trim(path, "/"), "/")
+	if len(want) != len(got) {
+		return false
+	}
+	for i, segment := range want {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			if got[i] == "" {
+				return false
+			}
+			continue
+		}
+		if segment != got[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// redactHeader 返回脱敏后的 HTTP 头副本。
+func (s *settings) redactHeader(header http.Header) map[string][]string {
+	if len(header) == 0 {
+		return nil
+	}
+
+	redacted := s.redactor.Header(header)
+	for key := range redacted {
+		if _, ok := s.headers[http.CanonicalHeaderKey(key)]; ok {
+			redacted[key] = []string{Redacted}
+		}
+	}
+	return redacted
+}
+
+// redactQuery 返回去掉忽略参数并脱敏后的查询参数。
+func (s *settings) redactQuery(query url.Values) map[string][]string {
+	if len(query) == 0 {
+		return nil
+	}
+
+	redacted := make(map[string][]string, len(query))
+	for key, values := range s.redactor.Values(query) {
+		if _, ok := s.ignored[key]; ok {
+			continue
+		}
+		if s.redact != nil && s.redact(key) {
+			redacted[key] = []string{Redacted}
+			continue
+		}
+		redacted[key] = values
+	}
+	if len(redacted) == 0 {
+		return nil
+	}
+	return redacted
+}
+
+// redactBody 按 redactor 脱敏 JSON、表单或文本消息体，再按 WithRedactedFields
+// 的字段脱敏；二进制内容原样返回。
+func (s *settings) redactBody(body []byte, contentType string) []byte {
+	if len(body) == 0 || !utf8.Valid(body) {
+		return body
+	}
+
+	body = s.redactor.Body(body, contentType)
+	if s.redact == nil {
+		return body
+	}
+
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return body
+		}
+		for key := range form {
+			if s.redact(key) {
+				form[key] = []string{Redacted}
+			}
+		}
+		return []byte(form.Encode())
+	}
+
+	var value interface{}
+	decoder := json.NewDecoder(strings.NewReader(string(body)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return body
+	}
+
+	redacted, err := json.Marshal(s.redactValue(value, false))
+	if err != nil {
+		return body
+	}
+	return redacted
+}
+
+// redactValue 递归脱敏 JSON 值。all 为 true 时脱敏所有字符串。
+func (s *settings) redactValue(value interface{}, all bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			v[key] = s.redactValue(child, all || s.redact(key))
+		}
+		return v
+	case []interface{}:
+		for i, child := range v {
+			v[i] = s.redactValue(child, all)
+		}
+		return v
+	case string:
+		if all {
+			return Redacted
+		}
+		return v
+	default:
+		return v
+	}
+}
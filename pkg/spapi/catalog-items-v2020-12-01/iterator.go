@@ -17,24 +17,6 @@
 // Unless you have obtained a commercial license, this file is licensed
 // under AGPL-3.0. By using this software, you agree to comply with the
 // terms of the applicable license.
-//
-// This file is part of Amazon SP-API Go SDK.
-//
-// Amazon SP-API Go SDK is dual-licensed:
-//
-// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
-//    - Free for personal, educational, and open source projects
-//    - Your project must also be open sourced under AGPL-3.0
-//    - See: https://www.gnu.org/licenses/agpl-3.0.html
-//
-// 2. Commercial License for proprietary/commercial use
-//    - Required for any commercial, enterprise, or proprietary use
-//    - Allows closed source distribution
-//    - Contact: vanling1111@gmail.com
-//
-// Unless you have obtained a commercial license, this file is licensed
-// under AGPL-3.0. By using this software, you agree to comply with the
-// terms of the applicable license.
 
 package catalog_items_v2020_12_01
 
@@ -46,11 +28,15 @@ import (
 	"github.com/pkg/errors"
 )
 
-// IterateCatalogItems 杩斿洖杩唬鍣紝鑷姩澶勭悊鍒嗛〉銆?
+// IterateCatalogItems 返回目录商品迭代器，自动处理分页。
+//
+// 使用 Go 1.25 迭代器特性，自动处理 nextToken 分页逻辑。
 //
-// 浣跨敤 Go 1.25 杩唬鍣ㄧ壒鎬э紝鑷姩澶勭悊 nextToken 鍒嗛〉閫昏緫銆?
+// 参数:
+//   - ctx: 请求上下文
+//   - query: 查询参数
 //
-// 绀轰緥:
+// 示例:
 //
 //	for item, err := range client.IterateCatalogItems(ctx, query) {
 //	    if err != nil { return err }
@@ -58,6 +44,14 @@ import (
 //	}
 func (c *Client) IterateCatalogItems(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
 	return func(yield func(map[string]interface{}, error) bool) {
+		// 登记到客户端，Close 等待迭代结束
+		ctx, release, err := c.baseClient.Acquire(ctx)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		defer release()
+
 		currentQuery := make(map[string]string)
 		for k, v := range query {
 			currentQuery[k] = v
@@ -82,13 +76,13 @@ func (c *Client) IterateCatalogItems(ctx context.Context, query map[string]strin
 				return
 			}
 
-			// 鑾峰彇鏁版嵁鏁扮粍
+			// 获取 items 数组
 			items, ok := response["items"].([]interface{})
 			if !ok || items == nil {
 				break
 			}
 
-			// 閬嶅巻褰撳墠椤?
+			// 遍历当前页
 			for _, item := range items {
 				itemMap, ok := item.(map[string]interface{})
 				if !ok {
@@ -99,7 +93,7 @@ func (c *Client) IterateCatalogItems(ctx context.Context, query map[string]strin
 				}
 			}
 
-			// 妫€鏌ヤ笅涓€椤?
+			// 检查下一页
 			nextToken, _ := response["nextToken"].(string)
 			if nextToken == "" {
 				break
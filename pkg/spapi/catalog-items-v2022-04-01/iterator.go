@@ -81,6 +81,14 @@ type SearchResponse struct {
 //	}
 func (c *Client) IterateCatalogItems(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
 	return func(yield func(map[string]interface{}, error) bool) {
+		// 登记到客户端，Close 等待迭代结束
+		ctx, release, err := c.baseClient.Acquire(ctx)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		defer release()
+
 		currentQuery := make(map[string]string)
 		for k, v := range query {
 			currentQuery[k] = v
@@ -27,11 +27,17 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"reflect"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/auth"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/circuit"
 	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/core"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/metrics"
 	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/ratelimit"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/redact"
 	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/signer"
 	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/transport"
 )
@@ -61,6 +67,27 @@ type Client struct {
 
 	// facade 是核心门面，封装所有内部组件
 	facade *core.Facade
+
+	// metrics 合并了 Config.MetricsRecorder 和 Config.Metrics
+	metrics metrics.Recorder
+
+	// redactor 脱敏日志、span 属性和调试输出
+	redactor *redact.Redactor
+
+	// latency 按操作记录延迟，用于请求对冲
+	latency *latencyTracker
+
+	// pages 记录下一页令牌对应的页码，用于分页事件
+	pages pageTracker
+
+	// lifecycle 跟踪进行中的请求和后台任务，用于 Close
+	lifecycle *lifecycle
+
+	// breaker 是熔断器（未启用时为 nil）
+	breaker *circuit.Breaker
+
+	// credentials 记录当前凭证，用于密钥轮换
+	credentials *credentialState
 }
 
 // NewClient 创建新的 SP-API 客户端。
@@ -88,6 +115,13 @@ type Client struct {
 //	    }),
 //	)
 //
+//	// 同时调用卖家授权操作和 Grantless 操作（按操作自动选择令牌）
+//	client, err := spapi.NewClient(
+//	    spapi.WithRegion(spapi.RegionNA),
+//	    spapi.WithCredentials("client-id", "client-secret", "refresh-token"),
+//	    spapi.WithGrantlessScopes("sellingpartnerapi::notifications"),
+//	)
+//
 //	// 自定义配置
 //	client, err := spapi.NewClient(
 //	    spapi.WithRegion(spapi.RegionNA),
@@ -119,42 +153,78 @@ func NewClient(opts ...ClientOption) (*Client, error) {
 		config.Tracer = NewNoOpTracer()
 	}
 
+	// 2.6. 从 CredentialsProvider 加载凭证（覆盖 ClientSecret 和 RefreshToken）
+	var provided Credentials
+	if config.CredentialsProvider != nil {
+		creds, err := loadCredentials(config)
+		if err != nil {
+			return nil, err
+		}
+		provided = creds
+	}
+
 	// 3. 验证配置
 	if err := config.Validate(); err != nil {
 		return nil, fmt.Errorf("invalid configuration: %w", err)
 	}
 
+	// Close 时刷新的日志器、指标收集器和追踪器（脱敏包装之前）
+	lifecycle := newLifecycle(config)
+
+	// 日志和追踪输出先经过脱敏（Amazon DPP 禁止记录 PII）
+	redactor, err := redact.New(config.Redaction...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+	applyRedaction(config, redactor)
+
+	recorder := newMetricsRecorder(config)
+
 	// 4. 创建 LWA 认证客户端
-	var lwaCredentials *auth.Credentials
-	var err error
+	// 同时设置刷新令牌和 Scopes 时创建两个客户端，共享令牌缓存，
+	// 两种授权方式使用不同的缓存键
+	tokenCache := auth.NewMemoryCache()
+	var lwaClient, grantlessLWAClient *auth.Client
 
 	if len(config.Scopes) > 0 {
 		// Grantless 操作
-		lwaCredentials, err = auth.NewGrantlessCredentials(
+		lwaCredentials, err := auth.NewGrantlessCredentials(
 			config.ClientID,
 			config.ClientSecret,
 			config.Scopes,
 			config.Region.LWAEndpoint,
 		)
-	} else {
+		if err != nil {
+			return nil, fmt.Errorf("create LWA credentials: %w", err)
+		}
+		grantlessLWAClient = auth.NewClient(lwaCredentials)
+		grantlessLWAClient.SetCache(tokenCache)
+		grantlessLWAClient.SetMetrics(recorder)
+		grantlessLWAClient.SetRefreshHook(tokenRefreshHook(config.Logger))
+	}
+
+	if config.RefreshToken != "" {
 		// Regular 操作
-		lwaCredentials, err = auth.NewCredentials(
+		lwaCredentials, err := auth.NewCredentials(
 			config.ClientID,
 			config.ClientSecret,
 			config.RefreshToken,
 			config.Region.LWAEndpoint,
 		)
+		if err != nil {
+			return nil, fmt.Errorf("create LWA credentials: %w", err)
+		}
+		lwaClient = auth.NewClient(lwaCredentials)
+		lwaClient.SetCache(tokenCache)
+		lwaClient.SetMetrics(recorder)
+		lwaClient.SetRefreshHook(tokenRefreshHook(config.Logger))
+	} else {
+		lwaClient = grantlessLWAClient
 	}
 
-	if err != nil {
-		return nil, fmt.Errorf("create LWA credentials: %w", err)
-	}
-
-	lwaClient := auth.NewClient(lwaCredentials)
-
 	// 5. 创建 HTTP 传输客户端
 	transportConfig := &transport.Config{
-		Timeout:             config.HTTPTimeout,
+		Timeout:             maxOperationTimeout(config),
 		MaxIdleConns:        200, // 生产级连接池配置
 		MaxIdleConnsPerHost: 20,
 		MaxConnsPerHost:     50,
@@ -163,17 +233,21 @@ func NewClient(opts ...ClientOption) (*Client, error) {
 		Debug:               config.Debug,
 	}
 
+	// 请求指标由 DoRequestValues 按操作和市场记录，不在传输层重复记录
 	httpClient := transport.NewClient(config.Region.Endpoint, transportConfig)
 
-	// 6. 设置 Metrics 记录器（如果提供）
-	httpClient.SetMetrics(config.MetricsRecorder)
-
-	// 7. 添加标准中间件
+	// 6. 添加标准中间件
 	httpClient.Use(transport.UserAgentMiddleware(transportConfig.UserAgent))
 	httpClient.Use(transport.DateMiddleware()) // 添加 x-amz-date 头部（官方要求）
 	httpClient.Use(transport.RequestIDMiddleware())
 
-	// 8. 添加重试中间件（官方建议的 back-off strategy）
+	// 熔断器在重试外侧，每次 API 调用只记录一次结果
+	breaker := newBreaker(config.CircuitBreaker, config.Logger)
+	if breaker != nil {
+		httpClient.Use(breakerMiddleware(breaker))
+	}
+
+	// 7. 添加重试中间件（官方建议的 back-off strategy）
 	if config.MaxRetries > 0 {
 		retryConfig := &transport.RetryConfig{
 			MaxRetries:      config.MaxRetries,
@@ -185,22 +259,72 @@ func NewClient(opts ...ClientOption) (*Client, error) {
 		httpClient.Use(transport.RetryMiddleware(retryConfig))
 	}
 
-	// 9. 创建签名器（LWA 签名器）
+	// 8. 创建签名器（LWA 签名器）
 	lwaSigner := signer.NewLWASigner(lwaClient)
 
-	// 10. 创建速率限制管理器
+	// 9. 创建速率限制管理器
 	// 官方文档建议：读取 x-amzn-RateLimit-Limit 头部，不要硬编码
-	rateLimitManager := ratelimit.NewManager(
+	// 设置 RateLimitStore 时，多个进程共享同一组令牌桶
+	managerOpts := []ratelimit.ManagerOption{
 		ratelimit.WithDefaultRate(1.0, 5), // 保守的默认值
-	)
+	}
+	if config.RateLimitStore != nil {
+		managerOpts = append(managerOpts, ratelimit.WithStore(config.RateLimitStore))
+	}
+	// 排队的请求按 WithPriority 的优先级获得令牌
+	managerOpts = append(managerOpts, ratelimit.WithReservedShare(config.PriorityReserve))
+	// 根据 429 响应在上限以下自适应调整速率
+	managerOpts = append(managerOpts, adaptiveOption(config))
+	if config.PriorityAging != 0 {
+		managerOpts = append(managerOpts, ratelimit.WithAging(max(config.PriorityAging, 0)))
+	}
+	rateLimitManager := ratelimit.NewManager(managerOpts...)
+	rateLimitManager.SetMetrics(recorder)
 
-	// 11. 创建核心门面，封装所有内部组件
+	// 10. 创建核心门面，封装所有内部组件
 	facade := core.NewFacade(lwaClient, httpClient, lwaSigner, rateLimitManager)
+	if grantlessLWAClient != nil && grantlessLWAClient != lwaClient {
+		facade.SetGrantless(grantlessLWAClient, signer.NewLWASigner(grantlessLWAClient))
+	}
 
-	// 12. 构建客户端
+	// 11. 构建客户端
 	client := &Client{
-		config: config,
-		facade: facade,
+		config:    config,
+		facade:    facade,
+		metrics:   recorder,
+		redactor:  redactor,
+		latency:   newLatencyTracker(),
+		lifecycle: lifecycle,
+		breaker:   breaker,
+		credentials: &credentialState{
+			current:  Credentials{ClientSecret: config.ClientSecret, RefreshToken: config.RefreshToken},
+			provided: provided,
+		},
+	}
+
+	// 12. 添加重试中间件内侧的中间件，每次尝试都会经过
+	if config.Hedging != nil {
+		httpClient.Use(client.hedgeMiddleware())
+	}
+	if len(config.OperationTimeouts) > 0 {
+		httpClient.Use(client.timeoutMiddleware())
+	}
+	// 为每次尝试编号，输出重试和对冲事件
+	httpClient.Use(client.attemptMiddleware())
+	// 调试输出记录每次实际发送的请求（包括对冲请求）
+	if config.Debug {
+		httpClient.Use(debugMiddleware(config.Logger, redactor))
+	}
+	// 每次实际响应（包括被重试的 429）都反馈给速率限制器
+	httpClient.Use(client.rateLimitFeedbackMiddleware())
+
+	// 13. 定期重新加载凭证，Close 时停止
+	if config.CredentialsProvider != nil && config.CredentialsReloadInterval >= 0 {
+		interval := config.CredentialsReloadInterval
+		if interval == 0 {
+			interval = defaultCredentialsReloadInterval
+		}
+		client.background(func(ctx context.Context) { client.watchCredentials(ctx, interval) })
 	}
 
 	return client, nil
@@ -208,29 +332,21 @@ func NewClient(opts ...ClientOption) (*Client, error) {
 
 // Config 返回客户端的配置副本。
 //
+// ClientSecret 和 RefreshToken 是当前使用的凭证，包含 RotateCredentials、
+// HandleSecretRotation 和 CredentialsProvider 带来的轮换。
+//
 // 返回值:
 //   - *Config: 配置副本
 func (c *Client) Config() *Config {
 	// 返回副本以防止外部修改
 	configCopy := *c.config
-	return &configCopy
-}
 
-// Close 关闭客户端并释放资源。
-//
-// 注意：调用 Close 后，客户端将不可用。
-//
-// 示例:
-//
-//	client, err := spapi.NewClient(...)
-//	if err != nil {
-//	    log.Fatal(err)
-//	}
-//	defer client.Close()
-func (c *Client) Close() error {
-	// 目前无需特殊清理
-	// 未来如果添加后台任务，在此处停止
-	return nil
+	s := c.credentials
+	s.mu.Lock()
+	configCopy.ClientSecret = s.current.ClientSecret
+	configCopy.RefreshToken = s.current.RefreshToken
+	s.mu.Unlock()
+	return &configCopy
 }
 
 // GetAccessToken 获取当前的 LWA 访问令牌。
@@ -311,11 +427,14 @@ func (c *Client) Signer() signer.Signer {
 // DoRequest 执行一个通用的 HTTP 请求。
 //
 // 此方法是所有 API 请求的基础，提供：
+//   - 关闭检查（Close 之后返回 ErrClientClosed）
+//   - 请求体校验（启用 WithRequestValidation 时）
+//   - 速率限制等待（启用 WithRateLimitWait 时）
 //   - 自动 LWA 认证
-//   - 速率限制检查
 //   - 请求签名
 //   - 错误处理
 //   - 响应解析
+//   - 指标记录（Config.Metrics）和分布式追踪（Config.Tracer）
 //
 // 参数:
 //   - ctx: 请求上下文
@@ -336,26 +455,106 @@ func (c *Client) Signer() signer.Signer {
 //	    "CreatedAfter": "2023-01-01T00:00:00Z",
 //	}, nil, &response)
 func (c *Client) DoRequest(ctx context.Context, method, path string, query map[string]string, body, result interface{}) error {
-	// 1. 获取access token
-	accessToken, err := c.facade.GetLWAClient().GetAccessToken(ctx)
+	var values url.Values
+	if len(query) > 0 {
+		values = make(url.Values, len(query))
+		for key, value := range query {
+			values.Set(key, value)
+		}
+	}
+	return c.DoRequestValues(ctx, method, path, values, body, result)
+}
+
+// DoRequestValues 与 DoRequest 相同，但使用 url.Values 作为查询参数。
+//
+// url.Values 可以表达重复的参数名（collectionFormat 为 multi 的数组参数），
+// 通常与 EncodeQuery 配合使用。
+//
+// 参数:
+//   - ctx: 请求上下文
+//   - method: HTTP 方法
+//   - path: API 路径
+//   - query: 查询参数（可选，传 nil 表示无查询参数）
+//   - body: 请求体（可选）
+//   - result: 响应结果的指针（可选）
+//
+// 返回值:
+//   - error: 如果请求失败，返回错误
+//
+// 示例:
+//
+//	query := url.Values{"MarketplaceIds": {"ATVPDKIKX0DER"}}
+//	query.Add("identifiers", "B000000001")
+//	query.Add("identifiers", "B000000002")
+//	err := client.DoRequestValues(ctx, "GET", "/catalog/2022-04-01/items", query, nil, &response)
+func (c *Client) DoRequestValues(ctx context.Context, method, path string, query url.Values, body, result interface{}) (err error) {
+	// 客户端关闭后不再接受新请求，Close 等待进行中的请求结束
+	release, err := c.lifecycle.enter(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %s %s", err, method, path)
+	}
+	defer release()
+
+	// 0. 校验请求体（不占用令牌和速率限制）
+	if c.config.RequestValidation {
+		if err := validateBody(body); err != nil {
+			return err
+		}
+	}
+
+	operation := c.extractOperationName(method, path)
+	marketplace := c.extractMarketplaceID(query)
+
+	ctx, span := c.startSpan(ctx, method, operation, marketplace)
+	ctx, attempts := withAttempts(ctx)
+	c.config.Logger.Debug(EventRequestStarted, requestFields(method, operation, marketplace)...)
+	var (
+		req       *http.Request
+		resp      *http.Response
+		errorType string
+		start     = time.Now()
+	)
+	defer func() {
+		duration := time.Since(start)
+		c.endRequest(span, method, operation, marketplace, req, resp, errorType, duration, err)
+		c.logRequest(method, operation, marketplace, resp, attempts, duration, errorType, err)
+	}()
+
+	// 1. 等待速率限制（启用 WithRateLimitWait 时）
+	if err := c.waitRateLimit(ctx, operation, marketplace); err != nil {
+		errorType = errorTypeRateLimitWait
+		return fmt.Errorf("failed to wait for rate limit: %w", err)
+	}
+
+	// 2. 获取access token（Grantless 操作使用 Grantless 凭据）
+	lwaClient, requestSigner := c.facade.GetLWAClient(), c.facade.GetSigner()
+	if grantless := c.facade.GetGrantlessLWAClient(); grantless != nil && isGrantlessOperation(method, path) {
+		lwaClient, requestSigner = grantless, c.facade.GetGrantlessSigner()
+	}
+	accessToken, err := lwaClient.GetAccessToken(ctx)
 	if err != nil {
+		errorType = errorTypeAuth
 		return fmt.Errorf("failed to get access token: %w", err)
 	}
 
-	// 2. 构建请求
-	req, err := c.buildRequest(ctx, method, path, query, body, accessToken)
+	// 3. 构建请求
+	req, err = c.buildRequest(ctx, method, path, query, body, accessToken)
 	if err != nil {
+		errorType = errorTypeEncode
 		return fmt.Errorf("failed to build request: %w", err)
 	}
+	c.injectTraceContext(ctx, req)
 
-	// 3. 签名请求
-	if err := c.facade.GetSigner().Sign(ctx, req); err != nil {
+	// 4. 签名请求
+	if err := requestSigner.Sign(ctx, req); err != nil {
+		errorType = errorTypeAuth
 		return fmt.Errorf("failed to sign request: %w", err)
 	}
 
-	// 4. 发送请求
-	resp, err := c.facade.GetHTTPClient().Do(ctx, req)
+	// 5. 发送请求
+	resp, err = c.facade.GetHTTPClient().Do(ctx, req)
 	if err != nil {
+		errorType = errorTypeNetwork
 		return fmt.Errorf("failed to send request: %w", err)
 	}
 	defer func() {
@@ -364,34 +563,33 @@ func (c *Client) DoRequest(ctx context.Context, method, path string, query map[s
 		}
 	}()
 
-	// 5. 处理响应
-	if err := c.handleResponse(resp, result); err != nil {
-		return err
-	}
+	// 6. 处理响应
+	// 速率限制已由 rateLimitFeedbackMiddleware 按每次实际响应更新
+	return c.handleResponse(req, resp, result)
+}
 
-	// 6. 更新速率限制（从响应头提取）
-	rateLimitManager := c.facade.GetRateLimitManager()
-	if rateLimitManager != nil {
-		sellerID := c.extractSellerID()
-		appID := c.config.ClientID
-		marketplace := c.extractMarketplaceID(query)
-		operation := c.extractOperationName(method, path)
-		c.updateRateLimitFromResponse(resp, sellerID, appID, marketplace, operation)
+// validateBody 调用请求体的 Validate 方法（如果有）。
+func validateBody(body interface{}) error {
+	v, ok := body.(interface{ Validate() error })
+	if !ok {
+		return nil
+	}
+	// 值接收者的 Validate 不能在 nil 指针上调用
+	if rv := reflect.ValueOf(body); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return nil
+	}
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidRequest, err)
 	}
-
 	return nil
 }
 
 // buildRequest 构建 HTTP 请求。
-func (c *Client) buildRequest(ctx context.Context, method, path string, query map[string]string, body interface{}, accessToken string) (*http.Request, error) {
+func (c *Client) buildRequest(ctx context.Context, method, path string, query url.Values, body interface{}, accessToken string) (*http.Request, error) {
 	// 构建完整 URL
 	fullURL := c.config.Region.Endpoint + path
 	if len(query) > 0 {
-		queryParams := url.Values{}
-		for key, value := range query {
-			queryParams.Add(key, value)
-		}
-		fullURL += "?" + queryParams.Encode()
+		fullURL += "?" + query.Encode()
 	}
 
 	// 编码请求体
@@ -419,7 +617,7 @@ func (c *Client) buildRequest(ctx context.Context, method, path string, query ma
 }
 
 // handleResponse 处理 HTTP 响应。
-func (c *Client) handleResponse(resp *http.Response, result interface{}) error {
+func (c *Client) handleResponse(req *http.Request, resp *http.Response, result interface{}) error {
 	// 读取响应体
 	bodyBytes, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -428,11 +626,15 @@ func (c *Client) handleResponse(resp *http.Response, result interface{}) error {
 
 	// 检查 HTTP 状态码
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return c.handleErrorResponse(resp.StatusCode, bodyBytes)
+		return c.handleErrorResponse(req, resp, bodyBytes)
 	}
 
-	// 如果 result 为 nil，不解析响应体
-	if result == nil {
+	if c.loggingEnabled() {
+		c.logPage(req, resp, bodyBytes)
+	}
+
+	// 如果 result 为 nil 或响应没有内容（如 204 No Content），不解析响应体
+	if result == nil || len(bytes.TrimSpace(bodyBytes)) == 0 {
 		return nil
 	}
 
@@ -445,35 +647,47 @@ func (c *Client) handleResponse(resp *http.Response, result interface{}) error {
 }
 
 // handleErrorResponse 处理错误响应。
-func (c *Client) handleErrorResponse(statusCode int, body []byte) error {
-	// 尝试解析为标准错误格式
-	var apiError struct {
-		Errors []struct {
-			Code    string `json:"code"`
-			Message string `json:"message"`
-			Details string `json:"details"`
-		} `json:"errors"`
-	}
-
-	if err := json.Unmarshal(body, &apiError); err == nil && len(apiError.Errors) > 0 {
-		// 返回第一个错误
-		return &APIError{
-			StatusCode: statusCode,
-			Code:       apiError.Errors[0].Code,
-			Message:    apiError.Errors[0].Message,
-			Details:    apiError.Errors[0].Details,
-		}
+func (c *Client) handleErrorResponse(req *http.Request, resp *http.Response, body []byte) error {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		RequestID:  resp.Header.Get("x-amzn-RequestId"),
+		Method:     req.Method,
+		Path:       req.URL.Path,
+		Operation:  c.extractOperationName(req.Method, req.URL.Path),
+		Header:     resp.Header,
+	}
+	if rate, err := strconv.ParseFloat(resp.Header.Get("x-amzn-RateLimit-Limit"), 64); err == nil {
+		apiErr.RateLimit = rate
 	}
 
-	// 如果无法解析为标准错误格式，返回通用错误
-	return &APIError{
-		StatusCode: statusCode,
-		Message:    string(body),
+	// 尝试解析为标准错误格式
+	var response struct {
+		Errors []ErrorDetail `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &response); err == nil && len(response.Errors) > 0 {
+		apiErr.Errors = response.Errors
+		apiErr.Code = response.Errors[0].Code
+		apiErr.Message = response.Errors[0].Message
+		apiErr.Details = response.Errors[0].Details
+		return apiErr
 	}
+
+	// 如果无法解析为标准错误格式，使用响应体作为消息
+	apiErr.Message = string(body)
+	return apiErr
 }
 
-// updateRateLimitFromResponse 从响应头更新速率限制。
+// updateRateLimitFromResponse 从响应更新速率限制。
+//
+// 先按状态码自适应调整速率（429 降低、持续成功后探测），
+// 再用 x-amzn-RateLimit-Limit 头部（如果有）更新速率上限。
 func (c *Client) updateRateLimitFromResponse(resp *http.Response, sellerID, appID, marketplace, operation string) {
+	manager := c.facade.GetRateLimitManager()
+	if manager == nil {
+		return
+	}
+	manager.Observe(sellerID, appID, marketplace, operation, resp.StatusCode)
+
 	// 提取 x-amzn-RateLimit-Limit header
 	rateLimitHeader := resp.Header.Get("x-amzn-RateLimit-Limit")
 	if rateLimitHeader == "" {
@@ -493,7 +707,7 @@ func (c *Client) updateRateLimitFromResponse(resp *http.Response, sellerID, appI
 	}
 
 	// 更新速率限制
-	if updateErr := c.facade.GetRateLimitManager().UpdateRate(sellerID, appID, marketplace, operation, rate, burst); updateErr != nil {
+	if updateErr := manager.UpdateRate(sellerID, appID, marketplace, operation, rate, burst); updateErr != nil {
 		// Log the error but don't fail the request
 	}
 }
@@ -525,7 +739,7 @@ func (c *Client) extractSellerID() string {
 //   - MarketplaceId (部分 API)
 //   - marketplace_ids (部分 API)
 //
-// 如果查询参数中包含多个 Marketplace ID（逗号分隔），
+// 如果查询参数中包含多个 Marketplace ID（逗号分隔或重复参数），
 // 则返回第一个。
 //
 // 参数:
@@ -533,34 +747,11 @@ func (c *Client) extractSellerID() string {
 //
 // 返回值:
 //   - string: Marketplace ID，如果未找到则返回 "global"
-func (c *Client) extractMarketplaceID(query map[string]string) string {
-	// 尝试标准参数名
-	if ids := query["MarketplaceIds"]; ids != "" {
-		// 如果包含多个 ID（逗号分隔），返回第一个
-		if idx := len(ids); idx > 0 {
-			for i := 0; i < len(ids); i++ {
-				if ids[i] == ',' {
-					return ids[:i]
-				}
-			}
-			return ids
-		}
-	}
-
-	// 尝试单数形式
-	if id := query["MarketplaceId"]; id != "" {
-		return id
-	}
-
-	// 尝试小写形式
-	if ids := query["marketplace_ids"]; ids != "" {
-		if idx := len(ids); idx > 0 {
-			for i := 0; i < len(ids); i++ {
-				if ids[i] == ',' {
-					return ids[:i]
-				}
-			}
-			return ids
+func (c *Client) extractMarketplaceID(query url.Values) string {
+	for _, key := range []string{"MarketplaceIds", "MarketplaceId", "marketplace_ids"} {
+		// 多个 ID 可能逗号分隔，也可能是重复的参数，都取第一个
+		if id, _, _ := strings.Cut(query.Get(key), ","); id != "" {
+			return id
 		}
 	}
 
@@ -570,13 +761,15 @@ func (c *Client) extractMarketplaceID(query map[string]string) string {
 
 // extractOperationName 从 HTTP 方法和路径提取操作名称。
 //
-// 操作名称用于速率限制的细粒度控制。
-// 格式：{API名称}:{操作名称}
+// 操作名称用于速率限制的细粒度控制，以及指标和 span 的 operation 标签。
+// 格式：{API包名}:{操作名称}
 //
 // 例如：
-//   - GET /orders/v0/orders -> orders:getOrders
-//   - GET /orders/v0/orders/{orderId} -> orders:getOrder
-//   - POST /feeds/2021-06-30/feeds -> feeds:createFeed
+//   - GET /orders/v0/orders -> orders-v0:getOrders
+//   - GET /orders/v0/orders/902-1845936-5435065 -> orders-v0:getOrder
+//   - POST /feeds/2021-06-30/feeds -> feeds-v2021-06-30:createFeed
+//
+// 不属于任何已知操作的路径回退为 {API名称}:{方法}:{资源路径}。
 //
 // 参数:
 //   - method: HTTP 方法
@@ -585,6 +778,10 @@ func (c *Client) extractMarketplaceID(query map[string]string) string {
 // 返回值:
 //   - string: 标准化的操作名称
 func (c *Client) extractOperationName(method, path string) string {
+	if name, ok := lookupOperation(method, path); ok {
+		return name
+	}
+
 	// 移除开头的 "/"
 	if path != "" && path[0] == '/' {
 		path = path[1:]
@@ -665,6 +862,28 @@ func (c *Client) Get(ctx context.Context, path string, query map[string]string,
 	return c.DoRequest(ctx, "GET", path, query, nil, result)
 }
 
+// GetValues 执行 GET 请求，使用 url.Values 作为查询参数。
+//
+// 参数:
+//   - ctx: 请求上下文
+//   - path: API 路径
+//   - query: 查询参数（可选）
+//   - result: 响应结果的指针
+//
+// 返回值:
+//   - error: 如果请求失败，返回错误
+//
+// 示例:
+//
+//	query, err := spapi.EncodeQuery(params)
+//	if err != nil {
+//	    return err
+//	}
+//	err = client.GetValues(ctx, "/orders/v0/orders", query, &response)
+func (c *Client) GetValues(ctx context.Context, path string, query url.Values, result interface{}) error {
+	return c.DoRequestValues(ctx, "GET", path, query, nil, result)
+}
+
 // Post 执行 POST 请求。
 //
 // 参数:
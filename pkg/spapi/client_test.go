@@ -21,10 +21,15 @@ package spapi_test
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"net/url"
 	"testing"
 	"time"
 
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/codec"
 	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/spapitest"
 )
 
 // TestNewClient 测试客户端创建。
@@ -111,7 +116,7 @@ func TestNewClient(t *testing.T) {
 				}
 
 				// 测试 Close
-				if err := client.Close(); err != nil {
+				if err := client.Close(context.Background()); err != nil {
 					t.Errorf("Close() error = %v", err)
 				}
 			}
@@ -129,7 +134,7 @@ func TestClient_Config(t *testing.T) {
 	if err != nil {
 		t.Fatalf("NewClient() error = %v", err)
 	}
-	defer client.Close()
+	defer client.Close(context.Background())
 
 	config := client.Config()
 	if config == nil {
@@ -162,7 +167,7 @@ func TestClient_GetAccessToken(t *testing.T) {
 	if err != nil {
 		t.Fatalf("NewClient() error = %v", err)
 	}
-	defer client.Close()
+	defer client.Close(context.Background())
 
 	ctx := context.Background()
 	token, err := client.GetAccessToken(ctx)
@@ -187,7 +192,7 @@ func TestClient_RateLimitManager(t *testing.T) {
 	if err != nil {
 		t.Fatalf("NewClient() error = %v", err)
 	}
-	defer client.Close()
+	defer client.Close(context.Background())
 
 	manager := client.RateLimitManager()
 	if manager == nil {
@@ -210,7 +215,7 @@ func TestClient_HTTPClient(t *testing.T) {
 	if err != nil {
 		t.Fatalf("NewClient() error = %v", err)
 	}
-	defer client.Close()
+	defer client.Close(context.Background())
 
 	httpClient := client.HTTPClient()
 	if httpClient == nil {
@@ -227,7 +232,7 @@ func TestClient_Signer(t *testing.T) {
 	if err != nil {
 		t.Fatalf("NewClient() error = %v", err)
 	}
-	defer client.Close()
+	defer client.Close(context.Background())
 
 	signer := client.Signer()
 	if signer == nil {
@@ -245,7 +250,7 @@ func TestClient_MultipleInstances(t *testing.T) {
 	if err != nil {
 		t.Fatalf("NewClient() 1 error = %v", err)
 	}
-	defer client1.Close()
+	defer client1.Close(context.Background())
 
 	client2, err := spapi.NewClient(
 		spapi.WithRegion(spapi.RegionEU),
@@ -254,7 +259,7 @@ func TestClient_MultipleInstances(t *testing.T) {
 	if err != nil {
 		t.Fatalf("NewClient() 2 error = %v", err)
 	}
-	defer client2.Close()
+	defer client2.Close(context.Background())
 
 	// 验证客户端独立性
 	config1 := client1.Config()
@@ -282,7 +287,7 @@ func BenchmarkNewClient(b *testing.B) {
 		if err != nil {
 			b.Fatalf("NewClient() error = %v", err)
 		}
-		client.Close()
+		client.Close(context.Background())
 	}
 }
 
@@ -298,7 +303,7 @@ func ExampleNewClient() {
 	if err != nil {
 		panic(err)
 	}
-	defer client.Close()
+	defer client.Close(context.Background())
 
 	// 使用客户端...
 	_ = client
@@ -318,7 +323,7 @@ func ExampleNewClient_grantless() {
 	if err != nil {
 		panic(err)
 	}
-	defer client.Close()
+	defer client.Close(context.Background())
 
 	// 使用客户端...
 	_ = client
@@ -333,7 +338,7 @@ func TestClient_DoRequest(t *testing.T) {
 	if err != nil {
 		t.Fatalf("NewClient() error = %v", err)
 	}
-	defer client.Close()
+	defer client.Close(context.Background())
 
 	ctx := context.Background()
 
@@ -397,7 +402,7 @@ func TestClient_RequestWithQueryParams(t *testing.T) {
 	if err != nil {
 		t.Fatalf("NewClient() error = %v", err)
 	}
-	defer client.Close()
+	defer client.Close(context.Background())
 
 	ctx := context.Background()
 	queryParams := map[string]string{
@@ -412,6 +417,35 @@ func TestClient_RequestWithQueryParams(t *testing.T) {
 	t.Logf("Request with query params: %v", err)
 }
 
+// TestClient_GetValues 测试重复的查询参数。
+func TestClient_GetValues(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+	srv.Handle(http.MethodGet, "/catalog/2022-04-01/items", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	})
+
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	query := url.Values{"marketplaceIds": {"ATVPDKIKX0DER"}}
+	query.Add("identifiers", "B01")
+	query.Add("identifiers", "B02")
+	if err := client.GetValues(context.Background(), "/catalog/2022-04-01/items", query, nil); err != nil {
+		t.Fatalf("GetValues() error = %v", err)
+	}
+
+	requests := srv.Requests()
+	if len(requests) != 1 {
+		t.Fatalf("requests = %d, want 1", len(requests))
+	}
+	if got := requests[0].Query["identifiers"]; len(got) != 2 || got[0] != "B01" || got[1] != "B02" {
+		t.Errorf("identifiers = %v, want repeated parameter", got)
+	}
+}
+
 // TestClient_ContextCancellation 测试上下文取消
 func TestClient_ContextCancellation(t *testing.T) {
 	client, err := spapi.NewClient(
@@ -421,7 +455,7 @@ func TestClient_ContextCancellation(t *testing.T) {
 	if err != nil {
 		t.Fatalf("NewClient() error = %v", err)
 	}
-	defer client.Close()
+	defer client.Close(context.Background())
 
 	// 创建已取消的上下文
 	ctx, cancel := context.WithCancel(context.Background())
@@ -455,7 +489,7 @@ func TestClient_AllRegions(t *testing.T) {
 				t.Errorf("NewClient() with region %s error = %v", region.Code, err)
 				return
 			}
-			defer client.Close()
+			defer client.Close(context.Background())
 
 			config := client.Config()
 			if config.Region.Code != region.Code {
@@ -464,3 +498,57 @@ func TestClient_AllRegions(t *testing.T) {
 		})
 	}
 }
+
+// widgetRequest 模拟带 Validate 方法的生成请求模型。
+type widgetRequest struct {
+	MarketplaceIds []string `json:"marketplaceIds"`
+}
+
+func (r widgetRequest) Validate() error {
+	v := codec.NewValidator()
+	v.MinItems("marketplaceIds", len(r.MarketplaceIds), 1)
+	return v.Error()
+}
+
+// TestClient_RequestValidation 测试发送前的请求体校验。
+func TestClient_RequestValidation(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+	srv.Handle(http.MethodPost, "/widgets", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	})
+	ctx := context.Background()
+
+	client, err := srv.NewClient(spapi.WithRequestValidation())
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	err = client.Post(ctx, "/widgets", widgetRequest{}, nil)
+	if !errors.Is(err, spapi.ErrInvalidRequest) {
+		t.Fatalf("Post() error = %v, want ErrInvalidRequest", err)
+	}
+	var fields codec.ValidationErrors
+	if !errors.As(err, &fields) || fields[0].Field != "marketplaceIds" {
+		t.Errorf("Post() error = %v, want marketplaceIds field error", err)
+	}
+	if got := len(srv.Requests()); got != 0 {
+		t.Errorf("requests = %d, want the invalid request not to be sent", got)
+	}
+
+	if err := client.Post(ctx, "/widgets", &widgetRequest{MarketplaceIds: []string{"ATVPDKIKX0DER"}}, nil); err != nil {
+		t.Errorf("Post() valid request error = %v", err)
+	}
+	if err := client.Post(ctx, "/widgets", (*widgetRequest)(nil), nil); err != nil {
+		t.Errorf("Post() nil request error = %v", err)
+	}
+
+	// 未启用时不校验
+	unchecked, err := srv.NewClient()
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if err := unchecked.Post(ctx, "/widgets", widgetRequest{}, nil); err != nil {
+		t.Errorf("Post() without validation error = %v", err)
+	}
+}
@@ -52,8 +52,19 @@ type Config struct {
 
 	// Scopes 是 Grantless 操作所需的权限范围。
 	// 如果为空，则使用 RefreshToken 进行 Regular 操作。
+	// 与 RefreshToken 同时设置时，客户端按操作自动选择令牌：
+	// Grantless 操作使用 Scopes，其余操作使用 RefreshToken。
 	Scopes []string `validate:"required_without=RefreshToken,dive,required"`
 
+	// CredentialsProvider 提供可以在运行时替换的客户端密钥和刷新令牌。
+	// 设置时 NewClient 先从它加载凭证（覆盖 ClientSecret 和 RefreshToken），
+	// 之后每隔 CredentialsReloadInterval 重新加载。
+	CredentialsProvider CredentialsProvider `validate:"-"`
+
+	// CredentialsReloadInterval 是重新加载 CredentialsProvider 的间隔。
+	// 为 0 时使用默认值 1 分钟；为负数时只在 NewClient 和 ReloadCredentials 时加载。
+	CredentialsReloadInterval time.Duration
+
 	// SellerID 是卖家 ID（可选）。
 	// 用于速率限制的多维度管理。如果未设置，将使用 ClientID。
 	SellerID string
@@ -61,6 +72,16 @@ type Config struct {
 	// HTTPTimeout 是 HTTP 请求超时时间。
 	HTTPTimeout time.Duration `validate:"min=1s,max=5m"`
 
+	// OperationTimeouts 按操作名称（如 "orders-v0:getOrder"）或 API 名称
+	// （如 "catalog-items-v2022-04-01"）覆盖 HTTPTimeout。操作名称优先。
+	OperationTimeouts map[string]time.Duration `validate:"dive,min=1ms,max=5m"`
+
+	// Hedging 启用幂等 GET 请求的对冲。如果为 nil，不对冲。
+	Hedging *HedgeConfig
+
+	// CircuitBreaker 启用熔断器。如果为 nil，不熔断。
+	CircuitBreaker *CircuitBreakerConfig
+
 	// MaxRetries 是请求失败时的最大重试次数。
 	MaxRetries int `validate:"min=0,max=10"`
 
@@ -68,6 +89,25 @@ type Config struct {
 	// 例如 0.1 表示保留 10% 的速率限制作为缓冲。
 	RateLimitBuffer float64 `validate:"min=0,max=1"`
 
+	// RateLimitStore 是可选的共享令牌桶存储。
+	// 如果为 nil，每个进程使用自己的进程内令牌桶。
+	RateLimitStore RateLimitStore `validate:"-"`
+
+	// PriorityReserve 是为 PriorityHigh 请求保留的突发配额比例（0.0-1.0）。
+	// 默认 0，不保留。
+	PriorityReserve float64 `validate:"min=0,max=1"`
+
+	// PriorityAging 是排队请求的老化间隔：每满一个间隔，有效优先级提高一级。
+	// 为 0 时使用默认值 10 秒；为负数时不老化。
+	PriorityAging time.Duration
+
+	// AdaptiveRateLimit 配置根据 429 响应自适应调整速率。
+	// 如果为 nil，使用 DefaultAdaptiveRateLimitConfig。
+	AdaptiveRateLimit *AdaptiveRateLimitConfig `validate:"-"`
+
+	// DisableAdaptiveRateLimit 关闭自适应调整，只使用响应头或默认速率。
+	DisableAdaptiveRateLimit bool
+
 	// Debug 启用调试模式（详细日志）。
 	Debug bool
 
@@ -90,6 +130,16 @@ type Config struct {
 	// Middlewares 是可选的中间件列表。
 	// 中间件按顺序执行，可用于日志、指标、追踪等。
 	Middlewares []Middleware `validate:"-"`
+
+	// Redaction 是内置规则之外的 JSON 路径脱敏规则（如 "$..PurchaseOrderNumber"）。
+	// 日志字段、span 属性和调试输出在离开 SDK 前都会脱敏。
+	Redaction []string `validate:"-"`
+
+	// RequestValidation 在发送请求前调用请求体的 Validate 方法。
+	RequestValidation bool
+
+	// RateLimitWait 在发送请求前等待操作的速率限制令牌。
+	RateLimitWait bool
 }
 
 // validate 全局验证器实例
@@ -227,6 +277,53 @@ func WithGrantlessCredentials(clientID, clientSecret string, scopes []string) Cl
 	}
 }
 
+// WithGrantlessScopes 为客户端添加 Grantless 权限范围。
+//
+// 与 WithCredentials 一起使用时，同一个客户端可以同时调用卖家授权操作和
+// Grantless 操作（如 Notifications API 的目标管理）：Grantless 操作使用
+// client_credentials 令牌，其余操作使用刷新令牌，两种令牌分别缓存。
+// Grantless 操作列表由代码生成器根据 cmd/generator/apis.json 生成。
+//
+// 参数:
+//   - scopes: 权限范围（如 "sellingpartnerapi::notifications"）
+//
+// 示例:
+//
+//	client, err := spapi.NewClient(
+//	    spapi.WithRegion(spapi.RegionNA),
+//	    spapi.WithCredentials("client-id", "client-secret", "refresh-token"),
+//	    spapi.WithGrantlessScopes("sellingpartnerapi::notifications"),
+//	)
+func WithGrantlessScopes(scopes ...string) ClientOption {
+	return func(c *Config) {
+		c.Scopes = append(c.Scopes, scopes...)
+	}
+}
+
+// WithCredentialsProvider 从 CredentialsProvider 加载客户端密钥和刷新令牌，
+// 并定期重新加载，密钥轮换时不需要重建客户端。
+//
+// 客户端 ID 仍由 WithCredentials 或 WithGrantlessCredentials 设置，
+// 提供者返回的空字段保留原值。
+//
+// 参数:
+//   - provider: 凭证提供者（EnvCredentials、FileCredentials 或 CredentialsFunc）
+//   - interval: 重新加载的间隔（0 使用默认值 1 分钟，负数不定期加载）
+//
+// 示例:
+//
+//	client, err := spapi.NewClient(
+//	    spapi.WithRegion(spapi.RegionNA),
+//	    spapi.WithCredentials(clientID, "", ""),
+//	    spapi.WithCredentialsProvider(spapi.FileCredentials("/etc/sp-api/credentials.json"), time.Minute),
+//	)
+func WithCredentialsProvider(provider CredentialsProvider, interval time.Duration) ClientOption {
+	return func(c *Config) {
+		c.CredentialsProvider = provider
+		c.CredentialsReloadInterval = interval
+	}
+}
+
 // WithSellerID 设置卖家 ID（可选）。
 //
 // Seller ID 用于速率限制的多维度管理。
@@ -262,6 +359,81 @@ func WithHTTPTimeout(timeout time.Duration) ClientOption {
 	}
 }
 
+// WithOperationTimeout 为操作设置超时时间，覆盖 HTTPTimeout。
+//
+// 与 HTTPTimeout 相同，超时按每次尝试计算（重试时重新计时），包括读取响应体的时间。
+//
+// 参数:
+//   - operation: 操作名称（如 "orders-v0:getOrder"）或 API 名称（如 "catalog-items-v2022-04-01"）
+//   - timeout: 超时时间（1ms-5m）
+//
+// 示例:
+//
+//	client := spapi.NewClient(
+//	    spapi.WithRegion(spapi.RegionNA),
+//	    spapi.WithCredentials(...),
+//	    spapi.WithOperationTimeout("orders-v0:getOrder", 2*time.Second),
+//	    spapi.WithOperationTimeout("catalog-items-v2022-04-01", 90*time.Second),
+//	)
+func WithOperationTimeout(operation string, timeout time.Duration) ClientOption {
+	return func(c *Config) {
+		if c.OperationTimeouts == nil {
+			c.OperationTimeouts = make(map[string]time.Duration)
+		}
+		c.OperationTimeouts[operation] = timeout
+	}
+}
+
+// WithHedging 启用幂等 GET 请求的对冲。
+//
+// 请求超过该操作近期延迟的分位数仍未返回时，再发送一次相同的请求，
+// 使用先返回的响应。对冲请求只使用速率限制器中的空闲令牌，
+// 请求只在启用 WithRateLimitWait 时消耗令牌。
+//
+// 参数:
+//   - config: 对冲配置（零值字段使用默认值）
+//
+// 示例:
+//
+//	client := spapi.NewClient(
+//	    spapi.WithRegion(spapi.RegionNA),
+//	    spapi.WithCredentials(...),
+//	    spapi.WithRateLimitWait(),
+//	    spapi.WithHedging(&spapi.HedgeConfig{
+//	        Percentile: 0.9,
+//	        Operations: []string{"catalog-items-v2022-04-01"},
+//	    }),
+//	)
+func WithHedging(config *HedgeConfig) ClientOption {
+	return func(c *Config) {
+		c.Hedging = config
+	}
+}
+
+// WithCircuitBreaker 启用熔断器。
+//
+// SP-API 持续返回 5xx 或网络不可达时，熔断器在 Timeout 内直接拒绝请求
+// （返回 ErrCircuitOpen），避免请求堆积。熔断器状态见 Client.Health。
+//
+// 参数:
+//   - config: 熔断器配置（零值字段使用默认值）
+//
+// 示例:
+//
+//	client := spapi.NewClient(
+//	    spapi.WithRegion(spapi.RegionNA),
+//	    spapi.WithCredentials(...),
+//	    spapi.WithCircuitBreaker(&spapi.CircuitBreakerConfig{
+//	        MaxFailures: 10,
+//	        Timeout:     30 * time.Second,
+//	    }),
+//	)
+func WithCircuitBreaker(config *CircuitBreakerConfig) ClientOption {
+	return func(c *Config) {
+		c.CircuitBreaker = config
+	}
+}
+
 // WithMaxRetries 设置最大重试次数。
 //
 // 参数:
@@ -290,8 +462,115 @@ func WithRateLimitBuffer(buffer float64) ClientOption {
 	}
 }
 
+// WithRateLimitStore 设置共享的速率限制令牌桶存储。
+//
+// 多个进程（如同一卖家和应用的多个 Pod）使用同一个存储时，
+// 从同一个桶中取令牌，合计不超过 SP-API 的配额。需要同时启用
+// WithRateLimitWait，否则请求不从桶中取令牌。
+//
+// 参数:
+//   - store: 令牌桶存储（见 NewRedisRateLimitStore、NewFileRateLimitStore）
+//
+// 示例:
+//
+//	store, err := spapi.NewFileRateLimitStore("/dev/shm/spapi-ratelimit")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	client, err := spapi.NewClient(
+//	    spapi.WithRegion(spapi.RegionNA),
+//	    spapi.WithCredentials(...),
+//	    spapi.WithRateLimitWait(),
+//	    spapi.WithRateLimitStore(store),
+//	)
+func WithRateLimitStore(store RateLimitStore) ClientOption {
+	return func(c *Config) {
+		c.RateLimitStore = store
+	}
+}
+
+// WithPriorityReserve 为高优先级请求保留一部分突发配额。
+//
+// PriorityLow 和 PriorityNormal 请求只在取出令牌后仍剩余 share*burst
+// 个令牌时才能发送，保留的令牌只供 PriorityHigh 请求（见 WithPriority）使用。
+// 只在启用 WithRateLimitWait 时生效。
+//
+// 参数:
+//   - share: 保留比例（0.0-1.0）
+//
+// 示例:
+//
+//	client := spapi.NewClient(
+//	    spapi.WithRegion(spapi.RegionNA),
+//	    spapi.WithCredentials(...),
+//	    spapi.WithPriorityReserve(0.2), // 20% 的突发配额留给交互式请求
+//	)
+func WithPriorityReserve(share float64) ClientOption {
+	return func(c *Config) {
+		c.PriorityReserve = share
+	}
+}
+
+// WithPriorityAging 设置排队请求的老化间隔。
+//
+// 请求每排队满一个间隔，有效优先级提高一级，使低优先级请求
+// 在持续的高优先级流量下也能被服务。
+//
+// 参数:
+//   - interval: 老化间隔（默认 10 秒；为负数时不老化）
+//
+// 示例:
+//
+//	client := spapi.NewClient(spapi.WithPriorityAging(30 * time.Second))
+func WithPriorityAging(interval time.Duration) ClientOption {
+	return func(c *Config) {
+		c.PriorityAging = interval
+	}
+}
+
+// WithAdaptiveRateLimit 设置根据 429 响应自适应调整速率的参数。
+//
+// 默认启用自适应调整（使用 DefaultAdaptiveRateLimitConfig），
+// 实际速率不会超过响应头或默认速率的上限。
+//
+// 参数:
+//   - config: 自适应配置（零值字段使用默认值）
+//
+// 示例:
+//
+//	client := spapi.NewClient(
+//	    spapi.WithRegion(spapi.RegionNA),
+//	    spapi.WithCredentials(...),
+//	    spapi.WithAdaptiveRateLimit(&spapi.AdaptiveRateLimitConfig{
+//	        Decrease:   0.25, // 收到 429 时速率减为 1/4
+//	        ProbeAfter: 20,   // 连续 20 次成功后向上探测
+//	    }),
+//	)
+func WithAdaptiveRateLimit(config *AdaptiveRateLimitConfig) ClientOption {
+	return func(c *Config) {
+		c.AdaptiveRateLimit = config
+		c.DisableAdaptiveRateLimit = false
+	}
+}
+
+// WithoutAdaptiveRateLimit 关闭自适应速率调整。
+//
+// 关闭后 429 不会降低速率，速率只来自 x-amzn-RateLimit-Limit 头部或默认值。
+//
+// 示例:
+//
+//	client := spapi.NewClient(spapi.WithoutAdaptiveRateLimit())
+func WithoutAdaptiveRateLimit() ClientOption {
+	return func(c *Config) {
+		c.DisableAdaptiveRateLimit = true
+	}
+}
+
 // WithDebug 启用调试模式。
 //
+// 调试模式通过 Logger.Debug 输出每次 HTTP 请求和响应的头部和消息体，
+// 输出内容按脱敏规则（见 WithRedaction）处理。
+//
 // 示例:
 //
 //	client := spapi.NewClient(spapi.WithDebug())
@@ -301,6 +580,30 @@ func WithDebug() ClientOption {
 	}
 }
 
+// WithRedaction 添加 JSON 路径脱敏规则。
+//
+// 内置规则已覆盖买家信息（BuyerInfo、BuyerEmail 等）、地址、邮箱、电话
+// 和令牌（x-amz-access-token、refresh_token、client_secret、
+// restrictedDataToken），通过 RDT 获取的受限响应同样适用。
+// 无效的规则使 NewClient 返回错误。
+//
+// 参数:
+//   - rules: JSON 路径规则，支持 $、.name、..name、[*] 和字段名中的 *
+//
+// 示例:
+//
+//	client, err := spapi.NewClient(
+//	    spapi.WithRegion(spapi.RegionNA),
+//	    spapi.WithCredentials(...),
+//	    spapi.WithLogger(logger),
+//	    spapi.WithRedaction("$..PurchaseOrderNumber", "$.payload.*.SellerNote"),
+//	)
+func WithRedaction(rules ...string) ClientOption {
+	return func(c *Config) {
+		c.Redaction = append(c.Redaction, rules...)
+	}
+}
+
 // WithMetricsRecorder 设置指标记录器（已废弃）。
 //
 // 已废弃：请使用 WithMetrics 代替。
@@ -344,9 +647,10 @@ func WithLogger(logger Logger) ClientOption {
 //
 // 示例:
 //
-//	prometheusMetrics := NewPrometheusMetrics()
+//	collector := prometheus.NewCollector()
+//	registry.MustRegister(collector)
 //	client := spapi.NewClient(
-//	    spapi.WithMetrics(prometheusMetrics),
+//	    spapi.WithMetrics(collector),
 //	)
 func WithMetrics(metrics MetricsCollector) ClientOption {
 	return func(c *Config) {
@@ -361,9 +665,8 @@ func WithMetrics(metrics MetricsCollector) ClientOption {
 //
 // 示例:
 //
-//	otelTracer := NewOpenTelemetryTracer()
 //	client := spapi.NewClient(
-//	    spapi.WithTracer(otelTracer),
+//	    spapi.WithTracer(otel.NewTracer()),
 //	)
 func WithTracer(tracer Tracer) ClientOption {
 	return func(c *Config) {
@@ -412,3 +715,48 @@ func WithMiddleware(middlewares ...Middleware) ClientOption {
 		c.Middlewares = append(c.Middlewares, middlewares...)
 	}
 }
+
+// WithRequestValidation 在发送请求前校验请求体。
+//
+// 请求体实现了 Validate() error（如生成的请求模型）时，DoRequest 会在
+// 获取令牌和占用速率限制之前调用它；校验失败的请求不会发出，返回的错误
+// 包装了 ErrInvalidRequest 和具体的字段错误。只校验请求体，
+// 查询参数和路径参数不校验。
+//
+// 示例:
+//
+//	client, err := spapi.NewClient(
+//	    spapi.WithRegion(spapi.RegionNA),
+//	    spapi.WithCredentials(...),
+//	    spapi.WithRequestValidation(),
+//	)
+//
+//	_, err = reportsClient.CreateReport(ctx, reports.CreateReportSpecification{...})
+//	if errors.Is(err, spapi.ErrInvalidRequest) {
+//	    // 请求未发送
+//	}
+func WithRequestValidation() ClientOption {
+	return func(c *Config) {
+		c.RequestValidation = true
+	}
+}
+
+// WithRateLimitWait 在发送请求前等待速率限制令牌。
+//
+// 默认情况下速率限制器只根据响应的 x-amzn-RateLimit-Limit 头部更新，
+// 请求不会等待。启用后 DoRequest 按操作（如 orders-v0:getOrder）和
+// 市场等待令牌，速率未知时使用保守的默认值（1 次/秒，突发 5），
+// 等待时间记录在 MetricRateLimitWait 中。
+//
+// 示例:
+//
+//	client, err := spapi.NewClient(
+//	    spapi.WithRegion(spapi.RegionNA),
+//	    spapi.WithCredentials(...),
+//	    spapi.WithRateLimitWait(),
+//	)
+func WithRateLimitWait() ClientOption {
+	return func(c *Config) {
+		c.RateLimitWait = true
+	}
+}
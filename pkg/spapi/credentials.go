@@ -0,0 +1,338 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// Credentials 是可以在运行时替换的 LWA 凭证。
+//
+// 空字段表示保留当前值。JSON 字段名与 FileCredentials 的文件格式一致。
+type Credentials struct {
+	// ClientSecret 是 LWA 客户端密钥。
+	ClientSecret string `json:"client_secret"`
+
+	// RefreshToken 是 LWA 刷新令牌（Grantless 客户端忽略）。
+	RefreshToken string `json:"refresh_token"`
+}
+
+// String 返回隐藏密钥的字符串表示，避免凭证出现在日志中。
+func (c Credentials) String() string {
+	mask := func(s string) string {
+		if s == "" {
+			return ""
+		}
+		return "****"
+	}
+	return fmt.Sprintf("Credentials{ClientSecret: %s, RefreshToken: %s}", mask(c.ClientSecret), mask(c.RefreshToken))
+}
+
+// CredentialsProvider 提供 LWA 凭证，客户端定期重新加载（见 WithCredentialsProvider）。
+//
+// 实现可以读取环境变量、文件或密钥管理服务（如 AWS Secrets Manager、Vault）。
+// 实现必须是并发安全的。
+type CredentialsProvider interface {
+	// Credentials 返回当前的凭证。
+	Credentials(ctx context.Context) (Credentials, error)
+}
+
+// CredentialsFunc 把函数适配为 CredentialsProvider，通常用于从密钥管理服务读取凭证。
+//
+// 示例:
+//
+//	provider := spapi.CredentialsFunc(func(ctx context.Context) (spapi.Credentials, error) {
+//	    secret, err := secretsManager.GetSecretValue(ctx, "sp-api/client-secret")
+//	    if err != nil {
+//	        return spapi.Credentials{}, err
+//	    }
+//	    return spapi.Credentials{ClientSecret: secret}, nil
+//	})
+type CredentialsFunc func(ctx context.Context) (Credentials, error)
+
+// Credentials 调用 f(ctx)。
+func (f CredentialsFunc) Credentials(ctx context.Context) (Credentials, error) {
+	return f(ctx)
+}
+
+// 默认的凭证环境变量，与 examples 中使用的名称一致。
+const (
+	EnvClientSecret = "SP_API_CLIENT_SECRET"
+	EnvRefreshToken = "SP_API_REFRESH_TOKEN"
+)
+
+// EnvCredentials 返回从环境变量读取凭证的提供者。
+//
+// 参数:
+//   - secretVar: 客户端密钥的环境变量（为空时使用 EnvClientSecret）
+//   - refreshTokenVar: 刷新令牌的环境变量（为空时使用 EnvRefreshToken）
+//
+// 返回值:
+//   - CredentialsProvider: 凭证提供者
+func EnvCredentials(secretVar, refreshTokenVar string) CredentialsProvider {
+	if secretVar == "" {
+		secretVar = EnvClientSecret
+	}
+	if refreshTokenVar == "" {
+		refreshTokenVar = EnvRefreshToken
+	}
+	return CredentialsFunc(func(context.Context) (Credentials, error) {
+		return Credentials{
+			ClientSecret: os.Getenv(secretVar),
+			RefreshToken: os.Getenv(refreshTokenVar),
+		}, nil
+	})
+}
+
+// FileCredentials 返回从 JSON 文件读取凭证的提供者，每次加载都重新读取文件。
+//
+// 文件格式为 {"client_secret": "...", "refresh_token": "..."}，
+// 适用于 Kubernetes Secret 挂载等由外部更新的文件。
+//
+// 参数:
+//   - path: 凭证文件路径
+//
+// 返回值:
+//   - CredentialsProvider: 凭证提供者
+func FileCredentials(path string) CredentialsProvider {
+	return CredentialsFunc(func(context.Context) (Credentials, error) {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return Credentials{}, fmt.Errorf("read credentials file: %w", err)
+		}
+		var creds Credentials
+		if err := json.Unmarshal(data, &creds); err != nil {
+			return Credentials{}, fmt.Errorf("parse credentials file %s: %w", path, err)
+		}
+		return creds, nil
+	})
+}
+
+// 凭证轮换的来源，见 EventCredentialsRotated。
+const (
+	credentialsSourceProvider     = "provider"
+	credentialsSourceNotification = "notification"
+	credentialsSourceManual       = "manual"
+)
+
+// defaultCredentialsReloadInterval 是 CredentialsReloadInterval 为 0 时的重新加载间隔。
+const defaultCredentialsReloadInterval = time.Minute
+
+// credentialState 记录客户端当前使用的凭证和提供者上次返回的凭证。
+type credentialState struct {
+	mu sync.Mutex
+
+	// current 是 LWA 客户端当前使用的凭证
+	current Credentials
+
+	// provided 是 CredentialsProvider 上次返回的凭证，
+	// 只有提供者的值变化时才应用，避免覆盖通知带来的新密钥
+	provided Credentials
+}
+
+// loadCredentials 在 NewClient 中从 CredentialsProvider 加载初始凭证。
+func loadCredentials(config *Config) (Credentials, error) {
+	creds, err := config.CredentialsProvider.Credentials(context.Background())
+	if err != nil {
+		return Credentials{}, fmt.Errorf("load credentials: %w", err)
+	}
+	if creds.ClientSecret != "" {
+		config.ClientSecret = creds.ClientSecret
+	}
+	if creds.RefreshToken != "" {
+		config.RefreshToken = creds.RefreshToken
+	}
+	return creds, nil
+}
+
+// watchCredentials 每隔 interval 重新加载凭证，直到客户端关闭。
+func (c *Client) watchCredentials(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_ = c.ReloadCredentials(ctx)
+		}
+	}
+}
+
+// ReloadCredentials 立即从 CredentialsProvider 重新加载凭证。
+//
+// 只有提供者返回的值与上次不同时才替换，因此通过 HandleSecretRotation
+// 获得的新密钥不会被尚未更新的提供者覆盖。加载失败时继续使用当前凭证。
+// 客户端会定期调用此方法，也可以在收到 SIGHUP 等信号时手动调用。
+//
+// 参数:
+//   - ctx: 加载凭证的上下文
+//
+// 返回值:
+//   - error: 未设置 CredentialsProvider 或加载失败时返回错误
+func (c *Client) ReloadCredentials(ctx context.Context) error {
+	provider := c.config.CredentialsProvider
+	if provider == nil {
+		return fmt.Errorf("%w: no credentials provider", ErrMissingCredentials)
+	}
+	creds, err := provider.Credentials(ctx)
+	if err != nil {
+		c.config.Logger.Warn(EventCredentialsReloadFailed, Field{LogKeyError, err.Error()})
+		return fmt.Errorf("load credentials: %w", err)
+	}
+
+	s := c.credentials
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var changed Credentials
+	if creds.ClientSecret != s.provided.ClientSecret {
+		changed.ClientSecret = creds.ClientSecret
+	}
+	if creds.RefreshToken != s.provided.RefreshToken {
+		changed.RefreshToken = creds.RefreshToken
+	}
+	s.provided = creds
+	c.rotate(changed, time.Time{}, credentialsSourceProvider)
+	return nil
+}
+
+// RotateCredentials 替换客户端密钥和（或）刷新令牌，不需要重建客户端。
+//
+// 进行中的请求不受影响，已缓存的访问令牌继续使用直到过期。
+// 旧密钥保留为备用：LWA 拒绝新密钥（invalid_client）时改用旧密钥，
+// 直到新密钥第一次获取令牌成功。空字段保留当前值。
+//
+// 参数:
+//   - creds: 新的凭证
+//
+// 示例:
+//
+//	client.RotateCredentials(spapi.Credentials{ClientSecret: newSecret})
+func (c *Client) RotateCredentials(creds Credentials) {
+	s := c.credentials
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c.rotate(creds, time.Time{}, credentialsSourceManual)
+}
+
+// rotate 把变化的凭证应用到所有 LWA 客户端，调用方持有 c.credentials.mu。
+//
+// previousValidUntil 是旧密钥的过期时间，为零时保留到新密钥获取令牌成功。
+func (c *Client) rotate(creds Credentials, previousValidUntil time.Time, source string) {
+	s := c.credentials
+	if creds.ClientSecret != "" && creds.ClientSecret != s.current.ClientSecret {
+		for _, lwaClient := range c.lwaClients() {
+			lwaClient.SetClientSecret(creds.ClientSecret, previousValidUntil)
+		}
+		s.current.ClientSecret = creds.ClientSecret
+		c.config.Logger.Info(EventCredentialsRotated, Field{LogKeyCredential, "client_secret"}, Field{LogKeySource, source})
+	}
+	if creds.RefreshToken != "" && creds.RefreshToken != s.current.RefreshToken && c.config.RefreshToken != "" {
+		c.facade.GetLWAClient().SetRefreshToken(creds.RefreshToken)
+		s.current.RefreshToken = creds.RefreshToken
+		c.config.Logger.Info(EventCredentialsRotated, Field{LogKeyCredential, "refresh_token"}, Field{LogKeySource, source})
+	}
+}
+
+// NotificationTypeApplicationOAuthClientNewSecret 是 LWA 客户端密钥轮换后
+// Amazon 发送新密钥的通知类型。
+const NotificationTypeApplicationOAuthClientNewSecret = "APPLICATION_OAUTH_CLIENT_NEW_SECRET"
+
+// ClientSecretRotation 是 APPLICATION_OAUTH_CLIENT_NEW_SECRET 通知的内容。
+type ClientSecretRotation struct {
+	// ClientID 是轮换密钥的 LWA 客户端 ID。
+	ClientID string `json:"clientId"`
+
+	// NewClientSecret 是新的客户端密钥。
+	NewClientSecret string `json:"newClientSecret"`
+
+	// NewClientSecretExpiryTime 是新密钥的过期时间，需要在此之前再次轮换。
+	NewClientSecretExpiryTime time.Time `json:"newClientSecretExpiryTime"`
+
+	// OldClientSecretExpiryTime 是旧密钥的过期时间。
+	OldClientSecretExpiryTime time.Time `json:"oldClientSecretExpiryTime"`
+}
+
+// String 返回隐藏新密钥的字符串表示，避免密钥出现在日志中。
+func (r *ClientSecretRotation) String() string {
+	return fmt.Sprintf("ClientSecretRotation{ClientID: %s, NewClientSecretExpiryTime: %s, OldClientSecretExpiryTime: %s}",
+		r.ClientID, r.NewClientSecretExpiryTime.Format(time.RFC3339), r.OldClientSecretExpiryTime.Format(time.RFC3339))
+}
+
+// secretRotationNotification 是 APPLICATION_OAUTH_CLIENT_NEW_SECRET 通知的消息格式。
+type secretRotationNotification struct {
+	NotificationType string `json:"notificationType"`
+	Payload          struct {
+		ApplicationOAuthClientNewSecret *ClientSecretRotation `json:"applicationOAuthClientNewSecret"`
+	} `json:"payload"`
+}
+
+// HandleSecretRotation 处理 APPLICATION_OAUTH_CLIENT_NEW_SECRET 通知，
+// 把客户端切换到新密钥，不需要重建客户端，也不会中断进行中的请求。
+//
+// 调用 application-management-v2023-11-30 的 RotateApplicationClientSecret 后，
+// Amazon 通过 SQS 或 EventBridge 发送此通知。旧密钥在 OldClientSecretExpiryTime
+// 之前仍作为备用。调用方应把返回的新密钥保存到 CredentialsProvider 读取的位置，
+// 否则进程重启后会使用即将过期的旧密钥。
+//
+// 参数:
+//   - message: 通知的 JSON 消息（SQS 消息体）
+//
+// 返回值:
+//   - *ClientSecretRotation: 通知中的新密钥和过期时间
+//   - error: 不是该类型的通知或客户端 ID 不匹配时返回 ErrInvalidNotification
+//
+// 示例:
+//
+//	rotation, err := client.HandleSecretRotation([]byte(*msg.Body))
+//	if err != nil {
+//	    return err
+//	}
+//	err = secrets.Put(ctx, "sp-api/client-secret", rotation.NewClientSecret)
+func (c *Client) HandleSecretRotation(message []byte) (*ClientSecretRotation, error) {
+	var notification secretRotationNotification
+	if err := json.Unmarshal(message, &notification); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidNotification, err)
+	}
+	if notification.NotificationType != NotificationTypeApplicationOAuthClientNewSecret {
+		return nil, fmt.Errorf("%w: unexpected notification type %q", ErrInvalidNotification, notification.NotificationType)
+	}
+	rotation := notification.Payload.ApplicationOAuthClientNewSecret
+	if rotation == nil || rotation.NewClientSecret == "" {
+		return nil, fmt.Errorf("%w: missing new client secret", ErrInvalidNotification)
+	}
+	if rotation.ClientID != c.config.ClientID {
+		return nil, fmt.Errorf("%w: client ID %s does not match this client", ErrInvalidNotification, rotation.ClientID)
+	}
+
+	s := c.credentials
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c.rotate(Credentials{ClientSecret: rotation.NewClientSecret}, rotation.OldClientSecretExpiryTime, credentialsSourceNotification)
+	return rotation, nil
+}
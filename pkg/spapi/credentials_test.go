@@ -0,0 +1,301 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapi_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/spapitest"
+)
+
+// newRotationServer 返回访问令牌有效期短于刷新余量的服务器，
+// 每次 Health 都从 LWA 获取新令牌，从而验证当前使用的密钥。
+func newRotationServer() *spapitest.Server {
+	return spapitest.NewServer(spapitest.WithTokenTTL(30 * time.Second))
+}
+
+// tokenValid 报告客户端能否用当前凭证获取访问令牌。
+func tokenValid(t *testing.T, client *spapi.Client) bool {
+	t.Helper()
+	status := client.Health(context.Background())
+	return len(status.Tokens) > 0 && status.Tokens[0].Valid
+}
+
+// rotationNotification 返回 APPLICATION_OAUTH_CLIENT_NEW_SECRET 通知。
+func rotationNotification(clientID, secret string, oldExpiry time.Time) []byte {
+	return []byte(fmt.Sprintf(`{
+		"notificationVersion": "1.0",
+		"notificationType": "APPLICATION_OAUTH_CLIENT_NEW_SECRET",
+		"payloadVersion": "2023-12-13",
+		"eventTime": "2025-01-01T00:00:00Z",
+		"payload": {
+			"applicationOAuthClientNewSecret": {
+				"clientId": %q,
+				"newClientSecret": %q,
+				"newClientSecretExpiryTime": "2025-06-30T00:00:00Z",
+				"oldClientSecretExpiryTime": %q
+			}
+		},
+		"notificationMetadata": {"notificationId": "n-1"}
+	}`, clientID, secret, oldExpiry.Format(time.RFC3339)))
+}
+
+// TestClient_HandleSecretRotation 测试通知带来的新密钥在旧密钥过期前后都能获取令牌。
+func TestClient_HandleSecretRotation(t *testing.T) {
+	srv := newRotationServer()
+	defer srv.Close()
+
+	logger := newRecordingLogger()
+	client, err := srv.NewClient(spapi.WithLogger(logger))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	defer client.Close(context.Background())
+
+	// 轮换过渡期：新旧密钥都有效
+	srv.SetClientSecrets(spapitest.ClientSecret, "new-secret")
+	rotation, err := client.HandleSecretRotation(rotationNotification(spapitest.ClientID, "new-secret", time.Now().Add(time.Hour)))
+	if err != nil {
+		t.Fatalf("HandleSecretRotation() error = %v", err)
+	}
+	if rotation.NewClientSecret != "new-secret" || rotation.NewClientSecretExpiryTime.IsZero() {
+		t.Errorf("rotation = %+v", rotation)
+	}
+	if strings.Contains(rotation.String(), "new-secret") {
+		t.Errorf("String() = %s, want secret hidden", rotation)
+	}
+	if !tokenValid(t, client) {
+		t.Error("token invalid during overlap")
+	}
+	if got := client.Config().ClientSecret; got != "new-secret" {
+		t.Errorf("Config().ClientSecret = %q, want rotated secret", got)
+	}
+
+	// 旧密钥过期后继续使用新密钥
+	srv.SetClientSecrets("new-secret")
+	if !tokenValid(t, client) {
+		t.Error("token invalid after old secret expired")
+	}
+
+	if out := logger.output(); !strings.Contains(out, "INFO sp-api credentials rotated credential=client_secret source=notification") {
+		t.Errorf("log output missing rotation event:\n%s", out)
+	}
+}
+
+// TestClient_HandleSecretRotation_Invalid 测试其他类型或其他应用的通知被拒绝。
+func TestClient_HandleSecretRotation_Invalid(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	defer client.Close(context.Background())
+
+	tests := []struct {
+		name    string
+		message []byte
+	}{
+		{"malformed", []byte(`{`)},
+		{"other type", []byte(`{"notificationType":"ORDER_CHANGE","payload":{}}`)},
+		{"missing secret", rotationNotification(spapitest.ClientID, "", time.Now())},
+		{"other client", rotationNotification("amzn1.application-oa2-client.other", "new-secret", time.Now())},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := client.HandleSecretRotation(tt.message); !errors.Is(err, spapi.ErrInvalidNotification) {
+				t.Errorf("HandleSecretRotation() error = %v, want ErrInvalidNotification", err)
+			}
+		})
+	}
+	if !tokenValid(t, client) {
+		t.Error("token invalid after rejected notifications")
+	}
+}
+
+// TestClient_RotateCredentials 测试新密钥生效前回退到旧密钥。
+func TestClient_RotateCredentials(t *testing.T) {
+	srv := newRotationServer()
+	defer srv.Close()
+
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	defer client.Close(context.Background())
+
+	// 服务器尚未接受新密钥
+	client.RotateCredentials(spapi.Credentials{ClientSecret: "new-secret"})
+	if !tokenValid(t, client) {
+		t.Error("token invalid before new secret is active, want fallback to old secret")
+	}
+
+	srv.SetClientSecrets("new-secret")
+	if !tokenValid(t, client) {
+		t.Error("token invalid after new secret is active")
+	}
+	if got := client.Config().ClientSecret; got != "new-secret" {
+		t.Errorf("Config().ClientSecret = %q, want rotated secret", got)
+	}
+}
+
+// TestWithCredentialsProvider_File 测试从文件加载凭证并在文件更新后重新加载。
+func TestWithCredentialsProvider_File(t *testing.T) {
+	srv := newRotationServer()
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	write := func(secret string) {
+		data := fmt.Sprintf(`{"client_secret": %q, "refresh_token": %q}`, secret, spapitest.RefreshToken)
+		if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write(spapitest.ClientSecret)
+
+	client, err := srv.NewClient(
+		spapi.WithCredentials(spapitest.ClientID, "", ""),
+		spapi.WithCredentialsProvider(spapi.FileCredentials(path), -1),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	defer client.Close(context.Background())
+	if !tokenValid(t, client) {
+		t.Fatal("token invalid with credentials from file")
+	}
+
+	srv.SetClientSecrets("rotated-secret")
+	write("rotated-secret")
+	if err := client.ReloadCredentials(context.Background()); err != nil {
+		t.Fatalf("ReloadCredentials() error = %v", err)
+	}
+	if !tokenValid(t, client) {
+		t.Error("token invalid after reloading rotated secret")
+	}
+
+	// 加载失败时继续使用当前凭证
+	os.Remove(path)
+	if err := client.ReloadCredentials(context.Background()); err == nil {
+		t.Error("ReloadCredentials() error = nil, want error for missing file")
+	}
+	if !tokenValid(t, client) {
+		t.Error("token invalid after failed reload")
+	}
+}
+
+// TestWithCredentialsProvider_KeepsNotificationSecret 测试未更新的提供者不覆盖通知带来的新密钥。
+func TestWithCredentialsProvider_KeepsNotificationSecret(t *testing.T) {
+	srv := newRotationServer()
+	defer srv.Close()
+	t.Setenv(spapi.EnvClientSecret, spapitest.ClientSecret)
+
+	client, err := srv.NewClient(spapi.WithCredentialsProvider(spapi.EnvCredentials("", ""), -1))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	defer client.Close(context.Background())
+
+	srv.SetClientSecrets("new-secret")
+	if _, err := client.HandleSecretRotation(rotationNotification(spapitest.ClientID, "new-secret", time.Now())); err != nil {
+		t.Fatalf("HandleSecretRotation() error = %v", err)
+	}
+	if err := client.ReloadCredentials(context.Background()); err != nil {
+		t.Fatalf("ReloadCredentials() error = %v", err)
+	}
+	if !tokenValid(t, client) {
+		t.Error("token invalid, stale provider overrode the notification secret")
+	}
+}
+
+// TestWithCredentialsProvider_Reload 测试定期重新加载在 Close 时停止。
+func TestWithCredentialsProvider_Reload(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+
+	var loads atomic.Int32
+	provider := spapi.CredentialsFunc(func(context.Context) (spapi.Credentials, error) {
+		loads.Add(1)
+		return spapi.Credentials{ClientSecret: spapitest.ClientSecret}, nil
+	})
+	client, err := srv.NewClient(spapi.WithCredentialsProvider(provider, 5*time.Millisecond))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for loads.Load() < 3 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if n := loads.Load(); n < 3 {
+		t.Fatalf("loads = %d, want at least 3", n)
+	}
+
+	if err := client.Close(context.Background()); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	n := loads.Load()
+	time.Sleep(20 * time.Millisecond)
+	if loads.Load() != n {
+		t.Error("credentials reloaded after Close")
+	}
+}
+
+// TestWithCredentialsProvider_Error 测试初始加载失败时 NewClient 返回错误。
+func TestWithCredentialsProvider_Error(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+
+	loadErr := errors.New("secrets manager unavailable")
+	provider := spapi.CredentialsFunc(func(context.Context) (spapi.Credentials, error) {
+		return spapi.Credentials{}, loadErr
+	})
+	if _, err := srv.NewClient(spapi.WithCredentialsProvider(provider, 0)); !errors.Is(err, loadErr) {
+		t.Errorf("NewClient() error = %v, want %v", err, loadErr)
+	}
+}
+
+// TestEnvCredentials 测试从环境变量读取凭证。
+func TestEnvCredentials(t *testing.T) {
+	t.Setenv(spapi.EnvClientSecret, "env-secret")
+	t.Setenv("CUSTOM_REFRESH_TOKEN", "Atzr|env")
+
+	creds, err := spapi.EnvCredentials("", "CUSTOM_REFRESH_TOKEN").Credentials(context.Background())
+	if err != nil {
+		t.Fatalf("Credentials() error = %v", err)
+	}
+	if creds.ClientSecret != "env-secret" || creds.RefreshToken != "Atzr|env" {
+		t.Errorf("Credentials() = %q, %q", creds.ClientSecret, creds.RefreshToken)
+	}
+	if s := creds.String(); strings.Contains(s, "env-secret") || strings.Contains(s, "Atzr") {
+		t.Errorf("String() = %s, want secrets hidden", s)
+	}
+}
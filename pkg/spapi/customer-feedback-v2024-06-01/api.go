@@ -0,0 +1,61 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package customer_feedback_v2024_06_01
+
+import (
+	"context"
+)
+
+// API 是 customer-feedback API v2024-06-01 客户端的全部方法，包括分页迭代器等辅助方法。
+//
+// 业务代码依赖 API 而不是 *Client，即可在单元测试中用 Fake 替换真实客户端。
+type API interface {
+	// GetBrowseNodeReturnTopics
+	// Method: GET | Path: /customerFeedback/2024-06-01/browseNodes/{browseNodeId}/returns/topics
+	GetBrowseNodeReturnTopics(ctx context.Context, browseNodeId string, query map[string]string) (interface{}, error)
+
+	// GetBrowseNodeReturnTrends
+	// Method: GET | Path: /customerFeedback/2024-06-01/browseNodes/{browseNodeId}/returns/trends
+	GetBrowseNodeReturnTrends(ctx context.Context, browseNodeId string, query map[string]string) (interface{}, error)
+
+	// GetBrowseNodeReviewTopics
+	// Method: GET | Path: /customerFeedback/2024-06-01/browseNodes/{browseNodeId}/reviews/topics
+	GetBrowseNodeReviewTopics(ctx context.Context, browseNodeId string, query map[string]string) (interface{}, error)
+
+	// GetBrowseNodeReviewTrends
+	// Method: GET | Path: /customerFeedback/2024-06-01/browseNodes/{browseNodeId}/reviews/trends
+	GetBrowseNodeReviewTrends(ctx context.Context, browseNodeId string, query map[string]string) (interface{}, error)
+
+	// GetItemBrowseNode
+	// Method: GET | Path: /customerFeedback/2024-06-01/items/{asin}/browseNode
+	GetItemBrowseNode(ctx context.Context, asin string, query map[string]string) (interface{}, error)
+
+	// GetItemReviewTopics
+	// Method: GET | Path: /customerFeedback/2024-06-01/items/{asin}/reviews/topics
+	GetItemReviewTopics(ctx context.Context, asin string, query map[string]string) (interface{}, error)
+
+	// GetItemReviewTrends
+	// Method: GET | Path: /customerFeedback/2024-06-01/items/{asin}/reviews/trends
+	GetItemReviewTrends(ctx context.Context, asin string, query map[string]string) (interface{}, error)
+}
+
+// 确保 Client 实现了 API。
+var _ API = (*Client)(nil)
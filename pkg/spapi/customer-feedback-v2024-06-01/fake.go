@@ -0,0 +1,129 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package customer_feedback_v2024_06_01
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。
+//
+// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回
+// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，
+// 可以通过 Calls 和 CallsTo 查看。
+type Fake struct {
+	spapi.FakeRecorder
+
+	// GetBrowseNodeReturnTopicsFunc 实现 GetBrowseNodeReturnTopics。
+	GetBrowseNodeReturnTopicsFunc func(ctx context.Context, browseNodeId string, query map[string]string) (interface{}, error)
+
+	// GetBrowseNodeReturnTrendsFunc 实现 GetBrowseNodeReturnTrends。
+	GetBrowseNodeReturnTrendsFunc func(ctx context.Context, browseNodeId string, query map[string]string) (interface{}, error)
+
+	// GetBrowseNodeReviewTopicsFunc 实现 GetBrowseNodeReviewTopics。
+	GetBrowseNodeReviewTopicsFunc func(ctx context.Context, browseNodeId string, query map[string]string) (interface{}, error)
+
+	// GetBrowseNodeReviewTrendsFunc 实现 GetBrowseNodeReviewTrends。
+	GetBrowseNodeReviewTrendsFunc func(ctx context.Context, browseNodeId string, query map[string]string) (interface{}, error)
+
+	// GetItemBrowseNodeFunc 实现 GetItemBrowseNode。
+	GetItemBrowseNodeFunc func(ctx context.Context, asin string, query map[string]string) (interface{}, error)
+
+	// GetItemReviewTopicsFunc 实现 GetItemReviewTopics。
+	GetItemReviewTopicsFunc func(ctx context.Context, asin string, query map[string]string) (interface{}, error)
+
+	// GetItemReviewTrendsFunc 实现 GetItemReviewTrends。
+	GetItemReviewTrendsFunc func(ctx context.Context, asin string, query map[string]string) (interface{}, error)
+}
+
+// NewFake 创建未编程任何响应的 Fake。
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// GetBrowseNodeReturnTopics 记录调用并执行 GetBrowseNodeReturnTopicsFunc。
+func (f *Fake) GetBrowseNodeReturnTopics(ctx context.Context, browseNodeId string, query map[string]string) (interface{}, error) {
+	f.Record("GetBrowseNodeReturnTopics", browseNodeId, query)
+	if f.GetBrowseNodeReturnTopicsFunc == nil {
+		return nil, fmt.Errorf("GetBrowseNodeReturnTopics: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetBrowseNodeReturnTopicsFunc(ctx, browseNodeId, query)
+}
+
+// GetBrowseNodeReturnTrends 记录调用并执行 GetBrowseNodeReturnTrendsFunc。
+func (f *Fake) GetBrowseNodeReturnTrends(ctx context.Context, browseNodeId string, query map[string]string) (interface{}, error) {
+	f.Record("GetBrowseNodeReturnTrends", browseNodeId, query)
+	if f.GetBrowseNodeReturnTrendsFunc == nil {
+		return nil, fmt.Errorf("GetBrowseNodeReturnTrends: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetBrowseNodeReturnTrendsFunc(ctx, browseNodeId, query)
+}
+
+// GetBrowseNodeReviewTopics 记录调用并执行 GetBrowseNodeReviewTopicsFunc。
+func (f *Fake) GetBrowseNodeReviewTopics(ctx context.Context, browseNodeId string, query map[string]string) (interface{}, error) {
+	f.Record("GetBrowseNodeReviewTopics", browseNodeId, query)
+	if f.GetBrowseNodeReviewTopicsFunc == nil {
+		return nil, fmt.Errorf("GetBrowseNodeReviewTopics: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetBrowseNodeReviewTopicsFunc(ctx, browseNodeId, query)
+}
+
+// GetBrowseNodeReviewTrends 记录调用并执行 GetBrowseNodeReviewTrendsFunc。
+func (f *Fake) GetBrowseNodeReviewTrends(ctx context.Context, browseNodeId string, query map[string]string) (interface{}, error) {
+	f.Record("GetBrowseNodeReviewTrends", browseNodeId, query)
+	if f.GetBrowseNodeReviewTrendsFunc == nil {
+		return nil, fmt.Errorf("GetBrowseNodeReviewTrends: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetBrowseNodeReviewTrendsFunc(ctx, browseNodeId, query)
+}
+
+// GetItemBrowseNode 记录调用并执行 GetItemBrowseNodeFunc。
+func (f *Fake) GetItemBrowseNode(ctx context.Context, asin string, query map[string]string) (interface{}, error) {
+	f.Record("GetItemBrowseNode", asin, query)
+	if f.GetItemBrowseNodeFunc == nil {
+		return nil, fmt.Errorf("GetItemBrowseNode: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetItemBrowseNodeFunc(ctx, asin, query)
+}
+
+// GetItemReviewTopics 记录调用并执行 GetItemReviewTopicsFunc。
+func (f *Fake) GetItemReviewTopics(ctx context.Context, asin string, query map[string]string) (interface{}, error) {
+	f.Record("GetItemReviewTopics", asin, query)
+	if f.GetItemReviewTopicsFunc == nil {
+		return nil, fmt.Errorf("GetItemReviewTopics: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetItemReviewTopicsFunc(ctx, asin, query)
+}
+
+// GetItemReviewTrends 记录调用并执行 GetItemReviewTrendsFunc。
+func (f *Fake) GetItemReviewTrends(ctx context.Context, asin string, query map[string]string) (interface{}, error) {
+	f.Record("GetItemReviewTrends", asin, query)
+	if f.GetItemReviewTrendsFunc == nil {
+		return nil, fmt.Errorf("GetItemReviewTrends: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetItemReviewTrendsFunc(ctx, asin, query)
+}
+
+// 确保 Fake 实现了 API。
+var _ API = (*Fake)(nil)
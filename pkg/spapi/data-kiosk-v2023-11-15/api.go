@@ -0,0 +1,62 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package data_kiosk_v2023_11_15
+
+import (
+	"context"
+	"iter"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// API 是 data-kiosk API v2023-11-15 客户端的全部方法，包括分页迭代器等辅助方法。
+//
+// 业务代码依赖 API 而不是 *Client，即可在单元测试中用 Fake 替换真实客户端。
+type API interface {
+	// CancelQuery
+	// Method: DELETE | Path: /dataKiosk/2023-11-15/queries/{queryId}
+	CancelQuery(ctx context.Context, queryId string) (interface{}, error)
+
+	// CreateQuery
+	// Method: POST | Path: /dataKiosk/2023-11-15/queries
+	CreateQuery(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetDocument
+	// Method: GET | Path: /dataKiosk/2023-11-15/documents/{documentId}
+	GetDocument(ctx context.Context, documentId string, query map[string]string) (interface{}, error)
+
+	// GetQueries
+	// Method: GET | Path: /dataKiosk/2023-11-15/queries
+	GetQueries(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetQuery
+	// Method: GET | Path: /dataKiosk/2023-11-15/queries/{queryId}
+	GetQuery(ctx context.Context, queryId string, query map[string]string) (interface{}, error)
+
+	// IterateQueries 返回 Data Kiosk 查询迭代器，自动处理分页。
+	IterateQueries(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// WaitForQuery 轮询 GetQuery 直到查询到达终止状态（DONE、CANCELLED、FATAL）。
+	WaitForQuery(ctx context.Context, queryID string, opts ...spapi.PollerOption) (interface{}, error)
+}
+
+// 确保 Client 实现了 API。
+var _ API = (*Client)(nil)
@@ -0,0 +1,130 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package data_kiosk_v2023_11_15
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。
+//
+// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回
+// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，
+// 可以通过 Calls 和 CallsTo 查看。
+type Fake struct {
+	spapi.FakeRecorder
+
+	// CancelQueryFunc 实现 CancelQuery。
+	CancelQueryFunc func(ctx context.Context, queryId string) (interface{}, error)
+
+	// CreateQueryFunc 实现 CreateQuery。
+	CreateQueryFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetDocumentFunc 实现 GetDocument。
+	GetDocumentFunc func(ctx context.Context, documentId string, query map[string]string) (interface{}, error)
+
+	// GetQueriesFunc 实现 GetQueries。
+	GetQueriesFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetQueryFunc 实现 GetQuery。
+	GetQueryFunc func(ctx context.Context, queryId string, query map[string]string) (interface{}, error)
+
+	// IterateQueriesFunc 实现 IterateQueries。
+	IterateQueriesFunc func(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// WaitForQueryFunc 实现 WaitForQuery。
+	WaitForQueryFunc func(ctx context.Context, queryID string, opts ...spapi.PollerOption) (interface{}, error)
+}
+
+// NewFake 创建未编程任何响应的 Fake。
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// CancelQuery 记录调用并执行 CancelQueryFunc。
+func (f *Fake) CancelQuery(ctx context.Context, queryId string) (interface{}, error) {
+	f.Record("CancelQuery", queryId)
+	if f.CancelQueryFunc == nil {
+		return nil, fmt.Errorf("CancelQuery: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CancelQueryFunc(ctx, queryId)
+}
+
+// CreateQuery 记录调用并执行 CreateQueryFunc。
+func (f *Fake) CreateQuery(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("CreateQuery", body)
+	if f.CreateQueryFunc == nil {
+		return nil, fmt.Errorf("CreateQuery: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateQueryFunc(ctx, body)
+}
+
+// GetDocument 记录调用并执行 GetDocumentFunc。
+func (f *Fake) GetDocument(ctx context.Context, documentId string, query map[string]string) (interface{}, error) {
+	f.Record("GetDocument", documentId, query)
+	if f.GetDocumentFunc == nil {
+		return nil, fmt.Errorf("GetDocument: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetDocumentFunc(ctx, documentId, query)
+}
+
+// GetQueries 记录调用并执行 GetQueriesFunc。
+func (f *Fake) GetQueries(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetQueries", query)
+	if f.GetQueriesFunc == nil {
+		return nil, fmt.Errorf("GetQueries: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetQueriesFunc(ctx, query)
+}
+
+// GetQuery 记录调用并执行 GetQueryFunc。
+func (f *Fake) GetQuery(ctx context.Context, queryId string, query map[string]string) (interface{}, error) {
+	f.Record("GetQuery", queryId, query)
+	if f.GetQueryFunc == nil {
+		return nil, fmt.Errorf("GetQuery: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetQueryFunc(ctx, queryId, query)
+}
+
+// IterateQueries 记录调用并执行 IterateQueriesFunc。
+func (f *Fake) IterateQueries(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+	f.Record("IterateQueries", query)
+	if f.IterateQueriesFunc == nil {
+		return spapi.FakeSeq[map[string]interface{}](nil, fmt.Errorf("IterateQueries: %w", spapi.ErrFakeNotConfigured))
+	}
+	return f.IterateQueriesFunc(ctx, query)
+}
+
+// WaitForQuery 记录调用并执行 WaitForQueryFunc。
+func (f *Fake) WaitForQuery(ctx context.Context, queryID string, opts ...spapi.PollerOption) (interface{}, error) {
+	f.Record("WaitForQuery", queryID, opts)
+	if f.WaitForQueryFunc == nil {
+		return nil, fmt.Errorf("WaitForQuery: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.WaitForQueryFunc(ctx, queryID, opts...)
+}
+
+// 确保 Fake 实现了 API。
+var _ API = (*Fake)(nil)
@@ -0,0 +1,366 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+package spapi
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidDecimal 表示无法解析的十进制数。
+var ErrInvalidDecimal = errors.New("invalid decimal")
+
+// RoundingMode 定义十进制数的舍入方式。
+type RoundingMode int
+
+// 舍入方式。
+const (
+	// RoundHalfUp 四舍五入（远离零方向），商业计算的常用方式
+	RoundHalfUp RoundingMode = iota
+
+	// RoundHalfEven 银行家舍入（恰好一半时舍入到偶数）
+	RoundHalfEven
+
+	// RoundDown 向零截断
+	RoundDown
+)
+
+// Decimal 是任意精度的十进制数，用于表示金额。
+//
+// 值 = coef × 10^(-scale)。Decimal 是不可变的值类型，
+// 所有运算都返回新值，可以安全地复制和并发读取。零值表示 0。
+//
+// JSON 解码同时接受数字（12.34）和字符串（"12.34"）形式，
+// 解码时直接解析 JSON 文本，不经过 float64，因此不会丢失精度。
+// JSON 编码输出数字形式；需要字符串形式时使用 DecimalString。
+// 模型中的可选金额字段使用 omitzero 标签，值为 0（IsZero）时不编码。
+type Decimal struct {
+	coef  *big.Int
+	scale int32
+}
+
+// NewDecimal 创建值为 value × 10^(-scale) 的十进制数。
+//
+// 示例:
+//
+//	spapi.NewDecimal(1999, 2) // 19.99
+func NewDecimal(value int64, scale int32) Decimal {
+	if scale < 0 {
+		coef := new(big.Int).Mul(big.NewInt(value), pow10(-scale))
+		return Decimal{coef: coef}
+	}
+	return Decimal{coef: big.NewInt(value), scale: scale}
+}
+
+// maxDecimalExponent 是 ParseDecimal 接受的最大指数和小数位数。
+const maxDecimalExponent = 64
+
+// ParseDecimal 解析十进制数字符串。
+//
+// 支持可选的正负号、小数点和科学计数法（如 "-12.50"、"1e-3"）。
+//
+// 参数:
+//   - s: 十进制数字符串
+//
+// 返回值:
+//   - Decimal: 解析结果（保留原始小数位数，"12.50" 的 Scale 为 2）
+//   - error: 格式无效，或指数、小数位数超过 ±64 时返回 ErrInvalidDecimal
+func ParseDecimal(s string) (Decimal, error) {
+	text := strings.TrimSpace(s)
+	if text == "" {
+		return Decimal{}, fmt.Errorf("%w: empty string", ErrInvalidDecimal)
+	}
+
+	exponent := int64(0)
+	if i := strings.IndexAny(text, "eE"); i >= 0 {
+		e, err := strconv.ParseInt(text[i+1:], 10, 32)
+		if err != nil {
+			return Decimal{}, fmt.Errorf("%w: %q", ErrInvalidDecimal, s)
+		}
+		exponent = e
+		text = text[:i]
+	}
+
+	digits := text
+	if i := strings.IndexByte(text, '.'); i >= 0 {
+		digits = text[:i] + text[i+1:]
+		exponent -= int64(len(text) - i - 1)
+	}
+
+	unsigned := strings.TrimLeft(digits, "+-")
+	if unsigned == "" || len(digits)-len(unsigned) > 1 || strings.ContainsAny(unsigned, "+-") {
+		return Decimal{}, fmt.Errorf("%w: %q", ErrInvalidDecimal, s)
+	}
+
+	coef, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("%w: %q", ErrInvalidDecimal, s)
+	}
+
+	// 限制指数，避免 "1e999999999" 这样的输入在解码 JSON 时分配巨大的整数
+	if exponent > maxDecimalExponent || -exponent > maxDecimalExponent {
+		return Decimal{}, fmt.Errorf("%w: exponent out of range: %q", ErrInvalidDecimal, s)
+	}
+	if exponent > 0 {
+		coef.Mul(coef, pow10(int32(exponent)))
+		exponent = 0
+	}
+
+	return Decimal{coef: coef, scale: int32(-exponent)}, nil
+}
+
+// MustParseDecimal 与 ParseDecimal 相同，但解析失败时 panic。
+//
+// 仅用于常量和测试。
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// DecimalFromFloat 将 float64 转换为十进制数。
+//
+// 使用最短往返表示（strconv.FormatFloat(f, 'f', -1, 64)），
+// 例如 0.1 得到精确的 0.1 而不是 0.1000000000000000055...。
+//
+// 参数:
+//   - f: 浮点数
+//
+// 返回值:
+//   - Decimal: 转换结果
+//   - error: NaN 或无穷大时返回 ErrInvalidDecimal
+func DecimalFromFloat(f float64) (Decimal, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return Decimal{}, fmt.Errorf("%w: %v", ErrInvalidDecimal, f)
+	}
+	return ParseDecimal(strconv.FormatFloat(f, 'f', -1, 64))
+}
+
+// int 返回系数（零值 Decimal 返回 0）。
+func (d Decimal) int() *big.Int {
+	if d.coef == nil {
+		return new(big.Int)
+	}
+	return d.coef
+}
+
+// rescale 返回系数调整到 scale 位小数后的值（scale 不小于 d.scale）。
+func (d Decimal) rescale(scale int32) *big.Int {
+	coef := new(big.Int).Set(d.int())
+	if scale > d.scale {
+		coef.Mul(coef, pow10(scale-d.scale))
+	}
+	return coef
+}
+
+// align 将两个数调整到相同的小数位数。
+func align(a, b Decimal) (*big.Int, *big.Int, int32) {
+	scale := max(a.scale, b.scale)
+	return a.rescale(scale), b.rescale(scale), scale
+}
+
+// Add 返回 d + other。结果的小数位数取两者中较大者。
+func (d Decimal) Add(other Decimal) Decimal {
+	x, y, scale := align(d, other)
+	return Decimal{coef: x.Add(x, y), scale: scale}
+}
+
+// Sub 返回 d - other。结果的小数位数取两者中较大者。
+func (d Decimal) Sub(other Decimal) Decimal {
+	x, y, scale := align(d, other)
+	return Decimal{coef: x.Sub(x, y), scale: scale}
+}
+
+// Mul 返回 d × other（精确结果，小数位数为两者之和）。
+func (d Decimal) Mul(other Decimal) Decimal {
+	return Decimal{coef: new(big.Int).Mul(d.int(), other.int()), scale: d.scale + other.scale}
+}
+
+// Neg 返回 -d。
+func (d Decimal) Neg() Decimal {
+	return Decimal{coef: new(big.Int).Neg(d.int()), scale: d.scale}
+}
+
+// Abs 返回 |d|。
+func (d Decimal) Abs() Decimal {
+	return Decimal{coef: new(big.Int).Abs(d.int()), scale: d.scale}
+}
+
+// Cmp 比较 d 和 other，返回 -1、0 或 1。
+//
+// 比较的是数值，"12.5" 与 "12.50" 相等。
+func (d Decimal) Cmp(other Decimal) int {
+	x, y, _ := align(d, other)
+	return x.Cmp(y)
+}
+
+// Equal 判断 d 和 other 数值是否相等。
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Cmp(other) == 0
+}
+
+// Sign 返回 d 的符号：-1、0 或 1。
+func (d Decimal) Sign() int {
+	return d.int().Sign()
+}
+
+// IsZero 判断 d 是否等于 0。
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Scale 返回小数位数。
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+// Round 按指定方式舍入到 places 位小数。
+//
+// places 大于当前小数位数时补零（"1.5" 舍入到 2 位得到 "1.50"）。
+//
+// 参数:
+//   - places: 保留的小数位数（不小于 0）
+//   - mode: 舍入方式
+//
+// 返回值:
+//   - Decimal: 舍入结果
+func (d Decimal) Round(places int32, mode RoundingMode) Decimal {
+	if places < 0 {
+		places = 0
+	}
+	if places >= d.scale {
+		return Decimal{coef: d.rescale(places), scale: places}
+	}
+
+	divisor := pow10(d.scale - places)
+	quotient, remainder := new(big.Int).QuoRem(d.int(), divisor, new(big.Int))
+
+	if remainder.Sign() != 0 && mode != RoundDown {
+		// 比较 2×|余数| 与除数，判断是否超过一半
+		half := new(big.Int).Abs(remainder)
+		half.Mul(half, big.NewInt(2))
+
+		switch c := half.Cmp(divisor); {
+		case c > 0, c == 0 && mode == RoundHalfUp, c == 0 && mode == RoundHalfEven && quotient.Bit(0) == 1:
+			if remainder.Sign() < 0 {
+				quotient.Sub(quotient, big.NewInt(1))
+			} else {
+				quotient.Add(quotient, big.NewInt(1))
+			}
+		}
+	}
+
+	return Decimal{coef: quotient, scale: places}
+}
+
+// Rat 返回 d 的 *big.Rat 表示。
+func (d Decimal) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(d.int(), pow10(d.scale))
+}
+
+// Float64 返回最接近 d 的 float64 值（可能损失精度，仅用于展示或近似计算）。
+func (d Decimal) Float64() float64 {
+	f, _ := d.Rat().Float64()
+	return f
+}
+
+// String 返回定点表示，保留全部小数位（如 "12.50"、"-0.001"）。
+func (d Decimal) String() string {
+	digits := new(big.Int).Abs(d.int()).String()
+
+	sign := ""
+	if d.Sign() < 0 {
+		sign = "-"
+	}
+
+	if d.scale == 0 {
+		return sign + digits
+	}
+
+	scale := int(d.scale)
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	point := len(digits) - scale
+
+	return sign + digits[:point] + "." + digits[point:]
+}
+
+// MarshalJSON 实现 json.Marshaler 接口，输出 JSON 数字。
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalJSON 实现 json.Unmarshaler 接口。
+//
+// 接受 JSON 数字、数字字符串和 null（null 与空字符串解码为 0）。
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	text := bytes.TrimSpace(data)
+
+	if bytes.Equal(text, []byte("null")) {
+		*d = Decimal{}
+		return nil
+	}
+
+	if len(text) > 0 && text[0] == '"' {
+		unquoted, err := strconv.Unquote(string(text))
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidDecimal, text)
+		}
+		if strings.TrimSpace(unquoted) == "" {
+			*d = Decimal{}
+			return nil
+		}
+		text = []byte(unquoted)
+	}
+
+	parsed, err := ParseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+	return nil
+}
+
+// DecimalString 是以 JSON 字符串形式编码的 Decimal。
+//
+// 用于 API 定义中金额为字符串类型的模型（如 Orders API 的 Money.Amount）。
+// 解码同样接受数字和字符串两种形式。
+type DecimalString struct {
+	Decimal
+}
+
+// MarshalJSON 实现 json.Marshaler 接口，输出 JSON 字符串。
+func (d DecimalString) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// pow10 返回 10^n。
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
@@ -0,0 +1,143 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+package spapi_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"12.50", "12.50"},
+		{"-0.001", "-0.001"},
+		{"+7", "7"},
+		{".5", "0.5"},
+		{"1e3", "1000"},
+		{"1.5e-2", "0.015"},
+		{"1e64", "1" + strings.Repeat("0", 64)},
+		{"1e-64", "0." + strings.Repeat("0", 63) + "1"},
+		{"123456789012345678901234567890.123456789", "123456789012345678901234567890.123456789"},
+	}
+
+	for _, tt := range tests {
+		got, err := spapi.ParseDecimal(tt.input)
+		if err != nil {
+			t.Errorf("ParseDecimal(%q) error = %v", tt.input, err)
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("ParseDecimal(%q) = %s, want %s", tt.input, got, tt.want)
+		}
+	}
+
+	for _, input := range []string{"", "abc", "1.2.3", "--1", "1-2", "1e", "1e999999999", "1e-999999999", "1e65", "0." + strings.Repeat("0", 65) + "1"} {
+		if _, err := spapi.ParseDecimal(input); !errors.Is(err, spapi.ErrInvalidDecimal) {
+			t.Errorf("ParseDecimal(%q) error = %v, want ErrInvalidDecimal", input, err)
+		}
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	a := spapi.MustParseDecimal("0.1")
+	b := spapi.MustParseDecimal("0.2")
+
+	if sum := a.Add(b); !sum.Equal(spapi.MustParseDecimal("0.3")) {
+		t.Errorf("0.1 + 0.2 = %s, want 0.3", sum)
+	}
+	if diff := a.Sub(b); diff.String() != "-0.1" {
+		t.Errorf("0.1 - 0.2 = %s, want -0.1", diff)
+	}
+	if product := spapi.MustParseDecimal("1.5").Mul(spapi.NewDecimal(3, 0)); product.String() != "4.5" {
+		t.Errorf("1.5 * 3 = %s, want 4.5", product)
+	}
+	if spapi.MustParseDecimal("12.5").Cmp(spapi.MustParseDecimal("12.50")) != 0 {
+		t.Error("12.5 should equal 12.50")
+	}
+	if (spapi.Decimal{}).Add(a).String() != "0.1" {
+		t.Error("zero value should behave as 0")
+	}
+}
+
+func TestDecimalRound(t *testing.T) {
+	tests := []struct {
+		input  string
+		places int32
+		mode   spapi.RoundingMode
+		want   string
+	}{
+		{"2.345", 2, spapi.RoundHalfUp, "2.35"},
+		{"-2.345", 2, spapi.RoundHalfUp, "-2.35"},
+		{"2.345", 2, spapi.RoundHalfEven, "2.34"},
+		{"2.355", 2, spapi.RoundHalfEven, "2.36"},
+		{"2.349", 2, spapi.RoundDown, "2.34"},
+		{"-2.349", 2, spapi.RoundDown, "-2.34"},
+		{"1.5", 2, spapi.RoundHalfUp, "1.50"},
+		{"1234.5", 0, spapi.RoundHalfUp, "1235"},
+	}
+
+	for _, tt := range tests {
+		got := spapi.MustParseDecimal(tt.input).Round(tt.places, tt.mode)
+		if got.String() != tt.want {
+			t.Errorf("Round(%s, %d, %d) = %s, want %s", tt.input, tt.places, tt.mode, got, tt.want)
+		}
+	}
+}
+
+func TestDecimalJSON(t *testing.T) {
+	var v struct {
+		Number spapi.Decimal       `json:"number"`
+		Text   spapi.DecimalString `json:"text"`
+	}
+
+	input := `{"number":"19.99","text":0.30000000000000000001}`
+	if err := json.Unmarshal([]byte(input), &v); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if v.Number.String() != "19.99" || v.Text.String() != "0.30000000000000000001" {
+		t.Errorf("decoded %s and %s", v.Number, v.Text)
+	}
+
+	out, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if want := `{"number":19.99,"text":"0.30000000000000000001"}`; string(out) != want {
+		t.Errorf("Marshal() = %s, want %s", out, want)
+	}
+
+	if err := json.Unmarshal([]byte(`{"number":null,"text":""}`), &v); err != nil {
+		t.Fatalf("Unmarshal(null) error = %v", err)
+	}
+	if !v.Number.IsZero() || !v.Text.IsZero() {
+		t.Errorf("null and empty string should decode to zero")
+	}
+
+	if err := json.Unmarshal([]byte(`{"number":1e999999999}`), &v); !errors.Is(err, spapi.ErrInvalidDecimal) {
+		t.Errorf("Unmarshal(1e999999999) error = %v, want ErrInvalidDecimal", err)
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package easy_ship_model_v2022_03_23
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。
+//
+// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回
+// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，
+// 可以通过 Calls 和 CallsTo 查看。
+type Fake struct {
+	spapi.FakeRecorder
+
+	// CreateScheduledPackageFunc 实现 CreateScheduledPackage。
+	CreateScheduledPackageFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// CreateScheduledPackageBulkFunc 实现 CreateScheduledPackageBulk。
+	CreateScheduledPackageBulkFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetScheduledPackageFunc 实现 GetScheduledPackage。
+	GetScheduledPackageFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// ListHandoverSlotsFunc 实现 ListHandoverSlots。
+	ListHandoverSlotsFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// UpdateScheduledPackagesFunc 实现 UpdateScheduledPackages。
+	UpdateScheduledPackagesFunc func(ctx context.Context, body interface{}) (interface{}, error)
+}
+
+// NewFake 创建未编程任何响应的 Fake。
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// CreateScheduledPackage 记录调用并执行 CreateScheduledPackageFunc。
+func (f *Fake) CreateScheduledPackage(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("CreateScheduledPackage", body)
+	if f.CreateScheduledPackageFunc == nil {
+		return nil, fmt.Errorf("CreateScheduledPackage: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateScheduledPackageFunc(ctx, body)
+}
+
+// CreateScheduledPackageBulk 记录调用并执行 CreateScheduledPackageBulkFunc。
+func (f *Fake) CreateScheduledPackageBulk(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("CreateScheduledPackageBulk", body)
+	if f.CreateScheduledPackageBulkFunc == nil {
+		return nil, fmt.Errorf("CreateScheduledPackageBulk: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateScheduledPackageBulkFunc(ctx, body)
+}
+
+// GetScheduledPackage 记录调用并执行 GetScheduledPackageFunc。
+func (f *Fake) GetScheduledPackage(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetScheduledPackage", query)
+	if f.GetScheduledPackageFunc == nil {
+		return nil, fmt.Errorf("GetScheduledPackage: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetScheduledPackageFunc(ctx, query)
+}
+
+// ListHandoverSlots 记录调用并执行 ListHandoverSlotsFunc。
+func (f *Fake) ListHandoverSlots(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("ListHandoverSlots", body)
+	if f.ListHandoverSlotsFunc == nil {
+		return nil, fmt.Errorf("ListHandoverSlots: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListHandoverSlotsFunc(ctx, body)
+}
+
+// UpdateScheduledPackages 记录调用并执行 UpdateScheduledPackagesFunc。
+func (f *Fake) UpdateScheduledPackages(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("UpdateScheduledPackages", body)
+	if f.UpdateScheduledPackagesFunc == nil {
+		return nil, fmt.Errorf("UpdateScheduledPackages: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.UpdateScheduledPackagesFunc(ctx, body)
+}
+
+// 确保 Fake 实现了 API。
+var _ API = (*Fake)(nil)
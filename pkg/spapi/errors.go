@@ -22,6 +22,10 @@ package spapi
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // 配置错误。
@@ -53,6 +57,15 @@ var (
 	// ErrClientNotInitialized 表示客户端未初始化。
 	ErrClientNotInitialized = errors.New("client not initialized")
 
+	// ErrClientClosed 表示客户端已关闭（或正在关闭），不再接受新请求。
+	ErrClientClosed = errors.New("client closed")
+
+	// ErrCircuitOpen 表示熔断器处于打开状态，请求未发送。
+	ErrCircuitOpen = errors.New("circuit breaker is open")
+
+	// ErrInvalidNotification 表示通知不是预期的类型或不属于本客户端。
+	ErrInvalidNotification = errors.New("invalid notification")
+
 	// ErrInvalidMarketplace 表示无效的市场配置。
 	ErrInvalidMarketplace = errors.New("invalid marketplace")
 
@@ -94,29 +107,115 @@ var (
 //
 // 此类型封装了 SP-API 的错误响应，包含：
 //   - HTTP 状态码
-//   - 错误代码（如 "InvalidInput"）
-//   - 错误消息
-//   - 额外的错误详情
+//   - 响应中的全部错误（Code、Message、Details 是第一个错误）
+//   - 请求 ID（x-amzn-RequestId，向 Amazon 提交支持工单时需要）
+//   - 操作和速率限制信息
+//   - 完整的响应头
+//
+// APIError 按状态码匹配本包的哨兵错误，可以使用 errors.Is 判断错误类别：
+//
+//	if errors.Is(err, spapi.ErrResourceNotFound) {
+//	    // HTTP 404
+//	}
+//
+//	var apiErr *spapi.APIError
+//	if errors.As(err, &apiErr) {
+//	    log.Printf("request ID: %s", apiErr.RequestID)
+//	}
 type APIError struct {
 	// StatusCode 是 HTTP 状态码
 	StatusCode int
 
-	// Code 是 SP-API 错误代码
+	// Code 是 SP-API 错误代码（第一个错误）
 	Code string
 
-	// Message 是错误消息
+	// Message 是错误消息（第一个错误）
 	Message string
 
-	// Details 是额外的错误详情
+	// Details 是额外的错误详情（第一个错误）
 	Details string
+
+	// Errors 是响应中的全部错误
+	Errors []ErrorDetail
+
+	// RequestID 是 x-amzn-RequestId 响应头的值
+	RequestID string
+
+	// Method 是请求的 HTTP 方法
+	Method string
+
+	// Path 是请求路径
+	Path string
+
+	// Operation 是速率限制使用的操作名称
+	Operation string
+
+	// RateLimit 是 x-amzn-RateLimit-Limit 响应头的值（每秒请求数），没有时为 0
+	RateLimit float64
+
+	// Header 是响应头
+	Header http.Header
+}
+
+// ErrorDetail 是 SP-API 错误响应中的一个错误。
+type ErrorDetail struct {
+	// Code 是错误代码（如 "InvalidInput"）
+	Code string `json:"code"`
+
+	// Message 是错误消息
+	Message string `json:"message"`
+
+	// Details 是额外的错误详情
+	Details string `json:"details,omitempty"`
 }
 
 // Error 实现 error 接口。
 func (e *APIError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "SP-API error (HTTP %d): ", e.StatusCode)
 	if e.Code != "" {
-		return fmt.Sprintf("SP-API error (HTTP %d): %s - %s", e.StatusCode, e.Code, e.Message)
+		b.WriteString(e.Code + " - ")
+	}
+	b.WriteString(e.Message)
+	if len(e.Errors) > 1 {
+		fmt.Fprintf(&b, " (and %d more)", len(e.Errors)-1)
 	}
-	return fmt.Sprintf("SP-API error (HTTP %d): %s", e.StatusCode, e.Message)
+	if e.RequestID != "" {
+		fmt.Fprintf(&b, " [request ID: %s]", e.RequestID)
+	}
+	return b.String()
+}
+
+// Is 按状态码匹配哨兵错误，供 errors.Is 使用。
+//
+// 对应关系：
+//   - 400: ErrInvalidRequest
+//   - 401: ErrUnauthorized、ErrAuthenticationFailed
+//   - 403: ErrForbidden
+//   - 404: ErrResourceNotFound
+//   - 408、504: ErrRequestTimeout
+//   - 429: ErrRateLimitExceeded
+//   - 5xx: ErrServerError，503 还匹配 ErrServiceUnavailable
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrInvalidRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized, ErrAuthenticationFailed:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrResourceNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRequestTimeout:
+		return e.StatusCode == http.StatusRequestTimeout || e.StatusCode == http.StatusGatewayTimeout
+	case ErrRateLimitExceeded:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServerError:
+		return e.StatusCode >= 500
+	case ErrServiceUnavailable:
+		return e.StatusCode == http.StatusServiceUnavailable
+	}
+	return false
 }
 
 // IsRetryable 判断错误是否可以重试。
@@ -127,3 +226,25 @@ func (e *APIError) IsRetryable() bool {
 	// 5xx 错误和 429 (Rate Limit) 错误可以重试
 	return e.StatusCode >= 500 || e.StatusCode == 429
 }
+
+// RetryAfter 返回重试前建议等待的时间。
+//
+// 优先使用 Retry-After 响应头（秒数或 HTTP 日期）；429 错误没有该响应头时，
+// 按 x-amzn-RateLimit-Limit 计算补充一个令牌所需的时间。
+//
+// 返回值:
+//   - time.Duration: 建议等待时间，无法确定时返回 0
+func (e *APIError) RetryAfter() time.Duration {
+	if value := e.Header.Get("Retry-After"); value != "" {
+		if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second
+		}
+		if date, err := http.ParseTime(value); err == nil {
+			return max(time.Until(date), 0)
+		}
+	}
+	if e.StatusCode == http.StatusTooManyRequests && e.RateLimit > 0 {
+		return time.Duration(float64(time.Second) / e.RateLimit)
+	}
+	return 0
+}
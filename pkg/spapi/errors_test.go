@@ -0,0 +1,135 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapi_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/spapitest"
+)
+
+func TestAPIError_Is(t *testing.T) {
+	tests := []struct {
+		status int
+		want   []error
+	}{
+		{http.StatusBadRequest, []error{spapi.ErrInvalidRequest}},
+		{http.StatusUnauthorized, []error{spapi.ErrUnauthorized, spapi.ErrAuthenticationFailed}},
+		{http.StatusForbidden, []error{spapi.ErrForbidden}},
+		{http.StatusNotFound, []error{spapi.ErrResourceNotFound}},
+		{http.StatusTooManyRequests, []error{spapi.ErrRateLimitExceeded}},
+		{http.StatusInternalServerError, []error{spapi.ErrServerError}},
+		{http.StatusServiceUnavailable, []error{spapi.ErrServerError, spapi.ErrServiceUnavailable}},
+		{http.StatusGatewayTimeout, []error{spapi.ErrServerError, spapi.ErrRequestTimeout}},
+	}
+	sentinels := []error{
+		spapi.ErrInvalidRequest, spapi.ErrUnauthorized, spapi.ErrAuthenticationFailed, spapi.ErrForbidden,
+		spapi.ErrResourceNotFound, spapi.ErrRequestTimeout, spapi.ErrRateLimitExceeded,
+		spapi.ErrServerError, spapi.ErrServiceUnavailable,
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			err := fmt.Errorf("GetOrder: %w", &spapi.APIError{StatusCode: tt.status})
+			for _, sentinel := range sentinels {
+				want := false
+				for _, w := range tt.want {
+					want = want || w == sentinel
+				}
+				if got := errors.Is(err, sentinel); got != want {
+					t.Errorf("errors.Is(%v) = %v, want %v", sentinel, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestAPIError_RetryAfter(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *spapi.APIError
+		want time.Duration
+	}{
+		{"Retry-After seconds", &spapi.APIError{StatusCode: 503, Header: http.Header{"Retry-After": {"3"}}}, 3 * time.Second},
+		{"rate limit", &spapi.APIError{StatusCode: 429, RateLimit: 0.5}, 2 * time.Second},
+		{"no hint", &spapi.APIError{StatusCode: 429}, 0},
+		{"not throttled", &spapi.APIError{StatusCode: 400, RateLimit: 0.5}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.RetryAfter(); got != tt.want {
+				t.Errorf("RetryAfter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_APIError(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+	srv.Handle(http.MethodGet, "/orders/v0/orders/{orderId}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("x-amzn-RateLimit-Limit", "0.5")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errors": [
+			{"code": "NotFound", "message": "Order not found"},
+			{"code": "InvalidInput", "message": "Bad marketplace", "details": "MarketplaceIds"}
+		]}`))
+	})
+
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	err = client.Get(context.Background(), "/orders/v0/orders/123-1234567-1234567", nil, nil)
+	if !errors.Is(err, spapi.ErrResourceNotFound) {
+		t.Fatalf("Get() error = %v, want ErrResourceNotFound", err)
+	}
+
+	var apiErr *spapi.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Get() error = %T, want *spapi.APIError", err)
+	}
+	if apiErr.Code != "NotFound" || len(apiErr.Errors) != 2 || apiErr.Errors[1].Details != "MarketplaceIds" {
+		t.Errorf("errors = %+v, want both errors", apiErr.Errors)
+	}
+	if apiErr.RequestID == "" || apiErr.RequestID != apiErr.Header.Get("x-amzn-RequestId") {
+		t.Errorf("RequestID = %q, want the x-amzn-RequestId header", apiErr.RequestID)
+	}
+	if apiErr.Method != http.MethodGet || apiErr.Path != "/orders/v0/orders/123-1234567-1234567" || apiErr.Operation == "" {
+		t.Errorf("request = %s %s (%s)", apiErr.Method, apiErr.Path, apiErr.Operation)
+	}
+	if apiErr.RateLimit != 0.5 {
+		t.Errorf("RateLimit = %v, want 0.5", apiErr.RateLimit)
+	}
+	want := "SP-API error (HTTP 404): NotFound - Order not found (and 1 more) [request ID: " + apiErr.RequestID + "]"
+	if apiErr.Error() != want {
+		t.Errorf("Error() = %q, want %q", apiErr.Error(), want)
+	}
+}
@@ -0,0 +1,304 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/auth"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/transport"
+)
+
+// 结构化日志事件的字段键。
+//
+// SDK 输出的所有事件使用相同的键，可以直接用于日志查询和告警。
+const (
+	LogKeyOperation   = "operation"
+	LogKeyMarketplace = "marketplace"
+	LogKeyRequestID   = "request_id"
+	LogKeyAttempt     = "attempt"
+	LogKeyMethod      = "method"
+	LogKeyStatus      = "status"
+	LogKeyDuration    = "duration"
+	LogKeyError       = "error"
+	LogKeyErrorType   = "error_type"
+	LogKeyWait        = "wait"
+	LogKeyPage        = "page"
+	LogKeyHasMore     = "has_more"
+	LogKeyGrantType   = "grant_type"
+	LogKeyExpiresIn   = "expires_in"
+
+	LogKeyState         = "state"
+	LogKeyPreviousState = "previous_state"
+	LogKeyCredential    = "credential"
+	LogKeySource        = "source"
+)
+
+// 结构化日志事件的消息。
+const (
+	// EventRequestStarted 在请求开始时输出（Debug）。
+	EventRequestStarted = "sp-api request started"
+
+	// EventRequestCompleted 在请求成功时输出（Debug），attempt 是实际发送的次数。
+	EventRequestCompleted = "sp-api request completed"
+
+	// EventRequestFailed 在请求失败时输出（Warn），包括 error_type 和 error。
+	EventRequestFailed = "sp-api request failed"
+
+	// EventRequestRetry 在重试前输出（Info），status 或 error 是上一次尝试的结果。
+	EventRequestRetry = "sp-api request retry"
+
+	// EventRequestHedged 在发送对冲请求时输出（Debug）。
+	EventRequestHedged = "sp-api request hedged"
+
+	// EventRateLimitWait 在请求等待速率限制令牌后输出（Debug）。
+	EventRateLimitWait = "sp-api rate limit wait"
+
+	// EventTokenRefreshed 在获取新的 LWA 访问令牌后输出（Info）。
+	EventTokenRefreshed = "sp-api token refreshed"
+
+	// EventTokenRefreshFailed 在获取 LWA 访问令牌失败时输出（Warn）。
+	EventTokenRefreshFailed = "sp-api token refresh failed"
+
+	// EventPageFetched 在获取分页结果的一页后输出（Debug），page 从 1 开始。
+	EventPageFetched = "sp-api page fetched"
+
+	// EventCircuitBreakerStateChanged 在熔断器状态变化时输出（Warn），包括 previous_state 和 state。
+	EventCircuitBreakerStateChanged = "sp-api circuit breaker state changed"
+
+	// EventCredentialsRotated 在替换客户端密钥或刷新令牌后输出（Info），
+	// credential 是 client_secret 或 refresh_token，source 是 provider、notification 或 manual。
+	EventCredentialsRotated = "sp-api credentials rotated"
+
+	// EventCredentialsReloadFailed 在从 CredentialsProvider 加载凭证失败时输出（Warn），继续使用当前凭证。
+	EventCredentialsReloadFailed = "sp-api credentials reload failed"
+)
+
+// attemptsKey 是 context 中请求尝试状态的键。
+type attemptsKey struct{}
+
+// hedgeKey 标记对冲请求的 context。
+type hedgeKey struct{}
+
+// attempts 记录一次 API 调用中实际发送的尝试（重试和对冲）。
+type attempts struct {
+	mu         sync.Mutex
+	count      int
+	lastStatus int
+	lastErr    error
+}
+
+// withAttempts 返回记录请求尝试的 context。
+func withAttempts(ctx context.Context) (context.Context, *attempts) {
+	a := &attempts{}
+	return context.WithValue(ctx, attemptsKey{}, a), a
+}
+
+// total 返回已发送的尝试次数。
+func (a *attempts) total() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.count
+}
+
+// requestFields 返回请求事件的公共字段。
+func requestFields(method, operation, marketplace string) []Field {
+	return []Field{
+		{LogKeyOperation, operation},
+		{LogKeyMarketplace, marketplace},
+		{LogKeyMethod, method},
+	}
+}
+
+// attemptMiddleware 为每次实际发送的请求编号，并在重试和对冲时输出事件。
+//
+// 它在重试和对冲中间件内侧，attempt 从 1 开始。
+func (c *Client) attemptMiddleware() transport.Middleware {
+	return func(next transport.Handler) transport.Handler {
+		return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			a, ok := ctx.Value(attemptsKey{}).(*attempts)
+			if !ok {
+				return next(ctx, req)
+			}
+
+			a.mu.Lock()
+			a.count++
+			attempt, lastStatus, lastErr := a.count, a.lastStatus, a.lastErr
+			a.mu.Unlock()
+
+			if attempt > 1 {
+				fields := append(requestFields(req.Method, c.extractOperationName(req.Method, req.URL.Path), c.extractMarketplaceID(req.URL.Query())),
+					Field{LogKeyAttempt, attempt})
+				if hedged, _ := ctx.Value(hedgeKey{}).(bool); hedged {
+					c.config.Logger.Debug(EventRequestHedged, fields...)
+				} else {
+					if lastErr != nil {
+						fields = append(fields, Field{LogKeyError, lastErr.Error()})
+					} else {
+						fields = append(fields, Field{LogKeyStatus, lastStatus})
+					}
+					c.config.Logger.Info(EventRequestRetry, fields...)
+				}
+			}
+
+			resp, err := next(ctx, req)
+			a.mu.Lock()
+			a.lastErr = err
+			if resp != nil {
+				a.lastStatus = resp.StatusCode
+			}
+			a.mu.Unlock()
+			return resp, err
+		}
+	}
+}
+
+// logRequest 输出请求完成或失败的事件。
+func (c *Client) logRequest(method, operation, marketplace string, resp *http.Response, a *attempts, duration time.Duration, errorType string, err error) {
+	fields := requestFields(method, operation, marketplace)
+	if resp != nil {
+		fields = append(fields, Field{LogKeyStatus, resp.StatusCode})
+		if requestID := resp.Header.Get("x-amzn-RequestId"); requestID != "" {
+			fields = append(fields, Field{LogKeyRequestID, requestID})
+		}
+	}
+	fields = append(fields, Field{LogKeyAttempt, a.total()}, Field{LogKeyDuration, duration})
+
+	if err == nil {
+		c.config.Logger.Debug(EventRequestCompleted, fields...)
+		return
+	}
+	fields = append(fields, Field{LogKeyErrorType, classifyError(err, errorType)}, Field{LogKeyError, err.Error()})
+	c.config.Logger.Warn(EventRequestFailed, fields...)
+}
+
+// loggingEnabled 报告是否设置了 Logger（不是 no-op）。
+func (c *Client) loggingEnabled() bool {
+	_, noop := c.config.Logger.(*noOpLogger)
+	return !noop
+}
+
+// tokenRefreshHook 返回输出令牌刷新事件的回调。
+func tokenRefreshHook(logger Logger) auth.RefreshHook {
+	return func(grantType string, token *auth.Token, err error) {
+		if err != nil {
+			logger.Warn(EventTokenRefreshFailed, Field{LogKeyGrantType, grantType}, Field{LogKeyError, err.Error()})
+			return
+		}
+		logger.Info(EventTokenRefreshed,
+			Field{LogKeyGrantType, grantType},
+			Field{LogKeyExpiresIn, time.Duration(token.ExpiresIn) * time.Second},
+		)
+	}
+}
+
+// 分页令牌的请求参数和响应字段。
+var (
+	pageTokenParams = []string{"nextToken", "NextToken", "pageToken", "paginationToken"}
+	pageTokenFields = map[string]bool{"nextToken": true, "NextToken": true}
+)
+
+// maxTrackedPages 是记录的下一页令牌数上限，超过时清空。
+const maxTrackedPages = 1024
+
+// pageTracker 根据响应中的下一页令牌推算分页请求的页码。
+type pageTracker struct {
+	mu    sync.Mutex
+	pages map[string]int
+}
+
+// logPage 在分页响应后输出 EventPageFetched。
+//
+// 请求没有分页令牌时是第 1 页；带令牌时，页码来自返回该令牌的上一页。
+// 只解析成功响应的消息体，Logger 是 no-op 时不调用。
+func (c *Client) logPage(req *http.Request, resp *http.Response, body []byte) {
+	token := pageToken(req.URL.Query())
+	next := findNextToken(body)
+	if token == "" && next == "" {
+		return
+	}
+
+	t := &c.pages
+	t.mu.Lock()
+	page := 1
+	if token != "" {
+		page = 2 // 令牌不是本客户端返回的（如调用方自己分页）
+		if p, ok := t.pages[token]; ok {
+			page = p
+			delete(t.pages, token)
+		}
+	}
+	if next != "" {
+		if t.pages == nil || len(t.pages) >= maxTrackedPages {
+			t.pages = make(map[string]int)
+		}
+		t.pages[next] = page + 1
+	}
+	t.mu.Unlock()
+
+	fields := requestFields(req.Method, c.extractOperationName(req.Method, req.URL.Path), c.extractMarketplaceID(req.URL.Query()))
+	if requestID := resp.Header.Get("x-amzn-RequestId"); requestID != "" {
+		fields = append(fields, Field{LogKeyRequestID, requestID})
+	}
+	fields = append(fields, Field{LogKeyPage, page}, Field{LogKeyHasMore, next != ""})
+	c.config.Logger.Debug(EventPageFetched, fields...)
+}
+
+// pageToken 返回请求中的分页令牌。
+func pageToken(query url.Values) string {
+	for _, param := range pageTokenParams {
+		if token := query.Get(param); token != "" {
+			return token
+		}
+	}
+	return ""
+}
+
+// findNextToken 在响应的前三层对象中查找下一页令牌
+// （如 nextToken、payload.NextToken、pagination.nextToken）。
+func findNextToken(body []byte) string {
+	var root map[string]interface{}
+	if json.Unmarshal(body, &root) != nil {
+		return ""
+	}
+
+	level := []map[string]interface{}{root}
+	for depth := 0; depth < 3 && len(level) > 0; depth++ {
+		var children []map[string]interface{}
+		for _, obj := range level {
+			for key, value := range obj {
+				if token, ok := value.(string); ok && token != "" && pageTokenFields[key] {
+					return token
+				}
+				if child, ok := value.(map[string]interface{}); ok {
+					children = append(children, child)
+				}
+			}
+		}
+		level = children
+	}
+	return ""
+}
@@ -0,0 +1,139 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapi_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/spapitest"
+)
+
+// TestLogEvents 测试请求、重试、令牌刷新、速率限制等待和分页的结构化事件。
+func TestLogEvents(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+	var calls atomic.Int32
+	srv.Handle(http.MethodGet, "/orders/v0/orders/{orderId}", func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{}`))
+	})
+	srv.Handle(http.MethodGet, "/orders/v0/orders", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("NextToken") == "" {
+			w.Write([]byte(`{"payload":{"Orders":[],"NextToken":"page-2"}}`))
+			return
+		}
+		w.Write([]byte(`{"payload":{"Orders":[]}}`))
+	})
+	srv.Handle(http.MethodGet, "/sellers/v1/marketplaceParticipations", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"errors":[{"code":"Unauthorized","message":"Access to requested resource is denied."}]}`))
+	})
+
+	logger := newRecordingLogger()
+	client, err := srv.NewClient(spapi.WithLogger(logger), spapi.WithSellerID("SELLER1"), spapi.WithMaxRetries(1), spapi.WithRateLimitWait())
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	ctx := context.Background()
+
+	if err := client.Get(ctx, "/orders/v0/orders/123", nil, nil); err != nil {
+		t.Fatalf("Get(getOrder) error = %v", err)
+	}
+	query := url.Values{"MarketplaceIds": {"ATVPDKIKX0DER"}}
+	if err := client.GetValues(ctx, "/orders/v0/orders", query, nil); err != nil {
+		t.Fatalf("GetValues(getOrders) error = %v", err)
+	}
+	query.Set("NextToken", "page-2")
+	if err := client.GetValues(ctx, "/orders/v0/orders", query, nil); err != nil {
+		t.Fatalf("GetValues(getOrders, page 2) error = %v", err)
+	}
+	if err := client.Get(ctx, "/sellers/v1/marketplaceParticipations", nil, nil); err == nil {
+		t.Fatal("Get(getMarketplaceParticipations) error = nil, want 403")
+	}
+
+	// 清空令牌桶，下一个请求需要等待
+	manager := client.RateLimitManager()
+	appID := client.Config().ClientID
+	if err := manager.UpdateRate("SELLER1", appID, "global", "orders-v0:getOrder", 20, 1); err != nil {
+		t.Fatal(err)
+	}
+	for manager.Allow("SELLER1", appID, "global", "orders-v0:getOrder") {
+	}
+	if err := client.Get(ctx, "/orders/v0/orders/456", nil, nil); err != nil {
+		t.Fatalf("Get(getOrder) error = %v", err)
+	}
+
+	out := logger.output()
+	for _, want := range []string{
+		"INFO sp-api token refreshed grant_type=refresh_token expires_in=1h0m0s",
+		"DEBUG sp-api request started operation=orders-v0:getOrder marketplace=global method=GET",
+		"INFO sp-api request retry operation=orders-v0:getOrder marketplace=global method=GET attempt=2 status=503",
+		"DEBUG sp-api request completed operation=orders-v0:getOrder marketplace=global method=GET status=200 request_id=spapitest-",
+		"DEBUG sp-api page fetched operation=orders-v0:getOrders marketplace=ATVPDKIKX0DER method=GET request_id=spapitest-",
+		"page=1 has_more=true",
+		"page=2 has_more=false",
+		"WARN sp-api request failed operation=sellers-v1:getMarketplaceParticipations marketplace=global method=GET status=403",
+		"attempt=1",
+		"error_type=client error=",
+		"DEBUG sp-api rate limit wait operation=orders-v0:getOrder marketplace=global wait=",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("log output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+// TestNewSlogLogger 测试 slog 适配器的级别和字段。
+func TestNewSlogLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := spapi.NewSlogLogger(slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo})))
+
+	logger.Debug("hidden", spapi.Field{Key: spapi.LogKeyOperation, Value: "orders-v0:getOrder"})
+	logger.With(spapi.Field{Key: "seller", Value: "SELLER1"}).Warn(spapi.EventRequestFailed,
+		spapi.Field{Key: spapi.LogKeyOperation, Value: "orders-v0:getOrder"},
+		spapi.Field{Key: spapi.LogKeyAttempt, Value: 2},
+	)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("output = %q, want one record (debug disabled)", buf.String())
+	}
+	var record map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[0]), &record); err != nil {
+		t.Fatal(err)
+	}
+	if record["level"] != "WARN" || record["msg"] != spapi.EventRequestFailed ||
+		record["seller"] != "SELLER1" || record[spapi.LogKeyOperation] != "orders-v0:getOrder" || record[spapi.LogKeyAttempt] != float64(2) {
+		t.Errorf("record = %v", record)
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapi
+
+import (
+	"errors"
+	"iter"
+	"slices"
+	"sync"
+)
+
+// ErrFakeNotConfigured 表示调用了 Fake 中未设置响应函数的方法。
+var ErrFakeNotConfigured = errors.New("fake method not configured")
+
+// FakeCall 是 Fake 记录的一次方法调用。
+type FakeCall struct {
+	// Method 是被调用的方法名（如 "GetOrder"）
+	Method string
+
+	// Args 是除 ctx 以外的参数，按声明顺序排列
+	Args []interface{}
+}
+
+// FakeRecorder 记录对生成的 Fake 的调用，可安全地并发使用。
+//
+// 每个 API 包生成的 Fake 都嵌入了 FakeRecorder，
+// 因此可以直接调用 fake.Calls()、fake.CallsTo("GetOrder") 等方法。
+type FakeRecorder struct {
+	mu    sync.Mutex
+	calls []FakeCall
+}
+
+// Record 记录一次调用。
+//
+// 参数:
+//   - method: 方法名
+//   - args: 除 ctx 以外的参数
+func (r *FakeRecorder) Record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, FakeCall{Method: method, Args: args})
+}
+
+// Calls 按调用顺序返回所有记录的调用。
+func (r *FakeRecorder) Calls() []FakeCall {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.calls)
+}
+
+// CallsTo 按调用顺序返回对指定方法的调用。
+//
+// 参数:
+//   - method: 方法名（如 "GetOrder"）
+//
+// 返回值:
+//   - []FakeCall: 对该方法的调用，没有调用时为空
+func (r *FakeRecorder) CallsTo(method string) []FakeCall {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var calls []FakeCall
+	for _, call := range r.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// ResetCalls 清空记录的调用。
+func (r *FakeRecorder) ResetCalls() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
+
+// FakeSeq 返回依次产生 items 的迭代器，便于为 Fake 的 Iterate* 方法编程。
+//
+// 如果 err 不为 nil，迭代器在产生所有元素后再产生一次该错误，
+// 用于模拟分页中途失败。
+//
+// 参数:
+//   - items: 要产生的元素
+//   - err: 最后产生的错误，可以为 nil
+//
+// 返回值:
+//   - iter.Seq2[T, error]: 迭代器
+//
+// 示例:
+//
+//	fake := orders_v0.NewFake()
+//	fake.IterateOrdersFunc = func(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+//	    return spapi.FakeSeq([]map[string]interface{}{{"AmazonOrderId": "1"}}, nil)
+//	}
+func FakeSeq[T any](items []T, err error) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for _, item := range items {
+			if !yield(item, nil) {
+				return
+			}
+		}
+		if err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
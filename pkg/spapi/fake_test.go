@@ -0,0 +1,116 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapi_test
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"reflect"
+	"testing"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	orders "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/orders-v0"
+)
+
+// syncOrders 模拟依赖 orders API 的业务代码：遍历订单并获取每个订单的订单项。
+func syncOrders(ctx context.Context, api orders.API) (int, error) {
+	items := 0
+	for order, err := range api.IterateOrders(ctx, map[string]string{"MarketplaceIds": "ATVPDKIKX0DER"}) {
+		if err != nil {
+			return items, err
+		}
+		if _, err := api.GetOrderItems(ctx, order["AmazonOrderId"].(string), nil); err != nil {
+			return items, err
+		}
+		items++
+	}
+	return items, nil
+}
+
+func TestFake(t *testing.T) {
+	fake := orders.NewFake()
+	fake.IterateOrdersFunc = func(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+		return spapi.FakeSeq([]map[string]interface{}{{"AmazonOrderId": "A"}, {"AmazonOrderId": "B"}}, nil)
+	}
+	fake.GetOrderItemsFunc = func(ctx context.Context, orderID string, query map[string]string) (interface{}, error) {
+		return map[string]interface{}{"payload": map[string]interface{}{"AmazonOrderId": orderID}}, nil
+	}
+
+	n, err := syncOrders(context.Background(), fake)
+	if err != nil || n != 2 {
+		t.Fatalf("syncOrders() = %d, %v; want 2, nil", n, err)
+	}
+
+	want := []spapi.FakeCall{
+		{Method: "IterateOrders", Args: []interface{}{map[string]string{"MarketplaceIds": "ATVPDKIKX0DER"}}},
+		{Method: "GetOrderItems", Args: []interface{}{"A", map[string]string(nil)}},
+		{Method: "GetOrderItems", Args: []interface{}{"B", map[string]string(nil)}},
+	}
+	if got := fake.Calls(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Calls() = %+v, want %+v", got, want)
+	}
+	if got := fake.CallsTo("GetOrderItems"); len(got) != 2 {
+		t.Errorf("CallsTo(GetOrderItems) = %d calls, want 2", len(got))
+	}
+
+	fake.ResetCalls()
+	if got := fake.Calls(); len(got) != 0 {
+		t.Errorf("Calls() after ResetCalls = %+v, want none", got)
+	}
+}
+
+func TestFake_NotConfigured(t *testing.T) {
+	fake := orders.NewFake()
+
+	if _, err := fake.GetOrder(context.Background(), "A", nil); !errors.Is(err, spapi.ErrFakeNotConfigured) {
+		t.Errorf("GetOrder() error = %v, want ErrFakeNotConfigured", err)
+	}
+	if _, err := syncOrders(context.Background(), fake); !errors.Is(err, spapi.ErrFakeNotConfigured) {
+		t.Errorf("IterateOrders() error = %v, want ErrFakeNotConfigured", err)
+	}
+	if got := len(fake.Calls()); got != 2 {
+		t.Errorf("Calls() = %d, want 2 (unconfigured calls are recorded too)", got)
+	}
+}
+
+func TestFakeSeq(t *testing.T) {
+	failure := errors.New("page 2 failed")
+
+	var items []int
+	var gotErr error
+	for item, err := range spapi.FakeSeq([]int{1, 2}, failure) {
+		if err != nil {
+			gotErr = err
+			break
+		}
+		items = append(items, item)
+	}
+	if !reflect.DeepEqual(items, []int{1, 2}) || gotErr != failure {
+		t.Errorf("FakeSeq() produced %v, %v; want [1 2], %v", items, gotErr, failure)
+	}
+
+	for item := range spapi.FakeSeq([]int{1, 2, 3}, nil) {
+		if item == 2 {
+			break
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package fba_inventory_v1
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。
+//
+// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回
+// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，
+// 可以通过 Calls 和 CallsTo 查看。
+type Fake struct {
+	spapi.FakeRecorder
+
+	// AddInventoryFunc 实现 AddInventory。
+	AddInventoryFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// CreateInventoryItemFunc 实现 CreateInventoryItem。
+	CreateInventoryItemFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// DeleteInventoryItemFunc 实现 DeleteInventoryItem。
+	DeleteInventoryItemFunc func(ctx context.Context, sellerSku string) (interface{}, error)
+
+	// GetInventorySummariesFunc 实现 GetInventorySummaries。
+	GetInventorySummariesFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// IterateInventorySummariesFunc 实现 IterateInventorySummaries。
+	IterateInventorySummariesFunc func(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+}
+
+// NewFake 创建未编程任何响应的 Fake。
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// AddInventory 记录调用并执行 AddInventoryFunc。
+func (f *Fake) AddInventory(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("AddInventory", body)
+	if f.AddInventoryFunc == nil {
+		return nil, fmt.Errorf("AddInventory: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.AddInventoryFunc(ctx, body)
+}
+
+// CreateInventoryItem 记录调用并执行 CreateInventoryItemFunc。
+func (f *Fake) CreateInventoryItem(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("CreateInventoryItem", body)
+	if f.CreateInventoryItemFunc == nil {
+		return nil, fmt.Errorf("CreateInventoryItem: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateInventoryItemFunc(ctx, body)
+}
+
+// DeleteInventoryItem 记录调用并执行 DeleteInventoryItemFunc。
+func (f *Fake) DeleteInventoryItem(ctx context.Context, sellerSku string) (interface{}, error) {
+	f.Record("DeleteInventoryItem", sellerSku)
+	if f.DeleteInventoryItemFunc == nil {
+		return nil, fmt.Errorf("DeleteInventoryItem: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.DeleteInventoryItemFunc(ctx, sellerSku)
+}
+
+// GetInventorySummaries 记录调用并执行 GetInventorySummariesFunc。
+func (f *Fake) GetInventorySummaries(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetInventorySummaries", query)
+	if f.GetInventorySummariesFunc == nil {
+		return nil, fmt.Errorf("GetInventorySummaries: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetInventorySummariesFunc(ctx, query)
+}
+
+// IterateInventorySummaries 记录调用并执行 IterateInventorySummariesFunc。
+func (f *Fake) IterateInventorySummaries(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+	f.Record("IterateInventorySummaries", query)
+	if f.IterateInventorySummariesFunc == nil {
+		return spapi.FakeSeq[map[string]interface{}](nil, fmt.Errorf("IterateInventorySummaries: %w", spapi.ErrFakeNotConfigured))
+	}
+	return f.IterateInventorySummariesFunc(ctx, query)
+}
+
+// 确保 Fake 实现了 API。
+var _ API = (*Fake)(nil)
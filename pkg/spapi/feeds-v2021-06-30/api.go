@@ -0,0 +1,66 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package feeds_v2021_06_30
+
+import (
+	"context"
+	"iter"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// API 是 feeds API v2021-06-30 客户端的全部方法，包括分页迭代器等辅助方法。
+//
+// 业务代码依赖 API 而不是 *Client，即可在单元测试中用 Fake 替换真实客户端。
+type API interface {
+	// CancelFeed
+	// Method: DELETE | Path: /feeds/2021-06-30/feeds/{feedId}
+	CancelFeed(ctx context.Context, feedId string) (interface{}, error)
+
+	// CreateFeed
+	// Method: POST | Path: /feeds/2021-06-30/feeds
+	CreateFeed(ctx context.Context, body interface{}) (interface{}, error)
+
+	// CreateFeedDocument
+	// Method: POST | Path: /feeds/2021-06-30/documents
+	CreateFeedDocument(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetFeed
+	// Method: GET | Path: /feeds/2021-06-30/feeds/{feedId}
+	GetFeed(ctx context.Context, feedId string, query map[string]string) (interface{}, error)
+
+	// GetFeedDocument
+	// Method: GET | Path: /feeds/2021-06-30/documents/{feedDocumentId}
+	GetFeedDocument(ctx context.Context, feedDocumentId string, query map[string]string) (interface{}, error)
+
+	// GetFeeds
+	// Method: GET | Path: /feeds/2021-06-30/feeds
+	GetFeeds(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// IterateFeeds 返回 Feed 迭代器，自动处理分页。
+	IterateFeeds(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// WaitForFeed 轮询 GetFeed 直到 Feed 到达终止状态（DONE、CANCELLED、FATAL）。
+	WaitForFeed(ctx context.Context, feedID string, opts ...spapi.PollerOption) (interface{}, error)
+}
+
+// 确保 Client 实现了 API。
+var _ API = (*Client)(nil)
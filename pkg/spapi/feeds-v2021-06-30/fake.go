@@ -0,0 +1,142 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package feeds_v2021_06_30
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。
+//
+// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回
+// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，
+// 可以通过 Calls 和 CallsTo 查看。
+type Fake struct {
+	spapi.FakeRecorder
+
+	// CancelFeedFunc 实现 CancelFeed。
+	CancelFeedFunc func(ctx context.Context, feedId string) (interface{}, error)
+
+	// CreateFeedFunc 实现 CreateFeed。
+	CreateFeedFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// CreateFeedDocumentFunc 实现 CreateFeedDocument。
+	CreateFeedDocumentFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetFeedFunc 实现 GetFeed。
+	GetFeedFunc func(ctx context.Context, feedId string, query map[string]string) (interface{}, error)
+
+	// GetFeedDocumentFunc 实现 GetFeedDocument。
+	GetFeedDocumentFunc func(ctx context.Context, feedDocumentId string, query map[string]string) (interface{}, error)
+
+	// GetFeedsFunc 实现 GetFeeds。
+	GetFeedsFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// IterateFeedsFunc 实现 IterateFeeds。
+	IterateFeedsFunc func(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// WaitForFeedFunc 实现 WaitForFeed。
+	WaitForFeedFunc func(ctx context.Context, feedID string, opts ...spapi.PollerOption) (interface{}, error)
+}
+
+// NewFake 创建未编程任何响应的 Fake。
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// CancelFeed 记录调用并执行 CancelFeedFunc。
+func (f *Fake) CancelFeed(ctx context.Context, feedId string) (interface{}, error) {
+	f.Record("CancelFeed", feedId)
+	if f.CancelFeedFunc == nil {
+		return nil, fmt.Errorf("CancelFeed: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CancelFeedFunc(ctx, feedId)
+}
+
+// CreateFeed 记录调用并执行 CreateFeedFunc。
+func (f *Fake) CreateFeed(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("CreateFeed", body)
+	if f.CreateFeedFunc == nil {
+		return nil, fmt.Errorf("CreateFeed: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateFeedFunc(ctx, body)
+}
+
+// CreateFeedDocument 记录调用并执行 CreateFeedDocumentFunc。
+func (f *Fake) CreateFeedDocument(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("CreateFeedDocument", body)
+	if f.CreateFeedDocumentFunc == nil {
+		return nil, fmt.Errorf("CreateFeedDocument: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateFeedDocumentFunc(ctx, body)
+}
+
+// GetFeed 记录调用并执行 GetFeedFunc。
+func (f *Fake) GetFeed(ctx context.Context, feedId string, query map[string]string) (interface{}, error) {
+	f.Record("GetFeed", feedId, query)
+	if f.GetFeedFunc == nil {
+		return nil, fmt.Errorf("GetFeed: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetFeedFunc(ctx, feedId, query)
+}
+
+// GetFeedDocument 记录调用并执行 GetFeedDocumentFunc。
+func (f *Fake) GetFeedDocument(ctx context.Context, feedDocumentId string, query map[string]string) (interface{}, error) {
+	f.Record("GetFeedDocument", feedDocumentId, query)
+	if f.GetFeedDocumentFunc == nil {
+		return nil, fmt.Errorf("GetFeedDocument: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetFeedDocumentFunc(ctx, feedDocumentId, query)
+}
+
+// GetFeeds 记录调用并执行 GetFeedsFunc。
+func (f *Fake) GetFeeds(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetFeeds", query)
+	if f.GetFeedsFunc == nil {
+		return nil, fmt.Errorf("GetFeeds: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetFeedsFunc(ctx, query)
+}
+
+// IterateFeeds 记录调用并执行 IterateFeedsFunc。
+func (f *Fake) IterateFeeds(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+	f.Record("IterateFeeds", query)
+	if f.IterateFeedsFunc == nil {
+		return spapi.FakeSeq[map[string]interface{}](nil, fmt.Errorf("IterateFeeds: %w", spapi.ErrFakeNotConfigured))
+	}
+	return f.IterateFeedsFunc(ctx, query)
+}
+
+// WaitForFeed 记录调用并执行 WaitForFeedFunc。
+func (f *Fake) WaitForFeed(ctx context.Context, feedID string, opts ...spapi.PollerOption) (interface{}, error) {
+	f.Record("WaitForFeed", feedID, opts)
+	if f.WaitForFeedFunc == nil {
+		return nil, fmt.Errorf("WaitForFeed: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.WaitForFeedFunc(ctx, feedID, opts...)
+}
+
+// 确保 Fake 实现了 API。
+var _ API = (*Fake)(nil)
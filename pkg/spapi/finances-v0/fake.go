@@ -0,0 +1,130 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package finances_v0
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。
+//
+// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回
+// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，
+// 可以通过 Calls 和 CallsTo 查看。
+type Fake struct {
+	spapi.FakeRecorder
+
+	// IterateFinancialEventGroupsFunc 实现 IterateFinancialEventGroups。
+	IterateFinancialEventGroupsFunc func(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// IterateFinancialEventsFunc 实现 IterateFinancialEvents。
+	IterateFinancialEventsFunc func(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// IterateFinancialEventsByGroupIdFunc 实现 IterateFinancialEventsByGroupId。
+	IterateFinancialEventsByGroupIdFunc func(ctx context.Context, eventGroupId string, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// ListFinancialEventGroupsFunc 实现 ListFinancialEventGroups。
+	ListFinancialEventGroupsFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// ListFinancialEventsFunc 实现 ListFinancialEvents。
+	ListFinancialEventsFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// ListFinancialEventsByGroupIdFunc 实现 ListFinancialEventsByGroupId。
+	ListFinancialEventsByGroupIdFunc func(ctx context.Context, eventGroupId string, query map[string]string) (interface{}, error)
+
+	// ListFinancialEventsByOrderIdFunc 实现 ListFinancialEventsByOrderId。
+	ListFinancialEventsByOrderIdFunc func(ctx context.Context, orderId string, query map[string]string) (interface{}, error)
+}
+
+// NewFake 创建未编程任何响应的 Fake。
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// IterateFinancialEventGroups 记录调用并执行 IterateFinancialEventGroupsFunc。
+func (f *Fake) IterateFinancialEventGroups(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+	f.Record("IterateFinancialEventGroups", query)
+	if f.IterateFinancialEventGroupsFunc == nil {
+		return spapi.FakeSeq[map[string]interface{}](nil, fmt.Errorf("IterateFinancialEventGroups: %w", spapi.ErrFakeNotConfigured))
+	}
+	return f.IterateFinancialEventGroupsFunc(ctx, query)
+}
+
+// IterateFinancialEvents 记录调用并执行 IterateFinancialEventsFunc。
+func (f *Fake) IterateFinancialEvents(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+	f.Record("IterateFinancialEvents", query)
+	if f.IterateFinancialEventsFunc == nil {
+		return spapi.FakeSeq[map[string]interface{}](nil, fmt.Errorf("IterateFinancialEvents: %w", spapi.ErrFakeNotConfigured))
+	}
+	return f.IterateFinancialEventsFunc(ctx, query)
+}
+
+// IterateFinancialEventsByGroupId 记录调用并执行 IterateFinancialEventsByGroupIdFunc。
+func (f *Fake) IterateFinancialEventsByGroupId(ctx context.Context, eventGroupId string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+	f.Record("IterateFinancialEventsByGroupId", eventGroupId, query)
+	if f.IterateFinancialEventsByGroupIdFunc == nil {
+		return spapi.FakeSeq[map[string]interface{}](nil, fmt.Errorf("IterateFinancialEventsByGroupId: %w", spapi.ErrFakeNotConfigured))
+	}
+	return f.IterateFinancialEventsByGroupIdFunc(ctx, eventGroupId, query)
+}
+
+// ListFinancialEventGroups 记录调用并执行 ListFinancialEventGroupsFunc。
+func (f *Fake) ListFinancialEventGroups(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("ListFinancialEventGroups", query)
+	if f.ListFinancialEventGroupsFunc == nil {
+		return nil, fmt.Errorf("ListFinancialEventGroups: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListFinancialEventGroupsFunc(ctx, query)
+}
+
+// ListFinancialEvents 记录调用并执行 ListFinancialEventsFunc。
+func (f *Fake) ListFinancialEvents(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("ListFinancialEvents", query)
+	if f.ListFinancialEventsFunc == nil {
+		return nil, fmt.Errorf("ListFinancialEvents: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListFinancialEventsFunc(ctx, query)
+}
+
+// ListFinancialEventsByGroupId 记录调用并执行 ListFinancialEventsByGroupIdFunc。
+func (f *Fake) ListFinancialEventsByGroupId(ctx context.Context, eventGroupId string, query map[string]string) (interface{}, error) {
+	f.Record("ListFinancialEventsByGroupId", eventGroupId, query)
+	if f.ListFinancialEventsByGroupIdFunc == nil {
+		return nil, fmt.Errorf("ListFinancialEventsByGroupId: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListFinancialEventsByGroupIdFunc(ctx, eventGroupId, query)
+}
+
+// ListFinancialEventsByOrderId 记录调用并执行 ListFinancialEventsByOrderIdFunc。
+func (f *Fake) ListFinancialEventsByOrderId(ctx context.Context, orderId string, query map[string]string) (interface{}, error) {
+	f.Record("ListFinancialEventsByOrderId", orderId, query)
+	if f.ListFinancialEventsByOrderIdFunc == nil {
+		return nil, fmt.Errorf("ListFinancialEventsByOrderId: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListFinancialEventsByOrderIdFunc(ctx, orderId, query)
+}
+
+// 确保 Fake 实现了 API。
+var _ API = (*Fake)(nil)
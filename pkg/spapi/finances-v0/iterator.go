@@ -17,24 +17,6 @@
 // Unless you have obtained a commercial license, this file is licensed
 // under AGPL-3.0. By using this software, you agree to comply with the
 // terms of the applicable license.
-//
-// This file is part of Amazon SP-API Go SDK.
-//
-// Amazon SP-API Go SDK is dual-licensed:
-//
-// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
-//    - Free for personal, educational, and open source projects
-//    - Your project must also be open sourced under AGPL-3.0
-//    - See: https://www.gnu.org/licenses/agpl-3.0.html
-//
-// 2. Commercial License for proprietary/commercial use
-//    - Required for any commercial, enterprise, or proprietary use
-//    - Allows closed source distribution
-//    - Contact: vanling1111@gmail.com
-//
-// Unless you have obtained a commercial license, this file is licensed
-// under AGPL-3.0. By using this software, you agree to comply with the
-// terms of the applicable license.
 
 package finances_v0
 
@@ -46,11 +28,15 @@ import (
 	"github.com/pkg/errors"
 )
 
-// IterateFinancialEvents 杩斿洖杩唬鍣紝鑷姩澶勭悊鍒嗛〉銆?
+// IterateFinancialEvents 返回财务事件迭代器，自动处理分页。
+//
+// 每页产出一次 FinancialEvents 对象，并自动处理 NextToken 分页逻辑。
 //
-// 浣跨敤 Go 1.25 杩唬鍣ㄧ壒鎬э紝鑷姩澶勭悊 NextToken 鍒嗛〉閫昏緫銆?
+// 参数:
+//   - ctx: 请求上下文
+//   - query: 查询参数
 //
-// 绀轰緥:
+// 示例:
 //
 //	for item, err := range client.IterateFinancialEvents(ctx, query) {
 //	    if err != nil { return err }
@@ -58,6 +44,14 @@ import (
 //	}
 func (c *Client) IterateFinancialEvents(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
 	return func(yield func(map[string]interface{}, error) bool) {
+		// 登记到客户端，Close 等待迭代结束
+		ctx, release, err := c.baseClient.Acquire(ctx)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		defer release()
+
 		currentQuery := make(map[string]string)
 		for k, v := range query {
 			currentQuery[k] = v
@@ -82,25 +76,20 @@ func (c *Client) IterateFinancialEvents(ctx context.Context, query map[string]st
 				return
 			}
 
-			// 鑾峰彇鏁版嵁鏁扮粍
-			items, ok := response["FinancialEvents"].([]interface{})
-			if !ok || items == nil {
+			// 获取 FinancialEvents 对象
+			payload, ok := response["payload"].(map[string]interface{})
+			if !ok {
 				break
 			}
 
-			// 閬嶅巻褰撳墠椤?
-			for _, item := range items {
-				itemMap, ok := item.(map[string]interface{})
-				if !ok {
-					continue
-				}
-				if !yield(itemMap, nil) {
+			if page, ok := payload["FinancialEvents"].(map[string]interface{}); ok {
+				if !yield(page, nil) {
 					return
 				}
 			}
 
-			// 妫€鏌ヤ笅涓€椤?
-			nextToken, _ := response["NextToken"].(string)
+			// 检查下一页
+			nextToken, _ := payload["NextToken"].(string)
 			if nextToken == "" {
 				break
 			}
@@ -110,11 +99,15 @@ func (c *Client) IterateFinancialEvents(ctx context.Context, query map[string]st
 	}
 }
 
-// IterateFinancialEventGroups 杩斿洖杩唬鍣紝鑷姩澶勭悊鍒嗛〉銆?
+// IterateFinancialEventGroups 返回财务事件组迭代器，自动处理分页。
+//
+// 使用 Go 1.25 迭代器特性，自动处理 NextToken 分页逻辑。
 //
-// 浣跨敤 Go 1.25 杩唬鍣ㄧ壒鎬э紝鑷姩澶勭悊 NextToken 鍒嗛〉閫昏緫銆?
+// 参数:
+//   - ctx: 请求上下文
+//   - query: 查询参数
 //
-// 绀轰緥:
+// 示例:
 //
 //	for item, err := range client.IterateFinancialEventGroups(ctx, query) {
 //	    if err != nil { return err }
@@ -122,6 +115,14 @@ func (c *Client) IterateFinancialEvents(ctx context.Context, query map[string]st
 //	}
 func (c *Client) IterateFinancialEventGroups(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
 	return func(yield func(map[string]interface{}, error) bool) {
+		// 登记到客户端，Close 等待迭代结束
+		ctx, release, err := c.baseClient.Acquire(ctx)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		defer release()
+
 		currentQuery := make(map[string]string)
 		for k, v := range query {
 			currentQuery[k] = v
@@ -146,13 +147,18 @@ func (c *Client) IterateFinancialEventGroups(ctx context.Context, query map[stri
 				return
 			}
 
-			// 鑾峰彇鏁版嵁鏁扮粍
-			items, ok := response["FinancialEventGroupList"].([]interface{})
+			// 获取 FinancialEventGroupList 数组
+			payload, ok := response["payload"].(map[string]interface{})
+			if !ok {
+				break
+			}
+
+			items, ok := payload["FinancialEventGroupList"].([]interface{})
 			if !ok || items == nil {
 				break
 			}
 
-			// 閬嶅巻褰撳墠椤?
+			// 遍历当前页
 			for _, item := range items {
 				itemMap, ok := item.(map[string]interface{})
 				if !ok {
@@ -163,8 +169,80 @@ func (c *Client) IterateFinancialEventGroups(ctx context.Context, query map[stri
 				}
 			}
 
-			// 妫€鏌ヤ笅涓€椤?
-			nextToken, _ := response["NextToken"].(string)
+			// 检查下一页
+			nextToken, _ := payload["NextToken"].(string)
+			if nextToken == "" {
+				break
+			}
+
+			currentQuery["NextToken"] = nextToken
+		}
+	}
+}
+
+// IterateFinancialEventsByGroupId 返回指定财务事件组的财务事件迭代器，自动处理分页。
+//
+// 每页产出一次 FinancialEvents 对象，并自动处理 NextToken 分页逻辑。
+//
+// 参数:
+//   - ctx: 请求上下文
+//   - eventGroupId: 财务事件组 ID
+//   - query: 查询参数
+//
+// 示例:
+//
+//	for item, err := range client.IterateFinancialEventsByGroupId(ctx, eventGroupId, query) {
+//	    if err != nil { return err }
+//	    process(item)
+//	}
+func (c *Client) IterateFinancialEventsByGroupId(ctx context.Context, eventGroupId string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+	return func(yield func(map[string]interface{}, error) bool) {
+		// 登记到客户端，Close 等待迭代结束
+		ctx, release, err := c.baseClient.Acquire(ctx)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		defer release()
+
+		currentQuery := make(map[string]string)
+		for k, v := range query {
+			currentQuery[k] = v
+		}
+
+		for {
+			result, err := c.ListFinancialEventsByGroupId(ctx, eventGroupId, currentQuery)
+			if err != nil {
+				yield(nil, errors.Wrap(err, "failed to call ListFinancialEventsByGroupId"))
+				return
+			}
+
+			resultBytes, err := json.Marshal(result)
+			if err != nil {
+				yield(nil, errors.Wrap(err, "failed to marshal result"))
+				return
+			}
+
+			var response map[string]interface{}
+			if err := json.Unmarshal(resultBytes, &response); err != nil {
+				yield(nil, errors.Wrap(err, "failed to unmarshal response"))
+				return
+			}
+
+			// 获取 FinancialEvents 对象
+			payload, ok := response["payload"].(map[string]interface{})
+			if !ok {
+				break
+			}
+
+			if page, ok := payload["FinancialEvents"].(map[string]interface{}); ok {
+				if !yield(page, nil) {
+					return
+				}
+			}
+
+			// 检查下一页
+			nextToken, _ := payload["NextToken"].(string)
 			if nextToken == "" {
 				break
 			}
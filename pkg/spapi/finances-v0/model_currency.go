@@ -8,9 +8,18 @@
  */
 package finances_v0
 
+import (
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
 // A currency type and amount.
 type Currency struct {
 	// The three-digit currency code in ISO 4217 format.
-	CurrencyCode   string  `json:"CurrencyCode,omitempty"`
-	CurrencyAmount float64 `json:"CurrencyAmount,omitempty"`
+	CurrencyCode   string        `json:"CurrencyCode,omitempty"`
+	CurrencyAmount spapi.Decimal `json:"CurrencyAmount,omitzero"`
+}
+
+// ToMoney 转换为 spapi.Money。
+func (c Currency) ToMoney() spapi.Money {
+	return spapi.NewMoney(c.CurrencyAmount, c.CurrencyCode)
 }
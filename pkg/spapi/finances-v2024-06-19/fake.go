@@ -0,0 +1,70 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package finances_v2024_06_19
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。
+//
+// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回
+// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，
+// 可以通过 Calls 和 CallsTo 查看。
+type Fake struct {
+	spapi.FakeRecorder
+
+	// IterateTransactionsFunc 实现 IterateTransactions。
+	IterateTransactionsFunc func(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// ListTransactionsFunc 实现 ListTransactions。
+	ListTransactionsFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+}
+
+// NewFake 创建未编程任何响应的 Fake。
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// IterateTransactions 记录调用并执行 IterateTransactionsFunc。
+func (f *Fake) IterateTransactions(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+	f.Record("IterateTransactions", query)
+	if f.IterateTransactionsFunc == nil {
+		return spapi.FakeSeq[map[string]interface{}](nil, fmt.Errorf("IterateTransactions: %w", spapi.ErrFakeNotConfigured))
+	}
+	return f.IterateTransactionsFunc(ctx, query)
+}
+
+// ListTransactions 记录调用并执行 ListTransactionsFunc。
+func (f *Fake) ListTransactions(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("ListTransactions", query)
+	if f.ListTransactionsFunc == nil {
+		return nil, fmt.Errorf("ListTransactions: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListTransactionsFunc(ctx, query)
+}
+
+// 确保 Fake 实现了 API。
+var _ API = (*Fake)(nil)
@@ -0,0 +1,64 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package fulfillment_inbound_v0
+
+import (
+	"context"
+	"iter"
+)
+
+// API 是 fulfillment-inbound API v0 客户端的全部方法，包括分页迭代器等辅助方法。
+//
+// 业务代码依赖 API 而不是 *Client，即可在单元测试中用 Fake 替换真实客户端。
+type API interface {
+	// GetBillOfLading
+	// Method: GET | Path: /fba/inbound/v0/shipments/{shipmentId}/billOfLading
+	GetBillOfLading(ctx context.Context, shipmentId string, query map[string]string) (interface{}, error)
+
+	// GetLabels
+	// Method: GET | Path: /fba/inbound/v0/shipments/{shipmentId}/labels
+	GetLabels(ctx context.Context, shipmentId string, query map[string]string) (interface{}, error)
+
+	// GetPrepInstructions
+	// Method: GET | Path: /fba/inbound/v0/prepInstructions
+	GetPrepInstructions(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetShipmentItems
+	// Method: GET | Path: /fba/inbound/v0/shipmentItems
+	GetShipmentItems(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetShipmentItemsByShipmentId
+	// Method: GET | Path: /fba/inbound/v0/shipments/{shipmentId}/items
+	GetShipmentItemsByShipmentId(ctx context.Context, shipmentId string, query map[string]string) (interface{}, error)
+
+	// GetShipments
+	// Method: GET | Path: /fba/inbound/v0/shipments
+	GetShipments(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// IterateShipmentItems 返回入库货件商品迭代器，自动处理分页。
+	IterateShipmentItems(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// IterateShipments 返回入库货件迭代器，自动处理分页。
+	IterateShipments(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+}
+
+// 确保 Client 实现了 API。
+var _ API = (*Client)(nil)
@@ -0,0 +1,142 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package fulfillment_inbound_v0
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。
+//
+// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回
+// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，
+// 可以通过 Calls 和 CallsTo 查看。
+type Fake struct {
+	spapi.FakeRecorder
+
+	// GetBillOfLadingFunc 实现 GetBillOfLading。
+	GetBillOfLadingFunc func(ctx context.Context, shipmentId string, query map[string]string) (interface{}, error)
+
+	// GetLabelsFunc 实现 GetLabels。
+	GetLabelsFunc func(ctx context.Context, shipmentId string, query map[string]string) (interface{}, error)
+
+	// GetPrepInstructionsFunc 实现 GetPrepInstructions。
+	GetPrepInstructionsFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetShipmentItemsFunc 实现 GetShipmentItems。
+	GetShipmentItemsFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetShipmentItemsByShipmentIdFunc 实现 GetShipmentItemsByShipmentId。
+	GetShipmentItemsByShipmentIdFunc func(ctx context.Context, shipmentId string, query map[string]string) (interface{}, error)
+
+	// GetShipmentsFunc 实现 GetShipments。
+	GetShipmentsFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// IterateShipmentItemsFunc 实现 IterateShipmentItems。
+	IterateShipmentItemsFunc func(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// IterateShipmentsFunc 实现 IterateShipments。
+	IterateShipmentsFunc func(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+}
+
+// NewFake 创建未编程任何响应的 Fake。
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// GetBillOfLading 记录调用并执行 GetBillOfLadingFunc。
+func (f *Fake) GetBillOfLading(ctx context.Context, shipmentId string, query map[string]string) (interface{}, error) {
+	f.Record("GetBillOfLading", shipmentId, query)
+	if f.GetBillOfLadingFunc == nil {
+		return nil, fmt.Errorf("GetBillOfLading: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetBillOfLadingFunc(ctx, shipmentId, query)
+}
+
+// GetLabels 记录调用并执行 GetLabelsFunc。
+func (f *Fake) GetLabels(ctx context.Context, shipmentId string, query map[string]string) (interface{}, error) {
+	f.Record("GetLabels", shipmentId, query)
+	if f.GetLabelsFunc == nil {
+		return nil, fmt.Errorf("GetLabels: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetLabelsFunc(ctx, shipmentId, query)
+}
+
+// GetPrepInstructions 记录调用并执行 GetPrepInstructionsFunc。
+func (f *Fake) GetPrepInstructions(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetPrepInstructions", query)
+	if f.GetPrepInstructionsFunc == nil {
+		return nil, fmt.Errorf("GetPrepInstructions: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetPrepInstructionsFunc(ctx, query)
+}
+
+// GetShipmentItems 记录调用并执行 GetShipmentItemsFunc。
+func (f *Fake) GetShipmentItems(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetShipmentItems", query)
+	if f.GetShipmentItemsFunc == nil {
+		return nil, fmt.Errorf("GetShipmentItems: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetShipmentItemsFunc(ctx, query)
+}
+
+// GetShipmentItemsByShipmentId 记录调用并执行 GetShipmentItemsByShipmentIdFunc。
+func (f *Fake) GetShipmentItemsByShipmentId(ctx context.Context, shipmentId string, query map[string]string) (interface{}, error) {
+	f.Record("GetShipmentItemsByShipmentId", shipmentId, query)
+	if f.GetShipmentItemsByShipmentIdFunc == nil {
+		return nil, fmt.Errorf("GetShipmentItemsByShipmentId: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetShipmentItemsByShipmentIdFunc(ctx, shipmentId, query)
+}
+
+// GetShipments 记录调用并执行 GetShipmentsFunc。
+func (f *Fake) GetShipments(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetShipments", query)
+	if f.GetShipmentsFunc == nil {
+		return nil, fmt.Errorf("GetShipments: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetShipmentsFunc(ctx, query)
+}
+
+// IterateShipmentItems 记录调用并执行 IterateShipmentItemsFunc。
+func (f *Fake) IterateShipmentItems(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+	f.Record("IterateShipmentItems", query)
+	if f.IterateShipmentItemsFunc == nil {
+		return spapi.FakeSeq[map[string]interface{}](nil, fmt.Errorf("IterateShipmentItems: %w", spapi.ErrFakeNotConfigured))
+	}
+	return f.IterateShipmentItemsFunc(ctx, query)
+}
+
+// IterateShipments 记录调用并执行 IterateShipmentsFunc。
+func (f *Fake) IterateShipments(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+	f.Record("IterateShipments", query)
+	if f.IterateShipmentsFunc == nil {
+		return spapi.FakeSeq[map[string]interface{}](nil, fmt.Errorf("IterateShipments: %w", spapi.ErrFakeNotConfigured))
+	}
+	return f.IterateShipmentsFunc(ctx, query)
+}
+
+// 确保 Fake 实现了 API。
+var _ API = (*Fake)(nil)
@@ -8,8 +8,21 @@
  */
 package fulfillment_inbound_v0
 
+import (
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
 // The monetary value.
 type Amount struct {
 	CurrencyCode *CurrencyCode `json:"CurrencyCode"`
-	Value        float64       `json:"Value"`
+	Value        spapi.Decimal `json:"Value"`
+}
+
+// ToMoney 转换为 spapi.Money。
+func (a Amount) ToMoney() spapi.Money {
+	currency := ""
+	if a.CurrencyCode != nil {
+		currency = string(*a.CurrencyCode)
+	}
+	return spapi.NewMoney(a.Value, currency)
 }
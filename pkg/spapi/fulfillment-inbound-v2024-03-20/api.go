@@ -0,0 +1,222 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package fulfillment_inbound_v2024_03_20
+
+import (
+	"context"
+	"iter"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// API 是 fulfillment-inbound API v2024-03-20 客户端的全部方法，包括分页迭代器等辅助方法。
+//
+// 业务代码依赖 API 而不是 *Client，即可在单元测试中用 Fake 替换真实客户端。
+type API interface {
+	// CancelInboundPlan
+	// Method: PUT | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/cancellation
+	CancelInboundPlan(ctx context.Context, inboundPlanId string, body interface{}) (interface{}, error)
+
+	// CancelSelfShipAppointment
+	// Method: PUT | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/selfShipAppointmentCancellation
+	CancelSelfShipAppointment(ctx context.Context, inboundPlanId string, shipmentId string, body interface{}) (interface{}, error)
+
+	// ConfirmDeliveryWindowOptions
+	// Method: POST | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/deliveryWindowOptions/{deliveryWindowOptionId}/confirmation
+	ConfirmDeliveryWindowOptions(ctx context.Context, inboundPlanId string, shipmentId string, deliveryWindowOptionId string, body interface{}) (interface{}, error)
+
+	// ConfirmPackingOption
+	// Method: POST | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/packingOptions/{packingOptionId}/confirmation
+	ConfirmPackingOption(ctx context.Context, inboundPlanId string, packingOptionId string, body interface{}) (interface{}, error)
+
+	// ConfirmPlacementOption
+	// Method: POST | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/placementOptions/{placementOptionId}/confirmation
+	ConfirmPlacementOption(ctx context.Context, inboundPlanId string, placementOptionId string, body interface{}) (interface{}, error)
+
+	// ConfirmShipmentContentUpdatePreview
+	// Method: POST | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/contentUpdatePreviews/{contentUpdatePreviewId}/confirmation
+	ConfirmShipmentContentUpdatePreview(ctx context.Context, inboundPlanId string, shipmentId string, contentUpdatePreviewId string, body interface{}) (interface{}, error)
+
+	// ConfirmTransportationOptions
+	// Method: POST | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/transportationOptions/confirmation
+	ConfirmTransportationOptions(ctx context.Context, inboundPlanId string, body interface{}) (interface{}, error)
+
+	// CreateInboundPlan
+	// Method: POST | Path: /inbound/fba/2024-03-20/inboundPlans
+	CreateInboundPlan(ctx context.Context, body interface{}) (interface{}, error)
+
+	// CreateMarketplaceItemLabels
+	// Method: POST | Path: /inbound/fba/2024-03-20/items/labels
+	CreateMarketplaceItemLabels(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GenerateDeliveryWindowOptions
+	// Method: POST | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/deliveryWindowOptions
+	GenerateDeliveryWindowOptions(ctx context.Context, inboundPlanId string, shipmentId string, body interface{}) (interface{}, error)
+
+	// GeneratePackingOptions
+	// Method: POST | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/packingOptions
+	GeneratePackingOptions(ctx context.Context, inboundPlanId string, body interface{}) (interface{}, error)
+
+	// GeneratePlacementOptions
+	// Method: POST | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/placementOptions
+	GeneratePlacementOptions(ctx context.Context, inboundPlanId string, body interface{}) (interface{}, error)
+
+	// GenerateSelfShipAppointmentSlots
+	// Method: POST | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/selfShipAppointmentSlots
+	GenerateSelfShipAppointmentSlots(ctx context.Context, inboundPlanId string, shipmentId string, body interface{}) (interface{}, error)
+
+	// GenerateShipmentContentUpdatePreviews
+	// Method: POST | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/contentUpdatePreviews
+	GenerateShipmentContentUpdatePreviews(ctx context.Context, inboundPlanId string, shipmentId string, body interface{}) (interface{}, error)
+
+	// GenerateTransportationOptions
+	// Method: POST | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/transportationOptions
+	GenerateTransportationOptions(ctx context.Context, inboundPlanId string, body interface{}) (interface{}, error)
+
+	// GetDeliveryChallanDocument
+	// Method: GET | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/deliveryChallanDocument
+	GetDeliveryChallanDocument(ctx context.Context, inboundPlanId string, shipmentId string, query map[string]string) (interface{}, error)
+
+	// GetInboundOperationStatus
+	// Method: GET | Path: /inbound/fba/2024-03-20/operations/{operationId}
+	GetInboundOperationStatus(ctx context.Context, operationId string, query map[string]string) (interface{}, error)
+
+	// GetInboundPlan
+	// Method: GET | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}
+	GetInboundPlan(ctx context.Context, inboundPlanId string, query map[string]string) (interface{}, error)
+
+	// GetSelfShipAppointmentSlots
+	// Method: GET | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/selfShipAppointmentSlots
+	GetSelfShipAppointmentSlots(ctx context.Context, inboundPlanId string, shipmentId string, query map[string]string) (interface{}, error)
+
+	// GetShipment
+	// Method: GET | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}
+	GetShipment(ctx context.Context, inboundPlanId string, shipmentId string, query map[string]string) (interface{}, error)
+
+	// GetShipmentContentUpdatePreview
+	// Method: GET | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/contentUpdatePreviews/{contentUpdatePreviewId}
+	GetShipmentContentUpdatePreview(ctx context.Context, inboundPlanId string, shipmentId string, contentUpdatePreviewId string, query map[string]string) (interface{}, error)
+
+	// IterateInboundPlans 返回入库计划迭代器，自动处理分页。
+	IterateInboundPlans(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// ListDeliveryWindowOptions
+	// Method: GET | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/deliveryWindowOptions
+	ListDeliveryWindowOptions(ctx context.Context, inboundPlanId string, shipmentId string, query map[string]string) (interface{}, error)
+
+	// ListInboundPlanBoxes
+	// Method: GET | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/boxes
+	ListInboundPlanBoxes(ctx context.Context, inboundPlanId string, query map[string]string) (interface{}, error)
+
+	// ListInboundPlanItems
+	// Method: GET | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/items
+	ListInboundPlanItems(ctx context.Context, inboundPlanId string, query map[string]string) (interface{}, error)
+
+	// ListInboundPlanPallets
+	// Method: GET | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/pallets
+	ListInboundPlanPallets(ctx context.Context, inboundPlanId string, query map[string]string) (interface{}, error)
+
+	// ListInboundPlans
+	// Method: GET | Path: /inbound/fba/2024-03-20/inboundPlans
+	ListInboundPlans(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// ListItemComplianceDetails
+	// Method: GET | Path: /inbound/fba/2024-03-20/items/compliance
+	ListItemComplianceDetails(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// ListPackingGroupBoxes
+	// Method: GET | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/packingGroups/{packingGroupId}/boxes
+	ListPackingGroupBoxes(ctx context.Context, inboundPlanId string, packingGroupId string, query map[string]string) (interface{}, error)
+
+	// ListPackingGroupItems
+	// Method: GET | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/packingGroups/{packingGroupId}/items
+	ListPackingGroupItems(ctx context.Context, inboundPlanId string, packingGroupId string, query map[string]string) (interface{}, error)
+
+	// ListPackingOptions
+	// Method: GET | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/packingOptions
+	ListPackingOptions(ctx context.Context, inboundPlanId string, query map[string]string) (interface{}, error)
+
+	// ListPlacementOptions
+	// Method: GET | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/placementOptions
+	ListPlacementOptions(ctx context.Context, inboundPlanId string, query map[string]string) (interface{}, error)
+
+	// ListPrepDetails
+	// Method: GET | Path: /inbound/fba/2024-03-20/items/prepDetails
+	ListPrepDetails(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// ListShipmentBoxes
+	// Method: GET | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/boxes
+	ListShipmentBoxes(ctx context.Context, inboundPlanId string, shipmentId string, query map[string]string) (interface{}, error)
+
+	// ListShipmentContentUpdatePreviews
+	// Method: GET | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/contentUpdatePreviews
+	ListShipmentContentUpdatePreviews(ctx context.Context, inboundPlanId string, shipmentId string, query map[string]string) (interface{}, error)
+
+	// ListShipmentItems
+	// Method: GET | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/items
+	ListShipmentItems(ctx context.Context, inboundPlanId string, shipmentId string, query map[string]string) (interface{}, error)
+
+	// ListShipmentPallets
+	// Method: GET | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/pallets
+	ListShipmentPallets(ctx context.Context, inboundPlanId string, shipmentId string, query map[string]string) (interface{}, error)
+
+	// ListTransportationOptions
+	// Method: GET | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/transportationOptions
+	ListTransportationOptions(ctx context.Context, inboundPlanId string, query map[string]string) (interface{}, error)
+
+	// ScheduleSelfShipAppointment
+	// Method: POST | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/selfShipAppointmentSlots/{slotId}/schedule
+	ScheduleSelfShipAppointment(ctx context.Context, inboundPlanId string, shipmentId string, slotId string, body interface{}) (interface{}, error)
+
+	// SetPackingInformation
+	// Method: POST | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/packingInformation
+	SetPackingInformation(ctx context.Context, inboundPlanId string, body interface{}) (interface{}, error)
+
+	// SetPrepDetails
+	// Method: POST | Path: /inbound/fba/2024-03-20/items/prepDetails
+	SetPrepDetails(ctx context.Context, body interface{}) (interface{}, error)
+
+	// UpdateInboundPlanName
+	// Method: PUT | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/name
+	UpdateInboundPlanName(ctx context.Context, inboundPlanId string, body interface{}) (interface{}, error)
+
+	// UpdateItemComplianceDetails
+	// Method: PUT | Path: /inbound/fba/2024-03-20/items/compliance
+	UpdateItemComplianceDetails(ctx context.Context, body interface{}) (interface{}, error)
+
+	// UpdateShipmentName
+	// Method: PUT | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/name
+	UpdateShipmentName(ctx context.Context, inboundPlanId string, shipmentId string, body interface{}) (interface{}, error)
+
+	// UpdateShipmentSourceAddress
+	// Method: PUT | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/sourceAddress
+	UpdateShipmentSourceAddress(ctx context.Context, inboundPlanId string, shipmentId string, body interface{}) (interface{}, error)
+
+	// UpdateShipmentTrackingDetails
+	// Method: PUT | Path: /inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/trackingDetails
+	UpdateShipmentTrackingDetails(ctx context.Context, inboundPlanId string, shipmentId string, body interface{}) (interface{}, error)
+
+	// WaitForInboundOperation 轮询 GetInboundOperationStatus 直到操作完成（SUCCESS、FAILED）。
+	WaitForInboundOperation(ctx context.Context, operationID string, opts ...spapi.PollerOption) (interface{}, error)
+}
+
+// 确保 Client 实现了 API。
+var _ API = (*Client)(nil)
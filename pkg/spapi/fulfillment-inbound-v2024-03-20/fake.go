@@ -0,0 +1,610 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package fulfillment_inbound_v2024_03_20
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。
+//
+// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回
+// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，
+// 可以通过 Calls 和 CallsTo 查看。
+type Fake struct {
+	spapi.FakeRecorder
+
+	// CancelInboundPlanFunc 实现 CancelInboundPlan。
+	CancelInboundPlanFunc func(ctx context.Context, inboundPlanId string, body interface{}) (interface{}, error)
+
+	// CancelSelfShipAppointmentFunc 实现 CancelSelfShipAppointment。
+	CancelSelfShipAppointmentFunc func(ctx context.Context, inboundPlanId string, shipmentId string, body interface{}) (interface{}, error)
+
+	// ConfirmDeliveryWindowOptionsFunc 实现 ConfirmDeliveryWindowOptions。
+	ConfirmDeliveryWindowOptionsFunc func(ctx context.Context, inboundPlanId string, shipmentId string, deliveryWindowOptionId string, body interface{}) (interface{}, error)
+
+	// ConfirmPackingOptionFunc 实现 ConfirmPackingOption。
+	ConfirmPackingOptionFunc func(ctx context.Context, inboundPlanId string, packingOptionId string, body interface{}) (interface{}, error)
+
+	// ConfirmPlacementOptionFunc 实现 ConfirmPlacementOption。
+	ConfirmPlacementOptionFunc func(ctx context.Context, inboundPlanId string, placementOptionId string, body interface{}) (interface{}, error)
+
+	// ConfirmShipmentContentUpdatePreviewFunc 实现 ConfirmShipmentContentUpdatePreview。
+	ConfirmShipmentContentUpdatePreviewFunc func(ctx context.Context, inboundPlanId string, shipmentId string, contentUpdatePreviewId string, body interface{}) (interface{}, error)
+
+	// ConfirmTransportationOptionsFunc 实现 ConfirmTransportationOptions。
+	ConfirmTransportationOptionsFunc func(ctx context.Context, inboundPlanId string, body interface{}) (interface{}, error)
+
+	// CreateInboundPlanFunc 实现 CreateInboundPlan。
+	CreateInboundPlanFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// CreateMarketplaceItemLabelsFunc 实现 CreateMarketplaceItemLabels。
+	CreateMarketplaceItemLabelsFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GenerateDeliveryWindowOptionsFunc 实现 GenerateDeliveryWindowOptions。
+	GenerateDeliveryWindowOptionsFunc func(ctx context.Context, inboundPlanId string, shipmentId string, body interface{}) (interface{}, error)
+
+	// GeneratePackingOptionsFunc 实现 GeneratePackingOptions。
+	GeneratePackingOptionsFunc func(ctx context.Context, inboundPlanId string, body interface{}) (interface{}, error)
+
+	// GeneratePlacementOptionsFunc 实现 GeneratePlacementOptions。
+	GeneratePlacementOptionsFunc func(ctx context.Context, inboundPlanId string, body interface{}) (interface{}, error)
+
+	// GenerateSelfShipAppointmentSlotsFunc 实现 GenerateSelfShipAppointmentSlots。
+	GenerateSelfShipAppointmentSlotsFunc func(ctx context.Context, inboundPlanId string, shipmentId string, body interface{}) (interface{}, error)
+
+	// GenerateShipmentContentUpdatePreviewsFunc 实现 GenerateShipmentContentUpdatePreviews。
+	GenerateShipmentContentUpdatePreviewsFunc func(ctx context.Context, inboundPlanId string, shipmentId string, body interface{}) (interface{}, error)
+
+	// GenerateTransportationOptionsFunc 实现 GenerateTransportationOptions。
+	GenerateTransportationOptionsFunc func(ctx context.Context, inboundPlanId string, body interface{}) (interface{}, error)
+
+	// GetDeliveryChallanDocumentFunc 实现 GetDeliveryChallanDocument。
+	GetDeliveryChallanDocumentFunc func(ctx context.Context, inboundPlanId string, shipmentId string, query map[string]string) (interface{}, error)
+
+	// GetInboundOperationStatusFunc 实现 GetInboundOperationStatus。
+	GetInboundOperationStatusFunc func(ctx context.Context, operationId string, query map[string]string) (interface{}, error)
+
+	// GetInboundPlanFunc 实现 GetInboundPlan。
+	GetInboundPlanFunc func(ctx context.Context, inboundPlanId string, query map[string]string) (interface{}, error)
+
+	// GetSelfShipAppointmentSlotsFunc 实现 GetSelfShipAppointmentSlots。
+	GetSelfShipAppointmentSlotsFunc func(ctx context.Context, inboundPlanId string, shipmentId string, query map[string]string) (interface{}, error)
+
+	// GetShipmentFunc 实现 GetShipment。
+	GetShipmentFunc func(ctx context.Context, inboundPlanId string, shipmentId string, query map[string]string) (interface{}, error)
+
+	// GetShipmentContentUpdatePreviewFunc 实现 GetShipmentContentUpdatePreview。
+	GetShipmentContentUpdatePreviewFunc func(ctx context.Context, inboundPlanId string, shipmentId string, contentUpdatePreviewId string, query map[string]string) (interface{}, error)
+
+	// IterateInboundPlansFunc 实现 IterateInboundPlans。
+	IterateInboundPlansFunc func(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// ListDeliveryWindowOptionsFunc 实现 ListDeliveryWindowOptions。
+	ListDeliveryWindowOptionsFunc func(ctx context.Context, inboundPlanId string, shipmentId string, query map[string]string) (interface{}, error)
+
+	// ListInboundPlanBoxesFunc 实现 ListInboundPlanBoxes。
+	ListInboundPlanBoxesFunc func(ctx context.Context, inboundPlanId string, query map[string]string) (interface{}, error)
+
+	// ListInboundPlanItemsFunc 实现 ListInboundPlanItems。
+	ListInboundPlanItemsFunc func(ctx context.Context, inboundPlanId string, query map[string]string) (interface{}, error)
+
+	// ListInboundPlanPalletsFunc 实现 ListInboundPlanPallets。
+	ListInboundPlanPalletsFunc func(ctx context.Context, inboundPlanId string, query map[string]string) (interface{}, error)
+
+	// ListInboundPlansFunc 实现 ListInboundPlans。
+	ListInboundPlansFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// ListItemComplianceDetailsFunc 实现 ListItemComplianceDetails。
+	ListItemComplianceDetailsFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// ListPackingGroupBoxesFunc 实现 ListPackingGroupBoxes。
+	ListPackingGroupBoxesFunc func(ctx context.Context, inboundPlanId string, packingGroupId string, query map[string]string) (interface{}, error)
+
+	// ListPackingGroupItemsFunc 实现 ListPackingGroupItems。
+	ListPackingGroupItemsFunc func(ctx context.Context, inboundPlanId string, packingGroupId string, query map[string]string) (interface{}, error)
+
+	// ListPackingOptionsFunc 实现 ListPackingOptions。
+	ListPackingOptionsFunc func(ctx context.Context, inboundPlanId string, query map[string]string) (interface{}, error)
+
+	// ListPlacementOptionsFunc 实现 ListPlacementOptions。
+	ListPlacementOptionsFunc func(ctx context.Context, inboundPlanId string, query map[string]string) (interface{}, error)
+
+	// ListPrepDetailsFunc 实现 ListPrepDetails。
+	ListPrepDetailsFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// ListShipmentBoxesFunc 实现 ListShipmentBoxes。
+	ListShipmentBoxesFunc func(ctx context.Context, inboundPlanId string, shipmentId string, query map[string]string) (interface{}, error)
+
+	// ListShipmentContentUpdatePreviewsFunc 实现 ListShipmentContentUpdatePreviews。
+	ListShipmentContentUpdatePreviewsFunc func(ctx context.Context, inboundPlanId string, shipmentId string, query map[string]string) (interface{}, error)
+
+	// ListShipmentItemsFunc 实现 ListShipmentItems。
+	ListShipmentItemsFunc func(ctx context.Context, inboundPlanId string, shipmentId string, query map[string]string) (interface{}, error)
+
+	// ListShipmentPalletsFunc 实现 ListShipmentPallets。
+	ListShipmentPalletsFunc func(ctx context.Context, inboundPlanId string, shipmentId string, query map[string]string) (interface{}, error)
+
+	// ListTransportationOptionsFunc 实现 ListTransportationOptions。
+	ListTransportationOptionsFunc func(ctx context.Context, inboundPlanId string, query map[string]string) (interface{}, error)
+
+	// ScheduleSelfShipAppointmentFunc 实现 ScheduleSelfShipAppointment。
+	ScheduleSelfShipAppointmentFunc func(ctx context.Context, inboundPlanId string, shipmentId string, slotId string, body interface{}) (interface{}, error)
+
+	// SetPackingInformationFunc 实现 SetPackingInformation。
+	SetPackingInformationFunc func(ctx context.Context, inboundPlanId string, body interface{}) (interface{}, error)
+
+	// SetPrepDetailsFunc 实现 SetPrepDetails。
+	SetPrepDetailsFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// UpdateInboundPlanNameFunc 实现 UpdateInboundPlanName。
+	UpdateInboundPlanNameFunc func(ctx context.Context, inboundPlanId string, body interface{}) (interface{}, error)
+
+	// UpdateItemComplianceDetailsFunc 实现 UpdateItemComplianceDetails。
+	UpdateItemComplianceDetailsFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// UpdateShipmentNameFunc 实现 UpdateShipmentName。
+	UpdateShipmentNameFunc func(ctx context.Context, inboundPlanId string, shipmentId string, body interface{}) (interface{}, error)
+
+	// UpdateShipmentSourceAddressFunc 实现 UpdateShipmentSourceAddress。
+	UpdateShipmentSourceAddressFunc func(ctx context.Context, inboundPlanId string, shipmentId string, body interface{}) (interface{}, error)
+
+	// UpdateShipmentTrackingDetailsFunc 实现 UpdateShipmentTrackingDetails。
+	UpdateShipmentTrackingDetailsFunc func(ctx context.Context, inboundPlanId string, shipmentId string, body interface{}) (interface{}, error)
+
+	// WaitForInboundOperationFunc 实现 WaitForInboundOperation。
+	WaitForInboundOperationFunc func(ctx context.Context, operationID string, opts ...spapi.PollerOption) (interface{}, error)
+}
+
+// NewFake 创建未编程任何响应的 Fake。
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// CancelInboundPlan 记录调用并执行 CancelInboundPlanFunc。
+func (f *Fake) CancelInboundPlan(ctx context.Context, inboundPlanId string, body interface{}) (interface{}, error) {
+	f.Record("CancelInboundPlan", inboundPlanId, body)
+	if f.CancelInboundPlanFunc == nil {
+		return nil, fmt.Errorf("CancelInboundPlan: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CancelInboundPlanFunc(ctx, inboundPlanId, body)
+}
+
+// CancelSelfShipAppointment 记录调用并执行 CancelSelfShipAppointmentFunc。
+func (f *Fake) CancelSelfShipAppointment(ctx context.Context, inboundPlanId string, shipmentId string, body interface{}) (interface{}, error) {
+	f.Record("CancelSelfShipAppointment", inboundPlanId, shipmentId, body)
+	if f.CancelSelfShipAppointmentFunc == nil {
+		return nil, fmt.Errorf("CancelSelfShipAppointment: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CancelSelfShipAppointmentFunc(ctx, inboundPlanId, shipmentId, body)
+}
+
+// ConfirmDeliveryWindowOptions 记录调用并执行 ConfirmDeliveryWindowOptionsFunc。
+func (f *Fake) ConfirmDeliveryWindowOptions(ctx context.Context, inboundPlanId string, shipmentId string, deliveryWindowOptionId string, body interface{}) (interface{}, error) {
+	f.Record("ConfirmDeliveryWindowOptions", inboundPlanId, shipmentId, deliveryWindowOptionId, body)
+	if f.ConfirmDeliveryWindowOptionsFunc == nil {
+		return nil, fmt.Errorf("ConfirmDeliveryWindowOptions: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ConfirmDeliveryWindowOptionsFunc(ctx, inboundPlanId, shipmentId, deliveryWindowOptionId, body)
+}
+
+// ConfirmPackingOption 记录调用并执行 ConfirmPackingOptionFunc。
+func (f *Fake) ConfirmPackingOption(ctx context.Context, inboundPlanId string, packingOptionId string, body interface{}) (interface{}, error) {
+	f.Record("ConfirmPackingOption", inboundPlanId, packingOptionId, body)
+	if f.ConfirmPackingOptionFunc == nil {
+		return nil, fmt.Errorf("ConfirmPackingOption: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ConfirmPackingOptionFunc(ctx, inboundPlanId, packingOptionId, body)
+}
+
+// ConfirmPlacementOption 记录调用并执行 ConfirmPlacementOptionFunc。
+func (f *Fake) ConfirmPlacementOption(ctx context.Context, inboundPlanId string, placementOptionId string, body interface{}) (interface{}, error) {
+	f.Record("ConfirmPlacementOption", inboundPlanId, placementOptionId, body)
+	if f.ConfirmPlacementOptionFunc == nil {
+		return nil, fmt.Errorf("ConfirmPlacementOption: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ConfirmPlacementOptionFunc(ctx, inboundPlanId, placementOptionId, body)
+}
+
+// ConfirmShipmentContentUpdatePreview 记录调用并执行 ConfirmShipmentContentUpdatePreviewFunc。
+func (f *Fake) ConfirmShipmentContentUpdatePreview(ctx context.Context, inboundPlanId string, shipmentId string, contentUpdatePreviewId string, body interface{}) (interface{}, error) {
+	f.Record("ConfirmShipmentContentUpdatePreview", inboundPlanId, shipmentId, contentUpdatePreviewId, body)
+	if f.ConfirmShipmentContentUpdatePreviewFunc == nil {
+		return nil, fmt.Errorf("ConfirmShipmentContentUpdatePreview: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ConfirmShipmentContentUpdatePreviewFunc(ctx, inboundPlanId, shipmentId, contentUpdatePreviewId, body)
+}
+
+// ConfirmTransportationOptions 记录调用并执行 ConfirmTransportationOptionsFunc。
+func (f *Fake) ConfirmTransportationOptions(ctx context.Context, inboundPlanId string, body interface{}) (interface{}, error) {
+	f.Record("ConfirmTransportationOptions", inboundPlanId, body)
+	if f.ConfirmTransportationOptionsFunc == nil {
+		return nil, fmt.Errorf("ConfirmTransportationOptions: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ConfirmTransportationOptionsFunc(ctx, inboundPlanId, body)
+}
+
+// CreateInboundPlan 记录调用并执行 CreateInboundPlanFunc。
+func (f *Fake) CreateInboundPlan(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("CreateInboundPlan", body)
+	if f.CreateInboundPlanFunc == nil {
+		return nil, fmt.Errorf("CreateInboundPlan: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateInboundPlanFunc(ctx, body)
+}
+
+// CreateMarketplaceItemLabels 记录调用并执行 CreateMarketplaceItemLabelsFunc。
+func (f *Fake) CreateMarketplaceItemLabels(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("CreateMarketplaceItemLabels", body)
+	if f.CreateMarketplaceItemLabelsFunc == nil {
+		return nil, fmt.Errorf("CreateMarketplaceItemLabels: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateMarketplaceItemLabelsFunc(ctx, body)
+}
+
+// GenerateDeliveryWindowOptions 记录调用并执行 GenerateDeliveryWindowOptionsFunc。
+func (f *Fake) GenerateDeliveryWindowOptions(ctx context.Context, inboundPlanId string, shipmentId string, body interface{}) (interface{}, error) {
+	f.Record("GenerateDeliveryWindowOptions", inboundPlanId, shipmentId, body)
+	if f.GenerateDeliveryWindowOptionsFunc == nil {
+		return nil, fmt.Errorf("GenerateDeliveryWindowOptions: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GenerateDeliveryWindowOptionsFunc(ctx, inboundPlanId, shipmentId, body)
+}
+
+// GeneratePackingOptions 记录调用并执行 GeneratePackingOptionsFunc。
+func (f *Fake) GeneratePackingOptions(ctx context.Context, inboundPlanId string, body interface{}) (interface{}, error) {
+	f.Record("GeneratePackingOptions", inboundPlanId, body)
+	if f.GeneratePackingOptionsFunc == nil {
+		return nil, fmt.Errorf("GeneratePackingOptions: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GeneratePackingOptionsFunc(ctx, inboundPlanId, body)
+}
+
+// GeneratePlacementOptions 记录调用并执行 GeneratePlacementOptionsFunc。
+func (f *Fake) GeneratePlacementOptions(ctx context.Context, inboundPlanId string, body interface{}) (interface{}, error) {
+	f.Record("GeneratePlacementOptions", inboundPlanId, body)
+	if f.GeneratePlacementOptionsFunc == nil {
+		return nil, fmt.Errorf("GeneratePlacementOptions: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GeneratePlacementOptionsFunc(ctx, inboundPlanId, body)
+}
+
+// GenerateSelfShipAppointmentSlots 记录调用并执行 GenerateSelfShipAppointmentSlotsFunc。
+func (f *Fake) GenerateSelfShipAppointmentSlots(ctx context.Context, inboundPlanId string, shipmentId string, body interface{}) (interface{}, error) {
+	f.Record("GenerateSelfShipAppointmentSlots", inboundPlanId, shipmentId, body)
+	if f.GenerateSelfShipAppointmentSlotsFunc == nil {
+		return nil, fmt.Errorf("GenerateSelfShipAppointmentSlots: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GenerateSelfShipAppointmentSlotsFunc(ctx, inboundPlanId, shipmentId, body)
+}
+
+// GenerateShipmentContentUpdatePreviews 记录调用并执行 GenerateShipmentContentUpdatePreviewsFunc。
+func (f *Fake) GenerateShipmentContentUpdatePreviews(ctx context.Context, inboundPlanId string, shipmentId string, body interface{}) (interface{}, error) {
+	f.Record("GenerateShipmentContentUpdatePreviews", inboundPlanId, shipmentId, body)
+	if f.GenerateShipmentContentUpdatePreviewsFunc == nil {
+		return nil, fmt.Errorf("GenerateShipmentContentUpdatePreviews: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GenerateShipmentContentUpdatePreviewsFunc(ctx, inboundPlanId, shipmentId, body)
+}
+
+// GenerateTransportationOptions 记录调用并执行 GenerateTransportationOptionsFunc。
+func (f *Fake) GenerateTransportationOptions(ctx context.Context, inboundPlanId string, body interface{}) (interface{}, error) {
+	f.Record("GenerateTransportationOptions", inboundPlanId, body)
+	if f.GenerateTransportationOptionsFunc == nil {
+		return nil, fmt.Errorf("GenerateTransportationOptions: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GenerateTransportationOptionsFunc(ctx, inboundPlanId, body)
+}
+
+// GetDeliveryChallanDocument 记录调用并执行 GetDeliveryChallanDocumentFunc。
+func (f *Fake) GetDeliveryChallanDocument(ctx context.Context, inboundPlanId string, shipmentId string, query map[string]string) (interface{}, error) {
+	f.Record("GetDeliveryChallanDocument", inboundPlanId, shipmentId, query)
+	if f.GetDeliveryChallanDocumentFunc == nil {
+		return nil, fmt.Errorf("GetDeliveryChallanDocument: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetDeliveryChallanDocumentFunc(ctx, inboundPlanId, shipmentId, query)
+}
+
+// GetInboundOperationStatus 记录调用并执行 GetInboundOperationStatusFunc。
+func (f *Fake) GetInboundOperationStatus(ctx context.Context, operationId string, query map[string]string) (interface{}, error) {
+	f.Record("GetInboundOperationStatus", operationId, query)
+	if f.GetInboundOperationStatusFunc == nil {
+		return nil, fmt.Errorf("GetInboundOperationStatus: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetInboundOperationStatusFunc(ctx, operationId, query)
+}
+
+// GetInboundPlan 记录调用并执行 GetInboundPlanFunc。
+func (f *Fake) GetInboundPlan(ctx context.Context, inboundPlanId string, query map[string]string) (interface{}, error) {
+	f.Record("GetInboundPlan", inboundPlanId, query)
+	if f.GetInboundPlanFunc == nil {
+		return nil, fmt.Errorf("GetInboundPlan: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetInboundPlanFunc(ctx, inboundPlanId, query)
+}
+
+// GetSelfShipAppointmentSlots 记录调用并执行 GetSelfShipAppointmentSlotsFunc。
+func (f *Fake) GetSelfShipAppointmentSlots(ctx context.Context, inboundPlanId string, shipmentId string, query map[string]string) (interface{}, error) {
+	f.Record("GetSelfShipAppointmentSlots", inboundPlanId, shipmentId, query)
+	if f.GetSelfShipAppointmentSlotsFunc == nil {
+		return nil, fmt.Errorf("GetSelfShipAppointmentSlots: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetSelfShipAppointmentSlotsFunc(ctx, inboundPlanId, shipmentId, query)
+}
+
+// GetShipment 记录调用并执行 GetShipmentFunc。
+func (f *Fake) GetShipment(ctx context.Context, inboundPlanId string, shipmentId string, query map[string]string) (interface{}, error) {
+	f.Record("GetShipment", inboundPlanId, shipmentId, query)
+	if f.GetShipmentFunc == nil {
+		return nil, fmt.Errorf("GetShipment: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetShipmentFunc(ctx, inboundPlanId, shipmentId, query)
+}
+
+// GetShipmentContentUpdatePreview 记录调用并执行 GetShipmentContentUpdatePreviewFunc。
+func (f *Fake) GetShipmentContentUpdatePreview(ctx context.Context, inboundPlanId string, shipmentId string, contentUpdatePreviewId string, query map[string]string) (interface{}, error) {
+	f.Record("GetShipmentContentUpdatePreview", inboundPlanId, shipmentId, contentUpdatePreviewId, query)
+	if f.GetShipmentContentUpdatePreviewFunc == nil {
+		return nil, fmt.Errorf("GetShipmentContentUpdatePreview: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetShipmentContentUpdatePreviewFunc(ctx, inboundPlanId, shipmentId, contentUpdatePreviewId, query)
+}
+
+// IterateInboundPlans 记录调用并执行 IterateInboundPlansFunc。
+func (f *Fake) IterateInboundPlans(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+	f.Record("IterateInboundPlans", query)
+	if f.IterateInboundPlansFunc == nil {
+		return spapi.FakeSeq[map[string]interface{}](nil, fmt.Errorf("IterateInboundPlans: %w", spapi.ErrFakeNotConfigured))
+	}
+	return f.IterateInboundPlansFunc(ctx, query)
+}
+
+// ListDeliveryWindowOptions 记录调用并执行 ListDeliveryWindowOptionsFunc。
+func (f *Fake) ListDeliveryWindowOptions(ctx context.Context, inboundPlanId string, shipmentId string, query map[string]string) (interface{}, error) {
+	f.Record("ListDeliveryWindowOptions", inboundPlanId, shipmentId, query)
+	if f.ListDeliveryWindowOptionsFunc == nil {
+		return nil, fmt.Errorf("ListDeliveryWindowOptions: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListDeliveryWindowOptionsFunc(ctx, inboundPlanId, shipmentId, query)
+}
+
+// ListInboundPlanBoxes 记录调用并执行 ListInboundPlanBoxesFunc。
+func (f *Fake) ListInboundPlanBoxes(ctx context.Context, inboundPlanId string, query map[string]string) (interface{}, error) {
+	f.Record("ListInboundPlanBoxes", inboundPlanId, query)
+	if f.ListInboundPlanBoxesFunc == nil {
+		return nil, fmt.Errorf("ListInboundPlanBoxes: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListInboundPlanBoxesFunc(ctx, inboundPlanId, query)
+}
+
+// ListInboundPlanItems 记录调用并执行 ListInboundPlanItemsFunc。
+func (f *Fake) ListInboundPlanItems(ctx context.Context, inboundPlanId string, query map[string]string) (interface{}, error) {
+	f.Record("ListInboundPlanItems", inboundPlanId, query)
+	if f.ListInboundPlanItemsFunc == nil {
+		return nil, fmt.Errorf("ListInboundPlanItems: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListInboundPlanItemsFunc(ctx, inboundPlanId, query)
+}
+
+// ListInboundPlanPallets 记录调用并执行 ListInboundPlanPalletsFunc。
+func (f *Fake) ListInboundPlanPallets(ctx context.Context, inboundPlanId string, query map[string]string) (interface{}, error) {
+	f.Record("ListInboundPlanPallets", inboundPlanId, query)
+	if f.ListInboundPlanPalletsFunc == nil {
+		return nil, fmt.Errorf("ListInboundPlanPallets: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListInboundPlanPalletsFunc(ctx, inboundPlanId, query)
+}
+
+// ListInboundPlans 记录调用并执行 ListInboundPlansFunc。
+func (f *Fake) ListInboundPlans(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("ListInboundPlans", query)
+	if f.ListInboundPlansFunc == nil {
+		return nil, fmt.Errorf("ListInboundPlans: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListInboundPlansFunc(ctx, query)
+}
+
+// ListItemComplianceDetails 记录调用并执行 ListItemComplianceDetailsFunc。
+func (f *Fake) ListItemComplianceDetails(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("ListItemComplianceDetails", query)
+	if f.ListItemComplianceDetailsFunc == nil {
+		return nil, fmt.Errorf("ListItemComplianceDetails: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListItemComplianceDetailsFunc(ctx, query)
+}
+
+// ListPackingGroupBoxes 记录调用并执行 ListPackingGroupBoxesFunc。
+func (f *Fake) ListPackingGroupBoxes(ctx context.Context, inboundPlanId string, packingGroupId string, query map[string]string) (interface{}, error) {
+	f.Record("ListPackingGroupBoxes", inboundPlanId, packingGroupId, query)
+	if f.ListPackingGroupBoxesFunc == nil {
+		return nil, fmt.Errorf("ListPackingGroupBoxes: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListPackingGroupBoxesFunc(ctx, inboundPlanId, packingGroupId, query)
+}
+
+// ListPackingGroupItems 记录调用并执行 ListPackingGroupItemsFunc。
+func (f *Fake) ListPackingGroupItems(ctx context.Context, inboundPlanId string, packingGroupId string, query map[string]string) (interface{}, error) {
+	f.Record("ListPackingGroupItems", inboundPlanId, packingGroupId, query)
+	if f.ListPackingGroupItemsFunc == nil {
+		return nil, fmt.Errorf("ListPackingGroupItems: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListPackingGroupItemsFunc(ctx, inboundPlanId, packingGroupId, query)
+}
+
+// ListPackingOptions 记录调用并执行 ListPackingOptionsFunc。
+func (f *Fake) ListPackingOptions(ctx context.Context, inboundPlanId string, query map[string]string) (interface{}, error) {
+	f.Record("ListPackingOptions", inboundPlanId, query)
+	if f.ListPackingOptionsFunc == nil {
+		return nil, fmt.Errorf("ListPackingOptions: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListPackingOptionsFunc(ctx, inboundPlanId, query)
+}
+
+// ListPlacementOptions 记录调用并执行 ListPlacementOptionsFunc。
+func (f *Fake) ListPlacementOptions(ctx context.Context, inboundPlanId string, query map[string]string) (interface{}, error) {
+	f.Record("ListPlacementOptions", inboundPlanId, query)
+	if f.ListPlacementOptionsFunc == nil {
+		return nil, fmt.Errorf("ListPlacementOptions: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListPlacementOptionsFunc(ctx, inboundPlanId, query)
+}
+
+// ListPrepDetails 记录调用并执行 ListPrepDetailsFunc。
+func (f *Fake) ListPrepDetails(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("ListPrepDetails", query)
+	if f.ListPrepDetailsFunc == nil {
+		return nil, fmt.Errorf("ListPrepDetails: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListPrepDetailsFunc(ctx, query)
+}
+
+// ListShipmentBoxes 记录调用并执行 ListShipmentBoxesFunc。
+func (f *Fake) ListShipmentBoxes(ctx context.Context, inboundPlanId string, shipmentId string, query map[string]string) (interface{}, error) {
+	f.Record("ListShipmentBoxes", inboundPlanId, shipmentId, query)
+	if f.ListShipmentBoxesFunc == nil {
+		return nil, fmt.Errorf("ListShipmentBoxes: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListShipmentBoxesFunc(ctx, inboundPlanId, shipmentId, query)
+}
+
+// ListShipmentContentUpdatePreviews 记录调用并执行 ListShipmentContentUpdatePreviewsFunc。
+func (f *Fake) ListShipmentContentUpdatePreviews(ctx context.Context, inboundPlanId string, shipmentId string, query map[string]string) (interface{}, error) {
+	f.Record("ListShipmentContentUpdatePreviews", inboundPlanId, shipmentId, query)
+	if f.ListShipmentContentUpdatePreviewsFunc == nil {
+		return nil, fmt.Errorf("ListShipmentContentUpdatePreviews: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListShipmentContentUpdatePreviewsFunc(ctx, inboundPlanId, shipmentId, query)
+}
+
+// ListShipmentItems 记录调用并执行 ListShipmentItemsFunc。
+func (f *Fake) ListShipmentItems(ctx context.Context, inboundPlanId string, shipmentId string, query map[string]string) (interface{}, error) {
+	f.Record("ListShipmentItems", inboundPlanId, shipmentId, query)
+	if f.ListShipmentItemsFunc == nil {
+		return nil, fmt.Errorf("ListShipmentItems: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListShipmentItemsFunc(ctx, inboundPlanId, shipmentId, query)
+}
+
+// ListShipmentPallets 记录调用并执行 ListShipmentPalletsFunc。
+func (f *Fake) ListShipmentPallets(ctx context.Context, inboundPlanId string, shipmentId string, query map[string]string) (interface{}, error) {
+	f.Record("ListShipmentPallets", inboundPlanId, shipmentId, query)
+	if f.ListShipmentPalletsFunc == nil {
+		return nil, fmt.Errorf("ListShipmentPallets: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListShipmentPalletsFunc(ctx, inboundPlanId, shipmentId, query)
+}
+
+// ListTransportationOptions 记录调用并执行 ListTransportationOptionsFunc。
+func (f *Fake) ListTransportationOptions(ctx context.Context, inboundPlanId string, query map[string]string) (interface{}, error) {
+	f.Record("ListTransportationOptions", inboundPlanId, query)
+	if f.ListTransportationOptionsFunc == nil {
+		return nil, fmt.Errorf("ListTransportationOptions: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListTransportationOptionsFunc(ctx, inboundPlanId, query)
+}
+
+// ScheduleSelfShipAppointment 记录调用并执行 ScheduleSelfShipAppointmentFunc。
+func (f *Fake) ScheduleSelfShipAppointment(ctx context.Context, inboundPlanId string, shipmentId string, slotId string, body interface{}) (interface{}, error) {
+	f.Record("ScheduleSelfShipAppointment", inboundPlanId, shipmentId, slotId, body)
+	if f.ScheduleSelfShipAppointmentFunc == nil {
+		return nil, fmt.Errorf("ScheduleSelfShipAppointment: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ScheduleSelfShipAppointmentFunc(ctx, inboundPlanId, shipmentId, slotId, body)
+}
+
+// SetPackingInformation 记录调用并执行 SetPackingInformationFunc。
+func (f *Fake) SetPackingInformation(ctx context.Context, inboundPlanId string, body interface{}) (interface{}, error) {
+	f.Record("SetPackingInformation", inboundPlanId, body)
+	if f.SetPackingInformationFunc == nil {
+		return nil, fmt.Errorf("SetPackingInformation: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.SetPackingInformationFunc(ctx, inboundPlanId, body)
+}
+
+// SetPrepDetails 记录调用并执行 SetPrepDetailsFunc。
+func (f *Fake) SetPrepDetails(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("SetPrepDetails", body)
+	if f.SetPrepDetailsFunc == nil {
+		return nil, fmt.Errorf("SetPrepDetails: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.SetPrepDetailsFunc(ctx, body)
+}
+
+// UpdateInboundPlanName 记录调用并执行 UpdateInboundPlanNameFunc。
+func (f *Fake) UpdateInboundPlanName(ctx context.Context, inboundPlanId string, body interface{}) (interface{}, error) {
+	f.Record("UpdateInboundPlanName", inboundPlanId, body)
+	if f.UpdateInboundPlanNameFunc == nil {
+		return nil, fmt.Errorf("UpdateInboundPlanName: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.UpdateInboundPlanNameFunc(ctx, inboundPlanId, body)
+}
+
+// UpdateItemComplianceDetails 记录调用并执行 UpdateItemComplianceDetailsFunc。
+func (f *Fake) UpdateItemComplianceDetails(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("UpdateItemComplianceDetails", body)
+	if f.UpdateItemComplianceDetailsFunc == nil {
+		return nil, fmt.Errorf("UpdateItemComplianceDetails: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.UpdateItemComplianceDetailsFunc(ctx, body)
+}
+
+// UpdateShipmentName 记录调用并执行 UpdateShipmentNameFunc。
+func (f *Fake) UpdateShipmentName(ctx context.Context, inboundPlanId string, shipmentId string, body interface{}) (interface{}, error) {
+	f.Record("UpdateShipmentName", inboundPlanId, shipmentId, body)
+	if f.UpdateShipmentNameFunc == nil {
+		return nil, fmt.Errorf("UpdateShipmentName: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.UpdateShipmentNameFunc(ctx, inboundPlanId, shipmentId, body)
+}
+
+// UpdateShipmentSourceAddress 记录调用并执行 UpdateShipmentSourceAddressFunc。
+func (f *Fake) UpdateShipmentSourceAddress(ctx context.Context, inboundPlanId string, shipmentId string, body interface{}) (interface{}, error) {
+	f.Record("UpdateShipmentSourceAddress", inboundPlanId, shipmentId, body)
+	if f.UpdateShipmentSourceAddressFunc == nil {
+		return nil, fmt.Errorf("UpdateShipmentSourceAddress: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.UpdateShipmentSourceAddressFunc(ctx, inboundPlanId, shipmentId, body)
+}
+
+// UpdateShipmentTrackingDetails 记录调用并执行 UpdateShipmentTrackingDetailsFunc。
+func (f *Fake) UpdateShipmentTrackingDetails(ctx context.Context, inboundPlanId string, shipmentId string, body interface{}) (interface{}, error) {
+	f.Record("UpdateShipmentTrackingDetails", inboundPlanId, shipmentId, body)
+	if f.UpdateShipmentTrackingDetailsFunc == nil {
+		return nil, fmt.Errorf("UpdateShipmentTrackingDetails: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.UpdateShipmentTrackingDetailsFunc(ctx, inboundPlanId, shipmentId, body)
+}
+
+// WaitForInboundOperation 记录调用并执行 WaitForInboundOperationFunc。
+func (f *Fake) WaitForInboundOperation(ctx context.Context, operationID string, opts ...spapi.PollerOption) (interface{}, error) {
+	f.Record("WaitForInboundOperation", operationID, opts)
+	if f.WaitForInboundOperationFunc == nil {
+		return nil, fmt.Errorf("WaitForInboundOperation: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.WaitForInboundOperationFunc(ctx, operationID, opts...)
+}
+
+// 确保 Fake 实现了 API。
+var _ API = (*Fake)(nil)
@@ -8,10 +8,19 @@
  */
 package fulfillment_inbound_v2024_03_20
 
+import (
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
 // The type and amount of currency.
 type Currency struct {
 	// Decimal value of the currency.
-	Amount float64 `json:"amount"`
+	Amount spapi.Decimal `json:"amount"`
 	// ISO 4217 standard of a currency code.
 	Code string `json:"code"`
 }
+
+// ToMoney 转换为 spapi.Money。
+func (c Currency) ToMoney() spapi.Money {
+	return spapi.NewMoney(c.Amount, c.Code)
+}
@@ -0,0 +1,73 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package fulfillment_inbound_v2024_03_20
+
+import (
+	"context"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// WaitForInboundOperation 轮询 GetInboundOperationStatus 直到操作完成（SUCCESS、FAILED）。
+//
+// v2024-03-20 的大部分写操作（createInboundPlan、generatePackingOptions 等）
+// 返回 operationId，需要轮询确认结果。操作进入 FAILED 时返回 spapi.ErrPollFailed，
+// 结果中的 operationProblems 描述失败原因。
+//
+// 参数:
+//   - ctx: 请求上下文
+//   - operationID: 操作 ID
+//   - opts: 轮询器配置选项
+//
+// 返回值:
+//   - interface{}: 最后一次 GetInboundOperationStatus 的结果
+//   - error: 如果轮询失败，返回错误
+func (c *Client) WaitForInboundOperation(ctx context.Context, operationID string, opts ...spapi.PollerOption) (interface{}, error) {
+	poller := spapi.NewPoller(
+		func(ctx context.Context) (interface{}, error) {
+			return c.GetInboundOperationStatus(ctx, operationID, nil)
+		},
+		spapi.StatusAt[interface{}]("operationStatus"),
+		append([]spapi.PollerOption{
+			spapi.WithTerminalStates(spapi.OperationStatusTerminal...),
+			spapi.WithFailureStates(spapi.OperationStatusFailed...),
+		}, opts...)...,
+	)
+	return poller.Wait(ctx)
+}
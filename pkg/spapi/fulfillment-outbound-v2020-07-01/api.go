@@ -0,0 +1,106 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package fulfillment_outbound_v2020_07_01
+
+import (
+	"context"
+	"iter"
+	"time"
+)
+
+// API 是 fulfillment-outbound API v2020-07-01 客户端的全部方法，包括分页迭代器等辅助方法。
+//
+// 业务代码依赖 API 而不是 *Client，即可在单元测试中用 Fake 替换真实客户端。
+type API interface {
+	// CancelFulfillmentOrder
+	// Method: PUT | Path: /fba/outbound/2020-07-01/fulfillmentOrders/{sellerFulfillmentOrderId}/cancel
+	CancelFulfillmentOrder(ctx context.Context, sellerFulfillmentOrderId string, body interface{}) (interface{}, error)
+
+	// CreateFulfillmentOrder
+	// Method: POST | Path: /fba/outbound/2020-07-01/fulfillmentOrders
+	CreateFulfillmentOrder(ctx context.Context, body interface{}) (interface{}, error)
+
+	// CreateFulfillmentOrderIdempotent 以 sellerFulfillmentOrderId 为幂等键创建订单。
+	CreateFulfillmentOrderIdempotent(ctx context.Context, request *CreateFulfillmentOrderRequest) (*GetFulfillmentOrderResult, bool, error)
+
+	// CreateFulfillmentReturn
+	// Method: PUT | Path: /fba/outbound/2020-07-01/fulfillmentOrders/{sellerFulfillmentOrderId}/return
+	CreateFulfillmentReturn(ctx context.Context, sellerFulfillmentOrderId string, body interface{}) (interface{}, error)
+
+	// DeliveryOffers
+	// Method: POST | Path: /fba/outbound/2020-07-01/deliveryOffers
+	DeliveryOffers(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetFeatureInventory
+	// Method: GET | Path: /fba/outbound/2020-07-01/features/inventory/{featureName}
+	GetFeatureInventory(ctx context.Context, featureName string, query map[string]string) (interface{}, error)
+
+	// GetFeatureSKU
+	// Method: GET | Path: /fba/outbound/2020-07-01/features/inventory/{featureName}/{sellerSku}
+	GetFeatureSKU(ctx context.Context, featureName string, sellerSku string, query map[string]string) (interface{}, error)
+
+	// GetFeatures
+	// Method: GET | Path: /fba/outbound/2020-07-01/features
+	GetFeatures(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetFulfillmentOrder
+	// Method: GET | Path: /fba/outbound/2020-07-01/fulfillmentOrders/{sellerFulfillmentOrderId}
+	GetFulfillmentOrder(ctx context.Context, sellerFulfillmentOrderId string, query map[string]string) (interface{}, error)
+
+	// GetFulfillmentPreview
+	// Method: POST | Path: /fba/outbound/2020-07-01/fulfillmentOrders/preview
+	GetFulfillmentPreview(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetPackageTrackingDetails
+	// Method: GET | Path: /fba/outbound/2020-07-01/tracking
+	GetPackageTrackingDetails(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// IterateAllFulfillmentOrders 返回配送订单迭代器，自动处理分页。
+	IterateAllFulfillmentOrders(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// IterateChangedFulfillmentOrders 返回自 since 以来状态发生变化的订单迭代器。
+	IterateChangedFulfillmentOrders(ctx context.Context, since time.Time) iter.Seq2[FulfillmentOrder, error]
+
+	// ListAllFulfillmentOrders
+	// Method: GET | Path: /fba/outbound/2020-07-01/fulfillmentOrders
+	ListAllFulfillmentOrders(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// ListReturnReasonCodes
+	// Method: GET | Path: /fba/outbound/2020-07-01/returnReasonCodes
+	ListReturnReasonCodes(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// PreviewShippingSpeeds 预览购物车在各配送速度下的履约情况。
+	PreviewShippingSpeeds(ctx context.Context, request *GetFulfillmentPreviewRequest) ([]ShippingSpeedOption, error)
+
+	// SubmitFulfillmentOrderStatusUpdate
+	// Method: PUT | Path: /fba/outbound/2020-07-01/fulfillmentOrders/{sellerFulfillmentOrderId}/status
+	SubmitFulfillmentOrderStatusUpdate(ctx context.Context, sellerFulfillmentOrderId string, body interface{}) (interface{}, error)
+
+	// TrackFulfillmentOrder 汇总订单所有货件、所有包裹的追踪信息。
+	TrackFulfillmentOrder(ctx context.Context, sellerFulfillmentOrderID string) (*OrderTracking, error)
+
+	// UpdateFulfillmentOrder
+	// Method: PUT | Path: /fba/outbound/2020-07-01/fulfillmentOrders/{sellerFulfillmentOrderId}
+	UpdateFulfillmentOrder(ctx context.Context, sellerFulfillmentOrderId string, body interface{}) (interface{}, error)
+}
+
+// 确保 Client 实现了 API。
+var _ API = (*Client)(nil)
@@ -0,0 +1,275 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package fulfillment_outbound_v2020_07_01
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。
+//
+// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回
+// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，
+// 可以通过 Calls 和 CallsTo 查看。
+type Fake struct {
+	spapi.FakeRecorder
+
+	// CancelFulfillmentOrderFunc 实现 CancelFulfillmentOrder。
+	CancelFulfillmentOrderFunc func(ctx context.Context, sellerFulfillmentOrderId string, body interface{}) (interface{}, error)
+
+	// CreateFulfillmentOrderFunc 实现 CreateFulfillmentOrder。
+	CreateFulfillmentOrderFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// CreateFulfillmentOrderIdempotentFunc 实现 CreateFulfillmentOrderIdempotent。
+	CreateFulfillmentOrderIdempotentFunc func(ctx context.Context, request *CreateFulfillmentOrderRequest) (*GetFulfillmentOrderResult, bool, error)
+
+	// CreateFulfillmentReturnFunc 实现 CreateFulfillmentReturn。
+	CreateFulfillmentReturnFunc func(ctx context.Context, sellerFulfillmentOrderId string, body interface{}) (interface{}, error)
+
+	// DeliveryOffersFunc 实现 DeliveryOffers。
+	DeliveryOffersFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetFeatureInventoryFunc 实现 GetFeatureInventory。
+	GetFeatureInventoryFunc func(ctx context.Context, featureName string, query map[string]string) (interface{}, error)
+
+	// GetFeatureSKUFunc 实现 GetFeatureSKU。
+	GetFeatureSKUFunc func(ctx context.Context, featureName string, sellerSku string, query map[string]string) (interface{}, error)
+
+	// GetFeaturesFunc 实现 GetFeatures。
+	GetFeaturesFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetFulfillmentOrderFunc 实现 GetFulfillmentOrder。
+	GetFulfillmentOrderFunc func(ctx context.Context, sellerFulfillmentOrderId string, query map[string]string) (interface{}, error)
+
+	// GetFulfillmentPreviewFunc 实现 GetFulfillmentPreview。
+	GetFulfillmentPreviewFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetPackageTrackingDetailsFunc 实现 GetPackageTrackingDetails。
+	GetPackageTrackingDetailsFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// IterateAllFulfillmentOrdersFunc 实现 IterateAllFulfillmentOrders。
+	IterateAllFulfillmentOrdersFunc func(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// IterateChangedFulfillmentOrdersFunc 实现 IterateChangedFulfillmentOrders。
+	IterateChangedFulfillmentOrdersFunc func(ctx context.Context, since time.Time) iter.Seq2[FulfillmentOrder, error]
+
+	// ListAllFulfillmentOrdersFunc 实现 ListAllFulfillmentOrders。
+	ListAllFulfillmentOrdersFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// ListReturnReasonCodesFunc 实现 ListReturnReasonCodes。
+	ListReturnReasonCodesFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// PreviewShippingSpeedsFunc 实现 PreviewShippingSpeeds。
+	PreviewShippingSpeedsFunc func(ctx context.Context, request *GetFulfillmentPreviewRequest) ([]ShippingSpeedOption, error)
+
+	// SubmitFulfillmentOrderStatusUpdateFunc 实现 SubmitFulfillmentOrderStatusUpdate。
+	SubmitFulfillmentOrderStatusUpdateFunc func(ctx context.Context, sellerFulfillmentOrderId string, body interface{}) (interface{}, error)
+
+	// TrackFulfillmentOrderFunc 实现 TrackFulfillmentOrder。
+	TrackFulfillmentOrderFunc func(ctx context.Context, sellerFulfillmentOrderID string) (*OrderTracking, error)
+
+	// UpdateFulfillmentOrderFunc 实现 UpdateFulfillmentOrder。
+	UpdateFulfillmentOrderFunc func(ctx context.Context, sellerFulfillmentOrderId string, body interface{}) (interface{}, error)
+}
+
+// NewFake 创建未编程任何响应的 Fake。
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// CancelFulfillmentOrder 记录调用并执行 CancelFulfillmentOrderFunc。
+func (f *Fake) CancelFulfillmentOrder(ctx context.Context, sellerFulfillmentOrderId string, body interface{}) (interface{}, error) {
+	f.Record("CancelFulfillmentOrder", sellerFulfillmentOrderId, body)
+	if f.CancelFulfillmentOrderFunc == nil {
+		return nil, fmt.Errorf("CancelFulfillmentOrder: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CancelFulfillmentOrderFunc(ctx, sellerFulfillmentOrderId, body)
+}
+
+// CreateFulfillmentOrder 记录调用并执行 CreateFulfillmentOrderFunc。
+func (f *Fake) CreateFulfillmentOrder(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("CreateFulfillmentOrder", body)
+	if f.CreateFulfillmentOrderFunc == nil {
+		return nil, fmt.Errorf("CreateFulfillmentOrder: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateFulfillmentOrderFunc(ctx, body)
+}
+
+// CreateFulfillmentOrderIdempotent 记录调用并执行 CreateFulfillmentOrderIdempotentFunc。
+func (f *Fake) CreateFulfillmentOrderIdempotent(ctx context.Context, request *CreateFulfillmentOrderRequest) (*GetFulfillmentOrderResult, bool, error) {
+	f.Record("CreateFulfillmentOrderIdempotent", request)
+	if f.CreateFulfillmentOrderIdempotentFunc == nil {
+		return nil, false, fmt.Errorf("CreateFulfillmentOrderIdempotent: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateFulfillmentOrderIdempotentFunc(ctx, request)
+}
+
+// CreateFulfillmentReturn 记录调用并执行 CreateFulfillmentReturnFunc。
+func (f *Fake) CreateFulfillmentReturn(ctx context.Context, sellerFulfillmentOrderId string, body interface{}) (interface{}, error) {
+	f.Record("CreateFulfillmentReturn", sellerFulfillmentOrderId, body)
+	if f.CreateFulfillmentReturnFunc == nil {
+		return nil, fmt.Errorf("CreateFulfillmentReturn: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateFulfillmentReturnFunc(ctx, sellerFulfillmentOrderId, body)
+}
+
+// DeliveryOffers 记录调用并执行 DeliveryOffersFunc。
+func (f *Fake) DeliveryOffers(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("DeliveryOffers", body)
+	if f.DeliveryOffersFunc == nil {
+		return nil, fmt.Errorf("DeliveryOffers: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.DeliveryOffersFunc(ctx, body)
+}
+
+// GetFeatureInventory 记录调用并执行 GetFeatureInventoryFunc。
+func (f *Fake) GetFeatureInventory(ctx context.Context, featureName string, query map[string]string) (interface{}, error) {
+	f.Record("GetFeatureInventory", featureName, query)
+	if f.GetFeatureInventoryFunc == nil {
+		return nil, fmt.Errorf("GetFeatureInventory: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetFeatureInventoryFunc(ctx, featureName, query)
+}
+
+// GetFeatureSKU 记录调用并执行 GetFeatureSKUFunc。
+func (f *Fake) GetFeatureSKU(ctx context.Context, featureName string, sellerSku string, query map[string]string) (interface{}, error) {
+	f.Record("GetFeatureSKU", featureName, sellerSku, query)
+	if f.GetFeatureSKUFunc == nil {
+		return nil, fmt.Errorf("GetFeatureSKU: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetFeatureSKUFunc(ctx, featureName, sellerSku, query)
+}
+
+// GetFeatures 记录调用并执行 GetFeaturesFunc。
+func (f *Fake) GetFeatures(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetFeatures", query)
+	if f.GetFeaturesFunc == nil {
+		return nil, fmt.Errorf("GetFeatures: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetFeaturesFunc(ctx, query)
+}
+
+// GetFulfillmentOrder 记录调用并执行 GetFulfillmentOrderFunc。
+func (f *Fake) GetFulfillmentOrder(ctx context.Context, sellerFulfillmentOrderId string, query map[string]string) (interface{}, error) {
+	f.Record("GetFulfillmentOrder", sellerFulfillmentOrderId, query)
+	if f.GetFulfillmentOrderFunc == nil {
+		return nil, fmt.Errorf("GetFulfillmentOrder: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetFulfillmentOrderFunc(ctx, sellerFulfillmentOrderId, query)
+}
+
+// GetFulfillmentPreview 记录调用并执行 GetFulfillmentPreviewFunc。
+func (f *Fake) GetFulfillmentPreview(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("GetFulfillmentPreview", body)
+	if f.GetFulfillmentPreviewFunc == nil {
+		return nil, fmt.Errorf("GetFulfillmentPreview: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetFulfillmentPreviewFunc(ctx, body)
+}
+
+// GetPackageTrackingDetails 记录调用并执行 GetPackageTrackingDetailsFunc。
+func (f *Fake) GetPackageTrackingDetails(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetPackageTrackingDetails", query)
+	if f.GetPackageTrackingDetailsFunc == nil {
+		return nil, fmt.Errorf("GetPackageTrackingDetails: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetPackageTrackingDetailsFunc(ctx, query)
+}
+
+// IterateAllFulfillmentOrders 记录调用并执行 IterateAllFulfillmentOrdersFunc。
+func (f *Fake) IterateAllFulfillmentOrders(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+	f.Record("IterateAllFulfillmentOrders", query)
+	if f.IterateAllFulfillmentOrdersFunc == nil {
+		return spapi.FakeSeq[map[string]interface{}](nil, fmt.Errorf("IterateAllFulfillmentOrders: %w", spapi.ErrFakeNotConfigured))
+	}
+	return f.IterateAllFulfillmentOrdersFunc(ctx, query)
+}
+
+// IterateChangedFulfillmentOrders 记录调用并执行 IterateChangedFulfillmentOrdersFunc。
+func (f *Fake) IterateChangedFulfillmentOrders(ctx context.Context, since time.Time) iter.Seq2[FulfillmentOrder, error] {
+	f.Record("IterateChangedFulfillmentOrders", since)
+	if f.IterateChangedFulfillmentOrdersFunc == nil {
+		return spapi.FakeSeq[FulfillmentOrder](nil, fmt.Errorf("IterateChangedFulfillmentOrders: %w", spapi.ErrFakeNotConfigured))
+	}
+	return f.IterateChangedFulfillmentOrdersFunc(ctx, since)
+}
+
+// ListAllFulfillmentOrders 记录调用并执行 ListAllFulfillmentOrdersFunc。
+func (f *Fake) ListAllFulfillmentOrders(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("ListAllFulfillmentOrders", query)
+	if f.ListAllFulfillmentOrdersFunc == nil {
+		return nil, fmt.Errorf("ListAllFulfillmentOrders: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListAllFulfillmentOrdersFunc(ctx, query)
+}
+
+// ListReturnReasonCodes 记录调用并执行 ListReturnReasonCodesFunc。
+func (f *Fake) ListReturnReasonCodes(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("ListReturnReasonCodes", query)
+	if f.ListReturnReasonCodesFunc == nil {
+		return nil, fmt.Errorf("ListReturnReasonCodes: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListReturnReasonCodesFunc(ctx, query)
+}
+
+// PreviewShippingSpeeds 记录调用并执行 PreviewShippingSpeedsFunc。
+func (f *Fake) PreviewShippingSpeeds(ctx context.Context, request *GetFulfillmentPreviewRequest) ([]ShippingSpeedOption, error) {
+	f.Record("PreviewShippingSpeeds", request)
+	if f.PreviewShippingSpeedsFunc == nil {
+		return nil, fmt.Errorf("PreviewShippingSpeeds: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.PreviewShippingSpeedsFunc(ctx, request)
+}
+
+// SubmitFulfillmentOrderStatusUpdate 记录调用并执行 SubmitFulfillmentOrderStatusUpdateFunc。
+func (f *Fake) SubmitFulfillmentOrderStatusUpdate(ctx context.Context, sellerFulfillmentOrderId string, body interface{}) (interface{}, error) {
+	f.Record("SubmitFulfillmentOrderStatusUpdate", sellerFulfillmentOrderId, body)
+	if f.SubmitFulfillmentOrderStatusUpdateFunc == nil {
+		return nil, fmt.Errorf("SubmitFulfillmentOrderStatusUpdate: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.SubmitFulfillmentOrderStatusUpdateFunc(ctx, sellerFulfillmentOrderId, body)
+}
+
+// TrackFulfillmentOrder 记录调用并执行 TrackFulfillmentOrderFunc。
+func (f *Fake) TrackFulfillmentOrder(ctx context.Context, sellerFulfillmentOrderID string) (*OrderTracking, error) {
+	f.Record("TrackFulfillmentOrder", sellerFulfillmentOrderID)
+	if f.TrackFulfillmentOrderFunc == nil {
+		return nil, fmt.Errorf("TrackFulfillmentOrder: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.TrackFulfillmentOrderFunc(ctx, sellerFulfillmentOrderID)
+}
+
+// UpdateFulfillmentOrder 记录调用并执行 UpdateFulfillmentOrderFunc。
+func (f *Fake) UpdateFulfillmentOrder(ctx context.Context, sellerFulfillmentOrderId string, body interface{}) (interface{}, error) {
+	f.Record("UpdateFulfillmentOrder", sellerFulfillmentOrderId, body)
+	if f.UpdateFulfillmentOrderFunc == nil {
+		return nil, fmt.Errorf("UpdateFulfillmentOrder: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.UpdateFulfillmentOrderFunc(ctx, sellerFulfillmentOrderId, body)
+}
+
+// 确保 Fake 实现了 API。
+var _ API = (*Fake)(nil)
@@ -0,0 +1,392 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package fulfillment_outbound_v2020_07_01
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// ShippingSpeedOption 是某个配送速度的预览结果摘要。
+type ShippingSpeedOption struct {
+	// Category 是配送速度（Standard、Expedited、Priority、ScheduledDelivery）
+	Category ShippingSpeedCategory
+
+	// Fulfillable 表示此速度下订单可以履约
+	Fulfillable bool
+
+	// EarliestArrival 是所有预览货件中最早的预计送达时间
+	EarliestArrival *time.Time
+
+	// LatestArrival 是所有预览货件中最晚的预计送达时间
+	LatestArrival *time.Time
+
+	// EstimatedFees 是预估费用
+	EstimatedFees []Fee
+
+	// UnfulfillableReasons 是订单无法履约的原因
+	UnfulfillableReasons []string
+
+	// Preview 是原始预览结果
+	Preview FulfillmentPreview
+}
+
+// PreviewShippingSpeeds 预览购物车在各配送速度下的履约情况。
+//
+// 参数:
+//   - ctx: 请求上下文
+//   - request: 预览请求（地址、商品、可选的配送速度列表）
+//
+// 返回值:
+//   - []ShippingSpeedOption: 每个配送速度的预览摘要
+//   - error: 如果请求失败，返回错误
+//
+// 示例:
+//
+//	options, err := client.PreviewShippingSpeeds(ctx, &GetFulfillmentPreviewRequest{
+//	    Address: &address,
+//	    Items:   &items,
+//	})
+//	for _, option := range options {
+//	    if option.Fulfillable {
+//	        fmt.Printf("%s: arrives by %v\n", option.Category, option.LatestArrival)
+//	    }
+//	}
+func (c *Client) PreviewShippingSpeeds(ctx context.Context, request *GetFulfillmentPreviewRequest) ([]ShippingSpeedOption, error) {
+	result, err := c.GetFulfillmentPreview(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var response GetFulfillmentPreviewResponse
+	if err := decodeResult(result, &response); err != nil {
+		return nil, fmt.Errorf("GetFulfillmentPreview: %w", err)
+	}
+	if response.Payload == nil || response.Payload.FulfillmentPreviews == nil {
+		return nil, nil
+	}
+
+	options := make([]ShippingSpeedOption, 0, len(*response.Payload.FulfillmentPreviews))
+	for _, preview := range *response.Payload.FulfillmentPreviews {
+		option := ShippingSpeedOption{
+			Fulfillable: preview.IsFulfillable,
+			Preview:     preview,
+		}
+		if preview.ShippingSpeedCategory != nil {
+			option.Category = *preview.ShippingSpeedCategory
+		}
+		if preview.EstimatedFees != nil {
+			option.EstimatedFees = *preview.EstimatedFees
+		}
+		if preview.OrderUnfulfillableReasons != nil {
+			option.UnfulfillableReasons = *preview.OrderUnfulfillableReasons
+		}
+		if preview.FulfillmentPreviewShipments != nil {
+			for _, shipment := range *preview.FulfillmentPreviewShipments {
+				if t := shipment.EarliestArrivalDate; t != nil && (option.EarliestArrival == nil || t.Before(*option.EarliestArrival)) {
+					option.EarliestArrival = t
+				}
+				if t := shipment.LatestArrivalDate; t != nil && (option.LatestArrival == nil || t.After(*option.LatestArrival)) {
+					option.LatestArrival = t
+				}
+			}
+		}
+		options = append(options, option)
+	}
+
+	return options, nil
+}
+
+// CreateFulfillmentOrderIdempotent 以 sellerFulfillmentOrderId 为幂等键创建订单。
+//
+// 先查询 sellerFulfillmentOrderId 对应的订单，已存在则直接返回；
+// 不存在（HTTP 404）才调用 CreateFulfillmentOrder。如果创建请求失败，
+// 会再查询一次，以处理并发重复创建或请求已成功但响应丢失的情况。
+//
+// 参数:
+//   - ctx: 请求上下文
+//   - request: 创建订单请求（必须设置 SellerFulfillmentOrderId）
+//
+// 返回值:
+//   - *GetFulfillmentOrderResult: 订单详情
+//   - bool: 本次调用是否新建了订单
+//   - error: 如果创建和查询都失败，返回错误
+func (c *Client) CreateFulfillmentOrderIdempotent(ctx context.Context, request *CreateFulfillmentOrderRequest) (*GetFulfillmentOrderResult, bool, error) {
+	if request == nil || request.SellerFulfillmentOrderId == "" {
+		return nil, false, fmt.Errorf("sellerFulfillmentOrderId is required: %w", spapi.ErrInvalidRequest)
+	}
+	orderID := request.SellerFulfillmentOrderId
+
+	existing, err := c.getFulfillmentOrder(ctx, orderID)
+	if err == nil {
+		return existing, false, nil
+	}
+	if !isNotFound(err) {
+		return nil, false, err
+	}
+
+	if _, createErr := c.CreateFulfillmentOrder(ctx, request); createErr != nil {
+		// 可能是并发创建或响应丢失，再确认一次
+		if existing, err := c.getFulfillmentOrder(ctx, orderID); err == nil {
+			return existing, false, nil
+		}
+		return nil, false, createErr
+	}
+
+	created, err := c.getFulfillmentOrder(ctx, orderID)
+	if err != nil {
+		return nil, true, err
+	}
+	return created, true, nil
+}
+
+// TrackingTimelineEvent 是订单追踪时间线中的一个事件。
+type TrackingTimelineEvent struct {
+	// Time 是事件时间
+	Time time.Time
+
+	// PackageNumber 是包裹编号
+	PackageNumber int32
+
+	// TrackingNumber 是包裹追踪号
+	TrackingNumber string
+
+	// CarrierCode 是承运商
+	CarrierCode string
+
+	// Code 是事件代码
+	Code EventCode
+
+	// Description 是事件描述
+	Description string
+
+	// Location 是事件地点
+	Location *TrackingAddress
+}
+
+// OrderTracking 是多包裹订单的汇总追踪信息。
+type OrderTracking struct {
+	// Order 是订单详情
+	Order *GetFulfillmentOrderResult
+
+	// Packages 是每个包裹的追踪详情
+	Packages []PackageTrackingDetails
+
+	// Timeline 是所有包裹事件按时间升序合并后的时间线
+	Timeline []TrackingTimelineEvent
+
+	// Delivered 表示所有包裹都已送达
+	Delivered bool
+}
+
+// TrackFulfillmentOrder 汇总订单所有货件、所有包裹的追踪信息。
+//
+// 依次调用 GetFulfillmentOrder 和每个包裹的 GetPackageTrackingDetails，
+// 将所有追踪事件合并为一条按时间排序的时间线。
+//
+// 参数:
+//   - ctx: 请求上下文
+//   - sellerFulfillmentOrderID: 卖家订单 ID
+//
+// 返回值:
+//   - *OrderTracking: 汇总追踪信息
+//   - error: 如果请求失败，返回错误
+func (c *Client) TrackFulfillmentOrder(ctx context.Context, sellerFulfillmentOrderID string) (*OrderTracking, error) {
+	order, err := c.getFulfillmentOrder(ctx, sellerFulfillmentOrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	tracking := &OrderTracking{Order: order}
+	if order.FulfillmentShipments == nil {
+		return tracking, nil
+	}
+
+	for _, shipment := range *order.FulfillmentShipments {
+		if shipment.FulfillmentShipmentPackage == nil {
+			continue
+		}
+		for _, pkg := range *shipment.FulfillmentShipmentPackage {
+			details, err := c.getPackageTrackingDetails(ctx, pkg.PackageNumber)
+			if err != nil {
+				return nil, fmt.Errorf("package %d: %w", pkg.PackageNumber, err)
+			}
+			tracking.Packages = append(tracking.Packages, *details)
+		}
+	}
+
+	tracking.Delivered = len(tracking.Packages) > 0
+	for _, details := range tracking.Packages {
+		if details.CurrentStatus == nil || *details.CurrentStatus != DELIVERED_CurrentStatus {
+			tracking.Delivered = false
+		}
+		if details.TrackingEvents == nil {
+			continue
+		}
+		for _, event := range *details.TrackingEvents {
+			timelineEvent := TrackingTimelineEvent{
+				PackageNumber:  details.PackageNumber,
+				TrackingNumber: details.TrackingNumber,
+				CarrierCode:    details.CarrierCode,
+				Description:    event.EventDescription,
+				Location:       event.EventAddress,
+			}
+			if event.EventDate != nil {
+				timelineEvent.Time = *event.EventDate
+			}
+			if event.EventCode != nil {
+				timelineEvent.Code = *event.EventCode
+			}
+			tracking.Timeline = append(tracking.Timeline, timelineEvent)
+		}
+	}
+
+	slices.SortStableFunc(tracking.Timeline, func(a, b TrackingTimelineEvent) int {
+		return a.Time.Compare(b.Time)
+	})
+
+	return tracking, nil
+}
+
+// IterateChangedFulfillmentOrders 返回自 since 以来状态发生变化的订单迭代器。
+//
+// 基于 IterateAllFulfillmentOrders（queryStartDate=since），自动处理分页，
+// 并按 statusUpdatedDate 过滤，只返回 since 之后（含）更新过的订单。
+//
+// 参数:
+//   - ctx: 请求上下文
+//   - since: 起始时间
+//
+// 返回值:
+//   - iter.Seq2[FulfillmentOrder, error]: 订单迭代器
+//
+// 示例:
+//
+//	for order, err := range client.IterateChangedFulfillmentOrders(ctx, lastSync) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Println(order.SellerFulfillmentOrderId, *order.FulfillmentOrderStatus)
+//	}
+func (c *Client) IterateChangedFulfillmentOrders(ctx context.Context, since time.Time) iter.Seq2[FulfillmentOrder, error] {
+	return func(yield func(FulfillmentOrder, error) bool) {
+		query := map[string]string{
+			"queryStartDate": since.UTC().Format(time.RFC3339),
+		}
+
+		for item, err := range c.IterateAllFulfillmentOrders(ctx, query) {
+			if err != nil {
+				yield(FulfillmentOrder{}, err)
+				return
+			}
+
+			var order FulfillmentOrder
+			if err := decodeResult(item, &order); err != nil {
+				yield(FulfillmentOrder{}, fmt.Errorf("decode fulfillment order: %w", err))
+				return
+			}
+
+			if order.StatusUpdatedDate != nil && order.StatusUpdatedDate.Before(since) {
+				continue
+			}
+
+			if !yield(order, nil) {
+				return
+			}
+		}
+	}
+}
+
+// getFulfillmentOrder 获取并解码订单详情。
+func (c *Client) getFulfillmentOrder(ctx context.Context, sellerFulfillmentOrderID string) (*GetFulfillmentOrderResult, error) {
+	result, err := c.GetFulfillmentOrder(ctx, sellerFulfillmentOrderID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response GetFulfillmentOrderResponse
+	if err := decodeResult(result, &response); err != nil {
+		return nil, fmt.Errorf("GetFulfillmentOrder: %w", err)
+	}
+	if response.Payload == nil {
+		return nil, fmt.Errorf("GetFulfillmentOrder: empty payload")
+	}
+	return response.Payload, nil
+}
+
+// getPackageTrackingDetails 获取并解码包裹追踪详情。
+func (c *Client) getPackageTrackingDetails(ctx context.Context, packageNumber int32) (*PackageTrackingDetails, error) {
+	result, err := c.GetPackageTrackingDetails(ctx, map[string]string{
+		"packageNumber": strconv.Itoa(int(packageNumber)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response GetPackageTrackingDetailsResponse
+	if err := decodeResult(result, &response); err != nil {
+		return nil, fmt.Errorf("GetPackageTrackingDetails: %w", err)
+	}
+	if response.Payload == nil {
+		return nil, fmt.Errorf("GetPackageTrackingDetails: empty payload")
+	}
+	return response.Payload, nil
+}
+
+// isNotFound 判断错误是否为 HTTP 404。
+func isNotFound(err error) bool {
+	return errors.Is(err, spapi.ErrResourceNotFound)
+}
+
+// decodeResult 将客户端返回的 interface{} 结果转换为类型化模型。
+func decodeResult(result interface{}, out interface{}) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("marshal result: %w", err)
+	}
+	return json.Unmarshal(data, out)
+}
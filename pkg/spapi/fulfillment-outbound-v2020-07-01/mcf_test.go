@@ -0,0 +1,208 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+package fulfillment_outbound_v2020_07_01_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	api "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/fulfillment-outbound-v2020-07-01"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *api.Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/auth/o2/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"access_token":"test-token","token_type":"bearer","expires_in":3600}`))
+	})
+	mux.HandleFunc("/", handler)
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	baseClient, err := spapi.NewClient(
+		spapi.WithRegion(spapi.Region{Code: "test", Endpoint: server.URL, LWAEndpoint: server.URL + "/auth/o2/token"}),
+		spapi.WithCredentials("test", "test", "test"),
+		spapi.WithMaxRetries(0),
+	)
+	if err != nil {
+		t.Fatalf("create base client: %v", err)
+	}
+	return api.NewClient(baseClient)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func TestPreviewShippingSpeeds(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{"payload": map[string]interface{}{
+			"fulfillmentPreviews": []interface{}{
+				map[string]interface{}{
+					"shippingSpeedCategory": "Expedited",
+					"isFulfillable":         true,
+					"estimatedFees":         []interface{}{map[string]interface{}{"name": "FBAPerUnitFulfillmentFee", "amount": map[string]interface{}{"currencyCode": "USD", "value": "3.22"}}},
+					"fulfillmentPreviewShipments": []interface{}{
+						map[string]interface{}{"earliestArrivalDate": "2025-01-03T00:00:00Z", "latestArrivalDate": "2025-01-04T00:00:00Z"},
+						map[string]interface{}{"earliestArrivalDate": "2025-01-02T00:00:00Z", "latestArrivalDate": "2025-01-05T00:00:00Z"},
+					},
+				},
+			},
+		}})
+	})
+
+	options, err := client.PreviewShippingSpeeds(context.Background(), &api.GetFulfillmentPreviewRequest{})
+	if err != nil {
+		t.Fatalf("PreviewShippingSpeeds() error = %v", err)
+	}
+	if len(options) != 1 {
+		t.Fatalf("got %d options, want 1", len(options))
+	}
+
+	option := options[0]
+	if option.Category != api.EXPEDITED_ShippingSpeedCategory || !option.Fulfillable || len(option.EstimatedFees) != 1 {
+		t.Errorf("unexpected option: %+v", option)
+	}
+	if option.EarliestArrival.Day() != 2 || option.LatestArrival.Day() != 5 {
+		t.Errorf("arrival window = %v - %v", option.EarliestArrival, option.LatestArrival)
+	}
+}
+
+func TestCreateFulfillmentOrderIdempotent(t *testing.T) {
+	exists := false
+	creates := 0
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/fba/outbound/2020-07-01/fulfillmentOrders/order-1":
+			if !exists {
+				writeJSON(w, http.StatusNotFound, map[string]interface{}{"errors": []interface{}{map[string]interface{}{"code": "NotFound", "message": "not found"}}})
+				return
+			}
+			writeJSON(w, http.StatusOK, map[string]interface{}{"payload": map[string]interface{}{
+				"fulfillmentOrder": map[string]interface{}{"sellerFulfillmentOrderId": "order-1", "fulfillmentOrderStatus": "Received"},
+			}})
+		case r.Method == http.MethodPost && r.URL.Path == "/fba/outbound/2020-07-01/fulfillmentOrders":
+			creates++
+			exists = true
+			writeJSON(w, http.StatusOK, map[string]interface{}{})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	request := &api.CreateFulfillmentOrderRequest{SellerFulfillmentOrderId: "order-1"}
+
+	order, created, err := client.CreateFulfillmentOrderIdempotent(context.Background(), request)
+	if err != nil || !created || order.FulfillmentOrder.SellerFulfillmentOrderId != "order-1" {
+		t.Fatalf("first call: order=%+v created=%v err=%v", order, created, err)
+	}
+
+	_, created, err = client.CreateFulfillmentOrderIdempotent(context.Background(), request)
+	if err != nil || created {
+		t.Fatalf("second call: created=%v err=%v", created, err)
+	}
+	if creates != 1 {
+		t.Errorf("CreateFulfillmentOrder called %d times, want 1", creates)
+	}
+}
+
+func TestTrackFulfillmentOrder(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/fba/outbound/2020-07-01/fulfillmentOrders/order-1":
+			writeJSON(w, http.StatusOK, map[string]interface{}{"payload": map[string]interface{}{
+				"fulfillmentShipments": []interface{}{
+					map[string]interface{}{"amazonShipmentId": "s1", "fulfillmentShipmentPackage": []interface{}{
+						map[string]interface{}{"packageNumber": 1, "carrierCode": "UPS"},
+					}},
+					map[string]interface{}{"amazonShipmentId": "s2", "fulfillmentShipmentPackage": []interface{}{
+						map[string]interface{}{"packageNumber": 2, "carrierCode": "USPS"},
+					}},
+				},
+			}})
+		case "/fba/outbound/2020-07-01/tracking":
+			number := r.URL.Query().Get("packageNumber")
+			events := map[string][]interface{}{
+				"1": {
+					map[string]interface{}{"eventDate": "2025-01-01T10:00:00Z", "eventCode": "EVENT_101", "eventDescription": "Shipped"},
+					map[string]interface{}{"eventDate": "2025-01-03T10:00:00Z", "eventCode": "EVENT_301", "eventDescription": "Delivered"},
+				},
+				"2": {
+					map[string]interface{}{"eventDate": "2025-01-02T10:00:00Z", "eventCode": "EVENT_101", "eventDescription": "Shipped"},
+				},
+			}
+			status := map[string]string{"1": "DELIVERED", "2": "IN_TRANSIT"}
+			packageNumber, _ := strconv.Atoi(number)
+			writeJSON(w, http.StatusOK, map[string]interface{}{"payload": map[string]interface{}{
+				"packageNumber":  packageNumber,
+				"trackingNumber": "T" + number,
+				"currentStatus":  status[number],
+				"trackingEvents": events[number],
+			}})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	tracking, err := client.TrackFulfillmentOrder(context.Background(), "order-1")
+	if err != nil {
+		t.Fatalf("TrackFulfillmentOrder() error = %v", err)
+	}
+
+	if len(tracking.Packages) != 2 || len(tracking.Timeline) != 3 {
+		t.Fatalf("packages=%d timeline=%d", len(tracking.Packages), len(tracking.Timeline))
+	}
+	if tracking.Timeline[1].TrackingNumber != "T2" || tracking.Timeline[2].Code != api.EVENT_301_EventCode {
+		t.Errorf("timeline not sorted: %+v", tracking.Timeline)
+	}
+	if tracking.Delivered {
+		t.Error("Delivered should be false while a package is in transit")
+	}
+}
+
+func TestIterateChangedFulfillmentOrders(t *testing.T) {
+	since := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("queryStartDate"); got != "2025-01-02T00:00:00Z" {
+			t.Errorf("queryStartDate = %q", got)
+		}
+		if r.URL.Query().Get("nextToken") == "" {
+			writeJSON(w, http.StatusOK, map[string]interface{}{"payload": map[string]interface{}{
+				"nextToken": "page-2",
+				"fulfillmentOrders": []interface{}{
+					map[string]interface{}{"sellerFulfillmentOrderId": "old", "statusUpdatedDate": "2025-01-01T00:00:00Z"},
+					map[string]interface{}{"sellerFulfillmentOrderId": "a", "statusUpdatedDate": "2025-01-02T00:00:00Z"},
+				},
+			}})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"payload": map[string]interface{}{
+			"fulfillmentOrders": []interface{}{
+				map[string]interface{}{"sellerFulfillmentOrderId": "b", "statusUpdatedDate": "2025-01-05T00:00:00Z"},
+			},
+		}})
+	})
+
+	var ids []string
+	for order, err := range client.IterateChangedFulfillmentOrders(context.Background(), since) {
+		if err != nil {
+			t.Fatalf("iterator error = %v", err)
+		}
+		ids = append(ids, order.SellerFulfillmentOrderId)
+	}
+
+	if len(ids) != 2 || ids[0] != "a" || ids[1] != "b" {
+		t.Errorf("ids = %v, want [a b]", ids)
+	}
+}
@@ -8,9 +8,18 @@
  */
 package fulfillment_outbound_v2020_07_01
 
+import (
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
 // An amount of money, including units in the form of currency.
 type Money struct {
 	// Three digit currency code in ISO 4217 format.
-	CurrencyCode string `json:"currencyCode"`
-	Value        string `json:"value"`
+	CurrencyCode string              `json:"currencyCode"`
+	Value        spapi.DecimalString `json:"value"`
+}
+
+// ToMoney 转换为 spapi.Money。
+func (m Money) ToMoney() spapi.Money {
+	return spapi.NewMoney(m.Value.Decimal, m.CurrencyCode)
 }
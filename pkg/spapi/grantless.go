@@ -0,0 +1,98 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapi
+
+import "strings"
+
+// operationRoute 是一个操作的 HTTP 方法和路径模板。
+type operationRoute struct {
+	method string
+
+	// path 是路径模板，如 "/notifications/v1/destinations/{destinationId}"
+	path string
+
+	// name 是操作名称，如 "notifications-v1:getDestination"（仅 operationRoutes 设置）
+	name string
+}
+
+// matches 检查请求的方法和路径是否属于此操作，路径参数匹配任意非空段。
+func (r operationRoute) matches(method, path string) bool {
+	if r.method != method {
+		return false
+	}
+
+	template, actual := strings.Split(r.path, "/"), strings.Split(path, "/")
+	if len(template) != len(actual) {
+		return false
+	}
+	for i, segment := range template {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			if actual[i] == "" {
+				return false
+			}
+			continue
+		}
+		if segment != actual[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// isGrantlessOperation 检查请求是否为 Grantless 操作。
+//
+// 操作列表 grantlessOperations 由代码生成器生成。
+//
+// 参数:
+//   - method: HTTP 方法
+//   - path: 请求路径（不含查询参数）
+//
+// 返回值:
+//   - bool: 如果是 Grantless 操作返回 true
+func isGrantlessOperation(method, path string) bool {
+	for _, route := range grantlessOperations {
+		if route.matches(method, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// lookupOperation 返回请求所属操作的名称。
+//
+// 操作列表 operationRoutes 由代码生成器生成，按路径排序，
+// 同一位置的字面量段先于路径参数匹配。
+//
+// 参数:
+//   - method: HTTP 方法
+//   - path: 请求路径（不含查询参数）
+//
+// 返回值:
+//   - string: 操作名称，如 "orders-v0:getOrder"
+//   - bool: 如果请求不属于任何已知操作返回 false
+func lookupOperation(method, path string) (string, bool) {
+	for _, route := range operationRoutes {
+		if route.matches(method, path) {
+			return route.name, true
+		}
+	}
+	return "", false
+}
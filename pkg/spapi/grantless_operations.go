@@ -0,0 +1,33 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package spapi
+
+// grantlessOperations lists the operations that take a grantless
+// (client_credentials) access token.
+var grantlessOperations = []operationRoute{
+	{method: "POST", path: "/applications/2023-11-30/clientSecret"},                                 // application_management_v2023_11_30.RotateApplicationClientSecret
+	{method: "GET", path: "/notifications/v1/destinations"},                                         // notifications_v1.GetDestinations
+	{method: "POST", path: "/notifications/v1/destinations"},                                        // notifications_v1.CreateDestination
+	{method: "DELETE", path: "/notifications/v1/destinations/{destinationId}"},                      // notifications_v1.DeleteDestination
+	{method: "GET", path: "/notifications/v1/destinations/{destinationId}"},                         // notifications_v1.GetDestination
+	{method: "DELETE", path: "/notifications/v1/subscriptions/{notificationType}/{subscriptionId}"}, // notifications_v1.DeleteSubscriptionById
+	{method: "GET", path: "/notifications/v1/subscriptions/{notificationType}/{subscriptionId}"},    // notifications_v1.GetSubscriptionById
+}
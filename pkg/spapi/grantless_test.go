@@ -0,0 +1,108 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapi_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/spapitest"
+)
+
+// TestClient_GrantlessAndRegular 测试同时持有两套凭据的客户端按操作选择令牌。
+func TestClient_GrantlessAndRegular(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+	ok := func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}
+	srv.Handle(http.MethodGet, "/orders/v0/orders", ok)
+	srv.Handle(http.MethodGet, "/notifications/v1/destinations", ok)
+	srv.Handle(http.MethodDelete, "/notifications/v1/destinations/{destinationId}", ok)
+	srv.Handle(http.MethodGet, "/notifications/v1/subscriptions/{notificationType}", ok)
+	ctx := context.Background()
+
+	client, err := srv.NewClient(spapi.WithGrantlessScopes("sellingpartnerapi::notifications"))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	calls := []struct {
+		method    string
+		path      string
+		grantless bool
+	}{
+		{http.MethodGet, "/orders/v0/orders", false},
+		{http.MethodGet, "/notifications/v1/destinations", true},
+		{http.MethodDelete, "/notifications/v1/destinations/dest-1", true},
+		{http.MethodGet, "/notifications/v1/subscriptions/ANY_OFFER_CHANGED", false},
+		{http.MethodGet, "/orders/v0/orders", false},
+	}
+	for _, call := range calls {
+		if err := client.DoRequest(ctx, call.method, call.path, nil, nil, nil); err != nil {
+			t.Fatalf("DoRequest(%s %s) error = %v", call.method, call.path, err)
+		}
+	}
+
+	regular, err := client.GetAccessToken(ctx)
+	if err != nil {
+		t.Fatalf("GetAccessToken() error = %v", err)
+	}
+
+	requests := srv.Requests()
+	grantless := requests[1].Header.Get("x-amz-access-token")
+	if grantless == "" || grantless == regular {
+		t.Fatalf("grantless token = %q, want a token different from the refresh token grant %q", grantless, regular)
+	}
+	for i, call := range calls {
+		want := regular
+		if call.grantless {
+			want = grantless
+		}
+		if got := requests[i].Header.Get("x-amz-access-token"); got != want {
+			t.Errorf("%s %s token = %q, want %q", call.method, call.path, got, want)
+		}
+	}
+}
+
+// TestClient_GrantlessOnly 测试只有 Grantless 凭据的客户端对所有操作使用 Grantless 令牌。
+func TestClient_GrantlessOnly(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+	srv.Handle(http.MethodGet, "/orders/v0/orders", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	})
+
+	// srv.NewClient 默认设置刷新令牌，这里直接构造纯 Grantless 客户端
+	client, err := spapi.NewClient(
+		spapi.WithRegion(srv.Region()),
+		spapi.WithGrantlessCredentials(spapitest.ClientID, spapitest.ClientSecret, []string{"sellingpartnerapi::notifications"}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	if err := client.Get(context.Background(), "/orders/v0/orders", nil, nil); err != nil {
+		t.Errorf("Get() error = %v", err)
+	}
+}
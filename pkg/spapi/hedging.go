@@ -0,0 +1,321 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/transport"
+)
+
+// HedgeConfig 配置幂等 GET 请求的对冲。
+//
+// 请求发出后超过该操作近期延迟的 Percentile 分位数仍未返回时，
+// 再发送一次相同的请求，使用先返回的响应并取消另一个。
+// 对冲请求只使用速率限制器中空闲的令牌：没有可用令牌或有请求在排队时不对冲。
+//
+// 延迟分位数按操作统计最近 128 个成功响应；样本少于 16 个时不对冲。
+type HedgeConfig struct {
+	// Percentile 是触发对冲的延迟分位数（0-1，默认 0.95）。
+	Percentile float64 `validate:"min=0,max=1"`
+
+	// MinDelay 是发送对冲请求前的最短等待时间（默认 10ms）。
+	MinDelay time.Duration `validate:"min=0"`
+
+	// Operations 限定对冲的操作，可以是操作名称（如 "catalog-items-v2022-04-01:getCatalogItem"）
+	// 或 API 名称（如 "catalog-items-v2022-04-01"）。为空时对冲所有 GET 操作。
+	Operations []string
+}
+
+// 对冲的默认值和延迟统计的窗口。
+const (
+	defaultHedgePercentile = 0.95
+	defaultHedgeMinDelay   = 10 * time.Millisecond
+	latencyWindow          = 128
+	latencyMinSamples      = 16
+)
+
+// latencyTracker 按操作记录最近的请求延迟。
+type latencyTracker struct {
+	mu      sync.Mutex
+	samples map[string]*latencySamples
+}
+
+// latencySamples 是一个操作的环形延迟窗口。
+type latencySamples struct {
+	values []time.Duration
+	next   int
+}
+
+func newLatencyTracker() *latencyTracker {
+	return &latencyTracker{samples: make(map[string]*latencySamples)}
+}
+
+// record 记录一次延迟。
+func (t *latencyTracker) record(operation string, latency time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	s, ok := t.samples[operation]
+	if !ok {
+		s = &latencySamples{values: make([]time.Duration, 0, latencyWindow)}
+		t.samples[operation] = s
+	}
+	if len(s.values) < latencyWindow {
+		s.values = append(s.values, latency)
+		return
+	}
+	s.values[s.next] = latency
+	s.next = (s.next + 1) % latencyWindow
+}
+
+// percentile 返回操作延迟的 p 分位数，样本不足时返回 false。
+func (t *latencyTracker) percentile(operation string, p float64) (time.Duration, bool) {
+	t.mu.Lock()
+	s, ok := t.samples[operation]
+	if !ok || len(s.values) < latencyMinSamples {
+		t.mu.Unlock()
+		return 0, false
+	}
+	values := slices.Clone(s.values)
+	t.mu.Unlock()
+
+	slices.Sort(values)
+	i := min(int(p*float64(len(values))), len(values)-1)
+	return values[i], true
+}
+
+// matchOperation 报告 operation 是否匹配 names 中的操作名称或 API 名称。
+func matchOperation(names []string, operation string) bool {
+	api, _, _ := strings.Cut(operation, ":")
+	for _, name := range names {
+		if name == operation || name == api {
+			return true
+		}
+	}
+	return false
+}
+
+// operationTimeout 返回操作的超时时间：操作名称优先，其次是 API 名称，
+// 都没有配置时使用 HTTPTimeout。
+func (c *Client) operationTimeout(operation string) time.Duration {
+	if timeout, ok := c.config.OperationTimeouts[operation]; ok {
+		return timeout
+	}
+	api, _, _ := strings.Cut(operation, ":")
+	if timeout, ok := c.config.OperationTimeouts[api]; ok {
+		return timeout
+	}
+	return c.config.HTTPTimeout
+}
+
+// maxOperationTimeout 返回 HTTPTimeout 和所有按操作超时中的最大值，
+// 作为底层 http.Client 的超时，使更长的按操作超时不被截断。
+func maxOperationTimeout(config *Config) time.Duration {
+	timeout := config.HTTPTimeout
+	for _, t := range config.OperationTimeouts {
+		timeout = max(timeout, t)
+	}
+	return timeout
+}
+
+// timeoutMiddleware 为每次尝试设置该操作的超时时间。
+//
+// 它在重试中间件内侧，超时按每次尝试计算（与 HTTPTimeout 相同），
+// 包括读取响应体的时间。
+func (c *Client) timeoutMiddleware() transport.Middleware {
+	return func(next transport.Handler) transport.Handler {
+		return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			ctx, cancel := context.WithTimeout(ctx, c.operationTimeout(c.extractOperationName(req.Method, req.URL.Path)))
+			resp, err := next(ctx, req.WithContext(ctx))
+			if err != nil {
+				cancel()
+				return resp, err
+			}
+			resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+			return resp, nil
+		}
+	}
+}
+
+// hedgeResult 是一次（可能是对冲的）尝试的结果。
+type hedgeResult struct {
+	attempt int
+	resp    *http.Response
+	err     error
+}
+
+// hedgeMiddleware 对配置的 GET 操作发送对冲请求。
+//
+// 它在重试中间件内侧、超时中间件外侧，两次尝试各自有超时时间。
+// 对冲请求在速率限制器有空闲令牌时才发送（Manager.Allow）。
+func (c *Client) hedgeMiddleware() transport.Middleware {
+	hedging := c.config.Hedging
+	percentile := hedging.Percentile
+	if percentile <= 0 {
+		percentile = defaultHedgePercentile
+	}
+	minDelay := hedging.MinDelay
+	if minDelay <= 0 {
+		minDelay = defaultHedgeMinDelay
+	}
+
+	return func(next transport.Handler) transport.Handler {
+		return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			if req.Method != http.MethodGet {
+				return next(ctx, req)
+			}
+			operation := c.extractOperationName(req.Method, req.URL.Path)
+			if len(hedging.Operations) > 0 && !matchOperation(hedging.Operations, operation) {
+				return next(ctx, req)
+			}
+
+			start := time.Now()
+			delay, ok := c.latency.percentile(operation, percentile)
+			if !ok {
+				resp, err := next(ctx, req)
+				c.recordLatency(operation, resp, err, time.Since(start))
+				return resp, err
+			}
+
+			results := make(chan hedgeResult, 2)
+			var cancels []context.CancelFunc
+			send := func() {
+				attemptCtx, cancel := context.WithCancel(ctx)
+				attempt := len(cancels)
+				if attempt > 0 {
+					attemptCtx = context.WithValue(attemptCtx, hedgeKey{}, true)
+				}
+				cancels = append(cancels, cancel)
+				go func() {
+					resp, err := next(attemptCtx, req.Clone(attemptCtx))
+					results <- hedgeResult{attempt: attempt, resp: resp, err: err}
+				}()
+			}
+
+			send()
+			pending, hedged := 1, false
+			timer := time.NewTimer(max(delay, minDelay))
+			defer timer.Stop()
+
+			var first hedgeResult
+			for pending > 0 {
+				select {
+				case <-timer.C:
+					// 只使用空闲令牌：没有令牌或有请求排队时不对冲
+					marketplace := c.extractMarketplaceID(req.URL.Query())
+					if !hedged && c.facade.GetRateLimitManager().Allow(c.extractSellerID(), c.config.ClientID, marketplace, operation) {
+						hedged = true
+						pending++
+						send()
+					}
+				case r := <-results:
+					pending--
+					if !hedgeWinner(r) {
+						// 429、5xx 和传输错误不胜出：等待另一个尝试，
+						// 两次都失败时返回先失败的结果
+						if first.resp == nil && first.err == nil {
+							first = r
+						} else {
+							discardHedge(r, cancels[r.attempt])
+						}
+						continue
+					}
+					if first.resp != nil || first.err != nil {
+						discardHedge(first, cancels[first.attempt])
+					}
+
+					// 先返回的成功响应胜出，取消另一个尝试并在后台清理
+					for i, cancel := range cancels {
+						if i != r.attempt {
+							cancel()
+						}
+					}
+					if pending > 0 {
+						c.background(func(context.Context) { drainHedges(results, pending) })
+					}
+					c.recordLatency(operation, r.resp, nil, time.Since(start))
+					r.resp.Body = &cancelOnClose{ReadCloser: r.resp.Body, cancel: cancels[r.attempt]}
+					return r.resp, nil
+				}
+			}
+			if first.err != nil {
+				cancels[first.attempt]()
+				return first.resp, first.err
+			}
+			c.recordLatency(operation, first.resp, nil, time.Since(start))
+			first.resp.Body = &cancelOnClose{ReadCloser: first.resp.Body, cancel: cancels[first.attempt]}
+			return first.resp, nil
+		}
+	}
+}
+
+// hedgeWinner 报告尝试的结果能否胜出：2xx 和 429 以外的 4xx 胜出，
+// 它们在另一个尝试上也会得到同样的结果；429 和 5xx 可能只是这一次失败。
+func hedgeWinner(r hedgeResult) bool {
+	if r.err != nil || r.resp == nil {
+		return false
+	}
+	status := r.resp.StatusCode
+	return status < 500 && status != http.StatusTooManyRequests
+}
+
+// discardHedge 丢弃不再使用的尝试结果。
+func discardHedge(r hedgeResult, cancel context.CancelFunc) {
+	if r.resp != nil {
+		r.resp.Body.Close()
+	}
+	cancel()
+}
+
+// recordLatency 记录成功（2xx）响应的延迟，作为对冲的依据。
+func (c *Client) recordLatency(operation string, resp *http.Response, err error, latency time.Duration) {
+	if err == nil && resp != nil && resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		c.latency.record(operation, latency)
+	}
+}
+
+// drainHedges 等待已取消的尝试结束，关闭其响应。
+func drainHedges(results <-chan hedgeResult, pending int) {
+	for range pending {
+		if r := <-results; r.resp != nil {
+			r.resp.Body.Close()
+		}
+	}
+}
+
+// cancelOnClose 在响应体关闭时取消请求的 context。
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
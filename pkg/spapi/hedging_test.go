@@ -0,0 +1,187 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapi_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/spapitest"
+)
+
+// sleep 等待 d 或请求被取消。
+func sleep(r *http.Request, d time.Duration) {
+	select {
+	case <-time.After(d):
+	case <-r.Context().Done():
+	}
+}
+
+// TestWithOperationTimeout 测试按操作和 API 名称覆盖 HTTPTimeout。
+func TestWithOperationTimeout(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+	slow := func(w http.ResponseWriter, r *http.Request) {
+		sleep(r, 200*time.Millisecond)
+		w.Write([]byte(`{}`))
+	}
+	srv.Handle(http.MethodGet, "/orders/v0/orders/{orderId}", slow)
+	srv.Handle(http.MethodGet, "/orders/v0/orders", slow)
+	srv.Handle(http.MethodGet, "/catalog/2022-04-01/items/{asin}", slow)
+
+	client, err := srv.NewClient(
+		spapi.WithMaxRetries(0),
+		spapi.WithOperationTimeout("orders-v0:getOrder", 50*time.Millisecond),
+		spapi.WithOperationTimeout("catalog-items-v2022-04-01", 50*time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	ctx := context.Background()
+
+	if err := client.Get(ctx, "/orders/v0/orders/123", nil, nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Get(getOrder) error = %v, want deadline exceeded", err)
+	}
+	if err := client.Get(ctx, "/catalog/2022-04-01/items/B000000001", nil, nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Get(getCatalogItem) error = %v, want deadline exceeded", err)
+	}
+	// 没有覆盖的操作使用 HTTPTimeout
+	if err := client.Get(ctx, "/orders/v0/orders", nil, nil); err != nil {
+		t.Errorf("Get(getOrders) error = %v", err)
+	}
+}
+
+// TestWithOperationTimeout_Invalid 测试无效的超时时间。
+func TestWithOperationTimeout_Invalid(t *testing.T) {
+	_, err := spapi.NewClient(
+		spapi.WithRegion(spapi.RegionNA),
+		spapi.WithCredentials("client-id", "client-secret", "refresh-token"),
+		spapi.WithOperationTimeout("orders-v0:getOrder", 0),
+	)
+	if err == nil {
+		t.Error("NewClient() error = nil, want invalid OperationTimeouts")
+	}
+}
+
+// newHedgingClient 返回第 17 个请求（预热 16 个之后）变慢的服务器和启用对冲的客户端。
+// hedge 处理第 18 个请求（对冲请求），为 nil 时立即返回成功。
+func newHedgingClient(t *testing.T, hedge http.HandlerFunc) (*spapitest.Server, *spapi.Client) {
+	t.Helper()
+
+	srv := spapitest.NewServer()
+	var calls atomic.Int32
+	srv.Handle(http.MethodGet, "/catalog/2022-04-01/items/{asin}", func(w http.ResponseWriter, r *http.Request) {
+		switch n := calls.Add(1); {
+		case n == 17:
+			sleep(r, time.Second)
+		case n == 18 && hedge != nil:
+			hedge(w, r)
+			return
+		}
+		w.Write([]byte(`{}`))
+	})
+
+	client, err := srv.NewClient(
+		spapi.WithSellerID("SELLER1"),
+		spapi.WithRateLimitWait(),
+		spapi.WithHedging(&spapi.HedgeConfig{Operations: []string{"catalog-items-v2022-04-01"}}),
+	)
+	if err != nil {
+		srv.Close()
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	manager := client.RateLimitManager()
+	if err := manager.UpdateRate("SELLER1", client.Config().ClientID, "global", "catalog-items-v2022-04-01:getCatalogItem", 1000, 100); err != nil {
+		srv.Close()
+		t.Fatal(err)
+	}
+	for range 16 {
+		if err := client.Get(context.Background(), "/catalog/2022-04-01/items/B000000001", nil, nil); err != nil {
+			srv.Close()
+			t.Fatalf("Get() error = %v", err)
+		}
+	}
+	return srv, client
+}
+
+// TestWithHedging 测试慢请求被对冲，使用先返回的响应。
+func TestWithHedging(t *testing.T) {
+	srv, client := newHedgingClient(t, nil)
+	defer srv.Close()
+
+	start := time.Now()
+	if err := client.Get(context.Background(), "/catalog/2022-04-01/items/B000000001", nil, nil); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed >= time.Second {
+		t.Errorf("Get() took %v, want hedged response before the slow one", elapsed)
+	}
+	if n := len(srv.Requests()); n != 18 {
+		t.Errorf("requests = %d, want 18 (16 warm-up, slow, hedge)", n)
+	}
+}
+
+// TestWithHedging_HedgeThrottled 测试对冲请求被限流（429）时等待原请求的成功响应。
+func TestWithHedging_HedgeThrottled(t *testing.T) {
+	srv, client := newHedgingClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"errors":[{"code":"QuotaExceeded","message":"You exceeded your quota for the requested resource."}]}`))
+	})
+	defer srv.Close()
+
+	start := time.Now()
+	if err := client.Get(context.Background(), "/catalog/2022-04-01/items/B000000001", nil, nil); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("Get() took %v, want the slow successful response", elapsed)
+	}
+	if n := len(srv.Requests()); n != 18 {
+		t.Errorf("requests = %d, want 18 (16 warm-up, slow, throttled hedge)", n)
+	}
+}
+
+// TestWithHedging_NoSpareTokens 测试没有空闲令牌时不对冲。
+func TestWithHedging_NoSpareTokens(t *testing.T) {
+	srv, client := newHedgingClient(t, nil)
+	defer srv.Close()
+
+	// 每 2 秒一个令牌，桶中只剩请求本身使用的一个
+	if err := client.RateLimitManager().UpdateRate("SELLER1", client.Config().ClientID, "global", "catalog-items-v2022-04-01:getCatalogItem", 0.5, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	if err := client.Get(context.Background(), "/catalog/2022-04-01/items/B000000001", nil, nil); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("Get() took %v, want the slow response without hedging", elapsed)
+	}
+	if n := len(srv.Requests()); n != 17 {
+		t.Errorf("requests = %d, want 17 (no hedge)", n)
+	}
+}
@@ -28,15 +28,16 @@ import (
 
 // Logger 定义日志接口。
 //
-// 用户可以提供自己的日志实现，或使用SDK提供的默认实现。
-// 默认情况下，SDK使用no-op logger（不输出任何日志）。
+// 用户可以提供自己的日志实现，或使用 NewSlogLogger、pkg/spapi/zap、
+// pkg/spapi/zerolog 中的适配器。默认情况下，SDK使用no-op logger（不输出任何日志）。
+// SDK 输出的事件和字段键见 Event* 和 LogKey* 常量。
 //
 // 示例:
 //
 //	// 使用Zap日志
-//	zapLogger := NewZapLogger(zap.NewProduction())
+//	logger, _ := gozap.NewProduction()
 //	client := spapi.NewClient(
-//	    spapi.WithLogger(zapLogger),
+//	    spapi.WithLogger(zap.NewLogger(logger)),
 //	)
 type Logger interface {
 	// Debug 记录调试级别的日志
@@ -63,15 +64,21 @@ type Field struct {
 
 // MetricsCollector 定义指标收集接口。
 //
-// 用户可以提供自己的指标收集实现，或使用SDK提供的Prometheus实现。
-// 默认情况下，SDK使用no-op collector（不收集任何指标）。
+// 用户可以提供自己的指标收集实现，或使用 pkg/spapi/prometheus、
+// pkg/spapi/otel 中的实现。默认情况下，SDK使用no-op collector（不收集任何指标）。
+//
+// 参数 api 是操作名称，如 "orders-v0:getOrder"。如果实现同时提供
+// RecordCounter、RecordGauge、RecordHistogram 和 RecordTiming
+// （与 WithMetricsRecorder 的记录器相同的方法），SDK 改用这些方法记录
+// 带市场标签的完整指标集，包括令牌刷新和活跃限制器数量。
 //
 // 示例:
 //
 //	// 使用Prometheus
-//	prometheusCollector := NewPrometheusCollector()
+//	collector := prometheus.NewCollector()
+//	registry.MustRegister(collector)
 //	client := spapi.NewClient(
-//	    spapi.WithMetrics(prometheusCollector),
+//	    spapi.WithMetrics(collector),
 //	)
 type MetricsCollector interface {
 	// RecordRequest 记录API请求
@@ -114,13 +121,34 @@ type RateLimiter interface {
 
 // Tracer 定义分布式追踪接口。
 //
-// 用户可以提供自己的追踪实现，或使用SDK提供的OpenTelemetry实现。
+// 用户可以提供自己的追踪实现，或使用 pkg/spapi/otel 中的 OpenTelemetry 实现。
 // 默认情况下，SDK使用no-op tracer（不进行追踪）。
+//
+// SDK 为每个请求开始一个以操作名称命名的 span，并设置遵循 OpenTelemetry
+// HTTP 语义约定的属性（http.request.method、http.response.status_code 等）。
 type Tracer interface {
 	// StartSpan 开始一个新的span
 	StartSpan(ctx context.Context, name string) (context.Context, Span)
 }
 
+// TracePropagator 是 Tracer 的可选扩展接口。
+//
+// 实现此接口的 Tracer 会在每个请求发送前把追踪上下文（如 W3C traceparent）
+// 注入请求头，使下游服务能够关联同一条调用链。
+type TracePropagator interface {
+	// Inject 把 ctx 中的追踪上下文写入 header
+	Inject(ctx context.Context, header http.Header)
+}
+
+// Flusher 是 Logger、MetricsCollector 和 Tracer 的可选扩展接口。
+//
+// Client.Close 在所有请求结束后调用 Flush，把缓冲中的日志、指标和 span
+// 写出（如 pkg/spapi/otel 的 Metrics 和 Tracer）。
+type Flusher interface {
+	// Flush 写出缓冲中的数据
+	Flush(ctx context.Context) error
+}
+
 // Span 表示一个追踪span。
 type Span interface {
 	// End 结束span
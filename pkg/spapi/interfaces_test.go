@@ -1,6 +1,7 @@
 package spapi_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -60,7 +61,7 @@ func TestWithLogger(t *testing.T) {
 	if err != nil {
 		t.Fatalf("NewClient() error = %v", err)
 	}
-	defer client.Close()
+	defer client.Close(context.Background())
 
 	// 验证logger被设置
 	config := client.Config()
@@ -81,7 +82,7 @@ func TestWithMetrics(t *testing.T) {
 	if err != nil {
 		t.Fatalf("NewClient() error = %v", err)
 	}
-	defer client.Close()
+	defer client.Close(context.Background())
 
 	// 验证metrics被设置
 	config := client.Config()
@@ -100,7 +101,7 @@ func TestDefaultNoOpImplementations(t *testing.T) {
 	if err != nil {
 		t.Fatalf("NewClient() error = %v", err)
 	}
-	defer client.Close()
+	defer client.Close(context.Background())
 
 	config := client.Config()
 
@@ -128,7 +129,7 @@ func ExampleWithLogger() {
 	if err != nil {
 		panic(err)
 	}
-	defer client.Close()
+	defer client.Close(context.Background())
 
 	// 使用客户端...
 }
@@ -145,7 +146,7 @@ func ExampleWithMetrics() {
 	if err != nil {
 		panic(err)
 	}
-	defer client.Close()
+	defer client.Close(context.Background())
 
 	// 使用客户端...
 }
@@ -0,0 +1,70 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package invoices_v2024_06_19
+
+import (
+	"context"
+	"iter"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// API 是 invoices API v2024-06-19 客户端的全部方法，包括分页迭代器等辅助方法。
+//
+// 业务代码依赖 API 而不是 *Client，即可在单元测试中用 Fake 替换真实客户端。
+type API interface {
+	// CreateInvoicesExport
+	// Method: POST | Path: /tax/invoices/2024-06-19/exports
+	CreateInvoicesExport(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetInvoice
+	// Method: GET | Path: /tax/invoices/2024-06-19/invoices/{invoiceId}
+	GetInvoice(ctx context.Context, invoiceId string, query map[string]string) (interface{}, error)
+
+	// GetInvoices
+	// Method: GET | Path: /tax/invoices/2024-06-19/invoices
+	GetInvoices(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetInvoicesAttributes
+	// Method: GET | Path: /tax/invoices/2024-06-19/attributes
+	GetInvoicesAttributes(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetInvoicesDocument
+	// Method: GET | Path: /tax/invoices/2024-06-19/documents/{invoicesDocumentId}
+	GetInvoicesDocument(ctx context.Context, invoicesDocumentId string, query map[string]string) (interface{}, error)
+
+	// GetInvoicesExport
+	// Method: GET | Path: /tax/invoices/2024-06-19/exports/{exportId}
+	GetInvoicesExport(ctx context.Context, exportId string, query map[string]string) (interface{}, error)
+
+	// GetInvoicesExports
+	// Method: GET | Path: /tax/invoices/2024-06-19/exports
+	GetInvoicesExports(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// IterateInvoices 返回发票迭代器，自动处理分页。
+	IterateInvoices(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// WaitForInvoicesExport 轮询 GetInvoicesExport 直到导出完成（DONE、ERROR）。
+	WaitForInvoicesExport(ctx context.Context, exportID string, opts ...spapi.PollerOption) (interface{}, error)
+}
+
+// 确保 Client 实现了 API。
+var _ API = (*Client)(nil)
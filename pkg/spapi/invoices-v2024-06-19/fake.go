@@ -0,0 +1,154 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package invoices_v2024_06_19
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。
+//
+// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回
+// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，
+// 可以通过 Calls 和 CallsTo 查看。
+type Fake struct {
+	spapi.FakeRecorder
+
+	// CreateInvoicesExportFunc 实现 CreateInvoicesExport。
+	CreateInvoicesExportFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetInvoiceFunc 实现 GetInvoice。
+	GetInvoiceFunc func(ctx context.Context, invoiceId string, query map[string]string) (interface{}, error)
+
+	// GetInvoicesFunc 实现 GetInvoices。
+	GetInvoicesFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetInvoicesAttributesFunc 实现 GetInvoicesAttributes。
+	GetInvoicesAttributesFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetInvoicesDocumentFunc 实现 GetInvoicesDocument。
+	GetInvoicesDocumentFunc func(ctx context.Context, invoicesDocumentId string, query map[string]string) (interface{}, error)
+
+	// GetInvoicesExportFunc 实现 GetInvoicesExport。
+	GetInvoicesExportFunc func(ctx context.Context, exportId string, query map[string]string) (interface{}, error)
+
+	// GetInvoicesExportsFunc 实现 GetInvoicesExports。
+	GetInvoicesExportsFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// IterateInvoicesFunc 实现 IterateInvoices。
+	IterateInvoicesFunc func(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// WaitForInvoicesExportFunc 实现 WaitForInvoicesExport。
+	WaitForInvoicesExportFunc func(ctx context.Context, exportID string, opts ...spapi.PollerOption) (interface{}, error)
+}
+
+// NewFake 创建未编程任何响应的 Fake。
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// CreateInvoicesExport 记录调用并执行 CreateInvoicesExportFunc。
+func (f *Fake) CreateInvoicesExport(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("CreateInvoicesExport", body)
+	if f.CreateInvoicesExportFunc == nil {
+		return nil, fmt.Errorf("CreateInvoicesExport: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateInvoicesExportFunc(ctx, body)
+}
+
+// GetInvoice 记录调用并执行 GetInvoiceFunc。
+func (f *Fake) GetInvoice(ctx context.Context, invoiceId string, query map[string]string) (interface{}, error) {
+	f.Record("GetInvoice", invoiceId, query)
+	if f.GetInvoiceFunc == nil {
+		return nil, fmt.Errorf("GetInvoice: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetInvoiceFunc(ctx, invoiceId, query)
+}
+
+// GetInvoices 记录调用并执行 GetInvoicesFunc。
+func (f *Fake) GetInvoices(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetInvoices", query)
+	if f.GetInvoicesFunc == nil {
+		return nil, fmt.Errorf("GetInvoices: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetInvoicesFunc(ctx, query)
+}
+
+// GetInvoicesAttributes 记录调用并执行 GetInvoicesAttributesFunc。
+func (f *Fake) GetInvoicesAttributes(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetInvoicesAttributes", query)
+	if f.GetInvoicesAttributesFunc == nil {
+		return nil, fmt.Errorf("GetInvoicesAttributes: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetInvoicesAttributesFunc(ctx, query)
+}
+
+// GetInvoicesDocument 记录调用并执行 GetInvoicesDocumentFunc。
+func (f *Fake) GetInvoicesDocument(ctx context.Context, invoicesDocumentId string, query map[string]string) (interface{}, error) {
+	f.Record("GetInvoicesDocument", invoicesDocumentId, query)
+	if f.GetInvoicesDocumentFunc == nil {
+		return nil, fmt.Errorf("GetInvoicesDocument: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetInvoicesDocumentFunc(ctx, invoicesDocumentId, query)
+}
+
+// GetInvoicesExport 记录调用并执行 GetInvoicesExportFunc。
+func (f *Fake) GetInvoicesExport(ctx context.Context, exportId string, query map[string]string) (interface{}, error) {
+	f.Record("GetInvoicesExport", exportId, query)
+	if f.GetInvoicesExportFunc == nil {
+		return nil, fmt.Errorf("GetInvoicesExport: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetInvoicesExportFunc(ctx, exportId, query)
+}
+
+// GetInvoicesExports 记录调用并执行 GetInvoicesExportsFunc。
+func (f *Fake) GetInvoicesExports(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetInvoicesExports", query)
+	if f.GetInvoicesExportsFunc == nil {
+		return nil, fmt.Errorf("GetInvoicesExports: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetInvoicesExportsFunc(ctx, query)
+}
+
+// IterateInvoices 记录调用并执行 IterateInvoicesFunc。
+func (f *Fake) IterateInvoices(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+	f.Record("IterateInvoices", query)
+	if f.IterateInvoicesFunc == nil {
+		return spapi.FakeSeq[map[string]interface{}](nil, fmt.Errorf("IterateInvoices: %w", spapi.ErrFakeNotConfigured))
+	}
+	return f.IterateInvoicesFunc(ctx, query)
+}
+
+// WaitForInvoicesExport 记录调用并执行 WaitForInvoicesExportFunc。
+func (f *Fake) WaitForInvoicesExport(ctx context.Context, exportID string, opts ...spapi.PollerOption) (interface{}, error) {
+	f.Record("WaitForInvoicesExport", exportID, opts)
+	if f.WaitForInvoicesExportFunc == nil {
+		return nil, fmt.Errorf("WaitForInvoicesExport: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.WaitForInvoicesExportFunc(ctx, exportID, opts...)
+}
+
+// 确保 Fake 实现了 API。
+var _ API = (*Fake)(nil)
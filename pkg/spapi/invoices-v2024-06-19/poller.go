@@ -0,0 +1,71 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package invoices_v2024_06_19
+
+import (
+	"context"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// WaitForInvoicesExport 轮询 GetInvoicesExport 直到导出完成（DONE、ERROR）。
+//
+// 导出进入 ERROR 时返回 spapi.ErrPollFailed，结果中的 export.errorMessage 描述失败原因。
+//
+// 参数:
+//   - ctx: 请求上下文
+//   - exportID: 导出 ID
+//   - opts: 轮询器配置选项
+//
+// 返回值:
+//   - interface{}: 最后一次 GetInvoicesExport 的结果
+//   - error: 如果轮询失败，返回错误
+func (c *Client) WaitForInvoicesExport(ctx context.Context, exportID string, opts ...spapi.PollerOption) (interface{}, error) {
+	poller := spapi.NewPoller(
+		func(ctx context.Context) (interface{}, error) {
+			return c.GetInvoicesExport(ctx, exportID, nil)
+		},
+		spapi.StatusAt[interface{}]("export.status"),
+		append([]spapi.PollerOption{
+			spapi.WithTerminalStates("DONE", "ERROR"),
+			spapi.WithFailureStates("ERROR"),
+		}, opts...)...,
+	)
+	return poller.Wait(ctx)
+}
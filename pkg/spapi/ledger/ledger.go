@@ -0,0 +1,220 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+//
+// Package ledger 将 Finances API 的财务事件规范化为带类型的账本行，并与结算组总额对账。
+//
+// 支持两套数据源：
+//   - Finances API v0 的财务事件（ShipmentEvent、RefundEvent、ServiceFeeEvent 等 30 余种事件列表）
+//   - Finances API v2024-06-19 的交易（Transaction 及其 breakdowns）
+//
+// 两者统一转换为 Line（订单、SKU、费用类型、金额、入账时间、结算组 ID），
+// 金额使用 spapi.Money 精确表示，求和与比较不会产生 float64 的舍入误差。
+//
+// 示例:
+//
+//	reconciler := ledger.NewReconciler(finances_v0.NewClient(baseClient))
+//	for result, err := range reconciler.ReconcileGroups(ctx, query) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    if !result.Balanced {
+//	        log.Printf("group %s: expected %s, got %s", result.GroupID, result.Expected, result.Actual)
+//	    }
+//	}
+package ledger
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// 错误定义。
+var (
+	// ErrInvalidAmount 表示金额字段无法解析为十进制数。
+	ErrInvalidAmount = errors.New("invalid currency amount")
+
+	// ErrMissingTotal 表示结算组尚未给出总额（通常是仍处于 Open 状态的组）。
+	ErrMissingTotal = errors.New("financial event group has no total")
+)
+
+// Source 标识账本行的数据来源。
+type Source string
+
+// 数据来源。
+const (
+	// SourceFinancesV0 表示 Finances API v0 的财务事件
+	SourceFinancesV0 Source = "finances/v0"
+
+	// SourceFinancesV2024 表示 Finances API v2024-06-19 的交易
+	SourceFinancesV2024 Source = "finances/2024-06-19"
+)
+
+// Line 是规范化后的一条账本记录。
+//
+// 一条财务事件通常会拆分为多条 Line，例如一个 ShipmentEvent 会按
+// 商品的 Principal、Tax、FBAPerUnitFulfillmentFee、Commission 等分别生成记录。
+// 金额带符号：收入为正，费用和退款为负。
+type Line struct {
+	// Source 是数据来源
+	Source Source
+
+	// EventType 是事件类型（v0 如 "ShipmentEvent"、"RefundEvent"；v2024 为 transactionType）
+	EventType string
+
+	// OrderID 是关联的订单 ID（没有订单的事件为空）
+	OrderID string
+
+	// SKU 是关联的卖家 SKU（订单级或组级费用为空）
+	SKU string
+
+	// Component 是金额所属的组成部分（如 "Charge"、"Fee"、"Promotion"，v2024 为顶层 breakdownType）
+	Component string
+
+	// FeeType 是费用或收费类型（如 "Principal"、"FBAPerUnitFulfillmentFee"）
+	FeeType string
+
+	// Amount 是精确金额
+	Amount spapi.Money
+
+	// PostedDate 是入账时间（事件未提供时为零值）
+	PostedDate time.Time
+
+	// GroupID 是所属财务事件组（结算组）ID
+	GroupID string
+}
+
+// Sum 按币种汇总账本行金额。
+//
+// 参数:
+//   - lines: 账本行
+//
+// 返回值:
+//   - map[string]spapi.Money: 币种到金额合计的映射
+func Sum(lines []Line) map[string]spapi.Money {
+	totals := make(map[string]spapi.Money)
+	for _, line := range lines {
+		currency := line.Amount.Currency
+		total := totals[currency]
+		totals[currency] = spapi.NewMoney(total.Amount.Add(line.Amount.Amount), currency)
+	}
+	return totals
+}
+
+// GroupByID 按结算组 ID 对账本行分组。
+func GroupByID(lines []Line) map[string][]Line {
+	groups := make(map[string][]Line)
+	for _, line := range lines {
+		groups[line.GroupID] = append(groups[line.GroupID], line)
+	}
+	return groups
+}
+
+// ParseAmount 将 JSON 中的金额值解析为精确十进制数。
+//
+// 支持 json.Number、字符串和 float64。各 API 客户端使用 encoding/json
+// 解码为 interface{}，金额会先成为 float64；这里按最短往返表示
+// （spapi.DecimalFromFloat）还原 JSON 中的十进制文本，
+// 对 15 位以内有效数字的金额是精确的。
+//
+// 参数:
+//   - value: 金额值
+//
+// 返回值:
+//   - spapi.Decimal: 精确金额
+//   - error: 无法解析时返回 ErrInvalidAmount
+func ParseAmount(value interface{}) (spapi.Decimal, error) {
+	var (
+		amount spapi.Decimal
+		err    error
+	)
+
+	switch v := value.(type) {
+	case json.Number:
+		amount, err = spapi.ParseDecimal(v.String())
+	case string:
+		amount, err = spapi.ParseDecimal(v)
+	case float64:
+		amount, err = spapi.DecimalFromFloat(v)
+	case int:
+		amount = spapi.NewDecimal(int64(v), 0)
+	case int64:
+		amount = spapi.NewDecimal(v, 0)
+	default:
+		return spapi.Decimal{}, fmt.Errorf("%w: unsupported type %T", ErrInvalidAmount, value)
+	}
+
+	if err != nil {
+		return spapi.Decimal{}, fmt.Errorf("%w: %v", ErrInvalidAmount, err)
+	}
+	return amount, nil
+}
+
+// parseCurrency 解析 Currency 对象（v0 为 CurrencyCode/CurrencyAmount，
+// v2024 为 currencyCode/currencyAmount）。
+//
+// 不是 Currency 对象时 ok 返回 false。
+func parseCurrency(value interface{}) (money spapi.Money, ok bool, err error) {
+	object, isObject := value.(map[string]interface{})
+	if !isObject {
+		return spapi.Money{}, false, nil
+	}
+
+	raw, found := lookup(object, "CurrencyAmount")
+	if !found {
+		return spapi.Money{}, false, nil
+	}
+
+	amount, err := ParseAmount(raw)
+	if err != nil {
+		return spapi.Money{}, true, err
+	}
+
+	return spapi.NewMoney(amount, lookupString(object, "CurrencyCode")), true, nil
+}
+
+// lookup 按字段名查找值，同时兼容首字母小写的写法（如 postedDate）。
+func lookup(object map[string]interface{}, key string) (interface{}, bool) {
+	if value, ok := object[key]; ok {
+		return value, true
+	}
+	value, ok := object[strings.ToLower(key[:1])+key[1:]]
+	return value, ok
+}
+
+// lookupString 按字段名查找字符串值。
+func lookupString(object map[string]interface{}, key string) string {
+	value, _ := lookup(object, key)
+	s, _ := value.(string)
+	return s
+}
+
+// lookupTime 按字段名查找 RFC 3339 时间值。
+func lookupTime(object map[string]interface{}, key string) time.Time {
+	t, err := time.Parse(time.RFC3339, lookupString(object, key))
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
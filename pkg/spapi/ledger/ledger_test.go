@@ -0,0 +1,340 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package ledger_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/finances-v0"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/ledger"
+)
+
+func usd(amount float64) map[string]interface{} {
+	return map[string]interface{}{"CurrencyCode": "USD", "CurrencyAmount": amount}
+}
+
+func money(amount, currency string) spapi.Money {
+	return spapi.NewMoney(spapi.MustParseDecimal(amount), currency)
+}
+
+func sampleEvents() map[string]interface{} {
+	return map[string]interface{}{
+		"ShipmentEventList": []interface{}{
+			map[string]interface{}{
+				"AmazonOrderId": "111-1",
+				"PostedDate":    "2025-01-02T03:04:05Z",
+				"ShipmentItemList": []interface{}{
+					map[string]interface{}{
+						"SellerSKU": "SKU-A",
+						"ItemChargeList": []interface{}{
+							map[string]interface{}{"ChargeType": "Principal", "ChargeAmount": usd(0.1)},
+							map[string]interface{}{"ChargeType": "Tax", "ChargeAmount": usd(0.2)},
+						},
+						"ItemFeeList": []interface{}{
+							map[string]interface{}{"FeeType": "FBAPerUnitFulfillmentFee", "FeeAmount": usd(-3.22)},
+						},
+						"PromotionList": []interface{}{
+							map[string]interface{}{"PromotionType": "PromotionMetaDataDefinitionValue", "PromotionId": "p", "PromotionAmount": usd(-0.05)},
+						},
+						"ItemTaxWithheldList": []interface{}{
+							map[string]interface{}{
+								"TaxCollectionModel": "MarketplaceFacilitator",
+								"TaxesWithheld": []interface{}{
+									map[string]interface{}{"ChargeType": "MarketplaceFacilitatorTax-Principal", "ChargeAmount": usd(-0.2)},
+								},
+							},
+						},
+						"CostOfPointsGranted": usd(1),
+					},
+				},
+			},
+		},
+		"ServiceFeeEventList": []interface{}{
+			map[string]interface{}{
+				"FeeReason": "Subscription",
+				"FeeList": []interface{}{
+					map[string]interface{}{"FeeType": "Subscription", "FeeAmount": usd(-39.99)},
+				},
+			},
+		},
+		"AdjustmentEventList": []interface{}{
+			map[string]interface{}{
+				"AdjustmentType":   "ReserveDebit",
+				"PostedDate":       "2025-01-03T00:00:00Z",
+				"AdjustmentAmount": usd(-10),
+				"AdjustmentItemList": []interface{}{
+					map[string]interface{}{"SellerSKU": "SKU-B", "TotalAmount": usd(-10), "PerUnitAmount": usd(-5)},
+				},
+			},
+		},
+		"RemovalShipmentEventList": []interface{}{
+			map[string]interface{}{
+				"OrderId":         "R-1",
+				"TransactionType": "WHOLESALE_LIQUIDATION",
+				"RemovalShipmentItemList": []interface{}{
+					map[string]interface{}{"Revenue": usd(4.5), "FeeAmount": usd(-0.5)},
+				},
+			},
+		},
+	}
+}
+
+func TestNormalizeFinancialEvents(t *testing.T) {
+	lines, err := ledger.NormalizeFinancialEvents(sampleEvents(), "group-1")
+	if err != nil {
+		t.Fatalf("NormalizeFinancialEvents() error = %v", err)
+	}
+
+	if len(lines) != 9 {
+		t.Fatalf("got %d lines, want 9: %+v", len(lines), lines)
+	}
+
+	// 0.1 + 0.2 - 3.22 - 0.05 - 0.2 - 39.99 - 10 + 4.5 - 0.5 = -49.16
+	total := ledger.Sum(lines)["USD"]
+	if !total.Amount.Equal(spapi.MustParseDecimal("-49.16")) {
+		t.Errorf("total = %s, want -49.16", total)
+	}
+
+	var principal *ledger.Line
+	for i := range lines {
+		if lines[i].FeeType == "Principal" {
+			principal = &lines[i]
+		}
+		if lines[i].GroupID != "group-1" {
+			t.Errorf("line %d GroupID = %q", i, lines[i].GroupID)
+		}
+	}
+	if principal == nil {
+		t.Fatal("Principal line not found")
+	}
+	if principal.OrderID != "111-1" || principal.SKU != "SKU-A" || principal.Component != "Charge" || principal.EventType != "ShipmentEvent" {
+		t.Errorf("unexpected principal line: %+v", principal)
+	}
+	if principal.PostedDate.Day() != 2 {
+		t.Errorf("PostedDate = %v", principal.PostedDate)
+	}
+}
+
+func TestNormalizeFinancialEventsDeterministic(t *testing.T) {
+	first, _ := ledger.NormalizeFinancialEvents(sampleEvents(), "")
+	for range 10 {
+		again, _ := ledger.NormalizeFinancialEvents(sampleEvents(), "")
+		for i := range first {
+			if first[i].FeeType != again[i].FeeType || first[i].Amount.String() != again[i].Amount.String() {
+				t.Fatalf("line %d differs between runs", i)
+			}
+		}
+	}
+}
+
+func transaction(itemAmount string) map[string]interface{} {
+	amount := func(v string) map[string]interface{} {
+		return map[string]interface{}{"currencyCode": "EUR", "currencyAmount": json.Number(v)}
+	}
+
+	return map[string]interface{}{
+		"transactionType": "Shipment",
+		"postedDate":      "2025-02-01T00:00:00Z",
+		"relatedIdentifiers": []interface{}{
+			map[string]interface{}{"relatedIdentifierName": "ORDER_ID", "relatedIdentifierValue": "302-1"},
+			map[string]interface{}{"relatedIdentifierName": "FINANCIAL_EVENT_GROUP_ID", "relatedIdentifierValue": "group-2"},
+		},
+		"totalAmount": amount("7.00"),
+		"breakdowns": []interface{}{
+			map[string]interface{}{
+				"breakdownType":   "ProductCharges",
+				"breakdownAmount": amount("10.00"),
+				"breakdowns": []interface{}{
+					map[string]interface{}{"breakdownType": "Principal", "breakdownAmount": amount("10.00")},
+				},
+			},
+			map[string]interface{}{"breakdownType": "AmazonFees", "breakdownAmount": amount("-3.00")},
+		},
+		"items": []interface{}{
+			map[string]interface{}{
+				"contexts": []interface{}{map[string]interface{}{"contextType": "ProductContext", "sku": "SKU-E"}},
+				"breakdowns": []interface{}{
+					map[string]interface{}{"breakdownType": "Principal", "breakdownAmount": amount("10.00")},
+					map[string]interface{}{"breakdownType": "Commission", "breakdownAmount": amount(itemAmount)},
+				},
+			},
+		},
+	}
+}
+
+func TestNormalizeTransaction(t *testing.T) {
+	t.Run("items balance", func(t *testing.T) {
+		lines, err := ledger.NormalizeTransaction(transaction("-3.00"))
+		if err != nil {
+			t.Fatalf("NormalizeTransaction() error = %v", err)
+		}
+		if len(lines) != 2 {
+			t.Fatalf("got %d lines, want 2", len(lines))
+		}
+		for _, line := range lines {
+			if line.SKU != "SKU-E" || line.OrderID != "302-1" || line.GroupID != "group-2" || line.Amount.Currency != "EUR" {
+				t.Errorf("unexpected line: %+v", line)
+			}
+		}
+	})
+
+	t.Run("items do not balance", func(t *testing.T) {
+		lines, err := ledger.NormalizeTransaction(transaction("-2.00"))
+		if err != nil {
+			t.Fatalf("NormalizeTransaction() error = %v", err)
+		}
+		if len(lines) != 2 {
+			t.Fatalf("got %d lines, want 2", len(lines))
+		}
+		if lines[0].Component != "ProductCharges" || lines[0].FeeType != "Principal" {
+			t.Errorf("unexpected first line: %+v", lines[0])
+		}
+		if sum := ledger.Sum(lines)["EUR"]; !sum.Amount.Equal(spapi.NewDecimal(7, 0)) {
+			t.Errorf("sum = %s, want 7", sum)
+		}
+	})
+}
+
+func TestReconcile(t *testing.T) {
+	lines := []ledger.Line{
+		{Amount: money("10.10", "USD")},
+		{Amount: money("-0.10", "USD")},
+	}
+	group := map[string]interface{}{
+		"FinancialEventGroupId": "g",
+		"ProcessingStatus":      "Closed",
+		"OriginalTotal":         usd(10),
+	}
+
+	result, err := ledger.Reconcile(group, lines)
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if !result.Balanced || !result.Difference.IsZero() {
+		t.Errorf("expected balanced result, got %+v", result)
+	}
+
+	result, _ = ledger.Reconcile(group, append(lines, ledger.Line{Amount: money("1", "CAD")}))
+	if result.Balanced || len(result.ForeignLines) != 1 {
+		t.Errorf("expected foreign line to unbalance result, got %+v", result)
+	}
+
+	group["OriginalTotal"] = usd(10.01)
+	result, _ = ledger.Reconcile(group, lines)
+	if result.Balanced || result.Difference.Amount.String() != "-0.01" {
+		t.Errorf("Difference = %s, want -0.01", result.Difference)
+	}
+
+	delete(group, "OriginalTotal")
+	if _, err := ledger.Reconcile(group, lines); !errors.Is(err, ledger.ErrMissingTotal) {
+		t.Errorf("error = %v, want ErrMissingTotal", err)
+	}
+}
+
+func TestReconcilerReconcileGroups(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/auth/o2/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"access_token":"test-token","token_type":"bearer","expires_in":3600}`))
+	})
+	mux.HandleFunc("/finances/v0/financialEventGroups", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"payload": map[string]interface{}{
+			"FinancialEventGroupList": []interface{}{
+				map[string]interface{}{"FinancialEventGroupId": "closed", "ProcessingStatus": "Closed", "OriginalTotal": usd(-49.16)},
+				map[string]interface{}{"FinancialEventGroupId": "open", "ProcessingStatus": "Open"},
+			},
+		}})
+	})
+	mux.HandleFunc("/finances/v0/financialEventGroups/closed/financialEvents", func(w http.ResponseWriter, r *http.Request) {
+		// 第一页只含 ShipmentEvent，第二页含其余事件
+		events := sampleEvents()
+		payload := map[string]interface{}{}
+		if r.URL.Query().Get("NextToken") == "" {
+			payload["FinancialEvents"] = map[string]interface{}{"ShipmentEventList": events["ShipmentEventList"]}
+			payload["NextToken"] = "page-2"
+		} else {
+			delete(events, "ShipmentEventList")
+			payload["FinancialEvents"] = events
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"payload": payload})
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	baseClient, err := spapi.NewClient(
+		spapi.WithRegion(spapi.Region{Code: "test", Endpoint: server.URL, LWAEndpoint: server.URL + "/auth/o2/token"}),
+		spapi.WithCredentials("test", "test", "test"),
+		spapi.WithMaxRetries(0),
+	)
+	if err != nil {
+		t.Fatalf("create base client: %v", err)
+	}
+
+	reconciler := ledger.NewReconciler(finances_v0.NewClient(baseClient))
+
+	var results []*ledger.Result
+	for result, err := range reconciler.ReconcileGroups(context.Background(), nil) {
+		if err != nil {
+			t.Fatalf("ReconcileGroups() error = %v", err)
+		}
+		results = append(results, result)
+	}
+
+	if len(results) != 1 {
+		t.Fatalf("got %d results, want 1 (open group skipped)", len(results))
+	}
+	if results[0].GroupID != "closed" || !results[0].Balanced || len(results[0].Lines) != 9 {
+		t.Errorf("unexpected result: %+v", results[0])
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		want  string
+	}{
+		{0.1, "0.1"},
+		{json.Number("123456789012.345"), "123456789012.345"},
+		{"-3.22", "-3.22"},
+		{int64(5), "5"},
+	}
+
+	for _, tt := range tests {
+		got, err := ledger.ParseAmount(tt.input)
+		if err != nil {
+			t.Errorf("ParseAmount(%v) error = %v", tt.input, err)
+			continue
+		}
+		if s := got.String(); s != tt.want {
+			t.Errorf("ParseAmount(%v) = %s, want %s", tt.input, s, tt.want)
+		}
+	}
+
+	if _, err := ledger.ParseAmount("abc"); !errors.Is(err, ledger.ErrInvalidAmount) {
+		t.Errorf("error = %v, want ErrInvalidAmount", err)
+	}
+}
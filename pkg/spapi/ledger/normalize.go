@@ -0,0 +1,459 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package ledger
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// amountField 描述事件中没有 XxxType/XxxAmount 配对的金额字段。
+type amountField struct {
+	// list 非空时，金额位于该列表的每个元素中
+	list string
+
+	// field 是金额字段名
+	field string
+
+	// typeField 是费用类型的来源字段（位于事件顶层），为空时使用 field
+	typeField string
+}
+
+// eventAmountFields 列出无法通过 XxxType/XxxAmount 配对识别的金额字段。
+//
+// 汇总字段（如 CouponPaymentEvent.TotalAmount、AdjustmentItem.TotalAmount）
+// 是其它字段的合计，不列入以免重复计算。
+var eventAmountFields = map[string][]amountField{
+	"ProductAdsPaymentEvent": {
+		{field: "transactionValue", typeField: "transactionType"},
+	},
+	"SellerDealPaymentEvent": {
+		{field: "taxAmount"},
+	},
+	"DebtRecoveryEvent": {
+		{field: "RecoveryAmount", typeField: "DebtRecoveryType"},
+	},
+	"LoanServicingEvent": {
+		{field: "LoanAmount", typeField: "SourceBusinessEventType"},
+	},
+	"RetrochargeEvent": {
+		{field: "BaseTax", typeField: "RetrochargeEventType"},
+		{field: "ShippingTax", typeField: "RetrochargeEventType"},
+	},
+	"FBALiquidationEvent": {
+		{field: "LiquidationProceedsAmount"},
+		{field: "LiquidationFeeAmount"},
+	},
+	"NetworkComminglingTransactionEvent": {
+		{field: "TaxExclusiveAmount", typeField: "TransactionType"},
+		{field: "TaxAmount", typeField: "TransactionType"},
+	},
+	"AffordabilityExpenseEvent": {
+		{field: "TotalExpense", typeField: "TransactionType"},
+	},
+	"AffordabilityExpenseReversalEvent": {
+		{field: "TotalExpense", typeField: "TransactionType"},
+	},
+	"RemovalShipmentEvent": {
+		{list: "RemovalShipmentItemList", field: "Revenue"},
+		{list: "RemovalShipmentItemList", field: "FeeAmount"},
+		{list: "RemovalShipmentItemList", field: "TaxAmount"},
+		{list: "RemovalShipmentItemList", field: "TaxWithheld"},
+	},
+	"RemovalShipmentAdjustmentEvent": {
+		{list: "RemovalShipmentItemAdjustmentList", field: "RevenueAdjustment"},
+		{list: "RemovalShipmentItemAdjustmentList", field: "TaxAmountAdjustment"},
+		{list: "RemovalShipmentItemAdjustmentList", field: "TaxWithheldAdjustment"},
+	},
+	"ServiceProviderCreditEvent": {
+		{field: "TransactionAmount", typeField: "ProviderTransactionType"},
+	},
+	"TDSReimbursementEvent": {
+		{field: "ReimbursedAmount"},
+	},
+	"TaxWithholdingEvent": {
+		{field: "WithheldAmount"},
+	},
+	"FailedAdhocDisbursementEvent": {
+		{field: "TransferAmount", typeField: "FundsTransfersType"},
+	},
+}
+
+// orderIDKeys 是事件中可能表示订单 ID 的字段，按优先级排列。
+var orderIDKeys = []string{"AmazonOrderId", "SellerOrderId", "OrderId", "OriginalRemovalOrderId", "TDSOrderId"}
+
+// skuKeys 是事件中可能表示 SKU 的字段，按优先级排列。
+var skuKeys = []string{"SellerSKU", "SKU"}
+
+// postedDateKeys 是事件中可能表示入账时间的字段，按优先级排列。
+var postedDateKeys = []string{"PostedDate", "TransactionPostedDate", "TransactionCreationDate"}
+
+// NormalizeFinancialEvents 将 Finances API v0 的 FinancialEvents 对象规范化为账本行。
+//
+// 遍历所有 XxxEventList，对每个事件：
+//   - 识别所有 XxxType/XxxAmount 配对（ChargeComponent、FeeComponent、Promotion、
+//     DirectPayment、AdjustmentEvent 等），每个配对生成一条 Line
+//   - 对没有配对的事件类型（RemovalShipmentEvent、DebtRecoveryEvent 等）按内置字段表提取金额
+//   - SKU、订单 ID、入账时间从外层对象继承
+//
+// 输出顺序是确定的：按事件列表名排序，列表内保持原始顺序。
+//
+// 参数:
+//   - events: FinancialEvents 对象（IterateFinancialEvents 等迭代器的产出）
+//   - groupID: 事件所属的财务事件组 ID（未知时传空字符串）
+//
+// 返回值:
+//   - []Line: 账本行
+//   - error: 金额无法解析时返回错误
+func NormalizeFinancialEvents(events map[string]interface{}, groupID string) ([]Line, error) {
+	var lines []Line
+
+	keys := make([]string, 0, len(events))
+	for key := range events {
+		if strings.HasSuffix(key, "EventList") {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		list, _ := events[key].([]interface{})
+		eventType := strings.TrimSuffix(key, "List")
+
+		for i, item := range list {
+			event, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			eventLines, err := normalizeEvent(eventType, event, groupID)
+			if err != nil {
+				return nil, fmt.Errorf("%s[%d]: %w", key, i, err)
+			}
+			lines = append(lines, eventLines...)
+		}
+	}
+
+	return lines, nil
+}
+
+// normalizeEvent 规范化单个 v0 财务事件。
+func normalizeEvent(eventType string, event map[string]interface{}, groupID string) ([]Line, error) {
+	base := inherit(Line{
+		Source:    SourceFinancesV0,
+		EventType: eventType,
+		GroupID:   groupID,
+	}, event)
+
+	var lines []Line
+	if err := walk(event, base, &lines); err != nil {
+		return nil, err
+	}
+
+	for _, field := range eventAmountFields[eventType] {
+		feeType := field.field
+		if field.typeField != "" {
+			if value := lookupString(event, field.typeField); value != "" {
+				feeType = value
+			}
+		}
+
+		objects := []map[string]interface{}{event}
+		if field.list != "" {
+			objects = objects[:0]
+			items, _ := lookup(event, field.list)
+			list, _ := items.([]interface{})
+			for _, item := range list {
+				if object, ok := item.(map[string]interface{}); ok {
+					objects = append(objects, object)
+				}
+			}
+		}
+
+		for _, object := range objects {
+			value, _ := lookup(object, field.field)
+			amount, ok, err := parseCurrency(value)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", field.field, err)
+			}
+			if !ok {
+				continue
+			}
+
+			line := inherit(base, object)
+			line.Component = capitalize(field.field)
+			line.FeeType = feeType
+			line.Amount = amount
+			lines = append(lines, line)
+		}
+	}
+
+	return lines, nil
+}
+
+// walk 递归遍历对象，为每个 XxxType/XxxAmount 配对生成账本行。
+func walk(object map[string]interface{}, parent Line, lines *[]Line) error {
+	current := inherit(parent, object)
+
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		value := object[key]
+
+		if prefix, ok := strings.CutSuffix(key, "Amount"); ok && prefix != "" {
+			if feeType, ok := object[prefix+"Type"].(string); ok && feeType != "" {
+				amount, isCurrency, err := parseCurrency(value)
+				if err != nil {
+					return fmt.Errorf("%s: %w", key, err)
+				}
+				if isCurrency {
+					line := current
+					line.Component = capitalize(prefix)
+					line.FeeType = feeType
+					line.Amount = amount
+					*lines = append(*lines, line)
+					continue
+				}
+			}
+		}
+
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if err := walk(v, current, lines); err != nil {
+				return err
+			}
+		case []interface{}:
+			for _, item := range v {
+				if child, ok := item.(map[string]interface{}); ok {
+					if err := walk(child, current, lines); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// inherit 用对象中的订单 ID、SKU、入账时间和组 ID 覆盖上下文。
+func inherit(line Line, object map[string]interface{}) Line {
+	for _, key := range orderIDKeys {
+		if value := lookupString(object, key); value != "" {
+			line.OrderID = value
+			break
+		}
+	}
+	for _, key := range skuKeys {
+		if value := lookupString(object, key); value != "" {
+			line.SKU = value
+			break
+		}
+	}
+	for _, key := range postedDateKeys {
+		if posted := lookupTime(object, key); !posted.IsZero() {
+			line.PostedDate = posted
+			break
+		}
+	}
+	if value := lookupString(object, "FinancialEventGroupId"); value != "" {
+		line.GroupID = value
+	}
+	return line
+}
+
+// NormalizeTransaction 将 Finances API v2024-06-19 的交易规范化为账本行。
+//
+// 拆分规则：
+//   - 商品级 breakdowns 的叶子节点合计等于交易总额时，按商品拆分（可以得到 SKU）
+//   - 否则按交易级 breakdowns 的叶子节点拆分
+//   - 都没有时，生成一条 totalAmount 记录
+//
+// 订单 ID 和组 ID 取自 relatedIdentifiers 的 ORDER_ID 和 FINANCIAL_EVENT_GROUP_ID。
+//
+// 参数:
+//   - transaction: 交易对象（IterateTransactions 的产出）
+//
+// 返回值:
+//   - []Line: 账本行
+//   - error: 金额无法解析时返回错误
+func NormalizeTransaction(transaction map[string]interface{}) ([]Line, error) {
+	base := Line{
+		Source:     SourceFinancesV2024,
+		EventType:  lookupString(transaction, "transactionType"),
+		OrderID:    relatedIdentifier(transaction, "relatedIdentifiers", "relatedIdentifierName", "ORDER_ID"),
+		PostedDate: lookupTime(transaction, "postedDate"),
+		GroupID:    relatedIdentifier(transaction, "relatedIdentifiers", "relatedIdentifierName", "FINANCIAL_EVENT_GROUP_ID"),
+	}
+
+	total, hasTotal, err := parseCurrency(transaction["totalAmount"])
+	if err != nil {
+		return nil, fmt.Errorf("totalAmount: %w", err)
+	}
+
+	var itemLines []Line
+	items, _ := transaction["items"].([]interface{})
+	for i, entry := range items {
+		item, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		itemBase := base
+		itemBase.SKU = contextSKU(item)
+		if orderID := relatedIdentifier(item, "relatedIdentifiers", "itemRelatedIdentifierName", "ORDER_ID"); orderID != "" {
+			itemBase.OrderID = orderID
+		}
+
+		lines, err := breakdownLines(item["breakdowns"], "", itemBase)
+		if err != nil {
+			return nil, fmt.Errorf("items[%d]: %w", i, err)
+		}
+		itemLines = append(itemLines, lines...)
+	}
+
+	if len(itemLines) > 0 && (!hasTotal || balances(itemLines, total)) {
+		return itemLines, nil
+	}
+
+	base.SKU = contextSKU(transaction)
+	lines, err := breakdownLines(transaction["breakdowns"], "", base)
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) > 0 {
+		return lines, nil
+	}
+
+	if !hasTotal {
+		return nil, nil
+	}
+
+	base.Component = "Total"
+	base.FeeType = base.EventType
+	base.Amount = total
+	return []Line{base}, nil
+}
+
+// breakdownLines 为 breakdowns 树的每个叶子节点生成账本行。
+func breakdownLines(value interface{}, component string, base Line) ([]Line, error) {
+	breakdowns, _ := value.([]interface{})
+
+	var lines []Line
+	for _, entry := range breakdowns {
+		breakdown, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		breakdownType := lookupString(breakdown, "breakdownType")
+		top := component
+		if top == "" {
+			top = breakdownType
+		}
+
+		if children, _ := breakdown["breakdowns"].([]interface{}); len(children) > 0 {
+			childLines, err := breakdownLines(children, top, base)
+			if err != nil {
+				return nil, err
+			}
+			lines = append(lines, childLines...)
+			continue
+		}
+
+		amount, ok, err := parseCurrency(breakdown["breakdownAmount"])
+		if err != nil {
+			return nil, fmt.Errorf("breakdown %s: %w", breakdownType, err)
+		}
+		if !ok {
+			continue
+		}
+
+		line := base
+		line.Component = top
+		line.FeeType = breakdownType
+		line.Amount = amount
+		lines = append(lines, line)
+	}
+
+	return lines, nil
+}
+
+// balances 判断账本行合计是否恰好等于给定金额（且只有一种币种）。
+func balances(lines []Line, total spapi.Money) bool {
+	totals := Sum(lines)
+	sum, ok := totals[total.Currency]
+	return ok && len(totals) == 1 && sum.Amount.Equal(total.Amount)
+}
+
+// relatedIdentifier 从关联标识列表中查找指定名称的值。
+func relatedIdentifier(object map[string]interface{}, listKey, nameKey, name string) string {
+	identifiers, _ := object[listKey].([]interface{})
+	for _, entry := range identifiers {
+		identifier, ok := entry.(map[string]interface{})
+		if !ok || identifier[nameKey] != name {
+			continue
+		}
+		value, _ := identifier[strings.TrimSuffix(nameKey, "Name")+"Value"].(string)
+		return value
+	}
+	return ""
+}
+
+// contextSKU 从 contexts 中提取唯一的 SKU。存在多个不同 SKU 时返回空字符串。
+func contextSKU(object map[string]interface{}) string {
+	contexts, _ := object["contexts"].([]interface{})
+
+	sku := ""
+	for _, entry := range contexts {
+		context, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		value, _ := context["sku"].(string)
+		if value == "" || value == sku {
+			continue
+		}
+		if sku != "" {
+			return ""
+		}
+		sku = value
+	}
+	return sku
+}
+
+// capitalize 将字段名首字母大写（如 feeAmount 的前缀 fee -> Fee）。
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	runes := []rune(s)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
@@ -0,0 +1,196 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package ledger
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/finances-v0"
+)
+
+// Result 是一个财务事件组（结算组）的对账结果。
+type Result struct {
+	// GroupID 是财务事件组 ID
+	GroupID string
+
+	// ProcessingStatus 是组的处理状态（Open 或 Closed）
+	ProcessingStatus string
+
+	// Expected 是 Amazon 给出的组总额（OriginalTotal）
+	Expected spapi.Money
+
+	// Actual 是账本行的合计（币种与 Expected 相同）
+	Actual spapi.Money
+
+	// Difference 是 Actual - Expected
+	Difference spapi.Money
+
+	// Lines 是参与对账的账本行
+	Lines []Line
+
+	// ForeignLines 是币种与组总额不一致、未计入合计的账本行
+	ForeignLines []Line
+
+	// Balanced 表示合计与组总额完全相等且没有币种不一致的行
+	Balanced bool
+}
+
+// Reconcile 将账本行与财务事件组的总额对账。
+//
+// 金额按精确十进制比较，不设容差。调用方负责传入属于该组的账本行
+// （例如 Reconciler.GroupLines 或 GroupByID 的结果）。
+//
+// 参数:
+//   - group: 财务事件组对象（IterateFinancialEventGroups 的产出）
+//   - lines: 该组的账本行
+//
+// 返回值:
+//   - *Result: 对账结果
+//   - error: 组没有总额时返回 ErrMissingTotal，总额无法解析时返回 ErrInvalidAmount
+func Reconcile(group map[string]interface{}, lines []Line) (*Result, error) {
+	groupID := lookupString(group, "FinancialEventGroupId")
+
+	expected, ok, err := parseCurrency(group["OriginalTotal"])
+	if err != nil {
+		return nil, fmt.Errorf("group %s: OriginalTotal: %w", groupID, err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("group %s: %w", groupID, ErrMissingTotal)
+	}
+
+	result := &Result{
+		GroupID:          groupID,
+		ProcessingStatus: lookupString(group, "ProcessingStatus"),
+		Expected:         expected,
+		Actual:           spapi.NewMoney(spapi.Decimal{}, expected.Currency),
+	}
+
+	for _, line := range lines {
+		actual, err := result.Actual.Add(line.Amount)
+		if err != nil {
+			result.ForeignLines = append(result.ForeignLines, line)
+			continue
+		}
+		result.Lines = append(result.Lines, line)
+		result.Actual = actual
+	}
+
+	result.Difference = spapi.NewMoney(result.Actual.Amount.Sub(expected.Amount), expected.Currency)
+	result.Balanced = result.Difference.IsZero() && len(result.ForeignLines) == 0
+
+	return result, nil
+}
+
+// Reconciler 基于 Finances API v0 拉取结算组及其事件并逐组对账。
+type Reconciler struct {
+	client *finances_v0.Client
+}
+
+// NewReconciler 创建新的对账器。
+//
+// 参数:
+//   - client: Finances API v0 客户端
+//
+// 返回值:
+//   - *Reconciler: 对账器实例
+func NewReconciler(client *finances_v0.Client) *Reconciler {
+	return &Reconciler{client: client}
+}
+
+// GroupLines 拉取财务事件组的全部事件并规范化为账本行。
+//
+// 参数:
+//   - ctx: 请求上下文
+//   - groupID: 财务事件组 ID
+//
+// 返回值:
+//   - []Line: 账本行
+//   - error: 请求或解析失败时返回错误
+func (r *Reconciler) GroupLines(ctx context.Context, groupID string) ([]Line, error) {
+	var lines []Line
+
+	for events, err := range r.client.IterateFinancialEventsByGroupId(ctx, groupID, nil) {
+		if err != nil {
+			return nil, fmt.Errorf("list events of group %s: %w", groupID, err)
+		}
+
+		pageLines, err := NormalizeFinancialEvents(events, groupID)
+		if err != nil {
+			return nil, fmt.Errorf("normalize events of group %s: %w", groupID, err)
+		}
+		lines = append(lines, pageLines...)
+	}
+
+	return lines, nil
+}
+
+// ReconcileGroup 拉取组内事件并与组总额对账。
+//
+// 参数:
+//   - ctx: 请求上下文
+//   - group: 财务事件组对象
+//
+// 返回值:
+//   - *Result: 对账结果
+//   - error: 请求、解析失败或组没有总额时返回错误
+func (r *Reconciler) ReconcileGroup(ctx context.Context, group map[string]interface{}) (*Result, error) {
+	groupID := lookupString(group, "FinancialEventGroupId")
+
+	lines, err := r.GroupLines(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	return Reconcile(group, lines)
+}
+
+// ReconcileGroups 返回逐组对账结果的迭代器。
+//
+// 只对已关闭（ProcessingStatus 为 Closed）的组对账；
+// 仍处于 Open 状态的组总额尚未确定，会被跳过。
+//
+// 参数:
+//   - ctx: 请求上下文
+//   - query: ListFinancialEventGroups 的查询参数（如 FinancialEventGroupStartedAfter）
+//
+// 返回值:
+//   - iter.Seq2[*Result, error]: 对账结果迭代器
+func (r *Reconciler) ReconcileGroups(ctx context.Context, query map[string]string) iter.Seq2[*Result, error] {
+	return func(yield func(*Result, error) bool) {
+		for group, err := range r.client.IterateFinancialEventGroups(ctx, query) {
+			if err != nil {
+				yield(nil, fmt.Errorf("list financial event groups: %w", err))
+				return
+			}
+
+			if lookupString(group, "ProcessingStatus") != "Closed" {
+				continue
+			}
+
+			if !yield(r.ReconcileGroup(ctx, group)) {
+				return
+			}
+		}
+	}
+}
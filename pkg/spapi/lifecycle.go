@@ -0,0 +1,398 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/auth"
+)
+
+// lifecycle 跟踪进行中的请求、迭代器和后台任务，实现 Client.Close。
+type lifecycle struct {
+	mu sync.Mutex
+
+	// closing 在 Close 开始后为 true，之后不再接受新请求
+	closing bool
+
+	// active 是进行中的请求和迭代器数量
+	active int
+
+	// drained 在 closing 且 active 归零时关闭
+	drained chan struct{}
+
+	// ctx 是后台任务的 context，Close 时取消
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// tasks 是运行中的后台任务数量
+	tasks int
+
+	// stopped 在 closing 且 tasks 归零时关闭
+	stopped chan struct{}
+
+	// flushers 是 Close 时刷新的日志器、指标收集器和追踪器
+	flushers []Flusher
+}
+
+// heldKey 是 Acquire 返回的 context 中的键，值为 *hold。
+type heldKey struct{}
+
+// hold 是一次 Acquire 的登记，release 之后它的 context 不再越过关闭检查。
+type hold struct {
+	lifecycle *lifecycle
+
+	// released 在 release 后为 true，由 lifecycle.mu 保护
+	released bool
+}
+
+func newLifecycle(config *Config) *lifecycle {
+	ctx, cancel := context.WithCancel(context.Background())
+	l := &lifecycle{ctx: ctx, cancel: cancel}
+	for _, v := range []interface{}{config.Logger, config.Metrics, config.Tracer, config.MetricsRecorder} {
+		if f, ok := v.(Flusher); ok {
+			l.flushers = append(l.flushers, f)
+		}
+	}
+	return l
+}
+
+// enter 登记一个进行中的请求，返回结束时调用的函数。
+//
+// 关闭开始后返回 ErrClientClosed，除非 ctx 来自同一客户端尚未 release 的 Acquire。
+func (l *lifecycle) enter(ctx context.Context) (func(), error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closing && !l.holding(ctx) {
+		return nil, ErrClientClosed
+	}
+	l.active++
+	return sync.OnceFunc(l.leave), nil
+}
+
+// holding 报告 ctx 是否来自本 lifecycle 尚未 release 的 Acquire。调用方持有 l.mu。
+func (l *lifecycle) holding(ctx context.Context) bool {
+	h, ok := ctx.Value(heldKey{}).(*hold)
+	return ok && h.lifecycle == l && !h.released
+}
+
+// acquire 登记一个跨越多个请求的操作，返回其登记和结束时调用的函数。
+func (l *lifecycle) acquire(ctx context.Context) (*hold, func(), error) {
+	leave, err := l.enter(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	h := &hold{lifecycle: l}
+	release := sync.OnceFunc(func() {
+		l.mu.Lock()
+		h.released = true
+		l.mu.Unlock()
+		leave()
+	})
+	return h, release, nil
+}
+
+// leave 结束一个请求，最后一个请求结束时通知 Close。
+func (l *lifecycle) leave() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.active--
+	if l.closing && l.active == 0 {
+		closeOnce(l.drained)
+	}
+}
+
+// shutdown 停止接受新请求和后台任务，返回所有请求结束时关闭的 channel。
+func (l *lifecycle) shutdown() <-chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.closing {
+		l.closing = true
+		l.drained = make(chan struct{})
+		if l.active == 0 {
+			close(l.drained)
+		}
+		l.stopped = make(chan struct{})
+		if l.tasks == 0 {
+			close(l.stopped)
+		}
+		l.cancel()
+	}
+	return l.drained
+}
+
+// done 结束一个后台任务，最后一个任务结束时通知 Close。
+func (l *lifecycle) done() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tasks--
+	if l.closing && l.tasks == 0 {
+		closeOnce(l.stopped)
+	}
+}
+
+// closeOnce 关闭尚未关闭的 channel。调用方持有 l.mu。
+func closeOnce(ch chan struct{}) {
+	select {
+	case <-ch:
+	default:
+		close(ch)
+	}
+}
+
+// state 返回是否正在关闭（或已关闭）和进行中的请求数。
+func (l *lifecycle) state() (bool, int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.closing, l.active
+}
+
+// background 在后台 goroutine 中运行 fn，Close 取消 ctx 并等待 fn 返回。
+//
+// 关闭已完成后启动的任务不再被跟踪，ctx 已取消。
+func (c *Client) background(fn func(ctx context.Context)) {
+	l := c.lifecycle
+	l.mu.Lock()
+	if l.closing && l.tasks == 0 {
+		l.mu.Unlock()
+		go fn(l.ctx)
+		return
+	}
+	l.tasks++
+	l.mu.Unlock()
+
+	go func() {
+		defer l.done()
+		fn(l.ctx)
+	}()
+}
+
+// Acquire 登记一个跨越多个请求的操作（如分页迭代器），Close 会等待它结束。
+//
+// 使用返回的 ctx 发出的请求在客户端关闭期间仍被接受，使进行中的
+// 迭代器能够取完剩余的页；操作结束时必须调用 release，之后该 ctx
+// 在关闭期间与其他 ctx 一样被拒绝。
+// 生成的 Iterate* 方法已经调用 Acquire，通常不需要直接使用。
+//
+// 参数:
+//   - ctx: 操作的上下文
+//
+// 返回值:
+//   - context.Context: 操作中发出请求使用的上下文
+//   - func(): 操作结束时调用的函数（可以多次调用）
+//   - error: 客户端已关闭时返回 ErrClientClosed
+//
+// 示例:
+//
+//	ctx, release, err := client.Acquire(ctx)
+//	if err != nil {
+//	    return err
+//	}
+//	defer release()
+func (c *Client) Acquire(ctx context.Context) (context.Context, func(), error) {
+	h, release, err := c.lifecycle.acquire(ctx)
+	if err != nil {
+		return ctx, nil, err
+	}
+	return context.WithValue(ctx, heldKey{}, h), release, nil
+}
+
+// Close 关闭客户端并释放资源。
+//
+// Close 立即拒绝新请求（返回 ErrClientClosed），停止后台任务，
+// 等待进行中的请求和迭代器（见 Acquire）结束，然后关闭连接池中的空闲连接，
+// 并刷新实现了 Flusher 的日志器、指标收集器和追踪器。
+// ctx 到期时不再等待，仍然释放资源并返回 ctx 的错误。
+//
+// Close 可以多次调用，之后的调用等待同样的条件。
+//
+// 参数:
+//   - ctx: 限制等待时间的上下文
+//
+// 返回值:
+//   - error: ctx 到期或刷新失败时返回错误
+//
+// 示例:
+//
+//	client, err := spapi.NewClient(...)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer client.Close(context.Background())
+//
+//	// 收到 SIGTERM 后最多等待 30 秒
+//	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+//	defer cancel()
+//	if err := client.Close(ctx); err != nil {
+//	    log.Printf("close: %v", err)
+//	}
+func (c *Client) Close(ctx context.Context) error {
+	var errs []error
+
+	drained := c.lifecycle.shutdown()
+	select {
+	case <-drained:
+	case <-ctx.Done():
+		errs = append(errs, fmt.Errorf("wait for in-flight requests: %w", ctx.Err()))
+	}
+
+	select {
+	case <-c.lifecycle.stopped:
+	case <-ctx.Done():
+		if len(errs) == 0 {
+			errs = append(errs, fmt.Errorf("wait for background tasks: %w", ctx.Err()))
+		}
+	}
+
+	c.facade.GetHTTPClient().HTTPClient().CloseIdleConnections()
+	for _, lwaClient := range c.lwaClients() {
+		lwaClient.CloseIdleConnections()
+	}
+
+	for _, f := range c.lifecycle.flushers {
+		if err := f.Flush(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("flush: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// lwaClients 返回客户端使用的 LWA 客户端（刷新令牌和 Grantless）。
+func (c *Client) lwaClients() []*auth.Client {
+	clients := []*auth.Client{c.facade.GetLWAClient()}
+	if grantless := c.facade.GetGrantlessLWAClient(); grantless != nil && grantless != clients[0] {
+		clients = append(clients, grantless)
+	}
+	return clients
+}
+
+// HealthStatus 是 Client.Health 的结果，可以序列化为 JSON。
+type HealthStatus struct {
+	// Ready 表示客户端未关闭且所有 LWA 令牌有效。
+	Ready bool `json:"ready"`
+
+	// Closed 表示 Close 已被调用。
+	Closed bool `json:"closed"`
+
+	// InFlight 是进行中的请求和迭代器数量。
+	InFlight int `json:"in_flight"`
+
+	// Tokens 是每种授权方式的令牌状态。
+	Tokens []TokenStatus `json:"tokens"`
+
+	// CircuitBreaker 是熔断器状态（CircuitBreakerClosed、CircuitBreakerOpen、
+	// CircuitBreakerHalfOpen，未启用时为 CircuitBreakerDisabled）。
+	CircuitBreaker string `json:"circuit_breaker"`
+
+	// RateLimitSaturation 是所有速率限制器中令牌桶的最高占用比例（0.0-1.0），
+	// 为 1 时至少一个操作已用完令牌。
+	RateLimitSaturation float64 `json:"rate_limit_saturation"`
+
+	// QueuedRequests 是正在排队等待速率限制令牌的请求数。
+	QueuedRequests int `json:"queued_requests"`
+}
+
+// TokenStatus 是一种授权方式的 LWA 令牌状态。
+type TokenStatus struct {
+	// GrantType 是 refresh_token 或 client_credentials。
+	GrantType string `json:"grant_type"`
+
+	// Valid 表示已获得未过期的访问令牌。
+	Valid bool `json:"valid"`
+
+	// ExpiresAt 是访问令牌的过期时间。
+	ExpiresAt time.Time `json:"expires_at,omitzero"`
+
+	// Error 是获取令牌失败的原因（已脱敏）。
+	Error string `json:"error,omitempty"`
+}
+
+// Health 返回客户端的健康状态，用于就绪探针等检查。
+//
+// 令牌使用缓存；缓存中没有有效令牌时从 LWA 服务器获取，
+// 因此 Health 也能发现失效的凭证。客户端关闭后不再获取令牌。
+// 熔断器状态和速率限制饱和度只用于报告，不影响 Ready。
+//
+// 参数:
+//   - ctx: 获取令牌的上下文
+//
+// 返回值:
+//   - HealthStatus: 健康状态
+//
+// 示例:
+//
+//	status := client.Health(ctx)
+//	if !status.Ready {
+//	    log.Printf("sp-api client not ready: %+v", status)
+//	}
+func (c *Client) Health(ctx context.Context) HealthStatus {
+	closed, inFlight := c.lifecycle.state()
+	status := HealthStatus{
+		Ready:          !closed,
+		Closed:         closed,
+		InFlight:       inFlight,
+		CircuitBreaker: breakerState(c.breaker),
+	}
+
+	for _, lwaClient := range c.lwaClients() {
+		token := TokenStatus{GrantType: lwaClient.GrantType()}
+		if !closed {
+			if t, err := lwaClient.Token(ctx); err != nil {
+				token.Error = c.redactor.Text(err.Error())
+			} else {
+				token.Valid, token.ExpiresAt = true, t.ExpiresAt
+			}
+		}
+		status.Ready = status.Ready && token.Valid
+		status.Tokens = append(status.Tokens, token)
+	}
+
+	status.RateLimitSaturation, status.QueuedRequests = c.facade.GetRateLimitManager().Saturation()
+	return status
+}
+
+// HealthHandler 返回输出 Health 结果的 HTTP 处理器，可以直接用作
+// Kubernetes 就绪探针：Ready 时返回 200，否则返回 503，响应体为 JSON。
+//
+// 示例:
+//
+//	http.Handle("/readyz", client.HealthHandler())
+func (c *Client) HealthHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := c.Health(r.Context())
+		w.Header().Set("Content-Type", "application/json")
+		if !status.Ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(status)
+	})
+}
@@ -0,0 +1,326 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	orders "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/orders-v0"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/spapitest"
+)
+
+// TestClient_Close_WaitsForInFlight 测试 Close 拒绝新请求并等待进行中的请求。
+func TestClient_Close_WaitsForInFlight(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+	started, finish := make(chan struct{}), make(chan struct{})
+	srv.Handle(http.MethodGet, "/orders/v0/orders/{orderId}", func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-finish
+		w.Write([]byte(`{}`))
+	})
+
+	client, err := srv.NewClient(spapi.WithMaxRetries(0))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	ctx := context.Background()
+
+	inFlight := make(chan error, 1)
+	go func() { inFlight <- client.Get(ctx, "/orders/v0/orders/123", nil, nil) }()
+	<-started
+
+	closed := make(chan error, 1)
+	go func() { closed <- client.Close(ctx) }()
+
+	// 等待 Close 开始拒绝新请求
+	deadline := time.Now().Add(time.Second)
+	for !client.Health(ctx).Closed && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if err := client.Get(ctx, "/orders/v0/orders/456", nil, nil); !errors.Is(err, spapi.ErrClientClosed) {
+		t.Errorf("Get() after Close error = %v, want ErrClientClosed", err)
+	}
+	select {
+	case err := <-closed:
+		t.Fatalf("Close() returned %v before the in-flight request finished", err)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(finish)
+	if err := <-inFlight; err != nil {
+		t.Errorf("in-flight Get() error = %v", err)
+	}
+	if err := <-closed; err != nil {
+		t.Errorf("Close() error = %v", err)
+	}
+}
+
+// TestClient_Close_Timeout 测试 ctx 到期时 Close 返回错误。
+func TestClient_Close_Timeout(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+	started, finish := make(chan struct{}), make(chan struct{})
+	defer close(finish)
+	srv.Handle(http.MethodGet, "/orders/v0/orders/{orderId}", func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-finish
+	})
+
+	client, err := srv.NewClient(spapi.WithMaxRetries(0))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	go client.Get(context.Background(), "/orders/v0/orders/123", nil, nil)
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := client.Close(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Close() error = %v, want deadline exceeded", err)
+	}
+}
+
+// TestClient_Close_WaitsForIterator 测试 Close 等待进行中的迭代器取完剩余的页。
+func TestClient_Close_WaitsForIterator(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+	page := func(id string) map[string]interface{} {
+		return map[string]interface{}{"payload": map[string]interface{}{
+			"Orders": []map[string]interface{}{{"AmazonOrderId": id}},
+		}}
+	}
+	if err := srv.Paginate(http.MethodGet, "/orders/v0/orders", "NextToken", "payload.NextToken",
+		page("1"), page("2"), page("3")); err != nil {
+		t.Fatalf("Paginate() error = %v", err)
+	}
+
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	ctx := context.Background()
+
+	closed := make(chan error, 1)
+	var ids []interface{}
+	for order, err := range orders.NewClient(client).IterateOrders(ctx, nil) {
+		if err != nil {
+			t.Fatalf("IterateOrders() error = %v", err)
+		}
+		ids = append(ids, order["AmazonOrderId"])
+		if len(ids) == 1 {
+			go func() { closed <- client.Close(ctx) }()
+			for !client.Health(ctx).Closed {
+				time.Sleep(time.Millisecond)
+			}
+		}
+	}
+	if len(ids) != 3 {
+		t.Errorf("orders = %v, want 3 orders", ids)
+	}
+	if err := <-closed; err != nil {
+		t.Errorf("Close() error = %v", err)
+	}
+
+	// 关闭后的新迭代立即返回 ErrClientClosed
+	for _, err := range orders.NewClient(client).IterateOrders(ctx, nil) {
+		if !errors.Is(err, spapi.ErrClientClosed) {
+			t.Errorf("IterateOrders() after Close error = %v, want ErrClientClosed", err)
+		}
+	}
+}
+
+// TestClient_Health 测试令牌、熔断器和速率限制的健康状态。
+func TestClient_Health(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+
+	client, err := srv.NewClient(spapi.WithCircuitBreaker(&spapi.CircuitBreakerConfig{}))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	ctx := context.Background()
+
+	status := client.Health(ctx)
+	if !status.Ready || status.Closed {
+		t.Errorf("Health() = %+v, want ready", status)
+	}
+	if len(status.Tokens) != 1 || !status.Tokens[0].Valid || status.Tokens[0].GrantType != "refresh_token" {
+		t.Errorf("Tokens = %+v, want one valid refresh_token", status.Tokens)
+	}
+	if status.Tokens[0].ExpiresAt.Before(time.Now()) {
+		t.Errorf("ExpiresAt = %v, want in the future", status.Tokens[0].ExpiresAt)
+	}
+	if status.CircuitBreaker != spapi.CircuitBreakerClosed {
+		t.Errorf("CircuitBreaker = %q, want %q", status.CircuitBreaker, spapi.CircuitBreakerClosed)
+	}
+
+	if err := client.Close(ctx); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if status := client.Health(ctx); status.Ready || !status.Closed {
+		t.Errorf("Health() after Close = %+v, want closed", status)
+	}
+}
+
+// TestClient_Health_InvalidCredentials 测试失效的凭证使客户端未就绪。
+func TestClient_Health_InvalidCredentials(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+
+	client, err := srv.NewClient(spapi.WithCredentials(spapitest.ClientID, spapitest.ClientSecret, "Atzr|revoked"))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	defer client.Close(context.Background())
+
+	status := client.Health(context.Background())
+	if status.Ready {
+		t.Error("Ready = true, want false")
+	}
+	if len(status.Tokens) != 1 || status.Tokens[0].Valid || status.Tokens[0].Error == "" {
+		t.Errorf("Tokens = %+v, want an invalid token with an error", status.Tokens)
+	}
+	if status.CircuitBreaker != spapi.CircuitBreakerDisabled {
+		t.Errorf("CircuitBreaker = %q, want %q", status.CircuitBreaker, spapi.CircuitBreakerDisabled)
+	}
+}
+
+// TestClient_HealthHandler 测试就绪探针的状态码和响应体。
+func TestClient_HealthHandler(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	handler := client.HealthHandler()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want 200", rec.Code)
+	}
+	var status spapi.HealthStatus
+	if err := json.Unmarshal(rec.Body.Bytes(), &status); err != nil || !status.Ready {
+		t.Errorf("body = %s, want ready status", rec.Body.String())
+	}
+
+	client.Close(context.Background())
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("status after Close = %d, want 503", rec.Code)
+	}
+}
+
+// TestWithCircuitBreaker 测试连续失败后熔断，超时后恢复。
+func TestWithCircuitBreaker(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+	status := http.StatusInternalServerError
+	srv.Handle(http.MethodGet, "/orders/v0/orders/{orderId}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		w.Write([]byte(`{}`))
+	})
+
+	client, err := srv.NewClient(
+		spapi.WithMaxRetries(0),
+		spapi.WithCircuitBreaker(&spapi.CircuitBreakerConfig{MaxFailures: 2, Timeout: 50 * time.Millisecond}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	defer client.Close(context.Background())
+	ctx := context.Background()
+
+	for range 2 {
+		if err := client.Get(ctx, "/orders/v0/orders/123", nil, nil); !errors.Is(err, spapi.ErrServerError) {
+			t.Fatalf("Get() error = %v, want ErrServerError", err)
+		}
+	}
+	if err := client.Get(ctx, "/orders/v0/orders/123", nil, nil); !errors.Is(err, spapi.ErrCircuitOpen) {
+		t.Errorf("Get() error = %v, want ErrCircuitOpen", err)
+	}
+	if got := client.Health(ctx).CircuitBreaker; got != spapi.CircuitBreakerOpen {
+		t.Errorf("CircuitBreaker = %q, want %q", got, spapi.CircuitBreakerOpen)
+	}
+	if n := len(srv.Requests()); n != 2 {
+		t.Errorf("requests = %d, want 2", n)
+	}
+
+	// 超时后放行试探请求，成功则恢复
+	time.Sleep(60 * time.Millisecond)
+	status = http.StatusOK
+	if err := client.Get(ctx, "/orders/v0/orders/123", nil, nil); err != nil {
+		t.Errorf("Get() after timeout error = %v", err)
+	}
+	if got := client.Health(ctx).CircuitBreaker; got != spapi.CircuitBreakerClosed {
+		t.Errorf("CircuitBreaker = %q, want %q", got, spapi.CircuitBreakerClosed)
+	}
+}
+
+// TestClient_Acquire_ReleasedDuringClose 测试 release 之后持有的 ctx 在关闭期间被拒绝。
+func TestClient_Acquire_ReleasedDuringClose(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+	srv.Handle(http.MethodGet, "/orders/v0/orders/{orderId}", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	})
+
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	ctx := context.Background()
+
+	heldCtx, release, err := client.Acquire(ctx)
+	if err != nil {
+		t.Fatalf("Acquire() error = %v", err)
+	}
+	closed := make(chan error, 1)
+	go func() { closed <- client.Close(ctx) }()
+	for !client.Health(ctx).Closed {
+		time.Sleep(time.Millisecond)
+	}
+
+	// 关闭期间持有的 ctx 仍可发出请求
+	if err := client.Get(heldCtx, "/orders/v0/orders/123", nil, nil); err != nil {
+		t.Errorf("Get() with held ctx error = %v", err)
+	}
+
+	release()
+	release()
+	if err := <-closed; err != nil {
+		t.Errorf("Close() error = %v", err)
+	}
+	if err := client.Get(heldCtx, "/orders/v0/orders/123", nil, nil); !errors.Is(err, spapi.ErrClientClosed) {
+		t.Errorf("Get() with released ctx error = %v, want ErrClientClosed", err)
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package merchant_fulfillment_v0
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。
+//
+// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回
+// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，
+// 可以通过 Calls 和 CallsTo 查看。
+type Fake struct {
+	spapi.FakeRecorder
+
+	// CancelShipmentFunc 实现 CancelShipment。
+	CancelShipmentFunc func(ctx context.Context, shipmentId string) (interface{}, error)
+
+	// CreateShipmentFunc 实现 CreateShipment。
+	CreateShipmentFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetAdditionalSellerInputsFunc 实现 GetAdditionalSellerInputs。
+	GetAdditionalSellerInputsFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetEligibleShipmentServicesFunc 实现 GetEligibleShipmentServices。
+	GetEligibleShipmentServicesFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetShipmentFunc 实现 GetShipment。
+	GetShipmentFunc func(ctx context.Context, shipmentId string, query map[string]string) (interface{}, error)
+}
+
+// NewFake 创建未编程任何响应的 Fake。
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// CancelShipment 记录调用并执行 CancelShipmentFunc。
+func (f *Fake) CancelShipment(ctx context.Context, shipmentId string) (interface{}, error) {
+	f.Record("CancelShipment", shipmentId)
+	if f.CancelShipmentFunc == nil {
+		return nil, fmt.Errorf("CancelShipment: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CancelShipmentFunc(ctx, shipmentId)
+}
+
+// CreateShipment 记录调用并执行 CreateShipmentFunc。
+func (f *Fake) CreateShipment(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("CreateShipment", body)
+	if f.CreateShipmentFunc == nil {
+		return nil, fmt.Errorf("CreateShipment: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateShipmentFunc(ctx, body)
+}
+
+// GetAdditionalSellerInputs 记录调用并执行 GetAdditionalSellerInputsFunc。
+func (f *Fake) GetAdditionalSellerInputs(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("GetAdditionalSellerInputs", body)
+	if f.GetAdditionalSellerInputsFunc == nil {
+		return nil, fmt.Errorf("GetAdditionalSellerInputs: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetAdditionalSellerInputsFunc(ctx, body)
+}
+
+// GetEligibleShipmentServices 记录调用并执行 GetEligibleShipmentServicesFunc。
+func (f *Fake) GetEligibleShipmentServices(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("GetEligibleShipmentServices", body)
+	if f.GetEligibleShipmentServicesFunc == nil {
+		return nil, fmt.Errorf("GetEligibleShipmentServices: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetEligibleShipmentServicesFunc(ctx, body)
+}
+
+// GetShipment 记录调用并执行 GetShipmentFunc。
+func (f *Fake) GetShipment(ctx context.Context, shipmentId string, query map[string]string) (interface{}, error) {
+	f.Record("GetShipment", shipmentId, query)
+	if f.GetShipmentFunc == nil {
+		return nil, fmt.Errorf("GetShipment: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetShipmentFunc(ctx, shipmentId, query)
+}
+
+// 确保 Fake 实现了 API。
+var _ API = (*Fake)(nil)
@@ -8,10 +8,19 @@
  */
 package merchant_fulfillment_v0
 
+import (
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
 // Currency type and amount.
 type CurrencyAmount struct {
 	// Three-digit currency code in ISO 4217 format.
 	CurrencyCode string `json:"CurrencyCode"`
 	// The currency amount.
-	Amount float64 `json:"Amount"`
+	Amount spapi.Decimal `json:"Amount"`
+}
+
+// ToMoney 转换为 spapi.Money。
+func (c CurrencyAmount) ToMoney() spapi.Money {
+	return spapi.NewMoney(c.Amount, c.CurrencyCode)
 }
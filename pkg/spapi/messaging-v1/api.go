@@ -0,0 +1,81 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package messaging_v1
+
+import (
+	"context"
+)
+
+// API 是 messaging API v1 客户端的全部方法，包括分页迭代器等辅助方法。
+//
+// 业务代码依赖 API 而不是 *Client，即可在单元测试中用 Fake 替换真实客户端。
+type API interface {
+	// ConfirmCustomizationDetails
+	// Method: POST | Path: /messaging/v1/orders/{amazonOrderId}/messages/confirmCustomizationDetails
+	ConfirmCustomizationDetails(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error)
+
+	// CreateAmazonMotors
+	// Method: POST | Path: /messaging/v1/orders/{amazonOrderId}/messages/amazonMotors
+	CreateAmazonMotors(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error)
+
+	// CreateConfirmDeliveryDetails
+	// Method: POST | Path: /messaging/v1/orders/{amazonOrderId}/messages/confirmDeliveryDetails
+	CreateConfirmDeliveryDetails(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error)
+
+	// CreateConfirmOrderDetails
+	// Method: POST | Path: /messaging/v1/orders/{amazonOrderId}/messages/confirmOrderDetails
+	CreateConfirmOrderDetails(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error)
+
+	// CreateConfirmServiceDetails
+	// Method: POST | Path: /messaging/v1/orders/{amazonOrderId}/messages/confirmServiceDetails
+	CreateConfirmServiceDetails(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error)
+
+	// CreateDigitalAccessKey
+	// Method: POST | Path: /messaging/v1/orders/{amazonOrderId}/messages/digitalAccessKey
+	CreateDigitalAccessKey(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error)
+
+	// CreateLegalDisclosure
+	// Method: POST | Path: /messaging/v1/orders/{amazonOrderId}/messages/legalDisclosure
+	CreateLegalDisclosure(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error)
+
+	// CreateUnexpectedProblem
+	// Method: POST | Path: /messaging/v1/orders/{amazonOrderId}/messages/unexpectedProblem
+	CreateUnexpectedProblem(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error)
+
+	// CreateWarranty
+	// Method: POST | Path: /messaging/v1/orders/{amazonOrderId}/messages/warranty
+	CreateWarranty(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error)
+
+	// GetAttributes
+	// Method: GET | Path: /messaging/v1/orders/{amazonOrderId}/attributes
+	GetAttributes(ctx context.Context, amazonOrderId string, query map[string]string) (interface{}, error)
+
+	// GetMessagingActionsForOrder
+	// Method: GET | Path: /messaging/v1/orders/{amazonOrderId}
+	GetMessagingActionsForOrder(ctx context.Context, amazonOrderId string, query map[string]string) (interface{}, error)
+
+	// SendInvoice
+	// Method: POST | Path: /messaging/v1/orders/{amazonOrderId}/messages/invoice
+	SendInvoice(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error)
+}
+
+// 确保 Client 实现了 API。
+var _ API = (*Client)(nil)
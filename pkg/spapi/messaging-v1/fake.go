@@ -0,0 +1,189 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package messaging_v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。
+//
+// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回
+// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，
+// 可以通过 Calls 和 CallsTo 查看。
+type Fake struct {
+	spapi.FakeRecorder
+
+	// ConfirmCustomizationDetailsFunc 实现 ConfirmCustomizationDetails。
+	ConfirmCustomizationDetailsFunc func(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error)
+
+	// CreateAmazonMotorsFunc 实现 CreateAmazonMotors。
+	CreateAmazonMotorsFunc func(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error)
+
+	// CreateConfirmDeliveryDetailsFunc 实现 CreateConfirmDeliveryDetails。
+	CreateConfirmDeliveryDetailsFunc func(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error)
+
+	// CreateConfirmOrderDetailsFunc 实现 CreateConfirmOrderDetails。
+	CreateConfirmOrderDetailsFunc func(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error)
+
+	// CreateConfirmServiceDetailsFunc 实现 CreateConfirmServiceDetails。
+	CreateConfirmServiceDetailsFunc func(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error)
+
+	// CreateDigitalAccessKeyFunc 实现 CreateDigitalAccessKey。
+	CreateDigitalAccessKeyFunc func(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error)
+
+	// CreateLegalDisclosureFunc 实现 CreateLegalDisclosure。
+	CreateLegalDisclosureFunc func(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error)
+
+	// CreateUnexpectedProblemFunc 实现 CreateUnexpectedProblem。
+	CreateUnexpectedProblemFunc func(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error)
+
+	// CreateWarrantyFunc 实现 CreateWarranty。
+	CreateWarrantyFunc func(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error)
+
+	// GetAttributesFunc 实现 GetAttributes。
+	GetAttributesFunc func(ctx context.Context, amazonOrderId string, query map[string]string) (interface{}, error)
+
+	// GetMessagingActionsForOrderFunc 实现 GetMessagingActionsForOrder。
+	GetMessagingActionsForOrderFunc func(ctx context.Context, amazonOrderId string, query map[string]string) (interface{}, error)
+
+	// SendInvoiceFunc 实现 SendInvoice。
+	SendInvoiceFunc func(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error)
+}
+
+// NewFake 创建未编程任何响应的 Fake。
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// ConfirmCustomizationDetails 记录调用并执行 ConfirmCustomizationDetailsFunc。
+func (f *Fake) ConfirmCustomizationDetails(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error) {
+	f.Record("ConfirmCustomizationDetails", amazonOrderId, body)
+	if f.ConfirmCustomizationDetailsFunc == nil {
+		return nil, fmt.Errorf("ConfirmCustomizationDetails: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ConfirmCustomizationDetailsFunc(ctx, amazonOrderId, body)
+}
+
+// CreateAmazonMotors 记录调用并执行 CreateAmazonMotorsFunc。
+func (f *Fake) CreateAmazonMotors(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error) {
+	f.Record("CreateAmazonMotors", amazonOrderId, body)
+	if f.CreateAmazonMotorsFunc == nil {
+		return nil, fmt.Errorf("CreateAmazonMotors: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateAmazonMotorsFunc(ctx, amazonOrderId, body)
+}
+
+// CreateConfirmDeliveryDetails 记录调用并执行 CreateConfirmDeliveryDetailsFunc。
+func (f *Fake) CreateConfirmDeliveryDetails(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error) {
+	f.Record("CreateConfirmDeliveryDetails", amazonOrderId, body)
+	if f.CreateConfirmDeliveryDetailsFunc == nil {
+		return nil, fmt.Errorf("CreateConfirmDeliveryDetails: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateConfirmDeliveryDetailsFunc(ctx, amazonOrderId, body)
+}
+
+// CreateConfirmOrderDetails 记录调用并执行 CreateConfirmOrderDetailsFunc。
+func (f *Fake) CreateConfirmOrderDetails(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error) {
+	f.Record("CreateConfirmOrderDetails", amazonOrderId, body)
+	if f.CreateConfirmOrderDetailsFunc == nil {
+		return nil, fmt.Errorf("CreateConfirmOrderDetails: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateConfirmOrderDetailsFunc(ctx, amazonOrderId, body)
+}
+
+// CreateConfirmServiceDetails 记录调用并执行 CreateConfirmServiceDetailsFunc。
+func (f *Fake) CreateConfirmServiceDetails(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error) {
+	f.Record("CreateConfirmServiceDetails", amazonOrderId, body)
+	if f.CreateConfirmServiceDetailsFunc == nil {
+		return nil, fmt.Errorf("CreateConfirmServiceDetails: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateConfirmServiceDetailsFunc(ctx, amazonOrderId, body)
+}
+
+// CreateDigitalAccessKey 记录调用并执行 CreateDigitalAccessKeyFunc。
+func (f *Fake) CreateDigitalAccessKey(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error) {
+	f.Record("CreateDigitalAccessKey", amazonOrderId, body)
+	if f.CreateDigitalAccessKeyFunc == nil {
+		return nil, fmt.Errorf("CreateDigitalAccessKey: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateDigitalAccessKeyFunc(ctx, amazonOrderId, body)
+}
+
+// CreateLegalDisclosure 记录调用并执行 CreateLegalDisclosureFunc。
+func (f *Fake) CreateLegalDisclosure(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error) {
+	f.Record("CreateLegalDisclosure", amazonOrderId, body)
+	if f.CreateLegalDisclosureFunc == nil {
+		return nil, fmt.Errorf("CreateLegalDisclosure: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateLegalDisclosureFunc(ctx, amazonOrderId, body)
+}
+
+// CreateUnexpectedProblem 记录调用并执行 CreateUnexpectedProblemFunc。
+func (f *Fake) CreateUnexpectedProblem(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error) {
+	f.Record("CreateUnexpectedProblem", amazonOrderId, body)
+	if f.CreateUnexpectedProblemFunc == nil {
+		return nil, fmt.Errorf("CreateUnexpectedProblem: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateUnexpectedProblemFunc(ctx, amazonOrderId, body)
+}
+
+// CreateWarranty 记录调用并执行 CreateWarrantyFunc。
+func (f *Fake) CreateWarranty(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error) {
+	f.Record("CreateWarranty", amazonOrderId, body)
+	if f.CreateWarrantyFunc == nil {
+		return nil, fmt.Errorf("CreateWarranty: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateWarrantyFunc(ctx, amazonOrderId, body)
+}
+
+// GetAttributes 记录调用并执行 GetAttributesFunc。
+func (f *Fake) GetAttributes(ctx context.Context, amazonOrderId string, query map[string]string) (interface{}, error) {
+	f.Record("GetAttributes", amazonOrderId, query)
+	if f.GetAttributesFunc == nil {
+		return nil, fmt.Errorf("GetAttributes: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetAttributesFunc(ctx, amazonOrderId, query)
+}
+
+// GetMessagingActionsForOrder 记录调用并执行 GetMessagingActionsForOrderFunc。
+func (f *Fake) GetMessagingActionsForOrder(ctx context.Context, amazonOrderId string, query map[string]string) (interface{}, error) {
+	f.Record("GetMessagingActionsForOrder", amazonOrderId, query)
+	if f.GetMessagingActionsForOrderFunc == nil {
+		return nil, fmt.Errorf("GetMessagingActionsForOrder: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetMessagingActionsForOrderFunc(ctx, amazonOrderId, query)
+}
+
+// SendInvoice 记录调用并执行 SendInvoiceFunc。
+func (f *Fake) SendInvoice(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error) {
+	f.Record("SendInvoice", amazonOrderId, body)
+	if f.SendInvoiceFunc == nil {
+		return nil, fmt.Errorf("SendInvoice: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.SendInvoiceFunc(ctx, amazonOrderId, body)
+}
+
+// 确保 Fake 实现了 API。
+var _ API = (*Fake)(nil)
@@ -24,6 +24,8 @@ import (
 	"context"
 	"net/http"
 	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/redact"
 )
 
 // Middleware 定义中间件类型。
@@ -53,6 +55,7 @@ type Handler func(ctx context.Context, req *http.Request) (*http.Response, error
 // LoggingMiddleware 创建日志中间件。
 //
 // 记录每个请求的开始和结束，包括耗时、状态码等信息。
+// URL 查询参数和错误消息按内置规则脱敏。
 //
 // 参数:
 //   - logger: 日志器实现
@@ -73,7 +76,7 @@ func LoggingMiddleware(logger Logger) Middleware {
 
 			logger.Info("request started",
 				Field{"method", req.Method},
-				Field{"url", req.URL.String()},
+				Field{"url", redact.Default().URL(req.URL)},
 			)
 
 			resp, err := next(ctx, req)
@@ -83,14 +86,14 @@ func LoggingMiddleware(logger Logger) Middleware {
 			if err != nil {
 				logger.Error("request failed",
 					Field{"method", req.Method},
-					Field{"url", req.URL.String()},
+					Field{"url", redact.Default().URL(req.URL)},
 					Field{"duration", duration},
-					Field{"error", err.Error()},
+					Field{"error", redact.Default().Text(err.Error())},
 				)
 			} else {
 				logger.Info("request completed",
 					Field{"method", req.Method},
-					Field{"url", req.URL.String()},
+					Field{"url", redact.Default().URL(req.URL)},
 					Field{"duration", duration},
 					Field{"status", resp.StatusCode},
 				)
@@ -139,7 +142,7 @@ func MetricsMiddleware(metrics MetricsCollector) Middleware {
 
 // TracingMiddleware 创建分布式追踪中间件。
 //
-// 为每个请求创建span，记录追踪信息。
+// 为每个请求创建span，记录追踪信息。URL 查询参数按内置规则脱敏。
 //
 // 参数:
 //   - tracer: 追踪器实现
@@ -160,7 +163,7 @@ func TracingMiddleware(tracer Tracer) Middleware {
 			defer span.End()
 
 			span.SetAttribute("http.method", req.Method)
-			span.SetAttribute("http.url", req.URL.String())
+			span.SetAttribute("http.url", redact.Default().URL(req.URL))
 
 			resp, err := next(ctx, req)
 
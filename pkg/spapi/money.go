@@ -0,0 +1,212 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+package spapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrCurrencyMismatch 表示对不同币种的金额做运算或比较。
+var ErrCurrencyMismatch = errors.New("currency mismatch")
+
+// currencyDigits 是 ISO 4217 中小数位数不为 2 的币种。
+var currencyDigits = map[string]int32{
+	"BHD": 3, "BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "IQD": 3, "ISK": 0,
+	"JOD": 3, "JPY": 0, "KMF": 0, "KRW": 0, "KWD": 3, "LYD": 3, "OMR": 3,
+	"PYG": 0, "RWF": 0, "TND": 3, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0,
+	"XAF": 0, "XOF": 0, "XPF": 0,
+}
+
+// CurrencyDigits 返回币种的小数位数（ISO 4217 minor units）。
+//
+// 例如 USD、EUR 为 2，JPY 为 0，KWD 为 3。未知币种按 2 处理。
+func CurrencyDigits(currency string) int32 {
+	if digits, ok := currencyDigits[strings.ToUpper(currency)]; ok {
+		return digits
+	}
+	return 2
+}
+
+// Money 是带币种的精确金额。
+//
+// 各 API 包的金额模型（orders_v0.Money、finances_v0.Currency、
+// product_pricing_v0.MoneyType 等）字段名各不相同，
+// 都可以通过 ToMoney 方法转换为 Money，从而跨 API 求和与比较。
+//
+// Money 是值类型，零值表示没有币种的 0，可以作为求和的初始值。
+//
+// 示例:
+//
+//	total := spapi.Money{}
+//	for _, item := range items {
+//	    total, err = total.Add(item.ItemPrice.ToMoney())
+//	    if err != nil {
+//	        return err
+//	    }
+//	}
+//	fmt.Println(total.Round()) // "59.97 USD"
+type Money struct {
+	// Amount 是金额
+	Amount Decimal
+
+	// Currency 是 ISO 4217 币种代码
+	Currency string
+}
+
+// NewMoney 创建金额。
+//
+// 参数:
+//   - amount: 金额
+//   - currency: ISO 4217 币种代码
+//
+// 返回值:
+//   - Money: 金额
+func NewMoney(amount Decimal, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// ParseMoney 解析字符串金额。
+//
+// 参数:
+//   - amount: 十进制金额字符串（如 "19.99"）
+//   - currency: ISO 4217 币种代码
+//
+// 返回值:
+//   - Money: 金额
+//   - error: 金额格式无效时返回 ErrInvalidDecimal
+func ParseMoney(amount, currency string) (Money, error) {
+	d, err := ParseDecimal(amount)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: d, Currency: currency}, nil
+}
+
+// unify 确定两个金额运算时使用的币种。
+//
+// 没有币种的零值可以与任何币种运算，便于以 Money{} 作为求和初始值。
+func (m Money) unify(other Money) (string, error) {
+	switch {
+	case m.Currency == other.Currency:
+		return m.Currency, nil
+	case m.Currency == "" && m.Amount.IsZero():
+		return other.Currency, nil
+	case other.Currency == "" && other.Amount.IsZero():
+		return m.Currency, nil
+	default:
+		return "", fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, other.Currency)
+	}
+}
+
+// Add 返回 m + other。
+//
+// 返回值:
+//   - Money: 合计
+//   - error: 币种不同时返回 ErrCurrencyMismatch
+func (m Money) Add(other Money) (Money, error) {
+	currency, err := m.unify(other)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: m.Amount.Add(other.Amount), Currency: currency}, nil
+}
+
+// Sub 返回 m - other。
+//
+// 返回值:
+//   - Money: 差额
+//   - error: 币种不同时返回 ErrCurrencyMismatch
+func (m Money) Sub(other Money) (Money, error) {
+	currency, err := m.unify(other)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: m.Amount.Sub(other.Amount), Currency: currency}, nil
+}
+
+// Cmp 比较 m 和 other，返回 -1、0 或 1。
+//
+// 返回值:
+//   - int: 比较结果
+//   - error: 币种不同时返回 ErrCurrencyMismatch
+func (m Money) Cmp(other Money) (int, error) {
+	if _, err := m.unify(other); err != nil {
+		return 0, err
+	}
+	return m.Amount.Cmp(other.Amount), nil
+}
+
+// Neg 返回 -m。
+func (m Money) Neg() Money {
+	return Money{Amount: m.Amount.Neg(), Currency: m.Currency}
+}
+
+// IsZero 判断金额是否为 0。
+func (m Money) IsZero() bool {
+	return m.Amount.IsZero()
+}
+
+// Round 按币种的小数位数四舍五入（RoundHalfUp）。
+//
+// 例如 USD 舍入到 2 位，JPY 舍入到 0 位，KWD 舍入到 3 位。
+func (m Money) Round() Money {
+	return m.RoundWith(RoundHalfUp)
+}
+
+// RoundWith 按币种的小数位数和指定舍入方式舍入。
+func (m Money) RoundWith(mode RoundingMode) Money {
+	return Money{Amount: m.Amount.Round(CurrencyDigits(m.Currency), mode), Currency: m.Currency}
+}
+
+// String 返回 "金额 币种" 形式的字符串（如 "19.99 USD"）。
+func (m Money) String() string {
+	if m.Currency == "" {
+		return m.Amount.String()
+	}
+	return m.Amount.String() + " " + m.Currency
+}
+
+// moneyJSON 是 Money 的 JSON 表示。
+type moneyJSON struct {
+	Amount       DecimalString `json:"amount"`
+	CurrencyCode string        `json:"currencyCode"`
+}
+
+// MarshalJSON 实现 json.Marshaler 接口。
+//
+// 输出 {"amount":"19.99","currencyCode":"USD"}，金额使用字符串避免下游按 float64 解析。
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(moneyJSON{Amount: DecimalString{m.Amount}, CurrencyCode: m.Currency})
+}
+
+// UnmarshalJSON 实现 json.Unmarshaler 接口。
+//
+// 金额接受字符串和数字两种形式。
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var v moneyJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*m = Money{Amount: v.Amount.Decimal, Currency: v.CurrencyCode}
+	return nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+package spapi_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	productfees "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/product-fees-v0"
+	vendorinvoices "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/vendor-invoices-v1"
+)
+
+func TestMoneyArithmetic(t *testing.T) {
+	price, _ := spapi.ParseMoney("19.99", "USD")
+	shipping, _ := spapi.ParseMoney("5.01", "USD")
+
+	total, err := spapi.Money{}.Add(price)
+	if err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	total, _ = total.Add(shipping)
+	if total.String() != "25.00 USD" {
+		t.Errorf("total = %s, want 25.00 USD", total)
+	}
+
+	refund, _ := total.Sub(price)
+	if c, _ := refund.Cmp(shipping); c != 0 {
+		t.Errorf("refund = %s, want %s", refund, shipping)
+	}
+
+	yen, _ := spapi.ParseMoney("100", "JPY")
+	if _, err := total.Add(yen); !errors.Is(err, spapi.ErrCurrencyMismatch) {
+		t.Errorf("Add() error = %v, want ErrCurrencyMismatch", err)
+	}
+	if _, err := total.Cmp(yen); !errors.Is(err, spapi.ErrCurrencyMismatch) {
+		t.Errorf("Cmp() error = %v, want ErrCurrencyMismatch", err)
+	}
+}
+
+func TestMoneyRound(t *testing.T) {
+	tests := []struct {
+		amount   string
+		currency string
+		want     string
+	}{
+		{"10.005", "USD", "10.01 USD"},
+		{"1234.5", "JPY", "1235 JPY"},
+		{"1.2345", "KWD", "1.235 KWD"},
+		{"3", "EUR", "3.00 EUR"},
+	}
+
+	for _, tt := range tests {
+		m, _ := spapi.ParseMoney(tt.amount, tt.currency)
+		if got := m.Round().String(); got != tt.want {
+			t.Errorf("Round(%s %s) = %s, want %s", tt.amount, tt.currency, got, tt.want)
+		}
+	}
+
+	m, _ := spapi.ParseMoney("10.005", "USD")
+	if got := m.RoundWith(spapi.RoundHalfEven).String(); got != "10.00 USD" {
+		t.Errorf("RoundWith(HalfEven) = %s, want 10.00 USD", got)
+	}
+}
+
+func TestMoneyJSON(t *testing.T) {
+	var m spapi.Money
+	if err := json.Unmarshal([]byte(`{"amount":12.34,"currencyCode":"EUR"}`), &m); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if m.String() != "12.34 EUR" {
+		t.Errorf("decoded %s, want 12.34 EUR", m)
+	}
+
+	out, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if want := `{"amount":"12.34","currencyCode":"EUR"}`; string(out) != want {
+		t.Errorf("Marshal() = %s, want %s", out, want)
+	}
+}
+
+func TestModelMoneyZeroJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		want  string
+	}{
+		{"decimal", productfees.PriceToEstimateFees{ListingPrice: &productfees.MoneyType{}}, `{"ListingPrice":{}}`},
+		{"decimal string", vendorinvoices.TaxDetails{TaxType: "VAT", TaxAmount: &vendorinvoices.Money{}}, `{"taxType":"VAT","taxAmount":{}}`},
+		{"non-zero", productfees.MoneyType{CurrencyCode: "USD", Amount: spapi.MustParseDecimal("0.50")}, `{"CurrencyCode":"USD","Amount":0.50}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if string(out) != tt.want {
+				t.Errorf("Marshal() = %s, want %s", out, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package notifications_v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。
+//
+// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回
+// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，
+// 可以通过 Calls 和 CallsTo 查看。
+type Fake struct {
+	spapi.FakeRecorder
+
+	// CreateDestinationFunc 实现 CreateDestination。
+	CreateDestinationFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// CreateSubscriptionFunc 实现 CreateSubscription。
+	CreateSubscriptionFunc func(ctx context.Context, notificationType string, body interface{}) (interface{}, error)
+
+	// DeleteDestinationFunc 实现 DeleteDestination。
+	DeleteDestinationFunc func(ctx context.Context, destinationId string) (interface{}, error)
+
+	// DeleteSubscriptionByIdFunc 实现 DeleteSubscriptionById。
+	DeleteSubscriptionByIdFunc func(ctx context.Context, notificationType string, subscriptionId string) (interface{}, error)
+
+	// GetDestinationFunc 实现 GetDestination。
+	GetDestinationFunc func(ctx context.Context, destinationId string, query map[string]string) (interface{}, error)
+
+	// GetDestinationsFunc 实现 GetDestinations。
+	GetDestinationsFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetSubscriptionFunc 实现 GetSubscription。
+	GetSubscriptionFunc func(ctx context.Context, notificationType string, query map[string]string) (interface{}, error)
+
+	// GetSubscriptionByIdFunc 实现 GetSubscriptionById。
+	GetSubscriptionByIdFunc func(ctx context.Context, notificationType string, subscriptionId string, query map[string]string) (interface{}, error)
+}
+
+// NewFake 创建未编程任何响应的 Fake。
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// CreateDestination 记录调用并执行 CreateDestinationFunc。
+func (f *Fake) CreateDestination(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("CreateDestination", body)
+	if f.CreateDestinationFunc == nil {
+		return nil, fmt.Errorf("CreateDestination: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateDestinationFunc(ctx, body)
+}
+
+// CreateSubscription 记录调用并执行 CreateSubscriptionFunc。
+func (f *Fake) CreateSubscription(ctx context.Context, notificationType string, body interface{}) (interface{}, error) {
+	f.Record("CreateSubscription", notificationType, body)
+	if f.CreateSubscriptionFunc == nil {
+		return nil, fmt.Errorf("CreateSubscription: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateSubscriptionFunc(ctx, notificationType, body)
+}
+
+// DeleteDestination 记录调用并执行 DeleteDestinationFunc。
+func (f *Fake) DeleteDestination(ctx context.Context, destinationId string) (interface{}, error) {
+	f.Record("DeleteDestination", destinationId)
+	if f.DeleteDestinationFunc == nil {
+		return nil, fmt.Errorf("DeleteDestination: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.DeleteDestinationFunc(ctx, destinationId)
+}
+
+// DeleteSubscriptionById 记录调用并执行 DeleteSubscriptionByIdFunc。
+func (f *Fake) DeleteSubscriptionById(ctx context.Context, notificationType string, subscriptionId string) (interface{}, error) {
+	f.Record("DeleteSubscriptionById", notificationType, subscriptionId)
+	if f.DeleteSubscriptionByIdFunc == nil {
+		return nil, fmt.Errorf("DeleteSubscriptionById: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.DeleteSubscriptionByIdFunc(ctx, notificationType, subscriptionId)
+}
+
+// GetDestination 记录调用并执行 GetDestinationFunc。
+func (f *Fake) GetDestination(ctx context.Context, destinationId string, query map[string]string) (interface{}, error) {
+	f.Record("GetDestination", destinationId, query)
+	if f.GetDestinationFunc == nil {
+		return nil, fmt.Errorf("GetDestination: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetDestinationFunc(ctx, destinationId, query)
+}
+
+// GetDestinations 记录调用并执行 GetDestinationsFunc。
+func (f *Fake) GetDestinations(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetDestinations", query)
+	if f.GetDestinationsFunc == nil {
+		return nil, fmt.Errorf("GetDestinations: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetDestinationsFunc(ctx, query)
+}
+
+// GetSubscription 记录调用并执行 GetSubscriptionFunc。
+func (f *Fake) GetSubscription(ctx context.Context, notificationType string, query map[string]string) (interface{}, error) {
+	f.Record("GetSubscription", notificationType, query)
+	if f.GetSubscriptionFunc == nil {
+		return nil, fmt.Errorf("GetSubscription: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetSubscriptionFunc(ctx, notificationType, query)
+}
+
+// GetSubscriptionById 记录调用并执行 GetSubscriptionByIdFunc。
+func (f *Fake) GetSubscriptionById(ctx context.Context, notificationType string, subscriptionId string, query map[string]string) (interface{}, error) {
+	f.Record("GetSubscriptionById", notificationType, subscriptionId, query)
+	if f.GetSubscriptionByIdFunc == nil {
+		return nil, fmt.Errorf("GetSubscriptionById: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetSubscriptionByIdFunc(ctx, notificationType, subscriptionId, query)
+}
+
+// 确保 Fake 实现了 API。
+var _ API = (*Fake)(nil)
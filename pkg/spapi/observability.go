@@ -0,0 +1,266 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/metrics"
+)
+
+// 追踪属性键，遵循 OpenTelemetry HTTP 客户端语义约定（semconv v1.26）。
+const (
+	attrHTTPRequestMethod      = "http.request.method"
+	attrHTTPResponseStatusCode = "http.response.status_code"
+	attrURLFull                = "url.full"
+	attrServerAddress          = "server.address"
+	attrErrorType              = "error.type"
+
+	// SP-API 专用属性
+	attrOperation   = "spapi.operation"
+	attrMarketplace = "spapi.marketplace_id"
+	attrRequestID   = "spapi.request_id"
+)
+
+// 指标的 error_type 标签值。
+const (
+	errorTypeRateLimitWait = "rate_limit_wait"
+	errorTypeAuth          = "auth"
+	errorTypeEncode        = "encode"
+	errorTypeNetwork       = "network"
+	errorTypeRateLimit     = "rate_limit"
+	errorTypeClient        = "client"
+	errorTypeServer        = "server"
+	errorTypeDecode        = "decode"
+	errorTypeCanceled      = "canceled"
+	errorTypeTimeout       = "timeout"
+)
+
+// newMetricsRecorder 合并 Config.MetricsRecorder 和 Config.Metrics，
+// 返回 SDK 内部统一使用的指标记录器。
+//
+// 如果 Config.Metrics 同时实现了 metrics.Recorder（如 pkg/spapi/prometheus
+// 和 pkg/spapi/otel 中的适配器），直接使用完整的指标名称集和标签；
+// 否则将指标转换为 MetricsCollector 的方法调用。
+func newMetricsRecorder(config *Config) metrics.Recorder {
+	var recorders multiRecorder
+	if config.MetricsRecorder != nil && config.MetricsRecorder != metrics.DefaultRecorder {
+		recorders = append(recorders, config.MetricsRecorder)
+	}
+	switch collector := config.Metrics.(type) {
+	case nil, *noOpMetrics:
+	case metrics.Recorder:
+		recorders = append(recorders, collector)
+	default:
+		recorders = append(recorders, collectorRecorder{collector})
+	}
+
+	switch len(recorders) {
+	case 0:
+		return metrics.DefaultRecorder
+	case 1:
+		return recorders[0]
+	default:
+		return recorders
+	}
+}
+
+// multiRecorder 将指标同时发送给多个记录器。
+type multiRecorder []metrics.Recorder
+
+func (m multiRecorder) RecordCounter(name string, value float64, labels map[string]string) {
+	for _, r := range m {
+		r.RecordCounter(name, value, labels)
+	}
+}
+
+func (m multiRecorder) RecordGauge(name string, value float64, labels map[string]string) {
+	for _, r := range m {
+		r.RecordGauge(name, value, labels)
+	}
+}
+
+func (m multiRecorder) RecordHistogram(name string, value float64, labels map[string]string) {
+	for _, r := range m {
+		r.RecordHistogram(name, value, labels)
+	}
+}
+
+func (m multiRecorder) RecordTiming(name string, duration time.Duration, labels map[string]string) {
+	for _, r := range m {
+		r.RecordTiming(name, duration, labels)
+	}
+}
+
+// collectorRecorder 把 metrics.Recorder 调用转换为 MetricsCollector 调用。
+//
+// MetricsCollector 只有请求、错误和速率限制等待三类指标，
+// 其余指标（令牌刷新、活跃限制器）被忽略。
+type collectorRecorder struct {
+	collector MetricsCollector
+}
+
+func (c collectorRecorder) RecordCounter(name string, value float64, labels map[string]string) {
+	if name == metrics.MetricRequestErrors {
+		c.collector.RecordError(labels[metrics.LabelOperation], labels[metrics.LabelErrorType])
+	}
+}
+
+func (c collectorRecorder) RecordGauge(name string, value float64, labels map[string]string) {}
+
+func (c collectorRecorder) RecordHistogram(name string, value float64, labels map[string]string) {}
+
+func (c collectorRecorder) RecordTiming(name string, duration time.Duration, labels map[string]string) {
+	switch name {
+	case metrics.MetricRequestDuration:
+		statusCode, _ := strconv.Atoi(labels[metrics.LabelStatusCode])
+		c.collector.RecordRequest(labels[metrics.LabelOperation], labels[metrics.LabelMethod], duration, statusCode)
+	case metrics.MetricRateLimitWait:
+		c.collector.RecordRateLimitWait(labels[metrics.LabelOperation], duration)
+	}
+}
+
+// startSpan 为一次 API 请求开始 span 并设置请求属性。
+func (c *Client) startSpan(ctx context.Context, method, operation, marketplace string) (context.Context, Span) {
+	ctx, span := c.config.Tracer.StartSpan(ctx, operation)
+	span.SetAttribute(attrHTTPRequestMethod, method)
+	span.SetAttribute(attrOperation, operation)
+	span.SetAttribute(attrMarketplace, marketplace)
+	if endpoint, err := url.Parse(c.config.Region.Endpoint); err == nil {
+		span.SetAttribute(attrServerAddress, endpoint.Hostname())
+	}
+	return ctx, span
+}
+
+// injectTraceContext 把追踪上下文注入请求头（Tracer 实现 TracePropagator 时）。
+func (c *Client) injectTraceContext(ctx context.Context, req *http.Request) {
+	if propagator, ok := c.config.Tracer.(TracePropagator); ok {
+		propagator.Inject(ctx, req.Header)
+	}
+}
+
+// waitRateLimit 等待操作的速率限制令牌（启用 WithRateLimitWait 时），记录等待时间
+// 和活跃限制器数量，等待超过 1ms 时输出 EventRateLimitWait。
+func (c *Client) waitRateLimit(ctx context.Context, operation, marketplace string) error {
+	manager := c.facade.GetRateLimitManager()
+	if manager == nil || !c.config.RateLimitWait {
+		return nil
+	}
+
+	start := time.Now()
+	err := manager.Wait(ctx, c.extractSellerID(), c.config.ClientID, marketplace, operation)
+	wait := time.Since(start)
+	if wait >= time.Millisecond {
+		c.config.Logger.Debug(EventRateLimitWait,
+			Field{LogKeyOperation, operation},
+			Field{LogKeyMarketplace, marketplace},
+			Field{LogKeyWait, wait},
+		)
+	}
+	c.metrics.RecordTiming(metrics.MetricRateLimitWait, wait, map[string]string{
+		metrics.LabelOperation:   operation,
+		metrics.LabelMarketplace: marketplace,
+	})
+	c.metrics.RecordGauge(metrics.MetricRateLimitActive, float64(manager.Count()), nil)
+	return err
+}
+
+// endRequest 记录一次 API 请求的指标并结束 span。
+//
+// 参数:
+//   - span: 请求的 span
+//   - req: 已发送的请求（请求构建失败时为 nil）
+//   - resp: HTTP 响应（未收到响应时为 nil）
+//   - errorType: 失败阶段（为空时根据 err 推断）
+//   - duration: 请求耗时
+//   - err: 请求错误
+func (c *Client) endRequest(span Span, method, operation, marketplace string, req *http.Request, resp *http.Response, errorType string, duration time.Duration, err error) {
+	defer span.End()
+
+	labels := map[string]string{
+		metrics.LabelOperation:   operation,
+		metrics.LabelMarketplace: marketplace,
+		metrics.LabelMethod:      method,
+	}
+	if req != nil {
+		span.SetAttribute(attrURLFull, req.URL.String())
+	}
+	if resp != nil {
+		labels[metrics.LabelStatusCode] = strconv.Itoa(resp.StatusCode)
+		span.SetAttribute(attrHTTPResponseStatusCode, resp.StatusCode)
+		if requestID := resp.Header.Get("x-amzn-RequestId"); requestID != "" {
+			span.SetAttribute(attrRequestID, requestID)
+		}
+	}
+
+	c.metrics.RecordCounter(metrics.MetricRequestTotal, 1, labels)
+	c.metrics.RecordTiming(metrics.MetricRequestDuration, duration, labels)
+
+	if err == nil {
+		return
+	}
+	errorType = classifyError(err, errorType)
+	c.metrics.RecordCounter(metrics.MetricRequestErrors, 1, map[string]string{
+		metrics.LabelOperation:   operation,
+		metrics.LabelMarketplace: marketplace,
+		metrics.LabelErrorType:   errorType,
+	})
+
+	// 语义约定：HTTP 错误使用状态码作为 error.type
+	if resp != nil && resp.StatusCode >= 400 {
+		span.SetAttribute(attrErrorType, strconv.Itoa(resp.StatusCode))
+	} else {
+		span.SetAttribute(attrErrorType, errorType)
+	}
+	span.RecordError(err)
+}
+
+// classifyError 推断错误的 error_type 标签值。
+//
+// 取消、超时和 API 错误优先于请求失败的阶段 stage；
+// stage 为空表示响应已收到，但读取或解析失败。
+func classifyError(err error, stage string) string {
+	var apiErr *APIError
+	switch {
+	case errors.Is(err, context.Canceled):
+		return errorTypeCanceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return errorTypeTimeout
+	case errors.As(err, &apiErr):
+		switch {
+		case apiErr.StatusCode == http.StatusTooManyRequests:
+			return errorTypeRateLimit
+		case apiErr.StatusCode >= 500:
+			return errorTypeServer
+		default:
+			return errorTypeClient
+		}
+	case stage != "":
+		return stage
+	default:
+		return errorTypeDecode
+	}
+}
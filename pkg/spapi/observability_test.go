@@ -0,0 +1,174 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapi_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/spapitest"
+)
+
+// recordingCollector 只实现 MetricsCollector，记录收到的调用。
+type recordingCollector struct {
+	mu       sync.Mutex
+	requests []string
+	errors   []string
+	waits    []string
+}
+
+func (c *recordingCollector) RecordRequest(api, method string, duration time.Duration, statusCode int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.requests = append(c.requests, api+" "+method+" "+http.StatusText(statusCode))
+}
+
+func (c *recordingCollector) RecordError(api, errorType string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.errors = append(c.errors, api+" "+errorType)
+}
+
+func (c *recordingCollector) RecordRateLimitWait(api string, duration time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.waits = append(c.waits, api)
+}
+
+// recordingTracer 实现 Tracer 和 TracePropagator，记录 span 属性。
+type recordingTracer struct {
+	spans []*recordingSpan
+}
+
+func (t *recordingTracer) StartSpan(ctx context.Context, name string) (context.Context, spapi.Span) {
+	span := &recordingSpan{name: name, attributes: make(map[string]interface{})}
+	t.spans = append(t.spans, span)
+	return context.WithValue(ctx, recordingSpanKey{}, span), span
+}
+
+func (t *recordingTracer) Inject(ctx context.Context, header http.Header) {
+	if span, ok := ctx.Value(recordingSpanKey{}).(*recordingSpan); ok {
+		header.Set("X-Test-Span", span.name)
+	}
+}
+
+type recordingSpanKey struct{}
+
+type recordingSpan struct {
+	name       string
+	attributes map[string]interface{}
+	err        error
+	ended      bool
+}
+
+func (s *recordingSpan) End()                                       { s.ended = true }
+func (s *recordingSpan) SetAttribute(key string, value interface{}) { s.attributes[key] = value }
+func (s *recordingSpan) RecordError(err error)                      { s.err = err }
+
+// TestClient_MetricsCollector 测试只实现 MetricsCollector 的收集器收到的指标。
+func TestClient_MetricsCollector(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+	srv.Handle(http.MethodGet, "/orders/v0/orders", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	})
+	srv.Handle(http.MethodGet, "/orders/v0/orders/{orderId}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"errors":[{"code":"InternalFailure","message":"boom"}]}`))
+	})
+
+	collector := &recordingCollector{}
+	client, err := srv.NewClient(spapi.WithMetrics(collector), spapi.WithMaxRetries(0), spapi.WithRateLimitWait())
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	ctx := context.Background()
+	if err := client.Get(ctx, "/orders/v0/orders", nil, nil); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if err := client.Get(ctx, "/orders/v0/orders/123-1234567-1234567", nil, nil); err == nil {
+		t.Fatal("Get() error = nil, want 500")
+	}
+
+	wantRequests := []string{"orders-v0:getOrders GET OK", "orders-v0:getOrder GET Internal Server Error"}
+	if len(collector.requests) != 2 || collector.requests[0] != wantRequests[0] || collector.requests[1] != wantRequests[1] {
+		t.Errorf("requests = %q, want %q", collector.requests, wantRequests)
+	}
+	if len(collector.errors) != 1 || collector.errors[0] != "orders-v0:getOrder server" {
+		t.Errorf("errors = %q, want [orders-v0:getOrder server]", collector.errors)
+	}
+	if len(collector.waits) != 2 {
+		t.Errorf("rate limit waits = %d, want 2", len(collector.waits))
+	}
+}
+
+// TestClient_Tracer 测试请求 span 的属性和追踪上下文注入。
+func TestClient_Tracer(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+	srv.Handle(http.MethodGet, "/orders/v0/orders/{orderId}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errors":[{"code":"NotFound","message":"no order"}]}`))
+	})
+
+	tracer := &recordingTracer{}
+	client, err := srv.NewClient(spapi.WithTracer(tracer))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	if err := client.Get(context.Background(), "/orders/v0/orders/123-1234567-1234567", map[string]string{"MarketplaceIds": "A1PA6795UKMFR9"}, nil); err == nil {
+		t.Fatal("Get() error = nil, want 404")
+	}
+
+	if len(tracer.spans) != 1 {
+		t.Fatalf("spans = %d, want 1", len(tracer.spans))
+	}
+	span := tracer.spans[0]
+	if span.name != "orders-v0:getOrder" || !span.ended || span.err == nil {
+		t.Errorf("span = %s (ended %v, err %v), want ended orders-v0:getOrder with error", span.name, span.ended, span.err)
+	}
+	wantAttributes := map[string]interface{}{
+		"http.request.method":       http.MethodGet,
+		"http.response.status_code": http.StatusNotFound,
+		"error.type":                "404",
+		"spapi.operation":           "orders-v0:getOrder",
+		"spapi.marketplace_id":      "A1PA6795UKMFR9",
+	}
+	for key, want := range wantAttributes {
+		if got := span.attributes[key]; got != want {
+			t.Errorf("attribute %s = %v, want %v", key, got, want)
+		}
+	}
+	if span.attributes["spapi.request_id"] == nil {
+		t.Error("attribute spapi.request_id missing")
+	}
+
+	requests := srv.Requests()
+	if got := requests[len(requests)-1].Header.Get("X-Test-Span"); got != "orders-v0:getOrder" {
+		t.Errorf("X-Test-Span = %q, want orders-v0:getOrder", got)
+	}
+}
@@ -0,0 +1,339 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package spapi
+
+// operationRoutes lists the route of every operation, named like
+// "orders-v0:getOrder".
+var operationRoutes = []operationRoute{
+	{method: "POST", path: "/aplus/2020-11-01/contentAsinValidations", name: "aplus-content-v2020-11-01:validateContentDocumentAsinRelations"},
+	{method: "GET", path: "/aplus/2020-11-01/contentDocuments", name: "aplus-content-v2020-11-01:searchContentDocuments"},
+	{method: "POST", path: "/aplus/2020-11-01/contentDocuments", name: "aplus-content-v2020-11-01:createContentDocument"},
+	{method: "GET", path: "/aplus/2020-11-01/contentDocuments/{contentReferenceKey}", name: "aplus-content-v2020-11-01:getContentDocument"},
+	{method: "POST", path: "/aplus/2020-11-01/contentDocuments/{contentReferenceKey}", name: "aplus-content-v2020-11-01:updateContentDocument"},
+	{method: "POST", path: "/aplus/2020-11-01/contentDocuments/{contentReferenceKey}/approvalSubmissions", name: "aplus-content-v2020-11-01:postContentDocumentApprovalSubmission"},
+	{method: "GET", path: "/aplus/2020-11-01/contentDocuments/{contentReferenceKey}/asins", name: "aplus-content-v2020-11-01:listContentDocumentAsinRelations"},
+	{method: "POST", path: "/aplus/2020-11-01/contentDocuments/{contentReferenceKey}/asins", name: "aplus-content-v2020-11-01:postContentDocumentAsinRelations"},
+	{method: "POST", path: "/aplus/2020-11-01/contentDocuments/{contentReferenceKey}/suspendSubmissions", name: "aplus-content-v2020-11-01:postContentDocumentSuspendSubmission"},
+	{method: "GET", path: "/aplus/2020-11-01/contentPublishRecords", name: "aplus-content-v2020-11-01:searchContentPublishRecords"},
+	{method: "POST", path: "/appIntegrations/2024-04-01/notifications", name: "application-integrations-v2024-04-01:createNotification"},
+	{method: "POST", path: "/appIntegrations/2024-04-01/notifications/deletion", name: "application-integrations-v2024-04-01:deleteNotifications"},
+	{method: "POST", path: "/appIntegrations/2024-04-01/notifications/{notificationId}/feedback", name: "application-integrations-v2024-04-01:recordActionFeedback"},
+	{method: "POST", path: "/applications/2023-11-30/clientSecret", name: "application-management-v2023-11-30:rotateApplicationClientSecret"},
+	{method: "POST", path: "/awd/2024-05-09/inboundEligibility", name: "amazon-warehousing-and-distribution-model-v2024-05-09:checkInboundEligibility"},
+	{method: "POST", path: "/awd/2024-05-09/inboundOrders", name: "amazon-warehousing-and-distribution-model-v2024-05-09:createInbound"},
+	{method: "GET", path: "/awd/2024-05-09/inboundOrders/{orderId}", name: "amazon-warehousing-and-distribution-model-v2024-05-09:getInbound"},
+	{method: "PUT", path: "/awd/2024-05-09/inboundOrders/{orderId}", name: "amazon-warehousing-and-distribution-model-v2024-05-09:updateInbound"},
+	{method: "POST", path: "/awd/2024-05-09/inboundOrders/{orderId}/cancellation", name: "amazon-warehousing-and-distribution-model-v2024-05-09:cancelInbound"},
+	{method: "POST", path: "/awd/2024-05-09/inboundOrders/{orderId}/confirmation", name: "amazon-warehousing-and-distribution-model-v2024-05-09:confirmInbound"},
+	{method: "GET", path: "/awd/2024-05-09/inboundShipments", name: "amazon-warehousing-and-distribution-model-v2024-05-09:listInboundShipments"},
+	{method: "GET", path: "/awd/2024-05-09/inboundShipments/{shipmentId}", name: "amazon-warehousing-and-distribution-model-v2024-05-09:getInboundShipment"},
+	{method: "GET", path: "/awd/2024-05-09/inboundShipments/{shipmentId}/labels", name: "amazon-warehousing-and-distribution-model-v2024-05-09:getInboundShipmentLabels"},
+	{method: "PUT", path: "/awd/2024-05-09/inboundShipments/{shipmentId}/transport", name: "amazon-warehousing-and-distribution-model-v2024-05-09:updateInboundShipmentTransportDetails"},
+	{method: "GET", path: "/awd/2024-05-09/inventory", name: "amazon-warehousing-and-distribution-model-v2024-05-09:listInventory"},
+	{method: "POST", path: "/batches/products/pricing/2022-05-01/items/competitiveSummary", name: "product-pricing-v2022-05-01:getCompetitiveSummary"},
+	{method: "POST", path: "/batches/products/pricing/2022-05-01/offer/featuredOfferExpectedPrice", name: "product-pricing-v2022-05-01:getFeaturedOfferExpectedPriceBatch"},
+	{method: "POST", path: "/batches/products/pricing/v0/itemOffers", name: "product-pricing-v0:getItemOffersBatch"},
+	{method: "POST", path: "/batches/products/pricing/v0/listingOffers", name: "product-pricing-v0:getListingOffersBatch"},
+	{method: "GET", path: "/catalog/2020-12-01/items", name: "catalog-items-v2020-12-01:searchCatalogItems"},
+	{method: "GET", path: "/catalog/2020-12-01/items/{asin}", name: "catalog-items-v2020-12-01:getCatalogItem"},
+	{method: "GET", path: "/catalog/2022-04-01/items", name: "catalog-items-v2022-04-01:searchCatalogItems"},
+	{method: "GET", path: "/catalog/2022-04-01/items/{asin}", name: "catalog-items-v2022-04-01:getCatalogItem"},
+	{method: "GET", path: "/catalog/2024-11-01/automotive/vehicles", name: "vehicles-v2024-11-01:getVehicles"},
+	{method: "GET", path: "/catalog/v0/categories", name: "catalog-items-v0:listCatalogCategories"},
+	{method: "GET", path: "/customerFeedback/2024-06-01/browseNodes/{browseNodeId}/returns/topics", name: "customer-feedback-v2024-06-01:getBrowseNodeReturnTopics"},
+	{method: "GET", path: "/customerFeedback/2024-06-01/browseNodes/{browseNodeId}/returns/trends", name: "customer-feedback-v2024-06-01:getBrowseNodeReturnTrends"},
+	{method: "GET", path: "/customerFeedback/2024-06-01/browseNodes/{browseNodeId}/reviews/topics", name: "customer-feedback-v2024-06-01:getBrowseNodeReviewTopics"},
+	{method: "GET", path: "/customerFeedback/2024-06-01/browseNodes/{browseNodeId}/reviews/trends", name: "customer-feedback-v2024-06-01:getBrowseNodeReviewTrends"},
+	{method: "GET", path: "/customerFeedback/2024-06-01/items/{asin}/browseNode", name: "customer-feedback-v2024-06-01:getItemBrowseNode"},
+	{method: "GET", path: "/customerFeedback/2024-06-01/items/{asin}/reviews/topics", name: "customer-feedback-v2024-06-01:getItemReviewTopics"},
+	{method: "GET", path: "/customerFeedback/2024-06-01/items/{asin}/reviews/trends", name: "customer-feedback-v2024-06-01:getItemReviewTrends"},
+	{method: "GET", path: "/dataKiosk/2023-11-15/documents/{documentId}", name: "data-kiosk-v2023-11-15:getDocument"},
+	{method: "GET", path: "/dataKiosk/2023-11-15/queries", name: "data-kiosk-v2023-11-15:getQueries"},
+	{method: "POST", path: "/dataKiosk/2023-11-15/queries", name: "data-kiosk-v2023-11-15:createQuery"},
+	{method: "DELETE", path: "/dataKiosk/2023-11-15/queries/{queryId}", name: "data-kiosk-v2023-11-15:cancelQuery"},
+	{method: "GET", path: "/dataKiosk/2023-11-15/queries/{queryId}", name: "data-kiosk-v2023-11-15:getQuery"},
+	{method: "GET", path: "/definitions/2020-09-01/productTypes", name: "product-type-definitions-v2020-09-01:searchDefinitionsProductTypes"},
+	{method: "GET", path: "/definitions/2020-09-01/productTypes/{productType}", name: "product-type-definitions-v2020-09-01:getDefinitionsProductType"},
+	{method: "GET", path: "/easyShip/2022-03-23/package", name: "easy-ship-model-v2022-03-23:getScheduledPackage"},
+	{method: "PATCH", path: "/easyShip/2022-03-23/package", name: "easy-ship-model-v2022-03-23:updateScheduledPackages"},
+	{method: "POST", path: "/easyShip/2022-03-23/package", name: "easy-ship-model-v2022-03-23:createScheduledPackage"},
+	{method: "POST", path: "/easyShip/2022-03-23/packages/bulk", name: "easy-ship-model-v2022-03-23:createScheduledPackageBulk"},
+	{method: "POST", path: "/easyShip/2022-03-23/timeSlot", name: "easy-ship-model-v2022-03-23:listHandoverSlots"},
+	{method: "GET", path: "/fba/inbound/v0/prepInstructions", name: "fulfillment-inbound-v0:getPrepInstructions"},
+	{method: "GET", path: "/fba/inbound/v0/shipmentItems", name: "fulfillment-inbound-v0:getShipmentItems"},
+	{method: "GET", path: "/fba/inbound/v0/shipments", name: "fulfillment-inbound-v0:getShipments"},
+	{method: "GET", path: "/fba/inbound/v0/shipments/{shipmentId}/billOfLading", name: "fulfillment-inbound-v0:getBillOfLading"},
+	{method: "GET", path: "/fba/inbound/v0/shipments/{shipmentId}/items", name: "fulfillment-inbound-v0:getShipmentItemsByShipmentId"},
+	{method: "GET", path: "/fba/inbound/v0/shipments/{shipmentId}/labels", name: "fulfillment-inbound-v0:getLabels"},
+	{method: "GET", path: "/fba/inbound/v1/eligibility/itemPreview", name: "fba-inbound-eligibility-v1:getItemEligibilityPreview"},
+	{method: "POST", path: "/fba/inventory/v1/items", name: "fba-inventory-v1:createInventoryItem"},
+	{method: "POST", path: "/fba/inventory/v1/items/inventory", name: "fba-inventory-v1:addInventory"},
+	{method: "DELETE", path: "/fba/inventory/v1/items/{sellerSku}", name: "fba-inventory-v1:deleteInventoryItem"},
+	{method: "GET", path: "/fba/inventory/v1/summaries", name: "fba-inventory-v1:getInventorySummaries"},
+	{method: "POST", path: "/fba/outbound/2020-07-01/deliveryOffers", name: "fulfillment-outbound-v2020-07-01:deliveryOffers"},
+	{method: "GET", path: "/fba/outbound/2020-07-01/features", name: "fulfillment-outbound-v2020-07-01:getFeatures"},
+	{method: "GET", path: "/fba/outbound/2020-07-01/features/inventory/{featureName}", name: "fulfillment-outbound-v2020-07-01:getFeatureInventory"},
+	{method: "GET", path: "/fba/outbound/2020-07-01/features/inventory/{featureName}/{sellerSku}", name: "fulfillment-outbound-v2020-07-01:getFeatureSKU"},
+	{method: "GET", path: "/fba/outbound/2020-07-01/fulfillmentOrders", name: "fulfillment-outbound-v2020-07-01:listAllFulfillmentOrders"},
+	{method: "POST", path: "/fba/outbound/2020-07-01/fulfillmentOrders", name: "fulfillment-outbound-v2020-07-01:createFulfillmentOrder"},
+	{method: "POST", path: "/fba/outbound/2020-07-01/fulfillmentOrders/preview", name: "fulfillment-outbound-v2020-07-01:getFulfillmentPreview"},
+	{method: "GET", path: "/fba/outbound/2020-07-01/fulfillmentOrders/{sellerFulfillmentOrderId}", name: "fulfillment-outbound-v2020-07-01:getFulfillmentOrder"},
+	{method: "PUT", path: "/fba/outbound/2020-07-01/fulfillmentOrders/{sellerFulfillmentOrderId}", name: "fulfillment-outbound-v2020-07-01:updateFulfillmentOrder"},
+	{method: "PUT", path: "/fba/outbound/2020-07-01/fulfillmentOrders/{sellerFulfillmentOrderId}/cancel", name: "fulfillment-outbound-v2020-07-01:cancelFulfillmentOrder"},
+	{method: "PUT", path: "/fba/outbound/2020-07-01/fulfillmentOrders/{sellerFulfillmentOrderId}/return", name: "fulfillment-outbound-v2020-07-01:createFulfillmentReturn"},
+	{method: "PUT", path: "/fba/outbound/2020-07-01/fulfillmentOrders/{sellerFulfillmentOrderId}/status", name: "fulfillment-outbound-v2020-07-01:submitFulfillmentOrderStatusUpdate"},
+	{method: "GET", path: "/fba/outbound/2020-07-01/returnReasonCodes", name: "fulfillment-outbound-v2020-07-01:listReturnReasonCodes"},
+	{method: "GET", path: "/fba/outbound/2020-07-01/tracking", name: "fulfillment-outbound-v2020-07-01:getPackageTrackingDetails"},
+	{method: "GET", path: "/fba/outbound/brazil/v0/shipments/{shipmentId}", name: "shipment-invoicing-v0:getShipmentDetails"},
+	{method: "POST", path: "/fba/outbound/brazil/v0/shipments/{shipmentId}/invoice", name: "shipment-invoicing-v0:submitInvoice"},
+	{method: "GET", path: "/fba/outbound/brazil/v0/shipments/{shipmentId}/invoice/status", name: "shipment-invoicing-v0:getInvoiceStatus"},
+	{method: "POST", path: "/feeds/2021-06-30/documents", name: "feeds-v2021-06-30:createFeedDocument"},
+	{method: "GET", path: "/feeds/2021-06-30/documents/{feedDocumentId}", name: "feeds-v2021-06-30:getFeedDocument"},
+	{method: "GET", path: "/feeds/2021-06-30/feeds", name: "feeds-v2021-06-30:getFeeds"},
+	{method: "POST", path: "/feeds/2021-06-30/feeds", name: "feeds-v2021-06-30:createFeed"},
+	{method: "DELETE", path: "/feeds/2021-06-30/feeds/{feedId}", name: "feeds-v2021-06-30:cancelFeed"},
+	{method: "GET", path: "/feeds/2021-06-30/feeds/{feedId}", name: "feeds-v2021-06-30:getFeed"},
+	{method: "GET", path: "/finances/2024-06-19/transactions", name: "finances-v2024-06-19:listTransactions"},
+	{method: "GET", path: "/finances/transfers/2024-06-01/paymentMethods", name: "finances-v2024-06-01-transfers:getPaymentMethods"},
+	{method: "POST", path: "/finances/transfers/2024-06-01/payouts", name: "finances-v2024-06-01-transfers:initiatePayout"},
+	{method: "GET", path: "/finances/transfers/wallet/2024-03-01/accounts", name: "seller-wallet-v2024-03-01:listAccounts"},
+	{method: "GET", path: "/finances/transfers/wallet/2024-03-01/accounts/{accountId}", name: "seller-wallet-v2024-03-01:getAccount"},
+	{method: "GET", path: "/finances/transfers/wallet/2024-03-01/accounts/{accountId}/balance", name: "seller-wallet-v2024-03-01:listAccountBalances"},
+	{method: "GET", path: "/finances/transfers/wallet/2024-03-01/transactions", name: "seller-wallet-v2024-03-01:listAccountTransactions"},
+	{method: "POST", path: "/finances/transfers/wallet/2024-03-01/transactions", name: "seller-wallet-v2024-03-01:createTransaction"},
+	{method: "GET", path: "/finances/transfers/wallet/2024-03-01/transactions/{transactionId}", name: "seller-wallet-v2024-03-01:getTransaction"},
+	{method: "GET", path: "/finances/transfers/wallet/2024-03-01/transferPreview", name: "seller-wallet-v2024-03-01:getTransferPreview"},
+	{method: "GET", path: "/finances/transfers/wallet/2024-03-01/transferSchedules", name: "seller-wallet-v2024-03-01:listTransferSchedules"},
+	{method: "POST", path: "/finances/transfers/wallet/2024-03-01/transferSchedules", name: "seller-wallet-v2024-03-01:createTransferSchedule"},
+	{method: "PUT", path: "/finances/transfers/wallet/2024-03-01/transferSchedules", name: "seller-wallet-v2024-03-01:updateTransferSchedule"},
+	{method: "DELETE", path: "/finances/transfers/wallet/2024-03-01/transferSchedules/{transferScheduleId}", name: "seller-wallet-v2024-03-01:deleteScheduleTransaction"},
+	{method: "GET", path: "/finances/transfers/wallet/2024-03-01/transferSchedules/{transferScheduleId}", name: "seller-wallet-v2024-03-01:getTransferSchedule"},
+	{method: "GET", path: "/finances/v0/financialEventGroups", name: "finances-v0:listFinancialEventGroups"},
+	{method: "GET", path: "/finances/v0/financialEventGroups/{eventGroupId}/financialEvents", name: "finances-v0:listFinancialEventsByGroupId"},
+	{method: "GET", path: "/finances/v0/financialEvents", name: "finances-v0:listFinancialEvents"},
+	{method: "GET", path: "/finances/v0/orders/{orderId}/financialEvents", name: "finances-v0:listFinancialEventsByOrderId"},
+	{method: "GET", path: "/inbound/fba/2024-03-20/inboundPlans", name: "fulfillment-inbound-v2024-03-20:listInboundPlans"},
+	{method: "POST", path: "/inbound/fba/2024-03-20/inboundPlans", name: "fulfillment-inbound-v2024-03-20:createInboundPlan"},
+	{method: "GET", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}", name: "fulfillment-inbound-v2024-03-20:getInboundPlan"},
+	{method: "GET", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/boxes", name: "fulfillment-inbound-v2024-03-20:listInboundPlanBoxes"},
+	{method: "PUT", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/cancellation", name: "fulfillment-inbound-v2024-03-20:cancelInboundPlan"},
+	{method: "GET", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/items", name: "fulfillment-inbound-v2024-03-20:listInboundPlanItems"},
+	{method: "PUT", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/name", name: "fulfillment-inbound-v2024-03-20:updateInboundPlanName"},
+	{method: "GET", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/packingGroups/{packingGroupId}/boxes", name: "fulfillment-inbound-v2024-03-20:listPackingGroupBoxes"},
+	{method: "GET", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/packingGroups/{packingGroupId}/items", name: "fulfillment-inbound-v2024-03-20:listPackingGroupItems"},
+	{method: "POST", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/packingInformation", name: "fulfillment-inbound-v2024-03-20:setPackingInformation"},
+	{method: "GET", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/packingOptions", name: "fulfillment-inbound-v2024-03-20:listPackingOptions"},
+	{method: "POST", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/packingOptions", name: "fulfillment-inbound-v2024-03-20:generatePackingOptions"},
+	{method: "POST", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/packingOptions/{packingOptionId}/confirmation", name: "fulfillment-inbound-v2024-03-20:confirmPackingOption"},
+	{method: "GET", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/pallets", name: "fulfillment-inbound-v2024-03-20:listInboundPlanPallets"},
+	{method: "GET", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/placementOptions", name: "fulfillment-inbound-v2024-03-20:listPlacementOptions"},
+	{method: "POST", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/placementOptions", name: "fulfillment-inbound-v2024-03-20:generatePlacementOptions"},
+	{method: "POST", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/placementOptions/{placementOptionId}/confirmation", name: "fulfillment-inbound-v2024-03-20:confirmPlacementOption"},
+	{method: "GET", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}", name: "fulfillment-inbound-v2024-03-20:getShipment"},
+	{method: "GET", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/boxes", name: "fulfillment-inbound-v2024-03-20:listShipmentBoxes"},
+	{method: "GET", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/contentUpdatePreviews", name: "fulfillment-inbound-v2024-03-20:listShipmentContentUpdatePreviews"},
+	{method: "POST", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/contentUpdatePreviews", name: "fulfillment-inbound-v2024-03-20:generateShipmentContentUpdatePreviews"},
+	{method: "GET", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/contentUpdatePreviews/{contentUpdatePreviewId}", name: "fulfillment-inbound-v2024-03-20:getShipmentContentUpdatePreview"},
+	{method: "POST", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/contentUpdatePreviews/{contentUpdatePreviewId}/confirmation", name: "fulfillment-inbound-v2024-03-20:confirmShipmentContentUpdatePreview"},
+	{method: "GET", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/deliveryChallanDocument", name: "fulfillment-inbound-v2024-03-20:getDeliveryChallanDocument"},
+	{method: "GET", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/deliveryWindowOptions", name: "fulfillment-inbound-v2024-03-20:listDeliveryWindowOptions"},
+	{method: "POST", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/deliveryWindowOptions", name: "fulfillment-inbound-v2024-03-20:generateDeliveryWindowOptions"},
+	{method: "POST", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/deliveryWindowOptions/{deliveryWindowOptionId}/confirmation", name: "fulfillment-inbound-v2024-03-20:confirmDeliveryWindowOptions"},
+	{method: "GET", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/items", name: "fulfillment-inbound-v2024-03-20:listShipmentItems"},
+	{method: "PUT", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/name", name: "fulfillment-inbound-v2024-03-20:updateShipmentName"},
+	{method: "GET", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/pallets", name: "fulfillment-inbound-v2024-03-20:listShipmentPallets"},
+	{method: "PUT", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/selfShipAppointmentCancellation", name: "fulfillment-inbound-v2024-03-20:cancelSelfShipAppointment"},
+	{method: "GET", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/selfShipAppointmentSlots", name: "fulfillment-inbound-v2024-03-20:getSelfShipAppointmentSlots"},
+	{method: "POST", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/selfShipAppointmentSlots", name: "fulfillment-inbound-v2024-03-20:generateSelfShipAppointmentSlots"},
+	{method: "POST", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/selfShipAppointmentSlots/{slotId}/schedule", name: "fulfillment-inbound-v2024-03-20:scheduleSelfShipAppointment"},
+	{method: "PUT", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/sourceAddress", name: "fulfillment-inbound-v2024-03-20:updateShipmentSourceAddress"},
+	{method: "PUT", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/trackingDetails", name: "fulfillment-inbound-v2024-03-20:updateShipmentTrackingDetails"},
+	{method: "GET", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/transportationOptions", name: "fulfillment-inbound-v2024-03-20:listTransportationOptions"},
+	{method: "POST", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/transportationOptions", name: "fulfillment-inbound-v2024-03-20:generateTransportationOptions"},
+	{method: "POST", path: "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/transportationOptions/confirmation", name: "fulfillment-inbound-v2024-03-20:confirmTransportationOptions"},
+	{method: "GET", path: "/inbound/fba/2024-03-20/items/compliance", name: "fulfillment-inbound-v2024-03-20:listItemComplianceDetails"},
+	{method: "PUT", path: "/inbound/fba/2024-03-20/items/compliance", name: "fulfillment-inbound-v2024-03-20:updateItemComplianceDetails"},
+	{method: "POST", path: "/inbound/fba/2024-03-20/items/labels", name: "fulfillment-inbound-v2024-03-20:createMarketplaceItemLabels"},
+	{method: "GET", path: "/inbound/fba/2024-03-20/items/prepDetails", name: "fulfillment-inbound-v2024-03-20:listPrepDetails"},
+	{method: "POST", path: "/inbound/fba/2024-03-20/items/prepDetails", name: "fulfillment-inbound-v2024-03-20:setPrepDetails"},
+	{method: "GET", path: "/inbound/fba/2024-03-20/operations/{operationId}", name: "fulfillment-inbound-v2024-03-20:getInboundOperationStatus"},
+	{method: "DELETE", path: "/listings/2020-09-01/items/{sellerId}/{sku}", name: "listings-items-v2020-09-01:deleteListingsItem"},
+	{method: "PATCH", path: "/listings/2020-09-01/items/{sellerId}/{sku}", name: "listings-items-v2020-09-01:patchListingsItem"},
+	{method: "PUT", path: "/listings/2020-09-01/items/{sellerId}/{sku}", name: "listings-items-v2020-09-01:putListingsItem"},
+	{method: "GET", path: "/listings/2021-08-01/items/{sellerId}", name: "listings-items-v2021-08-01:searchListingsItems"},
+	{method: "DELETE", path: "/listings/2021-08-01/items/{sellerId}/{sku}", name: "listings-items-v2021-08-01:deleteListingsItem"},
+	{method: "GET", path: "/listings/2021-08-01/items/{sellerId}/{sku}", name: "listings-items-v2021-08-01:getListingsItem"},
+	{method: "PATCH", path: "/listings/2021-08-01/items/{sellerId}/{sku}", name: "listings-items-v2021-08-01:patchListingsItem"},
+	{method: "PUT", path: "/listings/2021-08-01/items/{sellerId}/{sku}", name: "listings-items-v2021-08-01:putListingsItem"},
+	{method: "GET", path: "/listings/2021-08-01/restrictions", name: "listings-restrictions-v2021-08-01:getListingsRestrictions"},
+	{method: "GET", path: "/messaging/v1/orders/{amazonOrderId}", name: "messaging-v1:getMessagingActionsForOrder"},
+	{method: "GET", path: "/messaging/v1/orders/{amazonOrderId}/attributes", name: "messaging-v1:getAttributes"},
+	{method: "POST", path: "/messaging/v1/orders/{amazonOrderId}/messages/amazonMotors", name: "messaging-v1:createAmazonMotors"},
+	{method: "POST", path: "/messaging/v1/orders/{amazonOrderId}/messages/confirmCustomizationDetails", name: "messaging-v1:confirmCustomizationDetails"},
+	{method: "POST", path: "/messaging/v1/orders/{amazonOrderId}/messages/confirmDeliveryDetails", name: "messaging-v1:createConfirmDeliveryDetails"},
+	{method: "POST", path: "/messaging/v1/orders/{amazonOrderId}/messages/confirmOrderDetails", name: "messaging-v1:createConfirmOrderDetails"},
+	{method: "POST", path: "/messaging/v1/orders/{amazonOrderId}/messages/confirmServiceDetails", name: "messaging-v1:createConfirmServiceDetails"},
+	{method: "POST", path: "/messaging/v1/orders/{amazonOrderId}/messages/digitalAccessKey", name: "messaging-v1:createDigitalAccessKey"},
+	{method: "POST", path: "/messaging/v1/orders/{amazonOrderId}/messages/invoice", name: "messaging-v1:sendInvoice"},
+	{method: "POST", path: "/messaging/v1/orders/{amazonOrderId}/messages/legalDisclosure", name: "messaging-v1:createLegalDisclosure"},
+	{method: "POST", path: "/messaging/v1/orders/{amazonOrderId}/messages/unexpectedProblem", name: "messaging-v1:createUnexpectedProblem"},
+	{method: "POST", path: "/messaging/v1/orders/{amazonOrderId}/messages/warranty", name: "messaging-v1:createWarranty"},
+	{method: "POST", path: "/mfn/v0/additionalSellerInputs", name: "merchant-fulfillment-v0:getAdditionalSellerInputs"},
+	{method: "POST", path: "/mfn/v0/eligibleShippingServices", name: "merchant-fulfillment-v0:getEligibleShipmentServices"},
+	{method: "POST", path: "/mfn/v0/shipments", name: "merchant-fulfillment-v0:createShipment"},
+	{method: "DELETE", path: "/mfn/v0/shipments/{shipmentId}", name: "merchant-fulfillment-v0:cancelShipment"},
+	{method: "GET", path: "/mfn/v0/shipments/{shipmentId}", name: "merchant-fulfillment-v0:getShipment"},
+	{method: "GET", path: "/notifications/v1/destinations", name: "notifications-v1:getDestinations"},
+	{method: "POST", path: "/notifications/v1/destinations", name: "notifications-v1:createDestination"},
+	{method: "DELETE", path: "/notifications/v1/destinations/{destinationId}", name: "notifications-v1:deleteDestination"},
+	{method: "GET", path: "/notifications/v1/destinations/{destinationId}", name: "notifications-v1:getDestination"},
+	{method: "GET", path: "/notifications/v1/subscriptions/{notificationType}", name: "notifications-v1:getSubscription"},
+	{method: "POST", path: "/notifications/v1/subscriptions/{notificationType}", name: "notifications-v1:createSubscription"},
+	{method: "DELETE", path: "/notifications/v1/subscriptions/{notificationType}/{subscriptionId}", name: "notifications-v1:deleteSubscriptionById"},
+	{method: "GET", path: "/notifications/v1/subscriptions/{notificationType}/{subscriptionId}", name: "notifications-v1:getSubscriptionById"},
+	{method: "GET", path: "/orders/v0/orders", name: "orders-v0:getOrders"},
+	{method: "GET", path: "/orders/v0/orders/{orderId}", name: "orders-v0:getOrder"},
+	{method: "GET", path: "/orders/v0/orders/{orderId}/address", name: "orders-v0:getOrderAddress"},
+	{method: "GET", path: "/orders/v0/orders/{orderId}/buyerInfo", name: "orders-v0:getOrderBuyerInfo"},
+	{method: "GET", path: "/orders/v0/orders/{orderId}/orderItems", name: "orders-v0:getOrderItems"},
+	{method: "GET", path: "/orders/v0/orders/{orderId}/orderItems/buyerInfo", name: "orders-v0:getOrderItemsBuyerInfo"},
+	{method: "GET", path: "/orders/v0/orders/{orderId}/regulatedInfo", name: "orders-v0:getOrderRegulatedInfo"},
+	{method: "PATCH", path: "/orders/v0/orders/{orderId}/regulatedInfo", name: "orders-v0:updateVerificationStatus"},
+	{method: "POST", path: "/orders/v0/orders/{orderId}/shipment", name: "orders-v0:updateShipmentStatus"},
+	{method: "POST", path: "/orders/v0/orders/{orderId}/shipmentConfirmation", name: "orders-v0:confirmShipment"},
+	{method: "POST", path: "/products/fees/v0/feesEstimate", name: "product-fees-v0:getMyFeesEstimates"},
+	{method: "POST", path: "/products/fees/v0/items/{Asin}/feesEstimate", name: "product-fees-v0:getMyFeesEstimateForASIN"},
+	{method: "POST", path: "/products/fees/v0/listings/{SellerSKU}/feesEstimate", name: "product-fees-v0:getMyFeesEstimateForSKU"},
+	{method: "GET", path: "/products/pricing/v0/competitivePrice", name: "product-pricing-v0:getCompetitivePricing"},
+	{method: "GET", path: "/products/pricing/v0/items/{Asin}/offers", name: "product-pricing-v0:getItemOffers"},
+	{method: "GET", path: "/products/pricing/v0/listings/{SellerSKU}/offers", name: "product-pricing-v0:getListingOffers"},
+	{method: "GET", path: "/products/pricing/v0/price", name: "product-pricing-v0:getPricing"},
+	{method: "POST", path: "/replenishment/2022-11-07/offers/metrics/search", name: "replenishment-v2022-11-07:listOfferMetrics"},
+	{method: "POST", path: "/replenishment/2022-11-07/offers/search", name: "replenishment-v2022-11-07:listOffers"},
+	{method: "POST", path: "/replenishment/2022-11-07/sellingPartners/metrics/search", name: "replenishment-v2022-11-07:getSellingPartnerMetrics"},
+	{method: "GET", path: "/reports/2021-06-30/documents/{reportDocumentId}", name: "reports-v2021-06-30:getReportDocument"},
+	{method: "GET", path: "/reports/2021-06-30/reports", name: "reports-v2021-06-30:getReports"},
+	{method: "POST", path: "/reports/2021-06-30/reports", name: "reports-v2021-06-30:createReport"},
+	{method: "DELETE", path: "/reports/2021-06-30/reports/{reportId}", name: "reports-v2021-06-30:cancelReport"},
+	{method: "GET", path: "/reports/2021-06-30/reports/{reportId}", name: "reports-v2021-06-30:getReport"},
+	{method: "GET", path: "/reports/2021-06-30/schedules", name: "reports-v2021-06-30:getReportSchedules"},
+	{method: "POST", path: "/reports/2021-06-30/schedules", name: "reports-v2021-06-30:createReportSchedule"},
+	{method: "DELETE", path: "/reports/2021-06-30/schedules/{reportScheduleId}", name: "reports-v2021-06-30:cancelReportSchedule"},
+	{method: "GET", path: "/reports/2021-06-30/schedules/{reportScheduleId}", name: "reports-v2021-06-30:getReportSchedule"},
+	{method: "GET", path: "/sales/v1/orderMetrics", name: "sales-v1:getOrderMetrics"},
+	{method: "GET", path: "/sellers/v1/account", name: "sellers-v1:getAccount"},
+	{method: "GET", path: "/sellers/v1/marketplaceParticipations", name: "sellers-v1:getMarketplaceParticipations"},
+	{method: "GET", path: "/service/v1/appointmentSlots", name: "services-v1:getAppointmentSlots"},
+	{method: "POST", path: "/service/v1/documents", name: "services-v1:createServiceDocumentUploadDestination"},
+	{method: "POST", path: "/service/v1/reservation", name: "services-v1:createReservation"},
+	{method: "DELETE", path: "/service/v1/reservation/{reservationId}", name: "services-v1:cancelReservation"},
+	{method: "PUT", path: "/service/v1/reservation/{reservationId}", name: "services-v1:updateReservation"},
+	{method: "GET", path: "/service/v1/serviceJobs", name: "services-v1:getServiceJobs"},
+	{method: "GET", path: "/service/v1/serviceJobs/{serviceJobId}", name: "services-v1:getServiceJobByServiceJobId"},
+	{method: "GET", path: "/service/v1/serviceJobs/{serviceJobId}/appointmentSlots", name: "services-v1:getAppointmmentSlotsByJobId"},
+	{method: "POST", path: "/service/v1/serviceJobs/{serviceJobId}/appointments", name: "services-v1:addAppointmentForServiceJobByServiceJobId"},
+	{method: "POST", path: "/service/v1/serviceJobs/{serviceJobId}/appointments/{appointmentId}", name: "services-v1:rescheduleAppointmentForServiceJobByServiceJobId"},
+	{method: "PUT", path: "/service/v1/serviceJobs/{serviceJobId}/appointments/{appointmentId}/fulfillment", name: "services-v1:setAppointmentFulfillmentData"},
+	{method: "PUT", path: "/service/v1/serviceJobs/{serviceJobId}/appointments/{appointmentId}/resources", name: "services-v1:assignAppointmentResources"},
+	{method: "PUT", path: "/service/v1/serviceJobs/{serviceJobId}/cancellations", name: "services-v1:cancelServiceJobByServiceJobId"},
+	{method: "PUT", path: "/service/v1/serviceJobs/{serviceJobId}/completions", name: "services-v1:completeServiceJobByServiceJobId"},
+	{method: "POST", path: "/service/v1/serviceResources/{resourceId}/capacity/fixed", name: "services-v1:getFixedSlotCapacity"},
+	{method: "POST", path: "/service/v1/serviceResources/{resourceId}/capacity/range", name: "services-v1:getRangeSlotCapacity"},
+	{method: "PUT", path: "/service/v1/serviceResources/{resourceId}/schedules", name: "services-v1:updateSchedule"},
+	{method: "GET", path: "/shipping/v2/accessPoints", name: "shipping-v2:getAccessPoints"},
+	{method: "GET", path: "/shipping/v2/carrierAccountFormInputs", name: "shipping-v2:getCarrierAccountFormInputs"},
+	{method: "PUT", path: "/shipping/v2/carrierAccounts", name: "shipping-v2:getCarrierAccounts"},
+	{method: "PUT", path: "/shipping/v2/carrierAccounts/{carrierId}", name: "shipping-v2:linkCarrierAccount"},
+	{method: "PUT", path: "/shipping/v2/carrierAccounts/{carrierId}/unlink", name: "shipping-v2:unlinkCarrierAccount"},
+	{method: "POST", path: "/shipping/v2/claims", name: "shipping-v2:createClaim"},
+	{method: "POST", path: "/shipping/v2/collectionForms", name: "shipping-v2:generateCollectionForm"},
+	{method: "PUT", path: "/shipping/v2/collectionForms/history", name: "shipping-v2:getCollectionFormHistory"},
+	{method: "GET", path: "/shipping/v2/collectionForms/{collectionFormId}", name: "shipping-v2:getCollectionForm"},
+	{method: "POST", path: "/shipping/v2/ndrFeedback", name: "shipping-v2:submitNdrFeedback"},
+	{method: "POST", path: "/shipping/v2/oneClickShipment", name: "shipping-v2:oneClickShipment"},
+	{method: "POST", path: "/shipping/v2/shipments", name: "shipping-v2:purchaseShipment"},
+	{method: "GET", path: "/shipping/v2/shipments/additionalInputs/schema", name: "shipping-v2:getAdditionalInputs"},
+	{method: "POST", path: "/shipping/v2/shipments/directPurchase", name: "shipping-v2:directPurchaseShipment"},
+	{method: "POST", path: "/shipping/v2/shipments/rates", name: "shipping-v2:getRates"},
+	{method: "PUT", path: "/shipping/v2/shipments/{shipmentId}/cancel", name: "shipping-v2:cancelShipment"},
+	{method: "GET", path: "/shipping/v2/shipments/{shipmentId}/documents", name: "shipping-v2:getShipmentDocuments"},
+	{method: "GET", path: "/shipping/v2/tracking", name: "shipping-v2:getTracking"},
+	{method: "PUT", path: "/shipping/v2/unmanifestedShipments", name: "shipping-v2:getUnmanifestedShipments"},
+	{method: "GET", path: "/solicitations/v1/orders/{amazonOrderId}", name: "solicitations-v1:getSolicitationActionsForOrder"},
+	{method: "POST", path: "/solicitations/v1/orders/{amazonOrderId}/solicitations/productReviewAndSellerFeedback", name: "solicitations-v1:createProductReviewAndSellerFeedbackSolicitation"},
+	{method: "GET", path: "/supplySources/2020-07-01/supplySources", name: "supply-sources-v2020-07-01:getSupplySources"},
+	{method: "POST", path: "/supplySources/2020-07-01/supplySources", name: "supply-sources-v2020-07-01:createSupplySource"},
+	{method: "DELETE", path: "/supplySources/2020-07-01/supplySources/{supplySourceId}", name: "supply-sources-v2020-07-01:archiveSupplySource"},
+	{method: "GET", path: "/supplySources/2020-07-01/supplySources/{supplySourceId}", name: "supply-sources-v2020-07-01:getSupplySource"},
+	{method: "PUT", path: "/supplySources/2020-07-01/supplySources/{supplySourceId}", name: "supply-sources-v2020-07-01:updateSupplySource"},
+	{method: "PUT", path: "/supplySources/2020-07-01/supplySources/{supplySourceId}/status", name: "supply-sources-v2020-07-01:updateSupplySourceStatus"},
+	{method: "GET", path: "/tax/invoices/2024-06-19/attributes", name: "invoices-v2024-06-19:getInvoicesAttributes"},
+	{method: "GET", path: "/tax/invoices/2024-06-19/documents/{invoicesDocumentId}", name: "invoices-v2024-06-19:getInvoicesDocument"},
+	{method: "GET", path: "/tax/invoices/2024-06-19/exports", name: "invoices-v2024-06-19:getInvoicesExports"},
+	{method: "POST", path: "/tax/invoices/2024-06-19/exports", name: "invoices-v2024-06-19:createInvoicesExport"},
+	{method: "GET", path: "/tax/invoices/2024-06-19/exports/{exportId}", name: "invoices-v2024-06-19:getInvoicesExport"},
+	{method: "GET", path: "/tax/invoices/2024-06-19/invoices", name: "invoices-v2024-06-19:getInvoices"},
+	{method: "GET", path: "/tax/invoices/2024-06-19/invoices/{invoiceId}", name: "invoices-v2024-06-19:getInvoice"},
+	{method: "POST", path: "/tokens/2021-03-01/restrictedDataToken", name: "tokens-v2021-03-01:createRestrictedDataToken"},
+	{method: "POST", path: "/uploads/2020-11-01/uploadDestinations/{resource}", name: "uploads-v2020-11-01:createUploadDestinationForResource"},
+	{method: "POST", path: "/vendor/directFulfillment/inventory/v1/warehouses/{warehouseId}/items", name: "vendor-direct-fulfillment-inventory-v1:submitInventoryUpdate"},
+	{method: "POST", path: "/vendor/directFulfillment/orders/2021-12-28/acknowledgements", name: "vendor-direct-fulfillment-orders-v2021-12-28:submitAcknowledgement"},
+	{method: "GET", path: "/vendor/directFulfillment/orders/2021-12-28/purchaseOrders", name: "vendor-direct-fulfillment-orders-v2021-12-28:getOrders"},
+	{method: "GET", path: "/vendor/directFulfillment/orders/2021-12-28/purchaseOrders/{purchaseOrderNumber}", name: "vendor-direct-fulfillment-orders-v2021-12-28:getOrder"},
+	{method: "POST", path: "/vendor/directFulfillment/orders/v1/acknowledgements", name: "vendor-direct-fulfillment-orders-v1:submitAcknowledgement"},
+	{method: "GET", path: "/vendor/directFulfillment/orders/v1/purchaseOrders", name: "vendor-direct-fulfillment-orders-v1:getOrders"},
+	{method: "GET", path: "/vendor/directFulfillment/orders/v1/purchaseOrders/{purchaseOrderNumber}", name: "vendor-direct-fulfillment-orders-v1:getOrder"},
+	{method: "POST", path: "/vendor/directFulfillment/payments/v1/invoices", name: "vendor-direct-fulfillment-payments-v1:submitInvoice"},
+	{method: "POST", path: "/vendor/directFulfillment/sandbox/2021-10-28/orders", name: "vendor-direct-fulfillment-sandbox-test-data-v2021-10-28:generateOrderScenarios"},
+	{method: "GET", path: "/vendor/directFulfillment/sandbox/2021-10-28/transactions/{transactionId}", name: "vendor-direct-fulfillment-sandbox-test-data-v2021-10-28:getOrderScenarios"},
+	{method: "POST", path: "/vendor/directFulfillment/shipping/2021-12-28/containerLabel", name: "vendor-direct-fulfillment-shipping-v2021-12-28:createContainerLabel"},
+	{method: "GET", path: "/vendor/directFulfillment/shipping/2021-12-28/customerInvoices", name: "vendor-direct-fulfillment-shipping-v2021-12-28:getCustomerInvoices"},
+	{method: "GET", path: "/vendor/directFulfillment/shipping/2021-12-28/customerInvoices/{purchaseOrderNumber}", name: "vendor-direct-fulfillment-shipping-v2021-12-28:getCustomerInvoice"},
+	{method: "GET", path: "/vendor/directFulfillment/shipping/2021-12-28/packingSlips", name: "vendor-direct-fulfillment-shipping-v2021-12-28:getPackingSlips"},
+	{method: "GET", path: "/vendor/directFulfillment/shipping/2021-12-28/packingSlips/{purchaseOrderNumber}", name: "vendor-direct-fulfillment-shipping-v2021-12-28:getPackingSlip"},
+	{method: "POST", path: "/vendor/directFulfillment/shipping/2021-12-28/shipmentConfirmations", name: "vendor-direct-fulfillment-shipping-v2021-12-28:submitShipmentConfirmations"},
+	{method: "POST", path: "/vendor/directFulfillment/shipping/2021-12-28/shipmentStatusUpdates", name: "vendor-direct-fulfillment-shipping-v2021-12-28:submitShipmentStatusUpdates"},
+	{method: "GET", path: "/vendor/directFulfillment/shipping/2021-12-28/shippingLabels", name: "vendor-direct-fulfillment-shipping-v2021-12-28:getShippingLabels"},
+	{method: "POST", path: "/vendor/directFulfillment/shipping/2021-12-28/shippingLabels", name: "vendor-direct-fulfillment-shipping-v2021-12-28:submitShippingLabelRequest"},
+	{method: "GET", path: "/vendor/directFulfillment/shipping/2021-12-28/shippingLabels/{purchaseOrderNumber}", name: "vendor-direct-fulfillment-shipping-v2021-12-28:getShippingLabel"},
+	{method: "POST", path: "/vendor/directFulfillment/shipping/2021-12-28/shippingLabels/{purchaseOrderNumber}", name: "vendor-direct-fulfillment-shipping-v2021-12-28:createShippingLabels"},
+	{method: "GET", path: "/vendor/directFulfillment/shipping/v1/customerInvoices", name: "vendor-direct-fulfillment-shipping-v1:getCustomerInvoices"},
+	{method: "GET", path: "/vendor/directFulfillment/shipping/v1/customerInvoices/{purchaseOrderNumber}", name: "vendor-direct-fulfillment-shipping-v1:getCustomerInvoice"},
+	{method: "GET", path: "/vendor/directFulfillment/shipping/v1/packingSlips", name: "vendor-direct-fulfillment-shipping-v1:getPackingSlips"},
+	{method: "GET", path: "/vendor/directFulfillment/shipping/v1/packingSlips/{purchaseOrderNumber}", name: "vendor-direct-fulfillment-shipping-v1:getPackingSlip"},
+	{method: "POST", path: "/vendor/directFulfillment/shipping/v1/shipmentConfirmations", name: "vendor-direct-fulfillment-shipping-v1:submitShipmentConfirmations"},
+	{method: "POST", path: "/vendor/directFulfillment/shipping/v1/shipmentStatusUpdates", name: "vendor-direct-fulfillment-shipping-v1:submitShipmentStatusUpdates"},
+	{method: "GET", path: "/vendor/directFulfillment/shipping/v1/shippingLabels", name: "vendor-direct-fulfillment-shipping-v1:getShippingLabels"},
+	{method: "POST", path: "/vendor/directFulfillment/shipping/v1/shippingLabels", name: "vendor-direct-fulfillment-shipping-v1:submitShippingLabelRequest"},
+	{method: "GET", path: "/vendor/directFulfillment/shipping/v1/shippingLabels/{purchaseOrderNumber}", name: "vendor-direct-fulfillment-shipping-v1:getShippingLabel"},
+	{method: "GET", path: "/vendor/directFulfillment/transactions/2021-12-28/transactions/{transactionId}", name: "vendor-direct-fulfillment-transactions-v2021-12-28:getTransactionStatus"},
+	{method: "GET", path: "/vendor/directFulfillment/transactions/v1/transactions/{transactionId}", name: "vendor-direct-fulfillment-transactions-v1:getTransactionStatus"},
+	{method: "POST", path: "/vendor/orders/v1/acknowledgements", name: "vendor-orders-v1:submitAcknowledgement"},
+	{method: "GET", path: "/vendor/orders/v1/purchaseOrders", name: "vendor-orders-v1:getPurchaseOrders"},
+	{method: "GET", path: "/vendor/orders/v1/purchaseOrders/{purchaseOrderNumber}", name: "vendor-orders-v1:getPurchaseOrder"},
+	{method: "GET", path: "/vendor/orders/v1/purchaseOrdersStatus", name: "vendor-orders-v1:getPurchaseOrdersStatus"},
+	{method: "POST", path: "/vendor/payments/v1/invoices", name: "vendor-invoices-v1:submitInvoices"},
+	{method: "POST", path: "/vendor/shipping/v1/shipmentConfirmations", name: "vendor-shipments-v1:submitShipmentConfirmations"},
+	{method: "GET", path: "/vendor/shipping/v1/shipments", name: "vendor-shipments-v1:getShipmentDetails"},
+	{method: "POST", path: "/vendor/shipping/v1/shipments", name: "vendor-shipments-v1:submitShipments"},
+	{method: "GET", path: "/vendor/shipping/v1/transportLabels", name: "vendor-shipments-v1:getShipmentLabels"},
+	{method: "GET", path: "/vendor/transactions/v1/transactions/{transactionId}", name: "vendor-transaction-status-v1:getTransaction"},
+}
@@ -0,0 +1,144 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+// Package operations 列出所有 API 包的操作，支持在运行时按名称调用。
+//
+// 注册表（registry.go）由 cmd/generator 从各 API 包的 Client 方法生成，
+// 供 cmd/spapi 等需要根据用户输入选择操作的工具使用。业务代码通常应直接
+// 使用各 API 包的 Client。
+//
+// 示例:
+//
+//	op := operations.Lookup("orders", "v0", "GetOrder")
+//	result, err := op.Call(ctx, client, []string{"902-1845936-5435065"}, nil, nil)
+package operations
+
+import (
+	"context"
+	"iter"
+	"slices"
+	"sort"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Input 描述操作除路径参数以外的输入。
+type Input int
+
+const (
+	// InputNone 表示操作只有路径参数（DELETE）。
+	InputNone Input = iota
+
+	// InputQuery 表示操作接受查询参数（GET）。
+	InputQuery
+
+	// InputBody 表示操作接受请求体（POST、PUT、PATCH）。
+	InputBody
+)
+
+// Operation 描述 API 包中的一个操作。
+type Operation struct {
+	// API 是 API 名称，如 "orders"
+	API string
+
+	// Version 是 API 版本，如 "v0"
+	Version string
+
+	// Name 是 Client 方法名，如 "GetOrder"
+	Name string
+
+	// Method 是 HTTP 方法
+	Method string
+
+	// Path 是路径模板，如 "/orders/v0/orders/{orderId}"
+	Path string
+
+	// PathParams 是路径参数名，按调用顺序排列
+	PathParams []string
+
+	// Input 是路径参数以外的输入类型
+	Input Input
+
+	// Call 调用操作。p 必须与 PathParams 一一对应；
+	// 根据 Input 使用 query 或 body，另一个被忽略。
+	Call func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error)
+
+	// Iterator 是自动分页的 Iterate* 方法名，操作不分页时为空
+	Iterator string
+
+	// Iterate 通过 Iterator 遍历所有分页，操作不分页时为 nil
+	Iterate func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error]
+}
+
+// Package 返回操作所在的包目录名，如 "orders-v0"。
+func (op *Operation) Package() string {
+	return op.API + "-" + op.Version
+}
+
+// All 返回所有操作，按包目录名和操作名排序。
+//
+// 返回的切片是副本，但其中的 Operation 是共享的，不应修改。
+func All() []*Operation {
+	ops := make([]*Operation, len(registry))
+	copy(ops, registry)
+	sort.SliceStable(ops, func(i, j int) bool {
+		if ops[i].Package() != ops[j].Package() {
+			return ops[i].Package() < ops[j].Package()
+		}
+		return ops[i].Name < ops[j].Name
+	})
+	return ops
+}
+
+// Versions 返回 API 的所有版本，按从旧到新排序。
+//
+// 参数:
+//   - api: API 名称，如 "catalog-items"
+//
+// 返回值:
+//   - []string: 版本列表，API 不存在时为空
+func Versions(api string) []string {
+	var versions []string
+	for _, op := range registry {
+		if op.API == api {
+			versions = append(versions, op.Version)
+		}
+	}
+	slices.Sort(versions)
+	return slices.Compact(versions)
+}
+
+// Lookup 按 API、版本和方法名查找操作。
+//
+// 参数:
+//   - api: API 名称，如 "orders"
+//   - version: API 版本，如 "v0"
+//   - name: Client 方法名，如 "GetOrder"
+//
+// 返回值:
+//   - *Operation: 找到的操作，不存在时为 nil
+func Lookup(api, version, name string) *Operation {
+	for _, op := range registry {
+		if op.API == api && op.Version == version && op.Name == name {
+			return op
+		}
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package operations_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/operations"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/spapitest"
+)
+
+func TestLookup(t *testing.T) {
+	op := operations.Lookup("orders", "v0", "GetOrderItems")
+	if op == nil {
+		t.Fatal("Lookup(orders, v0, GetOrderItems) = nil")
+	}
+	if op.Method != http.MethodGet || op.Path != "/orders/v0/orders/{orderId}/orderItems" ||
+		!slices.Equal(op.PathParams, []string{"orderId"}) || op.Input != operations.InputQuery {
+		t.Errorf("GetOrderItems = %+v", op)
+	}
+	if op.Iterator != "IterateOrderItems" || op.Iterate == nil || op.Package() != "orders-v0" {
+		t.Errorf("GetOrderItems iterator = %q, package = %s", op.Iterator, op.Package())
+	}
+
+	if op := operations.Lookup("feeds", "v2021-06-30", "CreateFeed"); op == nil || op.Input != operations.InputBody || op.Iterate != nil {
+		t.Errorf("CreateFeed = %+v, want body input without iterator", op)
+	}
+	if op := operations.Lookup("orders", "v0", "Missing"); op != nil {
+		t.Errorf("Lookup(Missing) = %+v, want nil", op)
+	}
+}
+
+func TestVersions(t *testing.T) {
+	want := []string{"v0", "v2020-12-01", "v2022-04-01"}
+	if got := operations.Versions("catalog-items"); !slices.Equal(got, want) {
+		t.Errorf("Versions(catalog-items) = %v, want %v", got, want)
+	}
+	if got := operations.Versions("missing"); len(got) != 0 {
+		t.Errorf("Versions(missing) = %v, want none", got)
+	}
+}
+
+func TestAll(t *testing.T) {
+	ops := operations.All()
+	if len(ops) < 300 {
+		t.Fatalf("All() = %d operations, want every generated operation", len(ops))
+	}
+	for i := 1; i < len(ops); i++ {
+		a, b := ops[i-1], ops[i]
+		if a.Package() > b.Package() || (a.Package() == b.Package() && a.Name >= b.Name) {
+			t.Fatalf("All() not sorted at %s.%s, %s.%s", a.Package(), a.Name, b.Package(), b.Name)
+		}
+	}
+}
+
+func TestOperation_CallAndIterate(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	defer client.Close(context.Background())
+
+	srv.Handle(http.MethodGet, "/orders/v0/orders/{orderId}", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"payload": map[string]interface{}{"AmazonOrderId": r.PathValue("orderId")},
+		})
+	})
+	err = srv.Paginate(http.MethodGet, "/orders/v0/orders/{orderId}/orderItems", "NextToken", "payload.NextToken",
+		map[string]interface{}{"payload": map[string]interface{}{"OrderItems": []interface{}{map[string]interface{}{"ASIN": "A"}}}},
+		map[string]interface{}{"payload": map[string]interface{}{"OrderItems": []interface{}{map[string]interface{}{"ASIN": "B"}}}},
+	)
+	if err != nil {
+		t.Fatalf("Paginate() error = %v", err)
+	}
+	ctx := context.Background()
+
+	result, err := operations.Lookup("orders", "v0", "GetOrder").Call(ctx, client, []string{"902-1"}, nil, nil)
+	if err != nil {
+		t.Fatalf("Call() error = %v", err)
+	}
+	if id := result.(map[string]interface{})["payload"].(map[string]interface{})["AmazonOrderId"]; id != "902-1" {
+		t.Errorf("AmazonOrderId = %v, want 902-1", id)
+	}
+
+	var asins []string
+	for item, err := range operations.Lookup("orders", "v0", "GetOrderItems").Iterate(ctx, client, []string{"902-1"}, nil) {
+		if err != nil {
+			t.Fatalf("Iterate() error = %v", err)
+		}
+		asins = append(asins, item["ASIN"].(string))
+	}
+	if got := strings.Join(asins, ","); got != "A,B" {
+		t.Errorf("items = %s, want A,B", got)
+	}
+}
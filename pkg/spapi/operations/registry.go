@@ -0,0 +1,3969 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package operations
+
+import (
+	"context"
+	"iter"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	amazon_warehousing_and_distribution_model_v2024_05_09 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/amazon-warehousing-and-distribution-model-v2024-05-09"
+	aplus_content_v2020_11_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/aplus-content-v2020-11-01"
+	application_integrations_v2024_04_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/application-integrations-v2024-04-01"
+	application_management_v2023_11_30 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/application-management-v2023-11-30"
+	catalog_items_v0 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/catalog-items-v0"
+	catalog_items_v2020_12_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/catalog-items-v2020-12-01"
+	catalog_items_v2022_04_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/catalog-items-v2022-04-01"
+	customer_feedback_v2024_06_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/customer-feedback-v2024-06-01"
+	data_kiosk_v2023_11_15 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/data-kiosk-v2023-11-15"
+	easy_ship_model_v2022_03_23 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/easy-ship-model-v2022-03-23"
+	fba_inbound_eligibility_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/fba-inbound-eligibility-v1"
+	fba_inventory_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/fba-inventory-v1"
+	feeds_v2021_06_30 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/feeds-v2021-06-30"
+	finances_v0 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/finances-v0"
+	finances_v2024_06_01_transfers "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/finances-v2024-06-01-transfers"
+	finances_v2024_06_19 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/finances-v2024-06-19"
+	fulfillment_inbound_v0 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/fulfillment-inbound-v0"
+	fulfillment_inbound_v2024_03_20 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/fulfillment-inbound-v2024-03-20"
+	fulfillment_outbound_v2020_07_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/fulfillment-outbound-v2020-07-01"
+	invoices_v2024_06_19 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/invoices-v2024-06-19"
+	listings_items_v2020_09_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/listings-items-v2020-09-01"
+	listings_items_v2021_08_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/listings-items-v2021-08-01"
+	listings_restrictions_v2021_08_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/listings-restrictions-v2021-08-01"
+	merchant_fulfillment_v0 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/merchant-fulfillment-v0"
+	messaging_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/messaging-v1"
+	notifications_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/notifications-v1"
+	orders_v0 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/orders-v0"
+	product_fees_v0 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/product-fees-v0"
+	product_pricing_v0 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/product-pricing-v0"
+	product_pricing_v2022_05_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/product-pricing-v2022-05-01"
+	product_type_definitions_v2020_09_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/product-type-definitions-v2020-09-01"
+	replenishment_v2022_11_07 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/replenishment-v2022-11-07"
+	reports_v2021_06_30 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/reports-v2021-06-30"
+	sales_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/sales-v1"
+	seller_wallet_v2024_03_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/seller-wallet-v2024-03-01"
+	sellers_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/sellers-v1"
+	services_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/services-v1"
+	shipment_invoicing_v0 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/shipment-invoicing-v0"
+	shipping_v2 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/shipping-v2"
+	solicitations_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/solicitations-v1"
+	supply_sources_v2020_07_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/supply-sources-v2020-07-01"
+	tokens_v2021_03_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/tokens-v2021-03-01"
+	uploads_v2020_11_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/uploads-v2020-11-01"
+	vehicles_v2024_11_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/vehicles-v2024-11-01"
+	vendor_direct_fulfillment_inventory_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/vendor-direct-fulfillment-inventory-v1"
+	vendor_direct_fulfillment_orders_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/vendor-direct-fulfillment-orders-v1"
+	vendor_direct_fulfillment_orders_v2021_12_28 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/vendor-direct-fulfillment-orders-v2021-12-28"
+	vendor_direct_fulfillment_payments_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/vendor-direct-fulfillment-payments-v1"
+	vendor_direct_fulfillment_sandbox_test_data_v2021_10_28 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/vendor-direct-fulfillment-sandbox-test-data-v2021-10-28"
+	vendor_direct_fulfillment_shipping_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/vendor-direct-fulfillment-shipping-v1"
+	vendor_direct_fulfillment_shipping_v2021_12_28 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/vendor-direct-fulfillment-shipping-v2021-12-28"
+	vendor_direct_fulfillment_transactions_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/vendor-direct-fulfillment-transactions-v1"
+	vendor_direct_fulfillment_transactions_v2021_12_28 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/vendor-direct-fulfillment-transactions-v2021-12-28"
+	vendor_invoices_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/vendor-invoices-v1"
+	vendor_orders_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/vendor-orders-v1"
+	vendor_shipments_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/vendor-shipments-v1"
+	vendor_transaction_status_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/vendor-transaction-status-v1"
+)
+
+// registry lists the operations of every API package.
+var registry = []*Operation{
+	{
+		API:        "orders",
+		Version:    "v0",
+		Name:       "ConfirmShipment",
+		Method:     "POST",
+		Path:       "/orders/v0/orders/{orderId}/shipmentConfirmation",
+		PathParams: []string{"orderId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return orders_v0.NewClient(c).ConfirmShipment(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "orders",
+		Version:    "v0",
+		Name:       "GetOrder",
+		Method:     "GET",
+		Path:       "/orders/v0/orders/{orderId}",
+		PathParams: []string{"orderId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return orders_v0.NewClient(c).GetOrder(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "orders",
+		Version:    "v0",
+		Name:       "GetOrderAddress",
+		Method:     "GET",
+		Path:       "/orders/v0/orders/{orderId}/address",
+		PathParams: []string{"orderId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return orders_v0.NewClient(c).GetOrderAddress(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "orders",
+		Version:    "v0",
+		Name:       "GetOrderBuyerInfo",
+		Method:     "GET",
+		Path:       "/orders/v0/orders/{orderId}/buyerInfo",
+		PathParams: []string{"orderId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return orders_v0.NewClient(c).GetOrderBuyerInfo(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "orders",
+		Version:    "v0",
+		Name:       "GetOrderItems",
+		Method:     "GET",
+		Path:       "/orders/v0/orders/{orderId}/orderItems",
+		PathParams: []string{"orderId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return orders_v0.NewClient(c).GetOrderItems(ctx, p[0], query)
+		},
+		Iterator: "IterateOrderItems",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return orders_v0.NewClient(c).IterateOrderItems(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "orders",
+		Version:    "v0",
+		Name:       "GetOrderItemsBuyerInfo",
+		Method:     "GET",
+		Path:       "/orders/v0/orders/{orderId}/orderItems/buyerInfo",
+		PathParams: []string{"orderId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return orders_v0.NewClient(c).GetOrderItemsBuyerInfo(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "orders",
+		Version:    "v0",
+		Name:       "GetOrderRegulatedInfo",
+		Method:     "GET",
+		Path:       "/orders/v0/orders/{orderId}/regulatedInfo",
+		PathParams: []string{"orderId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return orders_v0.NewClient(c).GetOrderRegulatedInfo(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "orders",
+		Version:    "v0",
+		Name:       "GetOrders",
+		Method:     "GET",
+		Path:       "/orders/v0/orders",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return orders_v0.NewClient(c).GetOrders(ctx, query)
+		},
+		Iterator: "IterateOrders",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return orders_v0.NewClient(c).IterateOrders(ctx, query)
+		},
+	},
+	{
+		API:        "orders",
+		Version:    "v0",
+		Name:       "UpdateShipmentStatus",
+		Method:     "POST",
+		Path:       "/orders/v0/orders/{orderId}/shipment",
+		PathParams: []string{"orderId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return orders_v0.NewClient(c).UpdateShipmentStatus(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "orders",
+		Version:    "v0",
+		Name:       "UpdateVerificationStatus",
+		Method:     "PATCH",
+		Path:       "/orders/v0/orders/{orderId}/regulatedInfo",
+		PathParams: []string{"orderId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return orders_v0.NewClient(c).UpdateVerificationStatus(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "feeds",
+		Version:    "v2021-06-30",
+		Name:       "CancelFeed",
+		Method:     "DELETE",
+		Path:       "/feeds/2021-06-30/feeds/{feedId}",
+		PathParams: []string{"feedId"},
+		Input:      InputNone,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return feeds_v2021_06_30.NewClient(c).CancelFeed(ctx, p[0])
+		},
+	},
+	{
+		API:        "feeds",
+		Version:    "v2021-06-30",
+		Name:       "CreateFeed",
+		Method:     "POST",
+		Path:       "/feeds/2021-06-30/feeds",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return feeds_v2021_06_30.NewClient(c).CreateFeed(ctx, body)
+		},
+	},
+	{
+		API:        "feeds",
+		Version:    "v2021-06-30",
+		Name:       "CreateFeedDocument",
+		Method:     "POST",
+		Path:       "/feeds/2021-06-30/documents",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return feeds_v2021_06_30.NewClient(c).CreateFeedDocument(ctx, body)
+		},
+	},
+	{
+		API:        "feeds",
+		Version:    "v2021-06-30",
+		Name:       "GetFeed",
+		Method:     "GET",
+		Path:       "/feeds/2021-06-30/feeds/{feedId}",
+		PathParams: []string{"feedId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return feeds_v2021_06_30.NewClient(c).GetFeed(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "feeds",
+		Version:    "v2021-06-30",
+		Name:       "GetFeedDocument",
+		Method:     "GET",
+		Path:       "/feeds/2021-06-30/documents/{feedDocumentId}",
+		PathParams: []string{"feedDocumentId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return feeds_v2021_06_30.NewClient(c).GetFeedDocument(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "feeds",
+		Version:    "v2021-06-30",
+		Name:       "GetFeeds",
+		Method:     "GET",
+		Path:       "/feeds/2021-06-30/feeds",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return feeds_v2021_06_30.NewClient(c).GetFeeds(ctx, query)
+		},
+		Iterator: "IterateFeeds",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return feeds_v2021_06_30.NewClient(c).IterateFeeds(ctx, query)
+		},
+	},
+	{
+		API:        "catalog-items",
+		Version:    "v0",
+		Name:       "ListCatalogCategories",
+		Method:     "GET",
+		Path:       "/catalog/v0/categories",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return catalog_items_v0.NewClient(c).ListCatalogCategories(ctx, query)
+		},
+	},
+	{
+		API:        "catalog-items",
+		Version:    "v2020-12-01",
+		Name:       "GetCatalogItem",
+		Method:     "GET",
+		Path:       "/catalog/2020-12-01/items/{asin}",
+		PathParams: []string{"asin"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return catalog_items_v2020_12_01.NewClient(c).GetCatalogItem(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "catalog-items",
+		Version:    "v2020-12-01",
+		Name:       "SearchCatalogItems",
+		Method:     "GET",
+		Path:       "/catalog/2020-12-01/items",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return catalog_items_v2020_12_01.NewClient(c).SearchCatalogItems(ctx, query)
+		},
+		Iterator: "IterateCatalogItems",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return catalog_items_v2020_12_01.NewClient(c).IterateCatalogItems(ctx, query)
+		},
+	},
+	{
+		API:        "catalog-items",
+		Version:    "v2022-04-01",
+		Name:       "GetCatalogItem",
+		Method:     "GET",
+		Path:       "/catalog/2022-04-01/items/{asin}",
+		PathParams: []string{"asin"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return catalog_items_v2022_04_01.NewClient(c).GetCatalogItem(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "catalog-items",
+		Version:    "v2022-04-01",
+		Name:       "SearchCatalogItems",
+		Method:     "GET",
+		Path:       "/catalog/2022-04-01/items",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return catalog_items_v2022_04_01.NewClient(c).SearchCatalogItems(ctx, query)
+		},
+		Iterator: "IterateCatalogItems",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return catalog_items_v2022_04_01.NewClient(c).IterateCatalogItems(ctx, query)
+		},
+	},
+	{
+		API:        "reports",
+		Version:    "v2021-06-30",
+		Name:       "CancelReport",
+		Method:     "DELETE",
+		Path:       "/reports/2021-06-30/reports/{reportId}",
+		PathParams: []string{"reportId"},
+		Input:      InputNone,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return reports_v2021_06_30.NewClient(c).CancelReport(ctx, p[0])
+		},
+	},
+	{
+		API:        "reports",
+		Version:    "v2021-06-30",
+		Name:       "CancelReportSchedule",
+		Method:     "DELETE",
+		Path:       "/reports/2021-06-30/schedules/{reportScheduleId}",
+		PathParams: []string{"reportScheduleId"},
+		Input:      InputNone,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return reports_v2021_06_30.NewClient(c).CancelReportSchedule(ctx, p[0])
+		},
+	},
+	{
+		API:        "reports",
+		Version:    "v2021-06-30",
+		Name:       "CreateReport",
+		Method:     "POST",
+		Path:       "/reports/2021-06-30/reports",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return reports_v2021_06_30.NewClient(c).CreateReport(ctx, body)
+		},
+	},
+	{
+		API:        "reports",
+		Version:    "v2021-06-30",
+		Name:       "CreateReportSchedule",
+		Method:     "POST",
+		Path:       "/reports/2021-06-30/schedules",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return reports_v2021_06_30.NewClient(c).CreateReportSchedule(ctx, body)
+		},
+	},
+	{
+		API:        "reports",
+		Version:    "v2021-06-30",
+		Name:       "GetReport",
+		Method:     "GET",
+		Path:       "/reports/2021-06-30/reports/{reportId}",
+		PathParams: []string{"reportId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return reports_v2021_06_30.NewClient(c).GetReport(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "reports",
+		Version:    "v2021-06-30",
+		Name:       "GetReportDocument",
+		Method:     "GET",
+		Path:       "/reports/2021-06-30/documents/{reportDocumentId}",
+		PathParams: []string{"reportDocumentId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return reports_v2021_06_30.NewClient(c).GetReportDocument(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "reports",
+		Version:    "v2021-06-30",
+		Name:       "GetReportSchedule",
+		Method:     "GET",
+		Path:       "/reports/2021-06-30/schedules/{reportScheduleId}",
+		PathParams: []string{"reportScheduleId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return reports_v2021_06_30.NewClient(c).GetReportSchedule(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "reports",
+		Version:    "v2021-06-30",
+		Name:       "GetReportSchedules",
+		Method:     "GET",
+		Path:       "/reports/2021-06-30/schedules",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return reports_v2021_06_30.NewClient(c).GetReportSchedules(ctx, query)
+		},
+	},
+	{
+		API:        "reports",
+		Version:    "v2021-06-30",
+		Name:       "GetReports",
+		Method:     "GET",
+		Path:       "/reports/2021-06-30/reports",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return reports_v2021_06_30.NewClient(c).GetReports(ctx, query)
+		},
+		Iterator: "IterateReports",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return reports_v2021_06_30.NewClient(c).IterateReports(ctx, query)
+		},
+	},
+	{
+		API:        "finances",
+		Version:    "v0",
+		Name:       "ListFinancialEventGroups",
+		Method:     "GET",
+		Path:       "/finances/v0/financialEventGroups",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return finances_v0.NewClient(c).ListFinancialEventGroups(ctx, query)
+		},
+		Iterator: "IterateFinancialEventGroups",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return finances_v0.NewClient(c).IterateFinancialEventGroups(ctx, query)
+		},
+	},
+	{
+		API:        "finances",
+		Version:    "v0",
+		Name:       "ListFinancialEvents",
+		Method:     "GET",
+		Path:       "/finances/v0/financialEvents",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return finances_v0.NewClient(c).ListFinancialEvents(ctx, query)
+		},
+		Iterator: "IterateFinancialEvents",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return finances_v0.NewClient(c).IterateFinancialEvents(ctx, query)
+		},
+	},
+	{
+		API:        "finances",
+		Version:    "v0",
+		Name:       "ListFinancialEventsByGroupId",
+		Method:     "GET",
+		Path:       "/finances/v0/financialEventGroups/{eventGroupId}/financialEvents",
+		PathParams: []string{"eventGroupId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return finances_v0.NewClient(c).ListFinancialEventsByGroupId(ctx, p[0], query)
+		},
+		Iterator: "IterateFinancialEventsByGroupId",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return finances_v0.NewClient(c).IterateFinancialEventsByGroupId(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "finances",
+		Version:    "v0",
+		Name:       "ListFinancialEventsByOrderId",
+		Method:     "GET",
+		Path:       "/finances/v0/orders/{orderId}/financialEvents",
+		PathParams: []string{"orderId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return finances_v0.NewClient(c).ListFinancialEventsByOrderId(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "finances",
+		Version:    "v2024-06-19",
+		Name:       "ListTransactions",
+		Method:     "GET",
+		Path:       "/finances/2024-06-19/transactions",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return finances_v2024_06_19.NewClient(c).ListTransactions(ctx, query)
+		},
+		Iterator: "IterateTransactions",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return finances_v2024_06_19.NewClient(c).IterateTransactions(ctx, query)
+		},
+	},
+	{
+		API:        "fba-inventory",
+		Version:    "v1",
+		Name:       "AddInventory",
+		Method:     "POST",
+		Path:       "/fba/inventory/v1/items/inventory",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fba_inventory_v1.NewClient(c).AddInventory(ctx, body)
+		},
+	},
+	{
+		API:        "fba-inventory",
+		Version:    "v1",
+		Name:       "CreateInventoryItem",
+		Method:     "POST",
+		Path:       "/fba/inventory/v1/items",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fba_inventory_v1.NewClient(c).CreateInventoryItem(ctx, body)
+		},
+	},
+	{
+		API:        "fba-inventory",
+		Version:    "v1",
+		Name:       "DeleteInventoryItem",
+		Method:     "DELETE",
+		Path:       "/fba/inventory/v1/items/{sellerSku}",
+		PathParams: []string{"sellerSku"},
+		Input:      InputNone,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fba_inventory_v1.NewClient(c).DeleteInventoryItem(ctx, p[0])
+		},
+	},
+	{
+		API:        "fba-inventory",
+		Version:    "v1",
+		Name:       "GetInventorySummaries",
+		Method:     "GET",
+		Path:       "/fba/inventory/v1/summaries",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fba_inventory_v1.NewClient(c).GetInventorySummaries(ctx, query)
+		},
+		Iterator: "IterateInventorySummaries",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return fba_inventory_v1.NewClient(c).IterateInventorySummaries(ctx, query)
+		},
+	},
+	{
+		API:        "fba-inbound-eligibility",
+		Version:    "v1",
+		Name:       "GetItemEligibilityPreview",
+		Method:     "GET",
+		Path:       "/fba/inbound/v1/eligibility/itemPreview",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fba_inbound_eligibility_v1.NewClient(c).GetItemEligibilityPreview(ctx, query)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v0",
+		Name:       "GetBillOfLading",
+		Method:     "GET",
+		Path:       "/fba/inbound/v0/shipments/{shipmentId}/billOfLading",
+		PathParams: []string{"shipmentId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v0.NewClient(c).GetBillOfLading(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v0",
+		Name:       "GetLabels",
+		Method:     "GET",
+		Path:       "/fba/inbound/v0/shipments/{shipmentId}/labels",
+		PathParams: []string{"shipmentId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v0.NewClient(c).GetLabels(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v0",
+		Name:       "GetPrepInstructions",
+		Method:     "GET",
+		Path:       "/fba/inbound/v0/prepInstructions",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v0.NewClient(c).GetPrepInstructions(ctx, query)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v0",
+		Name:       "GetShipmentItems",
+		Method:     "GET",
+		Path:       "/fba/inbound/v0/shipmentItems",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v0.NewClient(c).GetShipmentItems(ctx, query)
+		},
+		Iterator: "IterateShipmentItems",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return fulfillment_inbound_v0.NewClient(c).IterateShipmentItems(ctx, query)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v0",
+		Name:       "GetShipmentItemsByShipmentId",
+		Method:     "GET",
+		Path:       "/fba/inbound/v0/shipments/{shipmentId}/items",
+		PathParams: []string{"shipmentId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v0.NewClient(c).GetShipmentItemsByShipmentId(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v0",
+		Name:       "GetShipments",
+		Method:     "GET",
+		Path:       "/fba/inbound/v0/shipments",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v0.NewClient(c).GetShipments(ctx, query)
+		},
+		Iterator: "IterateShipments",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return fulfillment_inbound_v0.NewClient(c).IterateShipments(ctx, query)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "CancelInboundPlan",
+		Method:     "PUT",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/cancellation",
+		PathParams: []string{"inboundPlanId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).CancelInboundPlan(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "CancelSelfShipAppointment",
+		Method:     "PUT",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/selfShipAppointmentCancellation",
+		PathParams: []string{"inboundPlanId", "shipmentId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).CancelSelfShipAppointment(ctx, p[0], p[1], body)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "ConfirmDeliveryWindowOptions",
+		Method:     "POST",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/deliveryWindowOptions/{deliveryWindowOptionId}/confirmation",
+		PathParams: []string{"inboundPlanId", "shipmentId", "deliveryWindowOptionId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).ConfirmDeliveryWindowOptions(ctx, p[0], p[1], p[2], body)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "ConfirmPackingOption",
+		Method:     "POST",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/packingOptions/{packingOptionId}/confirmation",
+		PathParams: []string{"inboundPlanId", "packingOptionId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).ConfirmPackingOption(ctx, p[0], p[1], body)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "ConfirmPlacementOption",
+		Method:     "POST",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/placementOptions/{placementOptionId}/confirmation",
+		PathParams: []string{"inboundPlanId", "placementOptionId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).ConfirmPlacementOption(ctx, p[0], p[1], body)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "ConfirmShipmentContentUpdatePreview",
+		Method:     "POST",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/contentUpdatePreviews/{contentUpdatePreviewId}/confirmation",
+		PathParams: []string{"inboundPlanId", "shipmentId", "contentUpdatePreviewId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).ConfirmShipmentContentUpdatePreview(ctx, p[0], p[1], p[2], body)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "ConfirmTransportationOptions",
+		Method:     "POST",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/transportationOptions/confirmation",
+		PathParams: []string{"inboundPlanId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).ConfirmTransportationOptions(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "CreateInboundPlan",
+		Method:     "POST",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).CreateInboundPlan(ctx, body)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "CreateMarketplaceItemLabels",
+		Method:     "POST",
+		Path:       "/inbound/fba/2024-03-20/items/labels",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).CreateMarketplaceItemLabels(ctx, body)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "GenerateDeliveryWindowOptions",
+		Method:     "POST",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/deliveryWindowOptions",
+		PathParams: []string{"inboundPlanId", "shipmentId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).GenerateDeliveryWindowOptions(ctx, p[0], p[1], body)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "GeneratePackingOptions",
+		Method:     "POST",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/packingOptions",
+		PathParams: []string{"inboundPlanId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).GeneratePackingOptions(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "GeneratePlacementOptions",
+		Method:     "POST",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/placementOptions",
+		PathParams: []string{"inboundPlanId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).GeneratePlacementOptions(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "GenerateSelfShipAppointmentSlots",
+		Method:     "POST",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/selfShipAppointmentSlots",
+		PathParams: []string{"inboundPlanId", "shipmentId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).GenerateSelfShipAppointmentSlots(ctx, p[0], p[1], body)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "GenerateShipmentContentUpdatePreviews",
+		Method:     "POST",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/contentUpdatePreviews",
+		PathParams: []string{"inboundPlanId", "shipmentId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).GenerateShipmentContentUpdatePreviews(ctx, p[0], p[1], body)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "GenerateTransportationOptions",
+		Method:     "POST",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/transportationOptions",
+		PathParams: []string{"inboundPlanId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).GenerateTransportationOptions(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "GetDeliveryChallanDocument",
+		Method:     "GET",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/deliveryChallanDocument",
+		PathParams: []string{"inboundPlanId", "shipmentId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).GetDeliveryChallanDocument(ctx, p[0], p[1], query)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "GetInboundOperationStatus",
+		Method:     "GET",
+		Path:       "/inbound/fba/2024-03-20/operations/{operationId}",
+		PathParams: []string{"operationId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).GetInboundOperationStatus(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "GetInboundPlan",
+		Method:     "GET",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}",
+		PathParams: []string{"inboundPlanId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).GetInboundPlan(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "GetSelfShipAppointmentSlots",
+		Method:     "GET",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/selfShipAppointmentSlots",
+		PathParams: []string{"inboundPlanId", "shipmentId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).GetSelfShipAppointmentSlots(ctx, p[0], p[1], query)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "GetShipment",
+		Method:     "GET",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}",
+		PathParams: []string{"inboundPlanId", "shipmentId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).GetShipment(ctx, p[0], p[1], query)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "GetShipmentContentUpdatePreview",
+		Method:     "GET",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/contentUpdatePreviews/{contentUpdatePreviewId}",
+		PathParams: []string{"inboundPlanId", "shipmentId", "contentUpdatePreviewId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).GetShipmentContentUpdatePreview(ctx, p[0], p[1], p[2], query)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "ListDeliveryWindowOptions",
+		Method:     "GET",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/deliveryWindowOptions",
+		PathParams: []string{"inboundPlanId", "shipmentId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).ListDeliveryWindowOptions(ctx, p[0], p[1], query)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "ListInboundPlanBoxes",
+		Method:     "GET",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/boxes",
+		PathParams: []string{"inboundPlanId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).ListInboundPlanBoxes(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "ListInboundPlanItems",
+		Method:     "GET",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/items",
+		PathParams: []string{"inboundPlanId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).ListInboundPlanItems(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "ListInboundPlanPallets",
+		Method:     "GET",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/pallets",
+		PathParams: []string{"inboundPlanId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).ListInboundPlanPallets(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "ListInboundPlans",
+		Method:     "GET",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).ListInboundPlans(ctx, query)
+		},
+		Iterator: "IterateInboundPlans",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).IterateInboundPlans(ctx, query)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "ListItemComplianceDetails",
+		Method:     "GET",
+		Path:       "/inbound/fba/2024-03-20/items/compliance",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).ListItemComplianceDetails(ctx, query)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "ListPackingGroupBoxes",
+		Method:     "GET",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/packingGroups/{packingGroupId}/boxes",
+		PathParams: []string{"inboundPlanId", "packingGroupId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).ListPackingGroupBoxes(ctx, p[0], p[1], query)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "ListPackingGroupItems",
+		Method:     "GET",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/packingGroups/{packingGroupId}/items",
+		PathParams: []string{"inboundPlanId", "packingGroupId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).ListPackingGroupItems(ctx, p[0], p[1], query)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "ListPackingOptions",
+		Method:     "GET",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/packingOptions",
+		PathParams: []string{"inboundPlanId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).ListPackingOptions(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "ListPlacementOptions",
+		Method:     "GET",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/placementOptions",
+		PathParams: []string{"inboundPlanId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).ListPlacementOptions(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "ListPrepDetails",
+		Method:     "GET",
+		Path:       "/inbound/fba/2024-03-20/items/prepDetails",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).ListPrepDetails(ctx, query)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "ListShipmentBoxes",
+		Method:     "GET",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/boxes",
+		PathParams: []string{"inboundPlanId", "shipmentId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).ListShipmentBoxes(ctx, p[0], p[1], query)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "ListShipmentContentUpdatePreviews",
+		Method:     "GET",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/contentUpdatePreviews",
+		PathParams: []string{"inboundPlanId", "shipmentId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).ListShipmentContentUpdatePreviews(ctx, p[0], p[1], query)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "ListShipmentItems",
+		Method:     "GET",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/items",
+		PathParams: []string{"inboundPlanId", "shipmentId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).ListShipmentItems(ctx, p[0], p[1], query)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "ListShipmentPallets",
+		Method:     "GET",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/pallets",
+		PathParams: []string{"inboundPlanId", "shipmentId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).ListShipmentPallets(ctx, p[0], p[1], query)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "ListTransportationOptions",
+		Method:     "GET",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/transportationOptions",
+		PathParams: []string{"inboundPlanId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).ListTransportationOptions(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "ScheduleSelfShipAppointment",
+		Method:     "POST",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/selfShipAppointmentSlots/{slotId}/schedule",
+		PathParams: []string{"inboundPlanId", "shipmentId", "slotId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).ScheduleSelfShipAppointment(ctx, p[0], p[1], p[2], body)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "SetPackingInformation",
+		Method:     "POST",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/packingInformation",
+		PathParams: []string{"inboundPlanId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).SetPackingInformation(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "SetPrepDetails",
+		Method:     "POST",
+		Path:       "/inbound/fba/2024-03-20/items/prepDetails",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).SetPrepDetails(ctx, body)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "UpdateInboundPlanName",
+		Method:     "PUT",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/name",
+		PathParams: []string{"inboundPlanId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).UpdateInboundPlanName(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "UpdateItemComplianceDetails",
+		Method:     "PUT",
+		Path:       "/inbound/fba/2024-03-20/items/compliance",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).UpdateItemComplianceDetails(ctx, body)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "UpdateShipmentName",
+		Method:     "PUT",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/name",
+		PathParams: []string{"inboundPlanId", "shipmentId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).UpdateShipmentName(ctx, p[0], p[1], body)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "UpdateShipmentSourceAddress",
+		Method:     "PUT",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/sourceAddress",
+		PathParams: []string{"inboundPlanId", "shipmentId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).UpdateShipmentSourceAddress(ctx, p[0], p[1], body)
+		},
+	},
+	{
+		API:        "fulfillment-inbound",
+		Version:    "v2024-03-20",
+		Name:       "UpdateShipmentTrackingDetails",
+		Method:     "PUT",
+		Path:       "/inbound/fba/2024-03-20/inboundPlans/{inboundPlanId}/shipments/{shipmentId}/trackingDetails",
+		PathParams: []string{"inboundPlanId", "shipmentId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_inbound_v2024_03_20.NewClient(c).UpdateShipmentTrackingDetails(ctx, p[0], p[1], body)
+		},
+	},
+	{
+		API:        "fulfillment-outbound",
+		Version:    "v2020-07-01",
+		Name:       "CancelFulfillmentOrder",
+		Method:     "PUT",
+		Path:       "/fba/outbound/2020-07-01/fulfillmentOrders/{sellerFulfillmentOrderId}/cancel",
+		PathParams: []string{"sellerFulfillmentOrderId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_outbound_v2020_07_01.NewClient(c).CancelFulfillmentOrder(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "fulfillment-outbound",
+		Version:    "v2020-07-01",
+		Name:       "CreateFulfillmentOrder",
+		Method:     "POST",
+		Path:       "/fba/outbound/2020-07-01/fulfillmentOrders",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_outbound_v2020_07_01.NewClient(c).CreateFulfillmentOrder(ctx, body)
+		},
+	},
+	{
+		API:        "fulfillment-outbound",
+		Version:    "v2020-07-01",
+		Name:       "CreateFulfillmentReturn",
+		Method:     "PUT",
+		Path:       "/fba/outbound/2020-07-01/fulfillmentOrders/{sellerFulfillmentOrderId}/return",
+		PathParams: []string{"sellerFulfillmentOrderId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_outbound_v2020_07_01.NewClient(c).CreateFulfillmentReturn(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "fulfillment-outbound",
+		Version:    "v2020-07-01",
+		Name:       "DeliveryOffers",
+		Method:     "POST",
+		Path:       "/fba/outbound/2020-07-01/deliveryOffers",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_outbound_v2020_07_01.NewClient(c).DeliveryOffers(ctx, body)
+		},
+	},
+	{
+		API:        "fulfillment-outbound",
+		Version:    "v2020-07-01",
+		Name:       "GetFeatureInventory",
+		Method:     "GET",
+		Path:       "/fba/outbound/2020-07-01/features/inventory/{featureName}",
+		PathParams: []string{"featureName"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_outbound_v2020_07_01.NewClient(c).GetFeatureInventory(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "fulfillment-outbound",
+		Version:    "v2020-07-01",
+		Name:       "GetFeatureSKU",
+		Method:     "GET",
+		Path:       "/fba/outbound/2020-07-01/features/inventory/{featureName}/{sellerSku}",
+		PathParams: []string{"featureName", "sellerSku"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_outbound_v2020_07_01.NewClient(c).GetFeatureSKU(ctx, p[0], p[1], query)
+		},
+	},
+	{
+		API:        "fulfillment-outbound",
+		Version:    "v2020-07-01",
+		Name:       "GetFeatures",
+		Method:     "GET",
+		Path:       "/fba/outbound/2020-07-01/features",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_outbound_v2020_07_01.NewClient(c).GetFeatures(ctx, query)
+		},
+	},
+	{
+		API:        "fulfillment-outbound",
+		Version:    "v2020-07-01",
+		Name:       "GetFulfillmentOrder",
+		Method:     "GET",
+		Path:       "/fba/outbound/2020-07-01/fulfillmentOrders/{sellerFulfillmentOrderId}",
+		PathParams: []string{"sellerFulfillmentOrderId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_outbound_v2020_07_01.NewClient(c).GetFulfillmentOrder(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "fulfillment-outbound",
+		Version:    "v2020-07-01",
+		Name:       "GetFulfillmentPreview",
+		Method:     "POST",
+		Path:       "/fba/outbound/2020-07-01/fulfillmentOrders/preview",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_outbound_v2020_07_01.NewClient(c).GetFulfillmentPreview(ctx, body)
+		},
+	},
+	{
+		API:        "fulfillment-outbound",
+		Version:    "v2020-07-01",
+		Name:       "GetPackageTrackingDetails",
+		Method:     "GET",
+		Path:       "/fba/outbound/2020-07-01/tracking",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_outbound_v2020_07_01.NewClient(c).GetPackageTrackingDetails(ctx, query)
+		},
+	},
+	{
+		API:        "fulfillment-outbound",
+		Version:    "v2020-07-01",
+		Name:       "ListAllFulfillmentOrders",
+		Method:     "GET",
+		Path:       "/fba/outbound/2020-07-01/fulfillmentOrders",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_outbound_v2020_07_01.NewClient(c).ListAllFulfillmentOrders(ctx, query)
+		},
+		Iterator: "IterateAllFulfillmentOrders",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return fulfillment_outbound_v2020_07_01.NewClient(c).IterateAllFulfillmentOrders(ctx, query)
+		},
+	},
+	{
+		API:        "fulfillment-outbound",
+		Version:    "v2020-07-01",
+		Name:       "ListReturnReasonCodes",
+		Method:     "GET",
+		Path:       "/fba/outbound/2020-07-01/returnReasonCodes",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_outbound_v2020_07_01.NewClient(c).ListReturnReasonCodes(ctx, query)
+		},
+	},
+	{
+		API:        "fulfillment-outbound",
+		Version:    "v2020-07-01",
+		Name:       "SubmitFulfillmentOrderStatusUpdate",
+		Method:     "PUT",
+		Path:       "/fba/outbound/2020-07-01/fulfillmentOrders/{sellerFulfillmentOrderId}/status",
+		PathParams: []string{"sellerFulfillmentOrderId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_outbound_v2020_07_01.NewClient(c).SubmitFulfillmentOrderStatusUpdate(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "fulfillment-outbound",
+		Version:    "v2020-07-01",
+		Name:       "UpdateFulfillmentOrder",
+		Method:     "PUT",
+		Path:       "/fba/outbound/2020-07-01/fulfillmentOrders/{sellerFulfillmentOrderId}",
+		PathParams: []string{"sellerFulfillmentOrderId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return fulfillment_outbound_v2020_07_01.NewClient(c).UpdateFulfillmentOrder(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "listings-items",
+		Version:    "v2020-09-01",
+		Name:       "DeleteListingsItem",
+		Method:     "DELETE",
+		Path:       "/listings/2020-09-01/items/{sellerId}/{sku}",
+		PathParams: []string{"sellerId", "sku"},
+		Input:      InputNone,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return listings_items_v2020_09_01.NewClient(c).DeleteListingsItem(ctx, p[0], p[1])
+		},
+	},
+	{
+		API:        "listings-items",
+		Version:    "v2020-09-01",
+		Name:       "PatchListingsItem",
+		Method:     "PATCH",
+		Path:       "/listings/2020-09-01/items/{sellerId}/{sku}",
+		PathParams: []string{"sellerId", "sku"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return listings_items_v2020_09_01.NewClient(c).PatchListingsItem(ctx, p[0], p[1], body)
+		},
+	},
+	{
+		API:        "listings-items",
+		Version:    "v2020-09-01",
+		Name:       "PutListingsItem",
+		Method:     "PUT",
+		Path:       "/listings/2020-09-01/items/{sellerId}/{sku}",
+		PathParams: []string{"sellerId", "sku"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return listings_items_v2020_09_01.NewClient(c).PutListingsItem(ctx, p[0], p[1], body)
+		},
+	},
+	{
+		API:        "listings-items",
+		Version:    "v2021-08-01",
+		Name:       "DeleteListingsItem",
+		Method:     "DELETE",
+		Path:       "/listings/2021-08-01/items/{sellerId}/{sku}",
+		PathParams: []string{"sellerId", "sku"},
+		Input:      InputNone,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return listings_items_v2021_08_01.NewClient(c).DeleteListingsItem(ctx, p[0], p[1])
+		},
+	},
+	{
+		API:        "listings-items",
+		Version:    "v2021-08-01",
+		Name:       "GetListingsItem",
+		Method:     "GET",
+		Path:       "/listings/2021-08-01/items/{sellerId}/{sku}",
+		PathParams: []string{"sellerId", "sku"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return listings_items_v2021_08_01.NewClient(c).GetListingsItem(ctx, p[0], p[1], query)
+		},
+	},
+	{
+		API:        "listings-items",
+		Version:    "v2021-08-01",
+		Name:       "PatchListingsItem",
+		Method:     "PATCH",
+		Path:       "/listings/2021-08-01/items/{sellerId}/{sku}",
+		PathParams: []string{"sellerId", "sku"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return listings_items_v2021_08_01.NewClient(c).PatchListingsItem(ctx, p[0], p[1], body)
+		},
+	},
+	{
+		API:        "listings-items",
+		Version:    "v2021-08-01",
+		Name:       "PutListingsItem",
+		Method:     "PUT",
+		Path:       "/listings/2021-08-01/items/{sellerId}/{sku}",
+		PathParams: []string{"sellerId", "sku"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return listings_items_v2021_08_01.NewClient(c).PutListingsItem(ctx, p[0], p[1], body)
+		},
+	},
+	{
+		API:        "listings-items",
+		Version:    "v2021-08-01",
+		Name:       "SearchListingsItems",
+		Method:     "GET",
+		Path:       "/listings/2021-08-01/items/{sellerId}",
+		PathParams: []string{"sellerId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return listings_items_v2021_08_01.NewClient(c).SearchListingsItems(ctx, p[0], query)
+		},
+		Iterator: "IterateListingsItems",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return listings_items_v2021_08_01.NewClient(c).IterateListingsItems(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "listings-restrictions",
+		Version:    "v2021-08-01",
+		Name:       "GetListingsRestrictions",
+		Method:     "GET",
+		Path:       "/listings/2021-08-01/restrictions",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return listings_restrictions_v2021_08_01.NewClient(c).GetListingsRestrictions(ctx, query)
+		},
+	},
+	{
+		API:        "merchant-fulfillment",
+		Version:    "v0",
+		Name:       "CancelShipment",
+		Method:     "DELETE",
+		Path:       "/mfn/v0/shipments/{shipmentId}",
+		PathParams: []string{"shipmentId"},
+		Input:      InputNone,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return merchant_fulfillment_v0.NewClient(c).CancelShipment(ctx, p[0])
+		},
+	},
+	{
+		API:        "merchant-fulfillment",
+		Version:    "v0",
+		Name:       "CreateShipment",
+		Method:     "POST",
+		Path:       "/mfn/v0/shipments",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return merchant_fulfillment_v0.NewClient(c).CreateShipment(ctx, body)
+		},
+	},
+	{
+		API:        "merchant-fulfillment",
+		Version:    "v0",
+		Name:       "GetAdditionalSellerInputs",
+		Method:     "POST",
+		Path:       "/mfn/v0/additionalSellerInputs",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return merchant_fulfillment_v0.NewClient(c).GetAdditionalSellerInputs(ctx, body)
+		},
+	},
+	{
+		API:        "merchant-fulfillment",
+		Version:    "v0",
+		Name:       "GetEligibleShipmentServices",
+		Method:     "POST",
+		Path:       "/mfn/v0/eligibleShippingServices",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return merchant_fulfillment_v0.NewClient(c).GetEligibleShipmentServices(ctx, body)
+		},
+	},
+	{
+		API:        "merchant-fulfillment",
+		Version:    "v0",
+		Name:       "GetShipment",
+		Method:     "GET",
+		Path:       "/mfn/v0/shipments/{shipmentId}",
+		PathParams: []string{"shipmentId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return merchant_fulfillment_v0.NewClient(c).GetShipment(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "messaging",
+		Version:    "v1",
+		Name:       "ConfirmCustomizationDetails",
+		Method:     "POST",
+		Path:       "/messaging/v1/orders/{amazonOrderId}/messages/confirmCustomizationDetails",
+		PathParams: []string{"amazonOrderId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return messaging_v1.NewClient(c).ConfirmCustomizationDetails(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "messaging",
+		Version:    "v1",
+		Name:       "CreateAmazonMotors",
+		Method:     "POST",
+		Path:       "/messaging/v1/orders/{amazonOrderId}/messages/amazonMotors",
+		PathParams: []string{"amazonOrderId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return messaging_v1.NewClient(c).CreateAmazonMotors(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "messaging",
+		Version:    "v1",
+		Name:       "CreateConfirmDeliveryDetails",
+		Method:     "POST",
+		Path:       "/messaging/v1/orders/{amazonOrderId}/messages/confirmDeliveryDetails",
+		PathParams: []string{"amazonOrderId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return messaging_v1.NewClient(c).CreateConfirmDeliveryDetails(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "messaging",
+		Version:    "v1",
+		Name:       "CreateConfirmOrderDetails",
+		Method:     "POST",
+		Path:       "/messaging/v1/orders/{amazonOrderId}/messages/confirmOrderDetails",
+		PathParams: []string{"amazonOrderId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return messaging_v1.NewClient(c).CreateConfirmOrderDetails(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "messaging",
+		Version:    "v1",
+		Name:       "CreateConfirmServiceDetails",
+		Method:     "POST",
+		Path:       "/messaging/v1/orders/{amazonOrderId}/messages/confirmServiceDetails",
+		PathParams: []string{"amazonOrderId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return messaging_v1.NewClient(c).CreateConfirmServiceDetails(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "messaging",
+		Version:    "v1",
+		Name:       "CreateDigitalAccessKey",
+		Method:     "POST",
+		Path:       "/messaging/v1/orders/{amazonOrderId}/messages/digitalAccessKey",
+		PathParams: []string{"amazonOrderId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return messaging_v1.NewClient(c).CreateDigitalAccessKey(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "messaging",
+		Version:    "v1",
+		Name:       "CreateLegalDisclosure",
+		Method:     "POST",
+		Path:       "/messaging/v1/orders/{amazonOrderId}/messages/legalDisclosure",
+		PathParams: []string{"amazonOrderId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return messaging_v1.NewClient(c).CreateLegalDisclosure(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "messaging",
+		Version:    "v1",
+		Name:       "CreateUnexpectedProblem",
+		Method:     "POST",
+		Path:       "/messaging/v1/orders/{amazonOrderId}/messages/unexpectedProblem",
+		PathParams: []string{"amazonOrderId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return messaging_v1.NewClient(c).CreateUnexpectedProblem(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "messaging",
+		Version:    "v1",
+		Name:       "CreateWarranty",
+		Method:     "POST",
+		Path:       "/messaging/v1/orders/{amazonOrderId}/messages/warranty",
+		PathParams: []string{"amazonOrderId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return messaging_v1.NewClient(c).CreateWarranty(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "messaging",
+		Version:    "v1",
+		Name:       "GetAttributes",
+		Method:     "GET",
+		Path:       "/messaging/v1/orders/{amazonOrderId}/attributes",
+		PathParams: []string{"amazonOrderId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return messaging_v1.NewClient(c).GetAttributes(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "messaging",
+		Version:    "v1",
+		Name:       "GetMessagingActionsForOrder",
+		Method:     "GET",
+		Path:       "/messaging/v1/orders/{amazonOrderId}",
+		PathParams: []string{"amazonOrderId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return messaging_v1.NewClient(c).GetMessagingActionsForOrder(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "messaging",
+		Version:    "v1",
+		Name:       "SendInvoice",
+		Method:     "POST",
+		Path:       "/messaging/v1/orders/{amazonOrderId}/messages/invoice",
+		PathParams: []string{"amazonOrderId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return messaging_v1.NewClient(c).SendInvoice(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "notifications",
+		Version:    "v1",
+		Name:       "CreateDestination",
+		Method:     "POST",
+		Path:       "/notifications/v1/destinations",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return notifications_v1.NewClient(c).CreateDestination(ctx, body)
+		},
+	},
+	{
+		API:        "notifications",
+		Version:    "v1",
+		Name:       "CreateSubscription",
+		Method:     "POST",
+		Path:       "/notifications/v1/subscriptions/{notificationType}",
+		PathParams: []string{"notificationType"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return notifications_v1.NewClient(c).CreateSubscription(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "notifications",
+		Version:    "v1",
+		Name:       "DeleteDestination",
+		Method:     "DELETE",
+		Path:       "/notifications/v1/destinations/{destinationId}",
+		PathParams: []string{"destinationId"},
+		Input:      InputNone,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return notifications_v1.NewClient(c).DeleteDestination(ctx, p[0])
+		},
+	},
+	{
+		API:        "notifications",
+		Version:    "v1",
+		Name:       "DeleteSubscriptionById",
+		Method:     "DELETE",
+		Path:       "/notifications/v1/subscriptions/{notificationType}/{subscriptionId}",
+		PathParams: []string{"notificationType", "subscriptionId"},
+		Input:      InputNone,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return notifications_v1.NewClient(c).DeleteSubscriptionById(ctx, p[0], p[1])
+		},
+	},
+	{
+		API:        "notifications",
+		Version:    "v1",
+		Name:       "GetDestination",
+		Method:     "GET",
+		Path:       "/notifications/v1/destinations/{destinationId}",
+		PathParams: []string{"destinationId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return notifications_v1.NewClient(c).GetDestination(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "notifications",
+		Version:    "v1",
+		Name:       "GetDestinations",
+		Method:     "GET",
+		Path:       "/notifications/v1/destinations",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return notifications_v1.NewClient(c).GetDestinations(ctx, query)
+		},
+	},
+	{
+		API:        "notifications",
+		Version:    "v1",
+		Name:       "GetSubscription",
+		Method:     "GET",
+		Path:       "/notifications/v1/subscriptions/{notificationType}",
+		PathParams: []string{"notificationType"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return notifications_v1.NewClient(c).GetSubscription(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "notifications",
+		Version:    "v1",
+		Name:       "GetSubscriptionById",
+		Method:     "GET",
+		Path:       "/notifications/v1/subscriptions/{notificationType}/{subscriptionId}",
+		PathParams: []string{"notificationType", "subscriptionId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return notifications_v1.NewClient(c).GetSubscriptionById(ctx, p[0], p[1], query)
+		},
+	},
+	{
+		API:        "product-pricing",
+		Version:    "v0",
+		Name:       "GetCompetitivePricing",
+		Method:     "GET",
+		Path:       "/products/pricing/v0/competitivePrice",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return product_pricing_v0.NewClient(c).GetCompetitivePricing(ctx, query)
+		},
+	},
+	{
+		API:        "product-pricing",
+		Version:    "v0",
+		Name:       "GetItemOffers",
+		Method:     "GET",
+		Path:       "/products/pricing/v0/items/{Asin}/offers",
+		PathParams: []string{"asin"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return product_pricing_v0.NewClient(c).GetItemOffers(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "product-pricing",
+		Version:    "v0",
+		Name:       "GetItemOffersBatch",
+		Method:     "POST",
+		Path:       "/batches/products/pricing/v0/itemOffers",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return product_pricing_v0.NewClient(c).GetItemOffersBatch(ctx, body)
+		},
+	},
+	{
+		API:        "product-pricing",
+		Version:    "v0",
+		Name:       "GetListingOffers",
+		Method:     "GET",
+		Path:       "/products/pricing/v0/listings/{SellerSKU}/offers",
+		PathParams: []string{"sellerSKU"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return product_pricing_v0.NewClient(c).GetListingOffers(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "product-pricing",
+		Version:    "v0",
+		Name:       "GetListingOffersBatch",
+		Method:     "POST",
+		Path:       "/batches/products/pricing/v0/listingOffers",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return product_pricing_v0.NewClient(c).GetListingOffersBatch(ctx, body)
+		},
+	},
+	{
+		API:        "product-pricing",
+		Version:    "v0",
+		Name:       "GetPricing",
+		Method:     "GET",
+		Path:       "/products/pricing/v0/price",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return product_pricing_v0.NewClient(c).GetPricing(ctx, query)
+		},
+	},
+	{
+		API:        "product-pricing",
+		Version:    "v2022-05-01",
+		Name:       "GetCompetitiveSummary",
+		Method:     "POST",
+		Path:       "/batches/products/pricing/2022-05-01/items/competitiveSummary",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return product_pricing_v2022_05_01.NewClient(c).GetCompetitiveSummary(ctx, body)
+		},
+	},
+	{
+		API:        "product-pricing",
+		Version:    "v2022-05-01",
+		Name:       "GetFeaturedOfferExpectedPriceBatch",
+		Method:     "POST",
+		Path:       "/batches/products/pricing/2022-05-01/offer/featuredOfferExpectedPrice",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return product_pricing_v2022_05_01.NewClient(c).GetFeaturedOfferExpectedPriceBatch(ctx, body)
+		},
+	},
+	{
+		API:        "product-fees",
+		Version:    "v0",
+		Name:       "GetMyFeesEstimateForASIN",
+		Method:     "POST",
+		Path:       "/products/fees/v0/items/{Asin}/feesEstimate",
+		PathParams: []string{"asin"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return product_fees_v0.NewClient(c).GetMyFeesEstimateForASIN(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "product-fees",
+		Version:    "v0",
+		Name:       "GetMyFeesEstimateForSKU",
+		Method:     "POST",
+		Path:       "/products/fees/v0/listings/{SellerSKU}/feesEstimate",
+		PathParams: []string{"sellerSKU"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return product_fees_v0.NewClient(c).GetMyFeesEstimateForSKU(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "product-fees",
+		Version:    "v0",
+		Name:       "GetMyFeesEstimates",
+		Method:     "POST",
+		Path:       "/products/fees/v0/feesEstimate",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return product_fees_v0.NewClient(c).GetMyFeesEstimates(ctx, body)
+		},
+	},
+	{
+		API:        "product-type-definitions",
+		Version:    "v2020-09-01",
+		Name:       "GetDefinitionsProductType",
+		Method:     "GET",
+		Path:       "/definitions/2020-09-01/productTypes/{productType}",
+		PathParams: []string{"productType"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return product_type_definitions_v2020_09_01.NewClient(c).GetDefinitionsProductType(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "product-type-definitions",
+		Version:    "v2020-09-01",
+		Name:       "SearchDefinitionsProductTypes",
+		Method:     "GET",
+		Path:       "/definitions/2020-09-01/productTypes",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return product_type_definitions_v2020_09_01.NewClient(c).SearchDefinitionsProductTypes(ctx, query)
+		},
+	},
+	{
+		API:        "replenishment",
+		Version:    "v2022-11-07",
+		Name:       "GetSellingPartnerMetrics",
+		Method:     "POST",
+		Path:       "/replenishment/2022-11-07/sellingPartners/metrics/search",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return replenishment_v2022_11_07.NewClient(c).GetSellingPartnerMetrics(ctx, body)
+		},
+	},
+	{
+		API:        "replenishment",
+		Version:    "v2022-11-07",
+		Name:       "ListOfferMetrics",
+		Method:     "POST",
+		Path:       "/replenishment/2022-11-07/offers/metrics/search",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return replenishment_v2022_11_07.NewClient(c).ListOfferMetrics(ctx, body)
+		},
+	},
+	{
+		API:        "replenishment",
+		Version:    "v2022-11-07",
+		Name:       "ListOffers",
+		Method:     "POST",
+		Path:       "/replenishment/2022-11-07/offers/search",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return replenishment_v2022_11_07.NewClient(c).ListOffers(ctx, body)
+		},
+	},
+	{
+		API:        "sales",
+		Version:    "v1",
+		Name:       "GetOrderMetrics",
+		Method:     "GET",
+		Path:       "/sales/v1/orderMetrics",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return sales_v1.NewClient(c).GetOrderMetrics(ctx, query)
+		},
+	},
+	{
+		API:        "sellers",
+		Version:    "v1",
+		Name:       "GetAccount",
+		Method:     "GET",
+		Path:       "/sellers/v1/account",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return sellers_v1.NewClient(c).GetAccount(ctx, query)
+		},
+	},
+	{
+		API:        "sellers",
+		Version:    "v1",
+		Name:       "GetMarketplaceParticipations",
+		Method:     "GET",
+		Path:       "/sellers/v1/marketplaceParticipations",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return sellers_v1.NewClient(c).GetMarketplaceParticipations(ctx, query)
+		},
+	},
+	{
+		API:        "seller-wallet",
+		Version:    "v2024-03-01",
+		Name:       "CreateTransaction",
+		Method:     "POST",
+		Path:       "/finances/transfers/wallet/2024-03-01/transactions",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return seller_wallet_v2024_03_01.NewClient(c).CreateTransaction(ctx, body)
+		},
+	},
+	{
+		API:        "seller-wallet",
+		Version:    "v2024-03-01",
+		Name:       "CreateTransferSchedule",
+		Method:     "POST",
+		Path:       "/finances/transfers/wallet/2024-03-01/transferSchedules",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return seller_wallet_v2024_03_01.NewClient(c).CreateTransferSchedule(ctx, body)
+		},
+	},
+	{
+		API:        "seller-wallet",
+		Version:    "v2024-03-01",
+		Name:       "DeleteScheduleTransaction",
+		Method:     "DELETE",
+		Path:       "/finances/transfers/wallet/2024-03-01/transferSchedules/{transferScheduleId}",
+		PathParams: []string{"transferScheduleId"},
+		Input:      InputNone,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return seller_wallet_v2024_03_01.NewClient(c).DeleteScheduleTransaction(ctx, p[0])
+		},
+	},
+	{
+		API:        "seller-wallet",
+		Version:    "v2024-03-01",
+		Name:       "GetAccount",
+		Method:     "GET",
+		Path:       "/finances/transfers/wallet/2024-03-01/accounts/{accountId}",
+		PathParams: []string{"accountId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return seller_wallet_v2024_03_01.NewClient(c).GetAccount(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "seller-wallet",
+		Version:    "v2024-03-01",
+		Name:       "GetTransaction",
+		Method:     "GET",
+		Path:       "/finances/transfers/wallet/2024-03-01/transactions/{transactionId}",
+		PathParams: []string{"transactionId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return seller_wallet_v2024_03_01.NewClient(c).GetTransaction(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "seller-wallet",
+		Version:    "v2024-03-01",
+		Name:       "GetTransferPreview",
+		Method:     "GET",
+		Path:       "/finances/transfers/wallet/2024-03-01/transferPreview",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return seller_wallet_v2024_03_01.NewClient(c).GetTransferPreview(ctx, query)
+		},
+	},
+	{
+		API:        "seller-wallet",
+		Version:    "v2024-03-01",
+		Name:       "GetTransferSchedule",
+		Method:     "GET",
+		Path:       "/finances/transfers/wallet/2024-03-01/transferSchedules/{transferScheduleId}",
+		PathParams: []string{"transferScheduleId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return seller_wallet_v2024_03_01.NewClient(c).GetTransferSchedule(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "seller-wallet",
+		Version:    "v2024-03-01",
+		Name:       "ListAccountBalances",
+		Method:     "GET",
+		Path:       "/finances/transfers/wallet/2024-03-01/accounts/{accountId}/balance",
+		PathParams: []string{"accountId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return seller_wallet_v2024_03_01.NewClient(c).ListAccountBalances(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "seller-wallet",
+		Version:    "v2024-03-01",
+		Name:       "ListAccountTransactions",
+		Method:     "GET",
+		Path:       "/finances/transfers/wallet/2024-03-01/transactions",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return seller_wallet_v2024_03_01.NewClient(c).ListAccountTransactions(ctx, query)
+		},
+		Iterator: "IterateAccountTransactions",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return seller_wallet_v2024_03_01.NewClient(c).IterateAccountTransactions(ctx, query)
+		},
+	},
+	{
+		API:        "seller-wallet",
+		Version:    "v2024-03-01",
+		Name:       "ListAccounts",
+		Method:     "GET",
+		Path:       "/finances/transfers/wallet/2024-03-01/accounts",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return seller_wallet_v2024_03_01.NewClient(c).ListAccounts(ctx, query)
+		},
+	},
+	{
+		API:        "seller-wallet",
+		Version:    "v2024-03-01",
+		Name:       "ListTransferSchedules",
+		Method:     "GET",
+		Path:       "/finances/transfers/wallet/2024-03-01/transferSchedules",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return seller_wallet_v2024_03_01.NewClient(c).ListTransferSchedules(ctx, query)
+		},
+	},
+	{
+		API:        "seller-wallet",
+		Version:    "v2024-03-01",
+		Name:       "UpdateTransferSchedule",
+		Method:     "PUT",
+		Path:       "/finances/transfers/wallet/2024-03-01/transferSchedules",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return seller_wallet_v2024_03_01.NewClient(c).UpdateTransferSchedule(ctx, body)
+		},
+	},
+	{
+		API:        "services",
+		Version:    "v1",
+		Name:       "AddAppointmentForServiceJobByServiceJobId",
+		Method:     "POST",
+		Path:       "/service/v1/serviceJobs/{serviceJobId}/appointments",
+		PathParams: []string{"serviceJobId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return services_v1.NewClient(c).AddAppointmentForServiceJobByServiceJobId(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "services",
+		Version:    "v1",
+		Name:       "AssignAppointmentResources",
+		Method:     "PUT",
+		Path:       "/service/v1/serviceJobs/{serviceJobId}/appointments/{appointmentId}/resources",
+		PathParams: []string{"serviceJobId", "appointmentId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return services_v1.NewClient(c).AssignAppointmentResources(ctx, p[0], p[1], body)
+		},
+	},
+	{
+		API:        "services",
+		Version:    "v1",
+		Name:       "CancelReservation",
+		Method:     "DELETE",
+		Path:       "/service/v1/reservation/{reservationId}",
+		PathParams: []string{"reservationId"},
+		Input:      InputNone,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return services_v1.NewClient(c).CancelReservation(ctx, p[0])
+		},
+	},
+	{
+		API:        "services",
+		Version:    "v1",
+		Name:       "CancelServiceJobByServiceJobId",
+		Method:     "PUT",
+		Path:       "/service/v1/serviceJobs/{serviceJobId}/cancellations",
+		PathParams: []string{"serviceJobId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return services_v1.NewClient(c).CancelServiceJobByServiceJobId(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "services",
+		Version:    "v1",
+		Name:       "CompleteServiceJobByServiceJobId",
+		Method:     "PUT",
+		Path:       "/service/v1/serviceJobs/{serviceJobId}/completions",
+		PathParams: []string{"serviceJobId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return services_v1.NewClient(c).CompleteServiceJobByServiceJobId(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "services",
+		Version:    "v1",
+		Name:       "CreateReservation",
+		Method:     "POST",
+		Path:       "/service/v1/reservation",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return services_v1.NewClient(c).CreateReservation(ctx, body)
+		},
+	},
+	{
+		API:        "services",
+		Version:    "v1",
+		Name:       "CreateServiceDocumentUploadDestination",
+		Method:     "POST",
+		Path:       "/service/v1/documents",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return services_v1.NewClient(c).CreateServiceDocumentUploadDestination(ctx, body)
+		},
+	},
+	{
+		API:        "services",
+		Version:    "v1",
+		Name:       "GetAppointmentSlots",
+		Method:     "GET",
+		Path:       "/service/v1/appointmentSlots",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return services_v1.NewClient(c).GetAppointmentSlots(ctx, query)
+		},
+	},
+	{
+		API:        "services",
+		Version:    "v1",
+		Name:       "GetAppointmmentSlotsByJobId",
+		Method:     "GET",
+		Path:       "/service/v1/serviceJobs/{serviceJobId}/appointmentSlots",
+		PathParams: []string{"serviceJobId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return services_v1.NewClient(c).GetAppointmmentSlotsByJobId(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "services",
+		Version:    "v1",
+		Name:       "GetFixedSlotCapacity",
+		Method:     "POST",
+		Path:       "/service/v1/serviceResources/{resourceId}/capacity/fixed",
+		PathParams: []string{"resourceId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return services_v1.NewClient(c).GetFixedSlotCapacity(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "services",
+		Version:    "v1",
+		Name:       "GetRangeSlotCapacity",
+		Method:     "POST",
+		Path:       "/service/v1/serviceResources/{resourceId}/capacity/range",
+		PathParams: []string{"resourceId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return services_v1.NewClient(c).GetRangeSlotCapacity(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "services",
+		Version:    "v1",
+		Name:       "GetServiceJobByServiceJobId",
+		Method:     "GET",
+		Path:       "/service/v1/serviceJobs/{serviceJobId}",
+		PathParams: []string{"serviceJobId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return services_v1.NewClient(c).GetServiceJobByServiceJobId(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "services",
+		Version:    "v1",
+		Name:       "GetServiceJobs",
+		Method:     "GET",
+		Path:       "/service/v1/serviceJobs",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return services_v1.NewClient(c).GetServiceJobs(ctx, query)
+		},
+		Iterator: "IterateServiceJobs",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return services_v1.NewClient(c).IterateServiceJobs(ctx, query)
+		},
+	},
+	{
+		API:        "services",
+		Version:    "v1",
+		Name:       "RescheduleAppointmentForServiceJobByServiceJobId",
+		Method:     "POST",
+		Path:       "/service/v1/serviceJobs/{serviceJobId}/appointments/{appointmentId}",
+		PathParams: []string{"serviceJobId", "appointmentId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return services_v1.NewClient(c).RescheduleAppointmentForServiceJobByServiceJobId(ctx, p[0], p[1], body)
+		},
+	},
+	{
+		API:        "services",
+		Version:    "v1",
+		Name:       "SetAppointmentFulfillmentData",
+		Method:     "PUT",
+		Path:       "/service/v1/serviceJobs/{serviceJobId}/appointments/{appointmentId}/fulfillment",
+		PathParams: []string{"serviceJobId", "appointmentId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return services_v1.NewClient(c).SetAppointmentFulfillmentData(ctx, p[0], p[1], body)
+		},
+	},
+	{
+		API:        "services",
+		Version:    "v1",
+		Name:       "UpdateReservation",
+		Method:     "PUT",
+		Path:       "/service/v1/reservation/{reservationId}",
+		PathParams: []string{"reservationId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return services_v1.NewClient(c).UpdateReservation(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "services",
+		Version:    "v1",
+		Name:       "UpdateSchedule",
+		Method:     "PUT",
+		Path:       "/service/v1/serviceResources/{resourceId}/schedules",
+		PathParams: []string{"resourceId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return services_v1.NewClient(c).UpdateSchedule(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "shipping",
+		Version:    "v2",
+		Name:       "CancelShipment",
+		Method:     "PUT",
+		Path:       "/shipping/v2/shipments/{shipmentId}/cancel",
+		PathParams: []string{"shipmentId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return shipping_v2.NewClient(c).CancelShipment(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "shipping",
+		Version:    "v2",
+		Name:       "CreateClaim",
+		Method:     "POST",
+		Path:       "/shipping/v2/claims",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return shipping_v2.NewClient(c).CreateClaim(ctx, body)
+		},
+	},
+	{
+		API:        "shipping",
+		Version:    "v2",
+		Name:       "DirectPurchaseShipment",
+		Method:     "POST",
+		Path:       "/shipping/v2/shipments/directPurchase",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return shipping_v2.NewClient(c).DirectPurchaseShipment(ctx, body)
+		},
+	},
+	{
+		API:        "shipping",
+		Version:    "v2",
+		Name:       "GenerateCollectionForm",
+		Method:     "POST",
+		Path:       "/shipping/v2/collectionForms",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return shipping_v2.NewClient(c).GenerateCollectionForm(ctx, body)
+		},
+	},
+	{
+		API:        "shipping",
+		Version:    "v2",
+		Name:       "GetAccessPoints",
+		Method:     "GET",
+		Path:       "/shipping/v2/accessPoints",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return shipping_v2.NewClient(c).GetAccessPoints(ctx, query)
+		},
+	},
+	{
+		API:        "shipping",
+		Version:    "v2",
+		Name:       "GetAdditionalInputs",
+		Method:     "GET",
+		Path:       "/shipping/v2/shipments/additionalInputs/schema",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return shipping_v2.NewClient(c).GetAdditionalInputs(ctx, query)
+		},
+	},
+	{
+		API:        "shipping",
+		Version:    "v2",
+		Name:       "GetCarrierAccountFormInputs",
+		Method:     "GET",
+		Path:       "/shipping/v2/carrierAccountFormInputs",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return shipping_v2.NewClient(c).GetCarrierAccountFormInputs(ctx, query)
+		},
+	},
+	{
+		API:        "shipping",
+		Version:    "v2",
+		Name:       "GetCarrierAccounts",
+		Method:     "PUT",
+		Path:       "/shipping/v2/carrierAccounts",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return shipping_v2.NewClient(c).GetCarrierAccounts(ctx, body)
+		},
+	},
+	{
+		API:        "shipping",
+		Version:    "v2",
+		Name:       "GetCollectionForm",
+		Method:     "GET",
+		Path:       "/shipping/v2/collectionForms/{collectionFormId}",
+		PathParams: []string{"collectionFormId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return shipping_v2.NewClient(c).GetCollectionForm(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "shipping",
+		Version:    "v2",
+		Name:       "GetCollectionFormHistory",
+		Method:     "PUT",
+		Path:       "/shipping/v2/collectionForms/history",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return shipping_v2.NewClient(c).GetCollectionFormHistory(ctx, body)
+		},
+	},
+	{
+		API:        "shipping",
+		Version:    "v2",
+		Name:       "GetRates",
+		Method:     "POST",
+		Path:       "/shipping/v2/shipments/rates",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return shipping_v2.NewClient(c).GetRates(ctx, body)
+		},
+	},
+	{
+		API:        "shipping",
+		Version:    "v2",
+		Name:       "GetShipmentDocuments",
+		Method:     "GET",
+		Path:       "/shipping/v2/shipments/{shipmentId}/documents",
+		PathParams: []string{"shipmentId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return shipping_v2.NewClient(c).GetShipmentDocuments(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "shipping",
+		Version:    "v2",
+		Name:       "GetTracking",
+		Method:     "GET",
+		Path:       "/shipping/v2/tracking",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return shipping_v2.NewClient(c).GetTracking(ctx, query)
+		},
+	},
+	{
+		API:        "shipping",
+		Version:    "v2",
+		Name:       "GetUnmanifestedShipments",
+		Method:     "PUT",
+		Path:       "/shipping/v2/unmanifestedShipments",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return shipping_v2.NewClient(c).GetUnmanifestedShipments(ctx, body)
+		},
+	},
+	{
+		API:        "shipping",
+		Version:    "v2",
+		Name:       "LinkCarrierAccount",
+		Method:     "PUT",
+		Path:       "/shipping/v2/carrierAccounts/{carrierId}",
+		PathParams: []string{"carrierId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return shipping_v2.NewClient(c).LinkCarrierAccount(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "shipping",
+		Version:    "v2",
+		Name:       "OneClickShipment",
+		Method:     "POST",
+		Path:       "/shipping/v2/oneClickShipment",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return shipping_v2.NewClient(c).OneClickShipment(ctx, body)
+		},
+	},
+	{
+		API:        "shipping",
+		Version:    "v2",
+		Name:       "PurchaseShipment",
+		Method:     "POST",
+		Path:       "/shipping/v2/shipments",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return shipping_v2.NewClient(c).PurchaseShipment(ctx, body)
+		},
+	},
+	{
+		API:        "shipping",
+		Version:    "v2",
+		Name:       "SubmitNdrFeedback",
+		Method:     "POST",
+		Path:       "/shipping/v2/ndrFeedback",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return shipping_v2.NewClient(c).SubmitNdrFeedback(ctx, body)
+		},
+	},
+	{
+		API:        "shipping",
+		Version:    "v2",
+		Name:       "UnlinkCarrierAccount",
+		Method:     "PUT",
+		Path:       "/shipping/v2/carrierAccounts/{carrierId}/unlink",
+		PathParams: []string{"carrierId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return shipping_v2.NewClient(c).UnlinkCarrierAccount(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "solicitations",
+		Version:    "v1",
+		Name:       "CreateProductReviewAndSellerFeedbackSolicitation",
+		Method:     "POST",
+		Path:       "/solicitations/v1/orders/{amazonOrderId}/solicitations/productReviewAndSellerFeedback",
+		PathParams: []string{"amazonOrderId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return solicitations_v1.NewClient(c).CreateProductReviewAndSellerFeedbackSolicitation(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "solicitations",
+		Version:    "v1",
+		Name:       "GetSolicitationActionsForOrder",
+		Method:     "GET",
+		Path:       "/solicitations/v1/orders/{amazonOrderId}",
+		PathParams: []string{"amazonOrderId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return solicitations_v1.NewClient(c).GetSolicitationActionsForOrder(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "supply-sources",
+		Version:    "v2020-07-01",
+		Name:       "ArchiveSupplySource",
+		Method:     "DELETE",
+		Path:       "/supplySources/2020-07-01/supplySources/{supplySourceId}",
+		PathParams: []string{"supplySourceId"},
+		Input:      InputNone,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return supply_sources_v2020_07_01.NewClient(c).ArchiveSupplySource(ctx, p[0])
+		},
+	},
+	{
+		API:        "supply-sources",
+		Version:    "v2020-07-01",
+		Name:       "CreateSupplySource",
+		Method:     "POST",
+		Path:       "/supplySources/2020-07-01/supplySources",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return supply_sources_v2020_07_01.NewClient(c).CreateSupplySource(ctx, body)
+		},
+	},
+	{
+		API:        "supply-sources",
+		Version:    "v2020-07-01",
+		Name:       "GetSupplySource",
+		Method:     "GET",
+		Path:       "/supplySources/2020-07-01/supplySources/{supplySourceId}",
+		PathParams: []string{"supplySourceId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return supply_sources_v2020_07_01.NewClient(c).GetSupplySource(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "supply-sources",
+		Version:    "v2020-07-01",
+		Name:       "GetSupplySources",
+		Method:     "GET",
+		Path:       "/supplySources/2020-07-01/supplySources",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return supply_sources_v2020_07_01.NewClient(c).GetSupplySources(ctx, query)
+		},
+		Iterator: "IterateSupplySources",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return supply_sources_v2020_07_01.NewClient(c).IterateSupplySources(ctx, query)
+		},
+	},
+	{
+		API:        "supply-sources",
+		Version:    "v2020-07-01",
+		Name:       "UpdateSupplySource",
+		Method:     "PUT",
+		Path:       "/supplySources/2020-07-01/supplySources/{supplySourceId}",
+		PathParams: []string{"supplySourceId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return supply_sources_v2020_07_01.NewClient(c).UpdateSupplySource(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "supply-sources",
+		Version:    "v2020-07-01",
+		Name:       "UpdateSupplySourceStatus",
+		Method:     "PUT",
+		Path:       "/supplySources/2020-07-01/supplySources/{supplySourceId}/status",
+		PathParams: []string{"supplySourceId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return supply_sources_v2020_07_01.NewClient(c).UpdateSupplySourceStatus(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "tokens",
+		Version:    "v2021-03-01",
+		Name:       "CreateRestrictedDataToken",
+		Method:     "POST",
+		Path:       "/tokens/2021-03-01/restrictedDataToken",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return tokens_v2021_03_01.NewClient(c).CreateRestrictedDataToken(ctx, body)
+		},
+	},
+	{
+		API:        "finances",
+		Version:    "v2024-06-01-transfers",
+		Name:       "GetPaymentMethods",
+		Method:     "GET",
+		Path:       "/finances/transfers/2024-06-01/paymentMethods",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return finances_v2024_06_01_transfers.NewClient(c).GetPaymentMethods(ctx, query)
+		},
+	},
+	{
+		API:        "finances",
+		Version:    "v2024-06-01-transfers",
+		Name:       "InitiatePayout",
+		Method:     "POST",
+		Path:       "/finances/transfers/2024-06-01/payouts",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return finances_v2024_06_01_transfers.NewClient(c).InitiatePayout(ctx, body)
+		},
+	},
+	{
+		API:        "uploads",
+		Version:    "v2020-11-01",
+		Name:       "CreateUploadDestinationForResource",
+		Method:     "POST",
+		Path:       "/uploads/2020-11-01/uploadDestinations/{resource}",
+		PathParams: []string{"resource"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return uploads_v2020_11_01.NewClient(c).CreateUploadDestinationForResource(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "vehicles",
+		Version:    "v2024-11-01",
+		Name:       "GetVehicles",
+		Method:     "GET",
+		Path:       "/catalog/2024-11-01/automotive/vehicles",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vehicles_v2024_11_01.NewClient(c).GetVehicles(ctx, query)
+		},
+		Iterator: "IterateVehicles",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return vehicles_v2024_11_01.NewClient(c).IterateVehicles(ctx, query)
+		},
+	},
+	{
+		API:        "aplus-content",
+		Version:    "v2020-11-01",
+		Name:       "CreateContentDocument",
+		Method:     "POST",
+		Path:       "/aplus/2020-11-01/contentDocuments",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return aplus_content_v2020_11_01.NewClient(c).CreateContentDocument(ctx, body)
+		},
+	},
+	{
+		API:        "aplus-content",
+		Version:    "v2020-11-01",
+		Name:       "GetContentDocument",
+		Method:     "GET",
+		Path:       "/aplus/2020-11-01/contentDocuments/{contentReferenceKey}",
+		PathParams: []string{"contentReferenceKey"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return aplus_content_v2020_11_01.NewClient(c).GetContentDocument(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "aplus-content",
+		Version:    "v2020-11-01",
+		Name:       "ListContentDocumentAsinRelations",
+		Method:     "GET",
+		Path:       "/aplus/2020-11-01/contentDocuments/{contentReferenceKey}/asins",
+		PathParams: []string{"contentReferenceKey"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return aplus_content_v2020_11_01.NewClient(c).ListContentDocumentAsinRelations(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "aplus-content",
+		Version:    "v2020-11-01",
+		Name:       "PostContentDocumentApprovalSubmission",
+		Method:     "POST",
+		Path:       "/aplus/2020-11-01/contentDocuments/{contentReferenceKey}/approvalSubmissions",
+		PathParams: []string{"contentReferenceKey"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return aplus_content_v2020_11_01.NewClient(c).PostContentDocumentApprovalSubmission(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "aplus-content",
+		Version:    "v2020-11-01",
+		Name:       "PostContentDocumentAsinRelations",
+		Method:     "POST",
+		Path:       "/aplus/2020-11-01/contentDocuments/{contentReferenceKey}/asins",
+		PathParams: []string{"contentReferenceKey"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return aplus_content_v2020_11_01.NewClient(c).PostContentDocumentAsinRelations(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "aplus-content",
+		Version:    "v2020-11-01",
+		Name:       "PostContentDocumentSuspendSubmission",
+		Method:     "POST",
+		Path:       "/aplus/2020-11-01/contentDocuments/{contentReferenceKey}/suspendSubmissions",
+		PathParams: []string{"contentReferenceKey"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return aplus_content_v2020_11_01.NewClient(c).PostContentDocumentSuspendSubmission(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "aplus-content",
+		Version:    "v2020-11-01",
+		Name:       "SearchContentDocuments",
+		Method:     "GET",
+		Path:       "/aplus/2020-11-01/contentDocuments",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return aplus_content_v2020_11_01.NewClient(c).SearchContentDocuments(ctx, query)
+		},
+		Iterator: "IterateContentDocuments",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return aplus_content_v2020_11_01.NewClient(c).IterateContentDocuments(ctx, query)
+		},
+	},
+	{
+		API:        "aplus-content",
+		Version:    "v2020-11-01",
+		Name:       "SearchContentPublishRecords",
+		Method:     "GET",
+		Path:       "/aplus/2020-11-01/contentPublishRecords",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return aplus_content_v2020_11_01.NewClient(c).SearchContentPublishRecords(ctx, query)
+		},
+	},
+	{
+		API:        "aplus-content",
+		Version:    "v2020-11-01",
+		Name:       "UpdateContentDocument",
+		Method:     "POST",
+		Path:       "/aplus/2020-11-01/contentDocuments/{contentReferenceKey}",
+		PathParams: []string{"contentReferenceKey"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return aplus_content_v2020_11_01.NewClient(c).UpdateContentDocument(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "aplus-content",
+		Version:    "v2020-11-01",
+		Name:       "ValidateContentDocumentAsinRelations",
+		Method:     "POST",
+		Path:       "/aplus/2020-11-01/contentAsinValidations",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return aplus_content_v2020_11_01.NewClient(c).ValidateContentDocumentAsinRelations(ctx, body)
+		},
+	},
+	{
+		API:        "application-integrations",
+		Version:    "v2024-04-01",
+		Name:       "CreateNotification",
+		Method:     "POST",
+		Path:       "/appIntegrations/2024-04-01/notifications",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return application_integrations_v2024_04_01.NewClient(c).CreateNotification(ctx, body)
+		},
+	},
+	{
+		API:        "application-integrations",
+		Version:    "v2024-04-01",
+		Name:       "DeleteNotifications",
+		Method:     "POST",
+		Path:       "/appIntegrations/2024-04-01/notifications/deletion",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return application_integrations_v2024_04_01.NewClient(c).DeleteNotifications(ctx, body)
+		},
+	},
+	{
+		API:        "application-integrations",
+		Version:    "v2024-04-01",
+		Name:       "RecordActionFeedback",
+		Method:     "POST",
+		Path:       "/appIntegrations/2024-04-01/notifications/{notificationId}/feedback",
+		PathParams: []string{"notificationId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return application_integrations_v2024_04_01.NewClient(c).RecordActionFeedback(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "application-management",
+		Version:    "v2023-11-30",
+		Name:       "RotateApplicationClientSecret",
+		Method:     "POST",
+		Path:       "/applications/2023-11-30/clientSecret",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return application_management_v2023_11_30.NewClient(c).RotateApplicationClientSecret(ctx, body)
+		},
+	},
+	{
+		API:        "amazon-warehousing-and-distribution-model",
+		Version:    "v2024-05-09",
+		Name:       "CancelInbound",
+		Method:     "POST",
+		Path:       "/awd/2024-05-09/inboundOrders/{orderId}/cancellation",
+		PathParams: []string{"orderId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return amazon_warehousing_and_distribution_model_v2024_05_09.NewClient(c).CancelInbound(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "amazon-warehousing-and-distribution-model",
+		Version:    "v2024-05-09",
+		Name:       "CheckInboundEligibility",
+		Method:     "POST",
+		Path:       "/awd/2024-05-09/inboundEligibility",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return amazon_warehousing_and_distribution_model_v2024_05_09.NewClient(c).CheckInboundEligibility(ctx, body)
+		},
+	},
+	{
+		API:        "amazon-warehousing-and-distribution-model",
+		Version:    "v2024-05-09",
+		Name:       "ConfirmInbound",
+		Method:     "POST",
+		Path:       "/awd/2024-05-09/inboundOrders/{orderId}/confirmation",
+		PathParams: []string{"orderId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return amazon_warehousing_and_distribution_model_v2024_05_09.NewClient(c).ConfirmInbound(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "amazon-warehousing-and-distribution-model",
+		Version:    "v2024-05-09",
+		Name:       "CreateInbound",
+		Method:     "POST",
+		Path:       "/awd/2024-05-09/inboundOrders",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return amazon_warehousing_and_distribution_model_v2024_05_09.NewClient(c).CreateInbound(ctx, body)
+		},
+	},
+	{
+		API:        "amazon-warehousing-and-distribution-model",
+		Version:    "v2024-05-09",
+		Name:       "GetInbound",
+		Method:     "GET",
+		Path:       "/awd/2024-05-09/inboundOrders/{orderId}",
+		PathParams: []string{"orderId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return amazon_warehousing_and_distribution_model_v2024_05_09.NewClient(c).GetInbound(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "amazon-warehousing-and-distribution-model",
+		Version:    "v2024-05-09",
+		Name:       "GetInboundShipment",
+		Method:     "GET",
+		Path:       "/awd/2024-05-09/inboundShipments/{shipmentId}",
+		PathParams: []string{"shipmentId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return amazon_warehousing_and_distribution_model_v2024_05_09.NewClient(c).GetInboundShipment(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "amazon-warehousing-and-distribution-model",
+		Version:    "v2024-05-09",
+		Name:       "GetInboundShipmentLabels",
+		Method:     "GET",
+		Path:       "/awd/2024-05-09/inboundShipments/{shipmentId}/labels",
+		PathParams: []string{"shipmentId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return amazon_warehousing_and_distribution_model_v2024_05_09.NewClient(c).GetInboundShipmentLabels(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "amazon-warehousing-and-distribution-model",
+		Version:    "v2024-05-09",
+		Name:       "ListInboundShipments",
+		Method:     "GET",
+		Path:       "/awd/2024-05-09/inboundShipments",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return amazon_warehousing_and_distribution_model_v2024_05_09.NewClient(c).ListInboundShipments(ctx, query)
+		},
+		Iterator: "IterateInboundShipments",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return amazon_warehousing_and_distribution_model_v2024_05_09.NewClient(c).IterateInboundShipments(ctx, query)
+		},
+	},
+	{
+		API:        "amazon-warehousing-and-distribution-model",
+		Version:    "v2024-05-09",
+		Name:       "ListInventory",
+		Method:     "GET",
+		Path:       "/awd/2024-05-09/inventory",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return amazon_warehousing_and_distribution_model_v2024_05_09.NewClient(c).ListInventory(ctx, query)
+		},
+	},
+	{
+		API:        "amazon-warehousing-and-distribution-model",
+		Version:    "v2024-05-09",
+		Name:       "UpdateInbound",
+		Method:     "PUT",
+		Path:       "/awd/2024-05-09/inboundOrders/{orderId}",
+		PathParams: []string{"orderId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return amazon_warehousing_and_distribution_model_v2024_05_09.NewClient(c).UpdateInbound(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "amazon-warehousing-and-distribution-model",
+		Version:    "v2024-05-09",
+		Name:       "UpdateInboundShipmentTransportDetails",
+		Method:     "PUT",
+		Path:       "/awd/2024-05-09/inboundShipments/{shipmentId}/transport",
+		PathParams: []string{"shipmentId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return amazon_warehousing_and_distribution_model_v2024_05_09.NewClient(c).UpdateInboundShipmentTransportDetails(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "customer-feedback",
+		Version:    "v2024-06-01",
+		Name:       "GetBrowseNodeReturnTopics",
+		Method:     "GET",
+		Path:       "/customerFeedback/2024-06-01/browseNodes/{browseNodeId}/returns/topics",
+		PathParams: []string{"browseNodeId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return customer_feedback_v2024_06_01.NewClient(c).GetBrowseNodeReturnTopics(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "customer-feedback",
+		Version:    "v2024-06-01",
+		Name:       "GetBrowseNodeReturnTrends",
+		Method:     "GET",
+		Path:       "/customerFeedback/2024-06-01/browseNodes/{browseNodeId}/returns/trends",
+		PathParams: []string{"browseNodeId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return customer_feedback_v2024_06_01.NewClient(c).GetBrowseNodeReturnTrends(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "customer-feedback",
+		Version:    "v2024-06-01",
+		Name:       "GetBrowseNodeReviewTopics",
+		Method:     "GET",
+		Path:       "/customerFeedback/2024-06-01/browseNodes/{browseNodeId}/reviews/topics",
+		PathParams: []string{"browseNodeId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return customer_feedback_v2024_06_01.NewClient(c).GetBrowseNodeReviewTopics(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "customer-feedback",
+		Version:    "v2024-06-01",
+		Name:       "GetBrowseNodeReviewTrends",
+		Method:     "GET",
+		Path:       "/customerFeedback/2024-06-01/browseNodes/{browseNodeId}/reviews/trends",
+		PathParams: []string{"browseNodeId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return customer_feedback_v2024_06_01.NewClient(c).GetBrowseNodeReviewTrends(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "customer-feedback",
+		Version:    "v2024-06-01",
+		Name:       "GetItemBrowseNode",
+		Method:     "GET",
+		Path:       "/customerFeedback/2024-06-01/items/{asin}/browseNode",
+		PathParams: []string{"asin"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return customer_feedback_v2024_06_01.NewClient(c).GetItemBrowseNode(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "customer-feedback",
+		Version:    "v2024-06-01",
+		Name:       "GetItemReviewTopics",
+		Method:     "GET",
+		Path:       "/customerFeedback/2024-06-01/items/{asin}/reviews/topics",
+		PathParams: []string{"asin"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return customer_feedback_v2024_06_01.NewClient(c).GetItemReviewTopics(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "customer-feedback",
+		Version:    "v2024-06-01",
+		Name:       "GetItemReviewTrends",
+		Method:     "GET",
+		Path:       "/customerFeedback/2024-06-01/items/{asin}/reviews/trends",
+		PathParams: []string{"asin"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return customer_feedback_v2024_06_01.NewClient(c).GetItemReviewTrends(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "data-kiosk",
+		Version:    "v2023-11-15",
+		Name:       "CancelQuery",
+		Method:     "DELETE",
+		Path:       "/dataKiosk/2023-11-15/queries/{queryId}",
+		PathParams: []string{"queryId"},
+		Input:      InputNone,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return data_kiosk_v2023_11_15.NewClient(c).CancelQuery(ctx, p[0])
+		},
+	},
+	{
+		API:        "data-kiosk",
+		Version:    "v2023-11-15",
+		Name:       "CreateQuery",
+		Method:     "POST",
+		Path:       "/dataKiosk/2023-11-15/queries",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return data_kiosk_v2023_11_15.NewClient(c).CreateQuery(ctx, body)
+		},
+	},
+	{
+		API:        "data-kiosk",
+		Version:    "v2023-11-15",
+		Name:       "GetDocument",
+		Method:     "GET",
+		Path:       "/dataKiosk/2023-11-15/documents/{documentId}",
+		PathParams: []string{"documentId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return data_kiosk_v2023_11_15.NewClient(c).GetDocument(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "data-kiosk",
+		Version:    "v2023-11-15",
+		Name:       "GetQueries",
+		Method:     "GET",
+		Path:       "/dataKiosk/2023-11-15/queries",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return data_kiosk_v2023_11_15.NewClient(c).GetQueries(ctx, query)
+		},
+		Iterator: "IterateQueries",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return data_kiosk_v2023_11_15.NewClient(c).IterateQueries(ctx, query)
+		},
+	},
+	{
+		API:        "data-kiosk",
+		Version:    "v2023-11-15",
+		Name:       "GetQuery",
+		Method:     "GET",
+		Path:       "/dataKiosk/2023-11-15/queries/{queryId}",
+		PathParams: []string{"queryId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return data_kiosk_v2023_11_15.NewClient(c).GetQuery(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "easy-ship-model",
+		Version:    "v2022-03-23",
+		Name:       "CreateScheduledPackage",
+		Method:     "POST",
+		Path:       "/easyShip/2022-03-23/package",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return easy_ship_model_v2022_03_23.NewClient(c).CreateScheduledPackage(ctx, body)
+		},
+	},
+	{
+		API:        "easy-ship-model",
+		Version:    "v2022-03-23",
+		Name:       "CreateScheduledPackageBulk",
+		Method:     "POST",
+		Path:       "/easyShip/2022-03-23/packages/bulk",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return easy_ship_model_v2022_03_23.NewClient(c).CreateScheduledPackageBulk(ctx, body)
+		},
+	},
+	{
+		API:        "easy-ship-model",
+		Version:    "v2022-03-23",
+		Name:       "GetScheduledPackage",
+		Method:     "GET",
+		Path:       "/easyShip/2022-03-23/package",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return easy_ship_model_v2022_03_23.NewClient(c).GetScheduledPackage(ctx, query)
+		},
+	},
+	{
+		API:        "easy-ship-model",
+		Version:    "v2022-03-23",
+		Name:       "ListHandoverSlots",
+		Method:     "POST",
+		Path:       "/easyShip/2022-03-23/timeSlot",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return easy_ship_model_v2022_03_23.NewClient(c).ListHandoverSlots(ctx, body)
+		},
+	},
+	{
+		API:        "easy-ship-model",
+		Version:    "v2022-03-23",
+		Name:       "UpdateScheduledPackages",
+		Method:     "PATCH",
+		Path:       "/easyShip/2022-03-23/package",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return easy_ship_model_v2022_03_23.NewClient(c).UpdateScheduledPackages(ctx, body)
+		},
+	},
+	{
+		API:        "invoices",
+		Version:    "v2024-06-19",
+		Name:       "CreateInvoicesExport",
+		Method:     "POST",
+		Path:       "/tax/invoices/2024-06-19/exports",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return invoices_v2024_06_19.NewClient(c).CreateInvoicesExport(ctx, body)
+		},
+	},
+	{
+		API:        "invoices",
+		Version:    "v2024-06-19",
+		Name:       "GetInvoice",
+		Method:     "GET",
+		Path:       "/tax/invoices/2024-06-19/invoices/{invoiceId}",
+		PathParams: []string{"invoiceId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return invoices_v2024_06_19.NewClient(c).GetInvoice(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "invoices",
+		Version:    "v2024-06-19",
+		Name:       "GetInvoices",
+		Method:     "GET",
+		Path:       "/tax/invoices/2024-06-19/invoices",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return invoices_v2024_06_19.NewClient(c).GetInvoices(ctx, query)
+		},
+		Iterator: "IterateInvoices",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return invoices_v2024_06_19.NewClient(c).IterateInvoices(ctx, query)
+		},
+	},
+	{
+		API:        "invoices",
+		Version:    "v2024-06-19",
+		Name:       "GetInvoicesAttributes",
+		Method:     "GET",
+		Path:       "/tax/invoices/2024-06-19/attributes",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return invoices_v2024_06_19.NewClient(c).GetInvoicesAttributes(ctx, query)
+		},
+	},
+	{
+		API:        "invoices",
+		Version:    "v2024-06-19",
+		Name:       "GetInvoicesDocument",
+		Method:     "GET",
+		Path:       "/tax/invoices/2024-06-19/documents/{invoicesDocumentId}",
+		PathParams: []string{"invoicesDocumentId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return invoices_v2024_06_19.NewClient(c).GetInvoicesDocument(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "invoices",
+		Version:    "v2024-06-19",
+		Name:       "GetInvoicesExport",
+		Method:     "GET",
+		Path:       "/tax/invoices/2024-06-19/exports/{exportId}",
+		PathParams: []string{"exportId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return invoices_v2024_06_19.NewClient(c).GetInvoicesExport(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "invoices",
+		Version:    "v2024-06-19",
+		Name:       "GetInvoicesExports",
+		Method:     "GET",
+		Path:       "/tax/invoices/2024-06-19/exports",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return invoices_v2024_06_19.NewClient(c).GetInvoicesExports(ctx, query)
+		},
+	},
+	{
+		API:        "shipment-invoicing",
+		Version:    "v0",
+		Name:       "GetInvoiceStatus",
+		Method:     "GET",
+		Path:       "/fba/outbound/brazil/v0/shipments/{shipmentId}/invoice/status",
+		PathParams: []string{"shipmentId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return shipment_invoicing_v0.NewClient(c).GetInvoiceStatus(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "shipment-invoicing",
+		Version:    "v0",
+		Name:       "GetShipmentDetails",
+		Method:     "GET",
+		Path:       "/fba/outbound/brazil/v0/shipments/{shipmentId}",
+		PathParams: []string{"shipmentId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return shipment_invoicing_v0.NewClient(c).GetShipmentDetails(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "shipment-invoicing",
+		Version:    "v0",
+		Name:       "SubmitInvoice",
+		Method:     "POST",
+		Path:       "/fba/outbound/brazil/v0/shipments/{shipmentId}/invoice",
+		PathParams: []string{"shipmentId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return shipment_invoicing_v0.NewClient(c).SubmitInvoice(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-inventory",
+		Version:    "v1",
+		Name:       "SubmitInventoryUpdate",
+		Method:     "POST",
+		Path:       "/vendor/directFulfillment/inventory/v1/warehouses/{warehouseId}/items",
+		PathParams: []string{"warehouseId"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_inventory_v1.NewClient(c).SubmitInventoryUpdate(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-orders",
+		Version:    "v1",
+		Name:       "GetOrder",
+		Method:     "GET",
+		Path:       "/vendor/directFulfillment/orders/v1/purchaseOrders/{purchaseOrderNumber}",
+		PathParams: []string{"purchaseOrderNumber"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_orders_v1.NewClient(c).GetOrder(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-orders",
+		Version:    "v1",
+		Name:       "GetOrders",
+		Method:     "GET",
+		Path:       "/vendor/directFulfillment/orders/v1/purchaseOrders",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_orders_v1.NewClient(c).GetOrders(ctx, query)
+		},
+		Iterator: "IterateOrders",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return vendor_direct_fulfillment_orders_v1.NewClient(c).IterateOrders(ctx, query)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-orders",
+		Version:    "v1",
+		Name:       "SubmitAcknowledgement",
+		Method:     "POST",
+		Path:       "/vendor/directFulfillment/orders/v1/acknowledgements",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_orders_v1.NewClient(c).SubmitAcknowledgement(ctx, body)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-orders",
+		Version:    "v2021-12-28",
+		Name:       "GetOrder",
+		Method:     "GET",
+		Path:       "/vendor/directFulfillment/orders/2021-12-28/purchaseOrders/{purchaseOrderNumber}",
+		PathParams: []string{"purchaseOrderNumber"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_orders_v2021_12_28.NewClient(c).GetOrder(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-orders",
+		Version:    "v2021-12-28",
+		Name:       "GetOrders",
+		Method:     "GET",
+		Path:       "/vendor/directFulfillment/orders/2021-12-28/purchaseOrders",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_orders_v2021_12_28.NewClient(c).GetOrders(ctx, query)
+		},
+		Iterator: "IterateOrders",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return vendor_direct_fulfillment_orders_v2021_12_28.NewClient(c).IterateOrders(ctx, query)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-orders",
+		Version:    "v2021-12-28",
+		Name:       "SubmitAcknowledgement",
+		Method:     "POST",
+		Path:       "/vendor/directFulfillment/orders/2021-12-28/acknowledgements",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_orders_v2021_12_28.NewClient(c).SubmitAcknowledgement(ctx, body)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-payments",
+		Version:    "v1",
+		Name:       "SubmitInvoice",
+		Method:     "POST",
+		Path:       "/vendor/directFulfillment/payments/v1/invoices",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_payments_v1.NewClient(c).SubmitInvoice(ctx, body)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-sandbox-test-data",
+		Version:    "v2021-10-28",
+		Name:       "GenerateOrderScenarios",
+		Method:     "POST",
+		Path:       "/vendor/directFulfillment/sandbox/2021-10-28/orders",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_sandbox_test_data_v2021_10_28.NewClient(c).GenerateOrderScenarios(ctx, body)
+		},
+		Iterator: "IterateTestCaseData",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return vendor_direct_fulfillment_sandbox_test_data_v2021_10_28.NewClient(c).IterateTestCaseData(ctx, query)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-sandbox-test-data",
+		Version:    "v2021-10-28",
+		Name:       "GetOrderScenarios",
+		Method:     "GET",
+		Path:       "/vendor/directFulfillment/sandbox/2021-10-28/transactions/{transactionId}",
+		PathParams: []string{"transactionId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_sandbox_test_data_v2021_10_28.NewClient(c).GetOrderScenarios(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-shipping",
+		Version:    "v1",
+		Name:       "GetCustomerInvoice",
+		Method:     "GET",
+		Path:       "/vendor/directFulfillment/shipping/v1/customerInvoices/{purchaseOrderNumber}",
+		PathParams: []string{"purchaseOrderNumber"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_shipping_v1.NewClient(c).GetCustomerInvoice(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-shipping",
+		Version:    "v1",
+		Name:       "GetCustomerInvoices",
+		Method:     "GET",
+		Path:       "/vendor/directFulfillment/shipping/v1/customerInvoices",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_shipping_v1.NewClient(c).GetCustomerInvoices(ctx, query)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-shipping",
+		Version:    "v1",
+		Name:       "GetPackingSlip",
+		Method:     "GET",
+		Path:       "/vendor/directFulfillment/shipping/v1/packingSlips/{purchaseOrderNumber}",
+		PathParams: []string{"purchaseOrderNumber"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_shipping_v1.NewClient(c).GetPackingSlip(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-shipping",
+		Version:    "v1",
+		Name:       "GetPackingSlips",
+		Method:     "GET",
+		Path:       "/vendor/directFulfillment/shipping/v1/packingSlips",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_shipping_v1.NewClient(c).GetPackingSlips(ctx, query)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-shipping",
+		Version:    "v1",
+		Name:       "GetShippingLabel",
+		Method:     "GET",
+		Path:       "/vendor/directFulfillment/shipping/v1/shippingLabels/{purchaseOrderNumber}",
+		PathParams: []string{"purchaseOrderNumber"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_shipping_v1.NewClient(c).GetShippingLabel(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-shipping",
+		Version:    "v1",
+		Name:       "GetShippingLabels",
+		Method:     "GET",
+		Path:       "/vendor/directFulfillment/shipping/v1/shippingLabels",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_shipping_v1.NewClient(c).GetShippingLabels(ctx, query)
+		},
+		Iterator: "IterateShippingLabels",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return vendor_direct_fulfillment_shipping_v1.NewClient(c).IterateShippingLabels(ctx, query)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-shipping",
+		Version:    "v1",
+		Name:       "SubmitShipmentConfirmations",
+		Method:     "POST",
+		Path:       "/vendor/directFulfillment/shipping/v1/shipmentConfirmations",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_shipping_v1.NewClient(c).SubmitShipmentConfirmations(ctx, body)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-shipping",
+		Version:    "v1",
+		Name:       "SubmitShipmentStatusUpdates",
+		Method:     "POST",
+		Path:       "/vendor/directFulfillment/shipping/v1/shipmentStatusUpdates",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_shipping_v1.NewClient(c).SubmitShipmentStatusUpdates(ctx, body)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-shipping",
+		Version:    "v1",
+		Name:       "SubmitShippingLabelRequest",
+		Method:     "POST",
+		Path:       "/vendor/directFulfillment/shipping/v1/shippingLabels",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_shipping_v1.NewClient(c).SubmitShippingLabelRequest(ctx, body)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-shipping",
+		Version:    "v2021-12-28",
+		Name:       "CreateContainerLabel",
+		Method:     "POST",
+		Path:       "/vendor/directFulfillment/shipping/2021-12-28/containerLabel",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_shipping_v2021_12_28.NewClient(c).CreateContainerLabel(ctx, body)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-shipping",
+		Version:    "v2021-12-28",
+		Name:       "CreateShippingLabels",
+		Method:     "POST",
+		Path:       "/vendor/directFulfillment/shipping/2021-12-28/shippingLabels/{purchaseOrderNumber}",
+		PathParams: []string{"purchaseOrderNumber"},
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_shipping_v2021_12_28.NewClient(c).CreateShippingLabels(ctx, p[0], body)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-shipping",
+		Version:    "v2021-12-28",
+		Name:       "GetCustomerInvoice",
+		Method:     "GET",
+		Path:       "/vendor/directFulfillment/shipping/2021-12-28/customerInvoices/{purchaseOrderNumber}",
+		PathParams: []string{"purchaseOrderNumber"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_shipping_v2021_12_28.NewClient(c).GetCustomerInvoice(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-shipping",
+		Version:    "v2021-12-28",
+		Name:       "GetCustomerInvoices",
+		Method:     "GET",
+		Path:       "/vendor/directFulfillment/shipping/2021-12-28/customerInvoices",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_shipping_v2021_12_28.NewClient(c).GetCustomerInvoices(ctx, query)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-shipping",
+		Version:    "v2021-12-28",
+		Name:       "GetPackingSlip",
+		Method:     "GET",
+		Path:       "/vendor/directFulfillment/shipping/2021-12-28/packingSlips/{purchaseOrderNumber}",
+		PathParams: []string{"purchaseOrderNumber"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_shipping_v2021_12_28.NewClient(c).GetPackingSlip(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-shipping",
+		Version:    "v2021-12-28",
+		Name:       "GetPackingSlips",
+		Method:     "GET",
+		Path:       "/vendor/directFulfillment/shipping/2021-12-28/packingSlips",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_shipping_v2021_12_28.NewClient(c).GetPackingSlips(ctx, query)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-shipping",
+		Version:    "v2021-12-28",
+		Name:       "GetShippingLabel",
+		Method:     "GET",
+		Path:       "/vendor/directFulfillment/shipping/2021-12-28/shippingLabels/{purchaseOrderNumber}",
+		PathParams: []string{"purchaseOrderNumber"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_shipping_v2021_12_28.NewClient(c).GetShippingLabel(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-shipping",
+		Version:    "v2021-12-28",
+		Name:       "GetShippingLabels",
+		Method:     "GET",
+		Path:       "/vendor/directFulfillment/shipping/2021-12-28/shippingLabels",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_shipping_v2021_12_28.NewClient(c).GetShippingLabels(ctx, query)
+		},
+		Iterator: "IterateShippingLabels",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return vendor_direct_fulfillment_shipping_v2021_12_28.NewClient(c).IterateShippingLabels(ctx, query)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-shipping",
+		Version:    "v2021-12-28",
+		Name:       "SubmitShipmentConfirmations",
+		Method:     "POST",
+		Path:       "/vendor/directFulfillment/shipping/2021-12-28/shipmentConfirmations",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_shipping_v2021_12_28.NewClient(c).SubmitShipmentConfirmations(ctx, body)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-shipping",
+		Version:    "v2021-12-28",
+		Name:       "SubmitShipmentStatusUpdates",
+		Method:     "POST",
+		Path:       "/vendor/directFulfillment/shipping/2021-12-28/shipmentStatusUpdates",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_shipping_v2021_12_28.NewClient(c).SubmitShipmentStatusUpdates(ctx, body)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-shipping",
+		Version:    "v2021-12-28",
+		Name:       "SubmitShippingLabelRequest",
+		Method:     "POST",
+		Path:       "/vendor/directFulfillment/shipping/2021-12-28/shippingLabels",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_shipping_v2021_12_28.NewClient(c).SubmitShippingLabelRequest(ctx, body)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-transactions",
+		Version:    "v1",
+		Name:       "GetTransactionStatus",
+		Method:     "GET",
+		Path:       "/vendor/directFulfillment/transactions/v1/transactions/{transactionId}",
+		PathParams: []string{"transactionId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_transactions_v1.NewClient(c).GetTransactionStatus(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "vendor-direct-fulfillment-transactions",
+		Version:    "v2021-12-28",
+		Name:       "GetTransactionStatus",
+		Method:     "GET",
+		Path:       "/vendor/directFulfillment/transactions/2021-12-28/transactions/{transactionId}",
+		PathParams: []string{"transactionId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_direct_fulfillment_transactions_v2021_12_28.NewClient(c).GetTransactionStatus(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "vendor-invoices",
+		Version:    "v1",
+		Name:       "SubmitInvoices",
+		Method:     "POST",
+		Path:       "/vendor/payments/v1/invoices",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_invoices_v1.NewClient(c).SubmitInvoices(ctx, body)
+		},
+	},
+	{
+		API:        "vendor-orders",
+		Version:    "v1",
+		Name:       "GetPurchaseOrder",
+		Method:     "GET",
+		Path:       "/vendor/orders/v1/purchaseOrders/{purchaseOrderNumber}",
+		PathParams: []string{"purchaseOrderNumber"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_orders_v1.NewClient(c).GetPurchaseOrder(ctx, p[0], query)
+		},
+	},
+	{
+		API:        "vendor-orders",
+		Version:    "v1",
+		Name:       "GetPurchaseOrders",
+		Method:     "GET",
+		Path:       "/vendor/orders/v1/purchaseOrders",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_orders_v1.NewClient(c).GetPurchaseOrders(ctx, query)
+		},
+		Iterator: "IteratePurchaseOrders",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return vendor_orders_v1.NewClient(c).IteratePurchaseOrders(ctx, query)
+		},
+	},
+	{
+		API:        "vendor-orders",
+		Version:    "v1",
+		Name:       "GetPurchaseOrdersStatus",
+		Method:     "GET",
+		Path:       "/vendor/orders/v1/purchaseOrdersStatus",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_orders_v1.NewClient(c).GetPurchaseOrdersStatus(ctx, query)
+		},
+	},
+	{
+		API:        "vendor-orders",
+		Version:    "v1",
+		Name:       "SubmitAcknowledgement",
+		Method:     "POST",
+		Path:       "/vendor/orders/v1/acknowledgements",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_orders_v1.NewClient(c).SubmitAcknowledgement(ctx, body)
+		},
+	},
+	{
+		API:        "vendor-shipments",
+		Version:    "v1",
+		Name:       "GetShipmentDetails",
+		Method:     "GET",
+		Path:       "/vendor/shipping/v1/shipments",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_shipments_v1.NewClient(c).GetShipmentDetails(ctx, query)
+		},
+		Iterator: "IterateShipmentDetails",
+		Iterate: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+			return vendor_shipments_v1.NewClient(c).IterateShipmentDetails(ctx, query)
+		},
+	},
+	{
+		API:        "vendor-shipments",
+		Version:    "v1",
+		Name:       "GetShipmentLabels",
+		Method:     "GET",
+		Path:       "/vendor/shipping/v1/transportLabels",
+		PathParams: nil,
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_shipments_v1.NewClient(c).GetShipmentLabels(ctx, query)
+		},
+	},
+	{
+		API:        "vendor-shipments",
+		Version:    "v1",
+		Name:       "SubmitShipmentConfirmations",
+		Method:     "POST",
+		Path:       "/vendor/shipping/v1/shipmentConfirmations",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_shipments_v1.NewClient(c).SubmitShipmentConfirmations(ctx, body)
+		},
+	},
+	{
+		API:        "vendor-shipments",
+		Version:    "v1",
+		Name:       "SubmitShipments",
+		Method:     "POST",
+		Path:       "/vendor/shipping/v1/shipments",
+		PathParams: nil,
+		Input:      InputBody,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_shipments_v1.NewClient(c).SubmitShipments(ctx, body)
+		},
+	},
+	{
+		API:        "vendor-transaction-status",
+		Version:    "v1",
+		Name:       "GetTransaction",
+		Method:     "GET",
+		Path:       "/vendor/transactions/v1/transactions/{transactionId}",
+		PathParams: []string{"transactionId"},
+		Input:      InputQuery,
+		Call: func(ctx context.Context, c *spapi.Client, p []string, query map[string]string, body interface{}) (interface{}, error) {
+			return vendor_transaction_status_v1.NewClient(c).GetTransaction(ctx, p[0], query)
+		},
+	},
+}
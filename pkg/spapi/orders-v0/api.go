@@ -0,0 +1,80 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package orders_v0
+
+import (
+	"context"
+	"iter"
+)
+
+// API 是 orders API v0 客户端的全部方法，包括分页迭代器等辅助方法。
+//
+// 业务代码依赖 API 而不是 *Client，即可在单元测试中用 Fake 替换真实客户端。
+type API interface {
+	// ConfirmShipment
+	// Method: POST | Path: /orders/v0/orders/{orderId}/shipmentConfirmation
+	ConfirmShipment(ctx context.Context, orderId string, body interface{}) (interface{}, error)
+
+	// GetOrder
+	// Method: GET | Path: /orders/v0/orders/{orderId}
+	GetOrder(ctx context.Context, orderId string, query map[string]string) (interface{}, error)
+
+	// GetOrderAddress
+	// Method: GET | Path: /orders/v0/orders/{orderId}/address
+	GetOrderAddress(ctx context.Context, orderId string, query map[string]string) (interface{}, error)
+
+	// GetOrderBuyerInfo
+	// Method: GET | Path: /orders/v0/orders/{orderId}/buyerInfo
+	GetOrderBuyerInfo(ctx context.Context, orderId string, query map[string]string) (interface{}, error)
+
+	// GetOrderItems
+	// Method: GET | Path: /orders/v0/orders/{orderId}/orderItems
+	GetOrderItems(ctx context.Context, orderId string, query map[string]string) (interface{}, error)
+
+	// GetOrderItemsBuyerInfo
+	// Method: GET | Path: /orders/v0/orders/{orderId}/orderItems/buyerInfo
+	GetOrderItemsBuyerInfo(ctx context.Context, orderId string, query map[string]string) (interface{}, error)
+
+	// GetOrderRegulatedInfo
+	// Method: GET | Path: /orders/v0/orders/{orderId}/regulatedInfo
+	GetOrderRegulatedInfo(ctx context.Context, orderId string, query map[string]string) (interface{}, error)
+
+	// GetOrders
+	// Method: GET | Path: /orders/v0/orders
+	GetOrders(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// IterateOrderItems 返回订单项迭代器，自动处理分页。
+	IterateOrderItems(ctx context.Context, orderID string, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// IterateOrders 返回订单迭代器，自动处理分页。
+	IterateOrders(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// UpdateShipmentStatus
+	// Method: POST | Path: /orders/v0/orders/{orderId}/shipment
+	UpdateShipmentStatus(ctx context.Context, orderId string, body interface{}) (interface{}, error)
+
+	// UpdateVerificationStatus
+	// Method: PATCH | Path: /orders/v0/orders/{orderId}/regulatedInfo
+	UpdateVerificationStatus(ctx context.Context, orderId string, body interface{}) (interface{}, error)
+}
+
+// 确保 Client 实现了 API。
+var _ API = (*Client)(nil)
@@ -0,0 +1,190 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package orders_v0
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。
+//
+// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回
+// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，
+// 可以通过 Calls 和 CallsTo 查看。
+type Fake struct {
+	spapi.FakeRecorder
+
+	// ConfirmShipmentFunc 实现 ConfirmShipment。
+	ConfirmShipmentFunc func(ctx context.Context, orderId string, body interface{}) (interface{}, error)
+
+	// GetOrderFunc 实现 GetOrder。
+	GetOrderFunc func(ctx context.Context, orderId string, query map[string]string) (interface{}, error)
+
+	// GetOrderAddressFunc 实现 GetOrderAddress。
+	GetOrderAddressFunc func(ctx context.Context, orderId string, query map[string]string) (interface{}, error)
+
+	// GetOrderBuyerInfoFunc 实现 GetOrderBuyerInfo。
+	GetOrderBuyerInfoFunc func(ctx context.Context, orderId string, query map[string]string) (interface{}, error)
+
+	// GetOrderItemsFunc 实现 GetOrderItems。
+	GetOrderItemsFunc func(ctx context.Context, orderId string, query map[string]string) (interface{}, error)
+
+	// GetOrderItemsBuyerInfoFunc 实现 GetOrderItemsBuyerInfo。
+	GetOrderItemsBuyerInfoFunc func(ctx context.Context, orderId string, query map[string]string) (interface{}, error)
+
+	// GetOrderRegulatedInfoFunc 实现 GetOrderRegulatedInfo。
+	GetOrderRegulatedInfoFunc func(ctx context.Context, orderId string, query map[string]string) (interface{}, error)
+
+	// GetOrdersFunc 实现 GetOrders。
+	GetOrdersFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// IterateOrderItemsFunc 实现 IterateOrderItems。
+	IterateOrderItemsFunc func(ctx context.Context, orderID string, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// IterateOrdersFunc 实现 IterateOrders。
+	IterateOrdersFunc func(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// UpdateShipmentStatusFunc 实现 UpdateShipmentStatus。
+	UpdateShipmentStatusFunc func(ctx context.Context, orderId string, body interface{}) (interface{}, error)
+
+	// UpdateVerificationStatusFunc 实现 UpdateVerificationStatus。
+	UpdateVerificationStatusFunc func(ctx context.Context, orderId string, body interface{}) (interface{}, error)
+}
+
+// NewFake 创建未编程任何响应的 Fake。
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// ConfirmShipment 记录调用并执行 ConfirmShipmentFunc。
+func (f *Fake) ConfirmShipment(ctx context.Context, orderId string, body interface{}) (interface{}, error) {
+	f.Record("ConfirmShipment", orderId, body)
+	if f.ConfirmShipmentFunc == nil {
+		return nil, fmt.Errorf("ConfirmShipment: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ConfirmShipmentFunc(ctx, orderId, body)
+}
+
+// GetOrder 记录调用并执行 GetOrderFunc。
+func (f *Fake) GetOrder(ctx context.Context, orderId string, query map[string]string) (interface{}, error) {
+	f.Record("GetOrder", orderId, query)
+	if f.GetOrderFunc == nil {
+		return nil, fmt.Errorf("GetOrder: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetOrderFunc(ctx, orderId, query)
+}
+
+// GetOrderAddress 记录调用并执行 GetOrderAddressFunc。
+func (f *Fake) GetOrderAddress(ctx context.Context, orderId string, query map[string]string) (interface{}, error) {
+	f.Record("GetOrderAddress", orderId, query)
+	if f.GetOrderAddressFunc == nil {
+		return nil, fmt.Errorf("GetOrderAddress: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetOrderAddressFunc(ctx, orderId, query)
+}
+
+// GetOrderBuyerInfo 记录调用并执行 GetOrderBuyerInfoFunc。
+func (f *Fake) GetOrderBuyerInfo(ctx context.Context, orderId string, query map[string]string) (interface{}, error) {
+	f.Record("GetOrderBuyerInfo", orderId, query)
+	if f.GetOrderBuyerInfoFunc == nil {
+		return nil, fmt.Errorf("GetOrderBuyerInfo: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetOrderBuyerInfoFunc(ctx, orderId, query)
+}
+
+// GetOrderItems 记录调用并执行 GetOrderItemsFunc。
+func (f *Fake) GetOrderItems(ctx context.Context, orderId string, query map[string]string) (interface{}, error) {
+	f.Record("GetOrderItems", orderId, query)
+	if f.GetOrderItemsFunc == nil {
+		return nil, fmt.Errorf("GetOrderItems: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetOrderItemsFunc(ctx, orderId, query)
+}
+
+// GetOrderItemsBuyerInfo 记录调用并执行 GetOrderItemsBuyerInfoFunc。
+func (f *Fake) GetOrderItemsBuyerInfo(ctx context.Context, orderId string, query map[string]string) (interface{}, error) {
+	f.Record("GetOrderItemsBuyerInfo", orderId, query)
+	if f.GetOrderItemsBuyerInfoFunc == nil {
+		return nil, fmt.Errorf("GetOrderItemsBuyerInfo: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetOrderItemsBuyerInfoFunc(ctx, orderId, query)
+}
+
+// GetOrderRegulatedInfo 记录调用并执行 GetOrderRegulatedInfoFunc。
+func (f *Fake) GetOrderRegulatedInfo(ctx context.Context, orderId string, query map[string]string) (interface{}, error) {
+	f.Record("GetOrderRegulatedInfo", orderId, query)
+	if f.GetOrderRegulatedInfoFunc == nil {
+		return nil, fmt.Errorf("GetOrderRegulatedInfo: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetOrderRegulatedInfoFunc(ctx, orderId, query)
+}
+
+// GetOrders 记录调用并执行 GetOrdersFunc。
+func (f *Fake) GetOrders(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetOrders", query)
+	if f.GetOrdersFunc == nil {
+		return nil, fmt.Errorf("GetOrders: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetOrdersFunc(ctx, query)
+}
+
+// IterateOrderItems 记录调用并执行 IterateOrderItemsFunc。
+func (f *Fake) IterateOrderItems(ctx context.Context, orderID string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+	f.Record("IterateOrderItems", orderID, query)
+	if f.IterateOrderItemsFunc == nil {
+		return spapi.FakeSeq[map[string]interface{}](nil, fmt.Errorf("IterateOrderItems: %w", spapi.ErrFakeNotConfigured))
+	}
+	return f.IterateOrderItemsFunc(ctx, orderID, query)
+}
+
+// IterateOrders 记录调用并执行 IterateOrdersFunc。
+func (f *Fake) IterateOrders(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+	f.Record("IterateOrders", query)
+	if f.IterateOrdersFunc == nil {
+		return spapi.FakeSeq[map[string]interface{}](nil, fmt.Errorf("IterateOrders: %w", spapi.ErrFakeNotConfigured))
+	}
+	return f.IterateOrdersFunc(ctx, query)
+}
+
+// UpdateShipmentStatus 记录调用并执行 UpdateShipmentStatusFunc。
+func (f *Fake) UpdateShipmentStatus(ctx context.Context, orderId string, body interface{}) (interface{}, error) {
+	f.Record("UpdateShipmentStatus", orderId, body)
+	if f.UpdateShipmentStatusFunc == nil {
+		return nil, fmt.Errorf("UpdateShipmentStatus: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.UpdateShipmentStatusFunc(ctx, orderId, body)
+}
+
+// UpdateVerificationStatus 记录调用并执行 UpdateVerificationStatusFunc。
+func (f *Fake) UpdateVerificationStatus(ctx context.Context, orderId string, body interface{}) (interface{}, error) {
+	f.Record("UpdateVerificationStatus", orderId, body)
+	if f.UpdateVerificationStatusFunc == nil {
+		return nil, fmt.Errorf("UpdateVerificationStatus: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.UpdateVerificationStatusFunc(ctx, orderId, body)
+}
+
+// 确保 Fake 实现了 API。
+var _ API = (*Fake)(nil)
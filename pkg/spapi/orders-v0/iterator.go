@@ -86,6 +86,14 @@ type OrdersResponse struct {
 //   - 支持提前退出（break）
 func (c *Client) IterateOrders(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
 	return func(yield func(map[string]interface{}, error) bool) {
+		// 登记到客户端，Close 等待迭代结束
+		ctx, release, err := c.baseClient.Acquire(ctx)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		defer release()
+
 		// 复制 query，避免修改原始参数
 		currentQuery := make(map[string]string)
 		for k, v := range query {
@@ -164,6 +172,14 @@ type OrderItemsResponse struct {
 //	}
 func (c *Client) IterateOrderItems(ctx context.Context, orderID string, query map[string]string) iter.Seq2[map[string]interface{}, error] {
 	return func(yield func(map[string]interface{}, error) bool) {
+		// 登记到客户端，Close 等待迭代结束
+		ctx, release, err := c.baseClient.Acquire(ctx)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		defer release()
+
 		// 复制 query
 		currentQuery := make(map[string]string)
 		if query != nil {
@@ -8,10 +8,19 @@
  */
 package orders_v0
 
+import (
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
 // The monetary value of the order.
 type Money struct {
 	// The three-digit currency code. In ISO 4217 format.
 	CurrencyCode string `json:"CurrencyCode,omitempty"`
 	// The currency amount.
-	Amount string `json:"Amount,omitempty"`
+	Amount spapi.DecimalString `json:"Amount,omitzero"`
+}
+
+// ToMoney 转换为 spapi.Money。
+func (m Money) ToMoney() spapi.Money {
+	return spapi.NewMoney(m.Amount.Decimal, m.CurrencyCode)
 }
@@ -0,0 +1,260 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package otel
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	gootel "go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/metrics"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// SP-API 专用的指标属性键，与 Tracer 设置的 span 属性一致。
+const (
+	attrOperation   = attribute.Key("spapi.operation")
+	attrMarketplace = attribute.Key("spapi.marketplace_id")
+	attrGrantType   = attribute.Key("spapi.grant_type")
+	attrPriority    = attribute.Key("spapi.priority")
+	attrDirection   = attribute.Key("spapi.direction")
+)
+
+// Metrics 是 spapi.MetricsCollector 的 OpenTelemetry 实现。
+//
+// Metrics 同时实现 SDK 内部的指标记录接口，通过 spapi.WithMetrics 注入后，
+// SDK 记录完整的指标集。指标名称与 pkg/spapi/prometheus 相同，
+// 标签转换为 OpenTelemetry 属性：status_code 和 method 使用 HTTP
+// 语义约定的 http.response.status_code 和 http.request.method，
+// error_type 使用 error.type，其余使用 spapi.* 前缀。
+//
+// Metrics 是并发安全的。
+type Metrics struct {
+	provider       metric.MeterProvider
+	requests       metric.Int64Counter
+	duration       metric.Float64Histogram
+	errors         metric.Int64Counter
+	tokenRefreshes metric.Int64Counter
+	rateLimitWait  metric.Float64Histogram
+	activeLimiters metric.Int64Gauge
+	queueDepth     metric.Int64Gauge
+	rate           metric.Float64Gauge
+	ceiling        metric.Float64Gauge
+	adjustments    metric.Int64Counter
+}
+
+// NewMetrics 创建 OpenTelemetry 指标收集器。
+//
+// 参数:
+//   - opts: 配置选项（WithMeterProvider）
+//
+// 返回值:
+//   - *Metrics: 指标收集器实例
+//   - error: 如果创建指标失败，返回错误
+//
+// 示例:
+//
+//	m, err := otel.NewMetrics(otel.WithMeterProvider(mp))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	client, err := spapi.NewClient(spapi.WithMetrics(m), ...)
+func NewMetrics(opts ...Option) (*Metrics, error) {
+	o := newOptions(opts)
+	provider := o.meterProvider
+	if provider == nil {
+		provider = gootel.GetMeterProvider()
+	}
+	meter := provider.Meter(instrumentationName)
+
+	m := &Metrics{provider: provider}
+	var err error
+	if m.requests, err = meter.Int64Counter(metrics.MetricRequestTotal,
+		metric.WithDescription("Total number of SP-API requests.")); err != nil {
+		return nil, fmt.Errorf("create %s: %w", metrics.MetricRequestTotal, err)
+	}
+	if m.duration, err = meter.Float64Histogram(metrics.MetricRequestDuration,
+		metric.WithDescription("SP-API request duration in seconds."), metric.WithUnit("s")); err != nil {
+		return nil, fmt.Errorf("create %s: %w", metrics.MetricRequestDuration, err)
+	}
+	if m.errors, err = meter.Int64Counter(metrics.MetricRequestErrors,
+		metric.WithDescription("Total number of failed SP-API requests.")); err != nil {
+		return nil, fmt.Errorf("create %s: %w", metrics.MetricRequestErrors, err)
+	}
+	if m.tokenRefreshes, err = meter.Int64Counter(metrics.MetricAuthTokenRefresh,
+		metric.WithDescription("Total number of LWA access token refreshes.")); err != nil {
+		return nil, fmt.Errorf("create %s: %w", metrics.MetricAuthTokenRefresh, err)
+	}
+	if m.rateLimitWait, err = meter.Float64Histogram(metrics.MetricRateLimitWait,
+		metric.WithDescription("Time spent waiting for the rate limiter in seconds."), metric.WithUnit("s")); err != nil {
+		return nil, fmt.Errorf("create %s: %w", metrics.MetricRateLimitWait, err)
+	}
+	if m.activeLimiters, err = meter.Int64Gauge(metrics.MetricRateLimitActive,
+		metric.WithDescription("Number of active rate limiters.")); err != nil {
+		return nil, fmt.Errorf("create %s: %w", metrics.MetricRateLimitActive, err)
+	}
+	if m.queueDepth, err = meter.Int64Gauge(metrics.MetricRateLimitQueueDepth,
+		metric.WithDescription("Number of requests queued for the rate limiter by priority.")); err != nil {
+		return nil, fmt.Errorf("create %s: %w", metrics.MetricRateLimitQueueDepth, err)
+	}
+	if m.rate, err = meter.Float64Gauge(metrics.MetricRateLimitRate,
+		metric.WithDescription("Adaptive request rate of the rate limiter in requests per second."), metric.WithUnit("{request}/s")); err != nil {
+		return nil, fmt.Errorf("create %s: %w", metrics.MetricRateLimitRate, err)
+	}
+	if m.ceiling, err = meter.Float64Gauge(metrics.MetricRateLimitCeiling,
+		metric.WithDescription("Rate limit ceiling from x-amzn-RateLimit-Limit or the static rate in requests per second."), metric.WithUnit("{request}/s")); err != nil {
+		return nil, fmt.Errorf("create %s: %w", metrics.MetricRateLimitCeiling, err)
+	}
+	if m.adjustments, err = meter.Int64Counter(metrics.MetricRateLimitAdjustments,
+		metric.WithDescription("Total number of adaptive rate adjustments by direction.")); err != nil {
+		return nil, fmt.Errorf("create %s: %w", metrics.MetricRateLimitAdjustments, err)
+	}
+	return m, nil
+}
+
+// Flush 实现 spapi.Flusher，调用 MeterProvider 的 ForceFlush（如 SDK 的 MeterProvider）。
+//
+// spapi.Client.Close 在所有请求结束后调用 Flush，导出最后一批指标。
+func (m *Metrics) Flush(ctx context.Context) error {
+	return forceFlush(ctx, m.provider)
+}
+
+// RecordRequest 实现 spapi.MetricsCollector。
+func (m *Metrics) RecordRequest(api, method string, duration time.Duration, statusCode int) {
+	m.RecordCounter(metrics.MetricRequestTotal, 1, map[string]string{
+		metrics.LabelOperation:  api,
+		metrics.LabelMethod:     method,
+		metrics.LabelStatusCode: strconv.Itoa(statusCode),
+	})
+	m.RecordTiming(metrics.MetricRequestDuration, duration, map[string]string{
+		metrics.LabelOperation:  api,
+		metrics.LabelMethod:     method,
+		metrics.LabelStatusCode: strconv.Itoa(statusCode),
+	})
+}
+
+// RecordError 实现 spapi.MetricsCollector。
+func (m *Metrics) RecordError(api, errorType string) {
+	m.RecordCounter(metrics.MetricRequestErrors, 1, map[string]string{
+		metrics.LabelOperation: api,
+		metrics.LabelErrorType: errorType,
+	})
+}
+
+// RecordRateLimitWait 实现 spapi.MetricsCollector。
+func (m *Metrics) RecordRateLimitWait(api string, duration time.Duration) {
+	m.RecordTiming(metrics.MetricRateLimitWait, duration, map[string]string{
+		metrics.LabelOperation: api,
+	})
+}
+
+// RecordCounter 记录计数器指标，忽略未知的指标名称。
+func (m *Metrics) RecordCounter(name string, value float64, labels map[string]string) {
+	var counter metric.Int64Counter
+	switch name {
+	case metrics.MetricRequestTotal:
+		counter = m.requests
+	case metrics.MetricRequestErrors:
+		counter = m.errors
+	case metrics.MetricAuthTokenRefresh:
+		counter = m.tokenRefreshes
+	case metrics.MetricRateLimitAdjustments:
+		counter = m.adjustments
+	default:
+		return
+	}
+	counter.Add(context.Background(), int64(value), metric.WithAttributes(attributesOf(labels)...))
+}
+
+// RecordGauge 记录仪表盘指标，忽略未知的指标名称。
+func (m *Metrics) RecordGauge(name string, value float64, labels map[string]string) {
+	ctx := context.Background()
+	switch name {
+	case metrics.MetricRateLimitActive:
+		m.activeLimiters.Record(ctx, int64(value), metric.WithAttributes(attributesOf(labels)...))
+	case metrics.MetricRateLimitQueueDepth:
+		m.queueDepth.Record(ctx, int64(value), metric.WithAttributes(attributesOf(labels)...))
+	case metrics.MetricRateLimitRate:
+		m.rate.Record(ctx, value, metric.WithAttributes(attributesOf(labels)...))
+	case metrics.MetricRateLimitCeiling:
+		m.ceiling.Record(ctx, value, metric.WithAttributes(attributesOf(labels)...))
+	}
+}
+
+// RecordHistogram 记录直方图指标（单位为秒），忽略未知的指标名称。
+func (m *Metrics) RecordHistogram(name string, value float64, labels map[string]string) {
+	var histogram metric.Float64Histogram
+	switch name {
+	case metrics.MetricRequestDuration:
+		histogram = m.duration
+	case metrics.MetricRateLimitWait:
+		histogram = m.rateLimitWait
+	default:
+		return
+	}
+	histogram.Record(context.Background(), value, metric.WithAttributes(attributesOf(labels)...))
+}
+
+// RecordTiming 记录时间指标，忽略未知的指标名称。
+func (m *Metrics) RecordTiming(name string, duration time.Duration, labels map[string]string) {
+	m.RecordHistogram(name, duration.Seconds(), labels)
+}
+
+// attributesOf 把 SDK 的指标标签转换为 OpenTelemetry 属性。
+func attributesOf(labels map[string]string) []attribute.KeyValue {
+	attrs := make([]attribute.KeyValue, 0, len(labels))
+	for key, value := range labels {
+		switch key {
+		case metrics.LabelOperation:
+			attrs = append(attrs, attrOperation.String(value))
+		case metrics.LabelMarketplace:
+			attrs = append(attrs, attrMarketplace.String(value))
+		case metrics.LabelMethod:
+			attrs = append(attrs, semconv.HTTPRequestMethodKey.String(value))
+		case metrics.LabelStatusCode:
+			if code, err := strconv.Atoi(value); err == nil {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+		case metrics.LabelErrorType:
+			attrs = append(attrs, semconv.ErrorTypeKey.String(value))
+		case metrics.LabelGrantType:
+			attrs = append(attrs, attrGrantType.String(value))
+		case metrics.LabelPriority:
+			attrs = append(attrs, attrPriority.String(value))
+		case metrics.LabelDirection:
+			attrs = append(attrs, attrDirection.String(value))
+		default:
+			attrs = append(attrs, attribute.String(key, value))
+		}
+	}
+	return attrs
+}
+
+var (
+	_ spapi.MetricsCollector = (*Metrics)(nil)
+	_ metrics.Recorder       = (*Metrics)(nil)
+)
@@ -0,0 +1,145 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package otel_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/metrics"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/otel"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/spapitest"
+)
+
+// TestMetrics_Client 测试通过 WithMetrics 注入后 SDK 记录的指标。
+func TestMetrics_Client(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+	srv.Handle(http.MethodGet, "/orders/v0/orders", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	})
+	srv.Handle(http.MethodGet, "/orders/v0/orders/{orderId}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"errors":[{"code":"QuotaExceeded","message":"slow down"}]}`))
+	})
+
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	m, err := otel.NewMetrics(otel.WithMeterProvider(provider))
+	if err != nil {
+		t.Fatalf("NewMetrics() error = %v", err)
+	}
+
+	client, err := srv.NewClient(spapi.WithMetrics(m), spapi.WithMaxRetries(0), spapi.WithRateLimitWait())
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	ctx := context.Background()
+	query := url.Values{"MarketplaceIds": {"ATVPDKIKX0DER"}}
+	if err := client.GetValues(ctx, "/orders/v0/orders", query, nil); err != nil {
+		t.Fatalf("GetValues() error = %v", err)
+	}
+	if err := client.Get(ctx, "/orders/v0/orders/123-1234567-1234567", nil, nil); err == nil {
+		t.Fatal("Get() error = nil, want 429")
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(ctx, &rm); err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	got := make(map[string]metricdata.Aggregation)
+	for _, scope := range rm.ScopeMetrics {
+		for _, metric := range scope.Metrics {
+			got[metric.Name] = metric.Data
+		}
+	}
+
+	for _, name := range []string{
+		metrics.MetricRequestTotal,
+		metrics.MetricRequestDuration,
+		metrics.MetricRequestErrors,
+		metrics.MetricAuthTokenRefresh,
+		metrics.MetricRateLimitWait,
+		metrics.MetricRateLimitActive,
+		metrics.MetricRateLimitRate,
+		metrics.MetricRateLimitAdjustments,
+	} {
+		if _, ok := got[name]; !ok {
+			t.Errorf("metric %s not recorded", name)
+		}
+	}
+
+	requests, _ := got[metrics.MetricRequestTotal].(metricdata.Sum[int64])
+	want := attribute.NewSet(
+		attribute.String("spapi.operation", "orders-v0:getOrders"),
+		attribute.String("spapi.marketplace_id", "ATVPDKIKX0DER"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPResponseStatusCode(http.StatusOK),
+	)
+	if !hasPoint(requests.DataPoints, want, 1) {
+		t.Errorf("%s data points = %+v, want %v = 1", metrics.MetricRequestTotal, requests.DataPoints, want.Encoded(attribute.DefaultEncoder()))
+	}
+
+	errors, _ := got[metrics.MetricRequestErrors].(metricdata.Sum[int64])
+	want = attribute.NewSet(
+		attribute.String("spapi.operation", "orders-v0:getOrder"),
+		attribute.String("spapi.marketplace_id", "global"),
+		semconv.ErrorTypeKey.String("rate_limit"),
+	)
+	if !hasPoint(errors.DataPoints, want, 1) {
+		t.Errorf("%s data points = %+v, want %v = 1", metrics.MetricRequestErrors, errors.DataPoints, want.Encoded(attribute.DefaultEncoder()))
+	}
+
+	adjustments, _ := got[metrics.MetricRateLimitAdjustments].(metricdata.Sum[int64])
+	want = attribute.NewSet(
+		attribute.String("spapi.operation", "orders-v0:getOrder"),
+		attribute.String("spapi.marketplace_id", "global"),
+		attribute.String("spapi.direction", "decrease"),
+	)
+	if !hasPoint(adjustments.DataPoints, want, 1) {
+		t.Errorf("%s data points = %+v, want %v = 1", metrics.MetricRateLimitAdjustments, adjustments.DataPoints, want.Encoded(attribute.DefaultEncoder()))
+	}
+
+	refreshes, _ := got[metrics.MetricAuthTokenRefresh].(metricdata.Sum[int64])
+	want = attribute.NewSet(attribute.String("spapi.grant_type", "refresh_token"))
+	if !hasPoint(refreshes.DataPoints, want, 1) {
+		t.Errorf("%s data points = %+v, want %v = 1", metrics.MetricAuthTokenRefresh, refreshes.DataPoints, want.Encoded(attribute.DefaultEncoder()))
+	}
+}
+
+// hasPoint 检查 points 中是否有属性为 attrs、值为 value 的数据点。
+func hasPoint(points []metricdata.DataPoint[int64], attrs attribute.Set, value int64) bool {
+	for _, p := range points {
+		if p.Attributes.Equals(&attrs) && p.Value == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,123 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+//
+// Package otel 提供 spapi.Tracer 和 spapi.MetricsCollector 的 OpenTelemetry 实现。
+//
+// Tracer 为每个 API 请求创建一个 client span，属性遵循 OpenTelemetry HTTP
+// 语义约定（http.request.method、http.response.status_code、url.full、
+// server.address、error.type），并把追踪上下文注入请求头，
+// 使调用链可以跨服务关联。
+//
+// Metrics 把 SDK 的完整指标集（请求数、延迟、错误、令牌刷新、速率限制等待、
+// 活跃限制器、排队深度和自适应速率）记录到 OpenTelemetry Meter。
+//
+// 示例:
+//
+//	tracer := otel.NewTracer()
+//	meterMetrics, err := otel.NewMetrics()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//
+//	client, err := spapi.NewClient(
+//	    spapi.WithRegion(spapi.RegionNA),
+//	    spapi.WithCredentials(clientID, clientSecret, refreshToken),
+//	    spapi.WithTracer(tracer),
+//	    spapi.WithMetrics(meterMetrics),
+//	)
+//
+// 未指定 Provider 时使用 OpenTelemetry 的全局 TracerProvider、MeterProvider
+// 和 TextMapPropagator。Tracer 和 Metrics 实现 spapi.Flusher，client.Close
+// 会刷新 SDK 的 Provider，导出最后一批 span 和指标。
+package otel
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName 是 Tracer 和 Meter 的 instrumentation 名称。
+const instrumentationName = "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+
+// Option 是 Tracer 和 Metrics 的配置选项。
+type Option func(*options)
+
+type options struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+	propagator     propagation.TextMapPropagator
+}
+
+// WithTracerProvider 设置 Tracer 使用的 TracerProvider。
+//
+// 示例:
+//
+//	tracer := otel.NewTracer(otel.WithTracerProvider(sdktrace.NewTracerProvider(...)))
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(o *options) {
+		o.tracerProvider = provider
+	}
+}
+
+// WithMeterProvider 设置 Metrics 使用的 MeterProvider。
+//
+// 示例:
+//
+//	m, err := otel.NewMetrics(otel.WithMeterProvider(sdkmetric.NewMeterProvider(...)))
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(o *options) {
+		o.meterProvider = provider
+	}
+}
+
+// WithPropagator 设置注入请求头的 TextMapPropagator。
+//
+// 示例:
+//
+//	tracer := otel.NewTracer(otel.WithPropagator(propagation.TraceContext{}))
+func WithPropagator(propagator propagation.TextMapPropagator) Option {
+	return func(o *options) {
+		o.propagator = propagator
+	}
+}
+
+// forceFlusher 是 SDK 的 TracerProvider 和 MeterProvider 实现的刷新方法。
+type forceFlusher interface {
+	ForceFlush(ctx context.Context) error
+}
+
+// forceFlush 刷新 provider，provider 不支持刷新时什么也不做。
+func forceFlush(ctx context.Context, provider interface{}) error {
+	if f, ok := provider.(forceFlusher); ok {
+		return f.ForceFlush(ctx)
+	}
+	return nil
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
@@ -0,0 +1,142 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package otel
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	gootel "go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Tracer 是 spapi.Tracer 的 OpenTelemetry 实现。
+//
+// Tracer 同时实现 spapi.TracePropagator，SDK 会在发送请求前
+// 把当前 span 的上下文注入请求头。
+type Tracer struct {
+	provider   trace.TracerProvider
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+}
+
+// NewTracer 创建 OpenTelemetry Tracer。
+//
+// 参数:
+//   - opts: 配置选项（WithTracerProvider、WithPropagator）
+//
+// 返回值:
+//   - *Tracer: Tracer 实例
+//
+// 示例:
+//
+//	tracer := otel.NewTracer(otel.WithTracerProvider(tp))
+//	client, err := spapi.NewClient(spapi.WithTracer(tracer), ...)
+func NewTracer(opts ...Option) *Tracer {
+	o := newOptions(opts)
+	provider := o.tracerProvider
+	if provider == nil {
+		provider = gootel.GetTracerProvider()
+	}
+	return &Tracer{
+		provider:   provider,
+		tracer:     provider.Tracer(instrumentationName),
+		propagator: o.propagator,
+	}
+}
+
+// Flush 实现 spapi.Flusher，调用 TracerProvider 的 ForceFlush（如 SDK 的 TracerProvider）。
+//
+// spapi.Client.Close 在所有请求结束后调用 Flush，导出已结束的 span。
+func (t *Tracer) Flush(ctx context.Context) error {
+	return forceFlush(ctx, t.provider)
+}
+
+// StartSpan 实现 spapi.Tracer，开始一个 client span。
+func (t *Tracer) StartSpan(ctx context.Context, name string) (context.Context, spapi.Span) {
+	ctx, span := t.tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient))
+	return ctx, &Span{span: span}
+}
+
+// Inject 实现 spapi.TracePropagator，把 ctx 中的追踪上下文写入 header。
+func (t *Tracer) Inject(ctx context.Context, header http.Header) {
+	propagator := t.propagator
+	if propagator == nil {
+		// 在调用时读取，应用可以在创建 Tracer 之后再设置全局 Propagator
+		propagator = gootel.GetTextMapPropagator()
+	}
+	propagator.Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+// Span 是 spapi.Span 的 OpenTelemetry 实现。
+type Span struct {
+	span trace.Span
+}
+
+// End 结束 span。
+func (s *Span) End() {
+	s.span.End()
+}
+
+// SetAttribute 设置属性，value 按类型转换为 OpenTelemetry 属性值。
+func (s *Span) SetAttribute(key string, value interface{}) {
+	s.span.SetAttributes(attributeOf(key, value))
+}
+
+// RecordError 记录错误并把 span 状态设为 Error。
+func (s *Span) RecordError(err error) {
+	s.span.RecordError(err)
+	s.span.SetStatus(codes.Error, err.Error())
+}
+
+// attributeOf 把任意值转换为 OpenTelemetry 属性。
+func attributeOf(key string, value interface{}) attribute.KeyValue {
+	switch v := value.(type) {
+	case string:
+		return attribute.String(key, v)
+	case bool:
+		return attribute.Bool(key, v)
+	case int:
+		return attribute.Int(key, v)
+	case int64:
+		return attribute.Int64(key, v)
+	case float64:
+		return attribute.Float64(key, v)
+	case []string:
+		return attribute.StringSlice(key, v)
+	case fmt.Stringer:
+		return attribute.String(key, v.String())
+	default:
+		return attribute.String(key, fmt.Sprint(v))
+	}
+}
+
+var (
+	_ spapi.Tracer          = (*Tracer)(nil)
+	_ spapi.TracePropagator = (*Tracer)(nil)
+	_ spapi.Span            = (*Span)(nil)
+)
@@ -0,0 +1,153 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package otel_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/otel"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/spapitest"
+)
+
+// TestTracer_Client 测试请求 span 的属性和追踪上下文传播。
+func TestTracer_Client(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+	srv.Handle(http.MethodGet, "/orders/v0/orders", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	})
+	srv.Handle(http.MethodGet, "/orders/v0/orders/{orderId}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errors":[{"code":"NotFound","message":"no order"}]}`))
+	})
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	tracer := otel.NewTracer(
+		otel.WithTracerProvider(provider),
+		otel.WithPropagator(propagation.TraceContext{}),
+	)
+
+	client, err := srv.NewClient(spapi.WithTracer(tracer))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	ctx := context.Background()
+	query := url.Values{"MarketplaceIds": {"ATVPDKIKX0DER"}}
+	if err := client.GetValues(ctx, "/orders/v0/orders", query, nil); err != nil {
+		t.Fatalf("GetValues() error = %v", err)
+	}
+	if err := client.Get(ctx, "/orders/v0/orders/123-1234567-1234567", nil, nil); err == nil {
+		t.Fatal("Get() error = nil, want 404")
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("ended spans = %d, want 2", len(spans))
+	}
+
+	ok := spans[0]
+	if ok.Name() != "orders-v0:getOrders" || ok.SpanKind() != trace.SpanKindClient {
+		t.Errorf("span = %s (%v), want orders-v0:getOrders (client)", ok.Name(), ok.SpanKind())
+	}
+	attrs := attribute.NewSet(ok.Attributes()...)
+	wantAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPResponseStatusCode(http.StatusOK),
+		semconv.ServerAddress("127.0.0.1"),
+		attribute.String("spapi.operation", "orders-v0:getOrders"),
+		attribute.String("spapi.marketplace_id", "ATVPDKIKX0DER"),
+	}
+	for _, want := range wantAttrs {
+		if got, found := attrs.Value(want.Key); !found || got != want.Value {
+			t.Errorf("attribute %s = %v, want %v", want.Key, got.Emit(), want.Value.Emit())
+		}
+	}
+	if _, found := attrs.Value(semconv.URLFullKey); !found {
+		t.Errorf("attribute %s missing", semconv.URLFullKey)
+	}
+
+	failed := spans[1]
+	if failed.Status().Code != codes.Error {
+		t.Errorf("status = %v, want Error", failed.Status().Code)
+	}
+	failedAttrs := attribute.NewSet(failed.Attributes()...)
+	if got, _ := failedAttrs.Value(semconv.ErrorTypeKey); got.AsString() != "404" {
+		t.Errorf("error.type = %q, want 404", got.AsString())
+	}
+
+	// 请求头中的 traceparent 属于对应的 span
+	requests := srv.Requests()
+	for i, span := range spans {
+		carrier := propagation.HeaderCarrier(requests[len(requests)-len(spans)+i].Header)
+		got := trace.SpanContextFromContext(propagation.TraceContext{}.Extract(context.Background(), carrier))
+		if got.SpanID() != span.SpanContext().SpanID() {
+			t.Errorf("request %d traceparent span = %s, want %s", i, got.SpanID(), span.SpanContext().SpanID())
+		}
+	}
+}
+
+// TestTracer_FlushOnClose 测试 client.Close 导出批处理中的 span。
+func TestTracer_FlushOnClose(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+	srv.Handle(http.MethodGet, "/orders/v0/orders", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	})
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter, sdktrace.WithBatchTimeout(time.Hour)))
+	defer provider.Shutdown(context.Background())
+
+	client, err := srv.NewClient(spapi.WithTracer(otel.NewTracer(otel.WithTracerProvider(provider))))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	ctx := context.Background()
+	if err := client.Get(ctx, "/orders/v0/orders", nil, nil); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if n := len(exporter.GetSpans()); n != 0 {
+		t.Fatalf("exported spans before Close = %d, want 0", n)
+	}
+
+	if err := client.Close(ctx); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if n := len(exporter.GetSpans()); n != 1 {
+		t.Errorf("exported spans after Close = %d, want 1", n)
+	}
+}
@@ -0,0 +1,366 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+package spapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"strings"
+	"time"
+)
+
+// 轮询错误。
+var (
+	// ErrPollFailed 表示异步操作进入了失败的终止状态（如 FATAL、CANCELLED）。
+	ErrPollFailed = errors.New("async operation failed")
+
+	// ErrPollMaxAttempts 表示轮询次数超过上限仍未到达终止状态。
+	ErrPollMaxAttempts = errors.New("async operation did not finish within max attempts")
+)
+
+// 常用的终止状态集合。
+var (
+	// ProcessingStatusTerminal 是 Reports、Feeds、Data Kiosk 的终止状态。
+	ProcessingStatusTerminal = []string{"DONE", "CANCELLED", "FATAL"}
+
+	// ProcessingStatusFailed 是 Reports、Feeds、Data Kiosk 的失败状态。
+	ProcessingStatusFailed = []string{"CANCELLED", "FATAL"}
+
+	// OperationStatusTerminal 是 Fulfillment Inbound v2024-03-20 操作的终止状态。
+	OperationStatusTerminal = []string{"SUCCESS", "FAILED"}
+
+	// OperationStatusFailed 是 Fulfillment Inbound v2024-03-20 操作的失败状态。
+	OperationStatusFailed = []string{"FAILED"}
+)
+
+// PollFunc 执行一次状态查询（如 GetReport、GetFeed）。
+type PollFunc[T any] func(ctx context.Context) (T, error)
+
+// StatusFunc 从查询结果中提取状态字符串。
+type StatusFunc[T any] func(result T) (string, error)
+
+// PollProgress 描述一次轮询的进度，传递给进度回调。
+type PollProgress struct {
+	// Attempt 是当前轮询次数（从 1 开始）
+	Attempt int
+
+	// Status 是本次查询得到的状态
+	Status string
+
+	// Elapsed 是自开始轮询以来经过的时间
+	Elapsed time.Duration
+
+	// NextDelay 是距离下一次查询的等待时间（终止状态时为 0）
+	NextDelay time.Duration
+}
+
+// pollerSettings 保存轮询器的配置。
+type pollerSettings struct {
+	terminal        map[string]struct{}
+	failed          map[string]struct{}
+	initialInterval time.Duration
+	maxInterval     time.Duration
+	multiplier      float64
+	jitter          float64
+	timeout         time.Duration
+	maxAttempts     int
+	onProgress      func(PollProgress)
+}
+
+// PollerOption 定义轮询器配置选项函数。
+type PollerOption func(*pollerSettings)
+
+// WithTerminalStates 设置终止状态。到达任一终止状态时停止轮询。
+func WithTerminalStates(states ...string) PollerOption {
+	return func(s *pollerSettings) {
+		s.terminal = toStateSet(states)
+	}
+}
+
+// WithFailureStates 设置失败状态。到达失败状态时返回 ErrPollFailed。
+//
+// 失败状态自动视为终止状态。
+func WithFailureStates(states ...string) PollerOption {
+	return func(s *pollerSettings) {
+		s.failed = toStateSet(states)
+	}
+}
+
+// WithPollInterval 设置指数退避的初始间隔、最大间隔和乘数。
+//
+// 参数:
+//   - initial: 第一次重新查询前的等待时间
+//   - max: 等待时间上限（0 或负数时为 MaxPollInterval）
+//   - multiplier: 退避乘数（小于 1 时按 1 处理，即固定间隔）
+func WithPollInterval(initial, max time.Duration, multiplier float64) PollerOption {
+	return func(s *pollerSettings) {
+		s.initialInterval = initial
+		s.maxInterval = max
+		s.multiplier = multiplier
+	}
+}
+
+// WithPollJitter 设置退避抖动比例（0.0-1.0）。
+//
+// 例如 0.2 表示每次等待时间在计算值的 ±20% 范围内随机浮动，
+// 避免大量轮询同时打到同一个操作上。
+func WithPollJitter(jitter float64) PollerOption {
+	return func(s *pollerSettings) {
+		s.jitter = jitter
+	}
+}
+
+// WithPollTimeout 设置整个轮询过程的超时时间。
+//
+// 与 ctx 的截止时间同时生效，以较早者为准。
+func WithPollTimeout(timeout time.Duration) PollerOption {
+	return func(s *pollerSettings) {
+		s.timeout = timeout
+	}
+}
+
+// WithMaxPollAttempts 设置最大查询次数（0 表示不限制）。
+func WithMaxPollAttempts(attempts int) PollerOption {
+	return func(s *pollerSettings) {
+		s.maxAttempts = attempts
+	}
+}
+
+// WithPollProgress 设置进度回调，每次查询后调用。
+func WithPollProgress(fn func(PollProgress)) PollerOption {
+	return func(s *pollerSettings) {
+		s.onProgress = fn
+	}
+}
+
+// Poller 是通用的异步操作轮询器。
+//
+// SP-API 中很多操作是"创建后轮询"语义：Reports/Feeds 的 processingStatus、
+// Data Kiosk 查询、Fulfillment Inbound v2024-03-20 的 GetInboundOperationStatus、
+// Invoices 的 GetInvoicesExport 等。Poller 统一处理这些场景的轮询逻辑：
+//   - 可配置的状态提取函数
+//   - 终止状态和失败状态集合
+//   - 带抖动的指数退避
+//   - 上下文截止时间和整体超时
+//   - 进度回调
+//
+// Poller 是无状态的，可以并发调用 Wait。
+//
+// 示例:
+//
+//	poller := spapi.NewPoller(
+//	    func(ctx context.Context) (interface{}, error) {
+//	        return reportsClient.GetReport(ctx, reportID, nil)
+//	    },
+//	    spapi.StatusAt[interface{}]("processingStatus"),
+//	    spapi.WithTerminalStates(spapi.ProcessingStatusTerminal...),
+//	    spapi.WithFailureStates(spapi.ProcessingStatusFailed...),
+//	    spapi.WithPollTimeout(30*time.Minute),
+//	)
+//	report, err := poller.Wait(ctx)
+type Poller[T any] struct {
+	poll     PollFunc[T]
+	status   StatusFunc[T]
+	settings pollerSettings
+}
+
+// NewPoller 创建新的轮询器。
+//
+// 默认配置：
+//   - 终止状态: ProcessingStatusTerminal
+//   - 失败状态: ProcessingStatusFailed
+//   - 初始间隔: 2s，最大间隔: 1m，乘数: 1.5
+//   - 抖动: 0.2
+//   - 无整体超时、无次数上限（受 ctx 控制）
+//
+// 参数:
+//   - poll: 状态查询函数
+//   - status: 状态提取函数
+//   - opts: 轮询器配置选项
+//
+// 返回值:
+//   - *Poller[T]: 轮询器实例
+func NewPoller[T any](poll PollFunc[T], status StatusFunc[T], opts ...PollerOption) *Poller[T] {
+	settings := pollerSettings{
+		terminal:        toStateSet(ProcessingStatusTerminal),
+		failed:          toStateSet(ProcessingStatusFailed),
+		initialInterval: 2 * time.Second,
+		maxInterval:     time.Minute,
+		multiplier:      1.5,
+		jitter:          0.2,
+	}
+
+	for _, opt := range opts {
+		opt(&settings)
+	}
+
+	return &Poller[T]{
+		poll:     poll,
+		status:   status,
+		settings: settings,
+	}
+}
+
+// Wait 轮询直到操作到达终止状态。
+//
+// 参数:
+//   - ctx: 请求上下文（取消或超时会终止轮询）
+//
+// 返回值:
+//   - T: 最后一次查询的结果（失败状态时同样返回，便于读取错误详情）
+//   - error: 查询失败、到达失败状态、超过次数上限或上下文结束时返回错误
+func (p *Poller[T]) Wait(ctx context.Context) (T, error) {
+	var last T
+
+	if p.settings.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.settings.timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+
+	for attempt := 1; ; attempt++ {
+		result, err := p.poll(ctx)
+		if err != nil {
+			return last, fmt.Errorf("poll attempt %d: %w", attempt, err)
+		}
+		last = result
+
+		status, err := p.status(result)
+		if err != nil {
+			return last, fmt.Errorf("extract status: %w", err)
+		}
+
+		_, failed := p.settings.failed[status]
+		_, terminal := p.settings.terminal[status]
+		done := failed || terminal
+
+		delay := time.Duration(0)
+		if !done {
+			delay = p.backoff(attempt)
+		}
+
+		if p.settings.onProgress != nil {
+			p.settings.onProgress(PollProgress{
+				Attempt:   attempt,
+				Status:    status,
+				Elapsed:   time.Since(start),
+				NextDelay: delay,
+			})
+		}
+
+		if failed {
+			return last, fmt.Errorf("%w: status %s", ErrPollFailed, status)
+		}
+		if terminal {
+			return last, nil
+		}
+
+		if p.settings.maxAttempts > 0 && attempt >= p.settings.maxAttempts {
+			return last, fmt.Errorf("%w: last status %s after %d attempts", ErrPollMaxAttempts, status, attempt)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return last, fmt.Errorf("poll canceled with status %s: %w", status, ctx.Err())
+		}
+	}
+}
+
+// MaxPollInterval 是未设置最大间隔（WithPollInterval 的 max 不为正数）时的等待时间上限。
+const MaxPollInterval = 24 * time.Hour
+
+// backoff 计算第 attempt 次查询后的等待时间。
+//
+// 使用指数退避算法：interval = initial * (multiplier ^ (attempt-1))，
+// 然后在 ±jitter 范围内随机浮动，并限制在 maxInterval（未设置时为
+// MaxPollInterval）以内，避免转换为 time.Duration 时溢出。
+func (p *Poller[T]) backoff(attempt int) time.Duration {
+	multiplier := p.settings.multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	limit := float64(MaxPollInterval)
+	if p.settings.maxInterval > 0 {
+		limit = float64(p.settings.maxInterval)
+	}
+
+	if p.settings.initialInterval <= 0 {
+		return 0
+	}
+	interval := float64(p.settings.initialInterval) * math.Pow(multiplier, float64(attempt-1))
+	interval = min(interval, limit)
+
+	if p.settings.jitter > 0 {
+		interval *= 1 + p.settings.jitter*(2*rand.Float64()-1)
+	}
+
+	return time.Duration(max(min(interval, limit), 0))
+}
+
+// StatusAt 返回从 JSON 对象结果中按路径提取状态的函数。
+//
+// 各 API 客户端的查询方法返回 interface{}（解码后的 map[string]interface{}），
+// 路径使用 "." 分隔嵌套字段，例如：
+//   - "processingStatus"（Reports、Feeds、Data Kiosk）
+//   - "operationStatus"（Fulfillment Inbound v2024-03-20）
+//   - "export.status"（Invoices GetInvoicesExport）
+//
+// 参数:
+//   - path: 状态字段路径
+//
+// 返回值:
+//   - StatusFunc[T]: 状态提取函数
+func StatusAt[T any](path string) StatusFunc[T] {
+	keys := strings.Split(path, ".")
+
+	return func(result T) (string, error) {
+		var current interface{} = result
+		for _, key := range keys {
+			object, ok := current.(map[string]interface{})
+			if !ok {
+				return "", fmt.Errorf("status path %q: %q is not an object", path, key)
+			}
+			current = object[key]
+		}
+
+		status, ok := current.(string)
+		if !ok || status == "" {
+			return "", fmt.Errorf("status path %q: missing or not a string", path)
+		}
+		return status, nil
+	}
+}
+
+// toStateSet 将状态列表转换为集合。
+func toStateSet(states []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(states))
+	for _, state := range states {
+		set[state] = struct{}{}
+	}
+	return set
+}
@@ -0,0 +1,213 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+package spapi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// statusSequence 返回依次产生给定状态的查询函数（最后一个状态会一直重复）和调用计数。
+func statusSequence(statuses ...string) (spapi.PollFunc[interface{}], *int) {
+	calls := 0
+	return func(ctx context.Context) (interface{}, error) {
+		status := statuses[min(calls, len(statuses)-1)]
+		calls++
+		return map[string]interface{}{"processingStatus": status}, nil
+	}, &calls
+}
+
+// TestPoller_Done 测试轮询到达成功终止状态。
+func TestPoller_Done(t *testing.T) {
+	poll, _ := statusSequence("IN_QUEUE", "IN_PROGRESS", "DONE")
+
+	var progress []spapi.PollProgress
+	poller := spapi.NewPoller(poll, spapi.StatusAt[interface{}]("processingStatus"),
+		spapi.WithPollInterval(time.Millisecond, 5*time.Millisecond, 2),
+		spapi.WithPollProgress(func(p spapi.PollProgress) {
+			progress = append(progress, p)
+		}),
+	)
+
+	result, err := poller.Wait(context.Background())
+	if err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+
+	if got := result.(map[string]interface{})["processingStatus"]; got != "DONE" {
+		t.Errorf("final status = %v, want DONE", got)
+	}
+
+	if len(progress) != 3 {
+		t.Fatalf("progress callbacks = %d, want 3", len(progress))
+	}
+	if progress[0].Attempt != 1 || progress[0].Status != "IN_QUEUE" || progress[0].NextDelay <= 0 {
+		t.Errorf("unexpected first progress: %+v", progress[0])
+	}
+	if progress[2].Status != "DONE" || progress[2].NextDelay != 0 {
+		t.Errorf("unexpected last progress: %+v", progress[2])
+	}
+}
+
+// TestPoller_Failed 测试轮询到达失败状态。
+func TestPoller_Failed(t *testing.T) {
+	poll, _ := statusSequence("IN_PROGRESS", "FATAL")
+
+	poller := spapi.NewPoller(poll, spapi.StatusAt[interface{}]("processingStatus"),
+		spapi.WithPollInterval(time.Millisecond, time.Millisecond, 1),
+	)
+
+	result, err := poller.Wait(context.Background())
+	if !errors.Is(err, spapi.ErrPollFailed) {
+		t.Fatalf("Wait() error = %v, want ErrPollFailed", err)
+	}
+	if result == nil {
+		t.Error("result should be returned on failure")
+	}
+}
+
+// TestPoller_MaxAttempts 测试轮询次数上限。
+func TestPoller_MaxAttempts(t *testing.T) {
+	poll, calls := statusSequence("IN_PROGRESS")
+
+	poller := spapi.NewPoller(poll, spapi.StatusAt[interface{}]("processingStatus"),
+		spapi.WithPollInterval(time.Millisecond, time.Millisecond, 1),
+		spapi.WithMaxPollAttempts(3),
+	)
+
+	_, err := poller.Wait(context.Background())
+	if !errors.Is(err, spapi.ErrPollMaxAttempts) {
+		t.Fatalf("Wait() error = %v, want ErrPollMaxAttempts", err)
+	}
+	if *calls != 3 {
+		t.Errorf("poll calls = %d, want 3", *calls)
+	}
+}
+
+// TestPoller_Timeout 测试整体超时。
+func TestPoller_Timeout(t *testing.T) {
+	poll, _ := statusSequence("IN_PROGRESS")
+
+	poller := spapi.NewPoller(poll, spapi.StatusAt[interface{}]("processingStatus"),
+		spapi.WithPollInterval(10*time.Millisecond, 10*time.Millisecond, 1),
+		spapi.WithPollTimeout(25*time.Millisecond),
+	)
+
+	_, err := poller.Wait(context.Background())
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Wait() error = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+// TestPoller_PollError 测试查询失败。
+func TestPoller_PollError(t *testing.T) {
+	wantErr := errors.New("boom")
+	poller := spapi.NewPoller(
+		func(ctx context.Context) (interface{}, error) { return nil, wantErr },
+		spapi.StatusAt[interface{}]("processingStatus"),
+	)
+
+	_, err := poller.Wait(context.Background())
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("Wait() error = %v, want %v", err, wantErr)
+	}
+}
+
+// TestPoller_CustomTerminalStates 测试自定义终止状态和类型化结果。
+func TestPoller_CustomTerminalStates(t *testing.T) {
+	type operation struct {
+		Status string
+	}
+
+	statuses := []string{"IN_PROGRESS", "SUCCESS"}
+	calls := 0
+	poller := spapi.NewPoller(
+		func(ctx context.Context) (operation, error) {
+			op := operation{Status: statuses[calls]}
+			calls++
+			return op, nil
+		},
+		func(op operation) (string, error) { return op.Status, nil },
+		spapi.WithTerminalStates(spapi.OperationStatusTerminal...),
+		spapi.WithFailureStates(spapi.OperationStatusFailed...),
+		spapi.WithPollInterval(time.Millisecond, time.Millisecond, 1),
+		spapi.WithPollJitter(0),
+	)
+
+	op, err := poller.Wait(context.Background())
+	if err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+	if op.Status != "SUCCESS" {
+		t.Errorf("status = %s, want SUCCESS", op.Status)
+	}
+}
+
+// TestStatusAt 测试按路径提取状态。
+func TestStatusAt(t *testing.T) {
+	result := map[string]interface{}{
+		"export": map[string]interface{}{"status": "PROCESSING"},
+	}
+
+	status, err := spapi.StatusAt[interface{}]("export.status")(result)
+	if err != nil || status != "PROCESSING" {
+		t.Errorf("StatusAt(export.status) = %q, %v", status, err)
+	}
+
+	if _, err := spapi.StatusAt[interface{}]("export.missing")(result); err == nil {
+		t.Error("expected error for missing status")
+	}
+
+	if _, err := spapi.StatusAt[interface{}]("processingStatus")("not an object"); err == nil {
+		t.Error("expected error for non-object result")
+	}
+}
+
+// TestPoller_UnboundedBackoff 测试未设置最大间隔时退避不会溢出。
+func TestPoller_UnboundedBackoff(t *testing.T) {
+	poll, _ := statusSequence("IN_PROGRESS")
+
+	var delays []time.Duration
+	poller := spapi.NewPoller(poll, spapi.StatusAt[interface{}]("processingStatus"),
+		spapi.WithPollInterval(time.Nanosecond, 0, 1e300),
+		spapi.WithPollJitter(0.5),
+		spapi.WithMaxPollAttempts(3),
+		spapi.WithPollProgress(func(p spapi.PollProgress) {
+			delays = append(delays, p.NextDelay)
+		}),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	// 第 2 次查询后的等待时间被限制为 MaxPollInterval，ctx 到期结束等待
+	if _, err := poller.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Wait() error = %v, want deadline exceeded", err)
+	}
+	if len(delays) != 2 {
+		t.Fatalf("delays = %v, want 2 entries", delays)
+	}
+	if d := delays[1]; d <= 0 || d > spapi.MaxPollInterval {
+		t.Errorf("NextDelay = %v, want in (0, %v]", d, spapi.MaxPollInterval)
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapi
+
+import (
+	"context"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/ratelimit"
+)
+
+// Priority 是请求在速率限制队列中的优先级。
+//
+// 同一操作的配额被多个调用方共享时（如交互式看板和夜间回填），
+// 排队的请求按优先级获得令牌，相同优先级先到先得。
+type Priority int
+
+// 预定义的优先级。
+const (
+	// PriorityLow 用于可以延后的后台任务（如夜间回填）。
+	PriorityLow = Priority(ratelimit.PriorityLow)
+
+	// PriorityNormal 是未设置优先级的请求的默认优先级。
+	PriorityNormal = Priority(ratelimit.PriorityNormal)
+
+	// PriorityHigh 用于面向用户的请求，可以使用 WithPriorityReserve 保留的配额。
+	PriorityHigh = Priority(ratelimit.PriorityHigh)
+)
+
+// String 返回优先级的名称（low、normal、high）。
+func (p Priority) String() string {
+	return ratelimit.Priority(p).String()
+}
+
+// WithPriority 返回携带请求优先级的 context。
+//
+// 排队等待速率限制（WithRateLimitWait）时，高优先级请求先获得令牌；排队较久的请求
+// 按 WithPriorityAging 的间隔逐级提升优先级，不会饿死。
+//
+// 参数:
+//   - ctx: 父 context
+//   - priority: 请求优先级
+//
+// 返回值:
+//   - context.Context: 携带优先级的 context
+//
+// 示例:
+//
+//	// 面向用户的请求
+//	ctx := spapi.WithPriority(r.Context(), spapi.PriorityHigh)
+//	order, err := ordersClient.GetOrder(ctx, orderID)
+//
+//	// 夜间回填
+//	ctx := spapi.WithPriority(context.Background(), spapi.PriorityLow)
+func WithPriority(ctx context.Context, priority Priority) context.Context {
+	return ratelimit.WithPriority(ctx, ratelimit.Priority(priority))
+}
+
+// PriorityFromContext 返回 context 中的请求优先级，未设置时返回 PriorityNormal。
+func PriorityFromContext(ctx context.Context) Priority {
+	return Priority(ratelimit.PriorityFromContext(ctx))
+}
@@ -0,0 +1,109 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapi_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/spapitest"
+)
+
+// TestWithPriority 测试排队的请求按优先级发送。
+func TestWithPriority(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+	srv.Handle(http.MethodGet, "/orders/v0/orders/{orderId}", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	})
+
+	client, err := srv.NewClient(spapi.WithSellerID("SELLER1"), spapi.WithRateLimitWait())
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	// 每 250ms 一个令牌，没有突发；清空桶后三个请求都需要排队
+	manager := client.RateLimitManager()
+	appID := client.Config().ClientID
+	if err := manager.UpdateRate("SELLER1", appID, "global", "orders-v0:getOrder", 4, 1); err != nil {
+		t.Fatal(err)
+	}
+	for manager.Allow("SELLER1", appID, "global", "orders-v0:getOrder") {
+	}
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for _, tc := range []struct {
+		orderID  string
+		priority spapi.Priority
+	}{
+		{"backfill", spapi.PriorityLow},
+		{"default", spapi.PriorityNormal},
+		{"dashboard", spapi.PriorityHigh},
+	} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := client.Get(spapi.WithPriority(ctx, tc.priority), "/orders/v0/orders/"+tc.orderID, nil, nil); err != nil {
+				t.Errorf("Get(%s) error = %v", tc.orderID, err)
+			}
+		}()
+		time.Sleep(5 * time.Millisecond)
+	}
+	wg.Wait()
+
+	var got []string
+	for _, req := range srv.Requests() {
+		got = append(got, req.Path)
+	}
+	want := []string{"/orders/v0/orders/dashboard", "/orders/v0/orders/default", "/orders/v0/orders/backfill"}
+	if len(got) != len(want) {
+		t.Fatalf("requests = %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("requests = %q, want %q", got, want)
+			break
+		}
+	}
+
+	if p := spapi.PriorityFromContext(spapi.WithPriority(ctx, spapi.PriorityHigh)); p != spapi.PriorityHigh || p.String() != "high" {
+		t.Errorf("PriorityFromContext() = %v, want high", p)
+	}
+	if p := spapi.PriorityFromContext(ctx); p != spapi.PriorityNormal {
+		t.Errorf("PriorityFromContext(background) = %v, want normal", p)
+	}
+}
+
+// TestWithPriorityReserve_Invalid 测试无效的保留比例。
+func TestWithPriorityReserve_Invalid(t *testing.T) {
+	_, err := spapi.NewClient(
+		spapi.WithRegion(spapi.RegionNA),
+		spapi.WithCredentials("client-id", "client-secret", "refresh-token"),
+		spapi.WithPriorityReserve(1.5),
+	)
+	if err == nil {
+		t.Error("NewClient() error = nil, want invalid PriorityReserve")
+	}
+}
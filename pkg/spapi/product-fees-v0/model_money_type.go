@@ -8,10 +8,19 @@
  */
 package product_fees_v0
 
+import (
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
 // An object representing a monetary value with its currency information.
 type MoneyType struct {
 	// The currency code in ISO 4217 format.
 	CurrencyCode string `json:"CurrencyCode,omitempty"`
 	// The monetary value.
-	Amount float64 `json:"Amount,omitempty"`
+	Amount spapi.Decimal `json:"Amount,omitzero"`
+}
+
+// ToMoney 转换为 spapi.Money。
+func (m MoneyType) ToMoney() spapi.Money {
+	return spapi.NewMoney(m.Amount, m.CurrencyCode)
 }
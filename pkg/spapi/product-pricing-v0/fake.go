@@ -0,0 +1,117 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package product_pricing_v0
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。
+//
+// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回
+// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，
+// 可以通过 Calls 和 CallsTo 查看。
+type Fake struct {
+	spapi.FakeRecorder
+
+	// GetCompetitivePricingFunc 实现 GetCompetitivePricing。
+	GetCompetitivePricingFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetItemOffersFunc 实现 GetItemOffers。
+	GetItemOffersFunc func(ctx context.Context, asin string, query map[string]string) (interface{}, error)
+
+	// GetItemOffersBatchFunc 实现 GetItemOffersBatch。
+	GetItemOffersBatchFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetListingOffersFunc 实现 GetListingOffers。
+	GetListingOffersFunc func(ctx context.Context, sellerSKU string, query map[string]string) (interface{}, error)
+
+	// GetListingOffersBatchFunc 实现 GetListingOffersBatch。
+	GetListingOffersBatchFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetPricingFunc 实现 GetPricing。
+	GetPricingFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+}
+
+// NewFake 创建未编程任何响应的 Fake。
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// GetCompetitivePricing 记录调用并执行 GetCompetitivePricingFunc。
+func (f *Fake) GetCompetitivePricing(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetCompetitivePricing", query)
+	if f.GetCompetitivePricingFunc == nil {
+		return nil, fmt.Errorf("GetCompetitivePricing: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetCompetitivePricingFunc(ctx, query)
+}
+
+// GetItemOffers 记录调用并执行 GetItemOffersFunc。
+func (f *Fake) GetItemOffers(ctx context.Context, asin string, query map[string]string) (interface{}, error) {
+	f.Record("GetItemOffers", asin, query)
+	if f.GetItemOffersFunc == nil {
+		return nil, fmt.Errorf("GetItemOffers: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetItemOffersFunc(ctx, asin, query)
+}
+
+// GetItemOffersBatch 记录调用并执行 GetItemOffersBatchFunc。
+func (f *Fake) GetItemOffersBatch(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("GetItemOffersBatch", body)
+	if f.GetItemOffersBatchFunc == nil {
+		return nil, fmt.Errorf("GetItemOffersBatch: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetItemOffersBatchFunc(ctx, body)
+}
+
+// GetListingOffers 记录调用并执行 GetListingOffersFunc。
+func (f *Fake) GetListingOffers(ctx context.Context, sellerSKU string, query map[string]string) (interface{}, error) {
+	f.Record("GetListingOffers", sellerSKU, query)
+	if f.GetListingOffersFunc == nil {
+		return nil, fmt.Errorf("GetListingOffers: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetListingOffersFunc(ctx, sellerSKU, query)
+}
+
+// GetListingOffersBatch 记录调用并执行 GetListingOffersBatchFunc。
+func (f *Fake) GetListingOffersBatch(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("GetListingOffersBatch", body)
+	if f.GetListingOffersBatchFunc == nil {
+		return nil, fmt.Errorf("GetListingOffersBatch: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetListingOffersBatchFunc(ctx, body)
+}
+
+// GetPricing 记录调用并执行 GetPricingFunc。
+func (f *Fake) GetPricing(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetPricing", query)
+	if f.GetPricingFunc == nil {
+		return nil, fmt.Errorf("GetPricing: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetPricingFunc(ctx, query)
+}
+
+// 确保 Fake 实现了 API。
+var _ API = (*Fake)(nil)
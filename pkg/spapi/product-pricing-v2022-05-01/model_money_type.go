@@ -8,10 +8,19 @@
  */
 package product_pricing_v2022_05_01
 
+import (
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
 // Currency type and monetary value schema to demonstrate pricing information.
 type MoneyType struct {
 	// The currency code in ISO 4217 format.
 	CurrencyCode string `json:"currencyCode,omitempty"`
 	// The monetary value.
-	Amount float64 `json:"amount,omitempty"`
+	Amount spapi.Decimal `json:"amount,omitzero"`
+}
+
+// ToMoney 转换为 spapi.Money。
+func (m MoneyType) ToMoney() spapi.Money {
+	return spapi.NewMoney(m.Amount, m.CurrencyCode)
 }
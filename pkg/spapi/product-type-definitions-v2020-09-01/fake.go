@@ -0,0 +1,69 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package product_type_definitions_v2020_09_01
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。
+//
+// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回
+// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，
+// 可以通过 Calls 和 CallsTo 查看。
+type Fake struct {
+	spapi.FakeRecorder
+
+	// GetDefinitionsProductTypeFunc 实现 GetDefinitionsProductType。
+	GetDefinitionsProductTypeFunc func(ctx context.Context, productType string, query map[string]string) (interface{}, error)
+
+	// SearchDefinitionsProductTypesFunc 实现 SearchDefinitionsProductTypes。
+	SearchDefinitionsProductTypesFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+}
+
+// NewFake 创建未编程任何响应的 Fake。
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// GetDefinitionsProductType 记录调用并执行 GetDefinitionsProductTypeFunc。
+func (f *Fake) GetDefinitionsProductType(ctx context.Context, productType string, query map[string]string) (interface{}, error) {
+	f.Record("GetDefinitionsProductType", productType, query)
+	if f.GetDefinitionsProductTypeFunc == nil {
+		return nil, fmt.Errorf("GetDefinitionsProductType: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetDefinitionsProductTypeFunc(ctx, productType, query)
+}
+
+// SearchDefinitionsProductTypes 记录调用并执行 SearchDefinitionsProductTypesFunc。
+func (f *Fake) SearchDefinitionsProductTypes(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("SearchDefinitionsProductTypes", query)
+	if f.SearchDefinitionsProductTypesFunc == nil {
+		return nil, fmt.Errorf("SearchDefinitionsProductTypes: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.SearchDefinitionsProductTypesFunc(ctx, query)
+}
+
+// 确保 Fake 实现了 API。
+var _ API = (*Fake)(nil)
@@ -0,0 +1,308 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+//
+// Package prometheus 提供 spapi.MetricsCollector 的 Prometheus 实现。
+//
+// Collector 导出 SDK 的完整指标集：
+//
+//	spapi_request_total{operation, marketplace, status_code}
+//	spapi_request_duration_seconds{operation, marketplace, status_code}
+//	spapi_request_errors_total{operation, marketplace, error_type}
+//	spapi_auth_token_refresh_total{grant_type}
+//	spapi_ratelimit_wait_seconds{operation, marketplace}
+//	spapi_ratelimit_active_limiters
+//	spapi_ratelimit_queue_depth{operation, marketplace, priority}
+//	spapi_ratelimit_rate{operation, marketplace}
+//	spapi_ratelimit_ceiling{operation, marketplace}
+//	spapi_ratelimit_adjustments_total{operation, marketplace, direction}
+//
+// Collector 实现 prometheus.Collector，需要注册到应用自己的 Registry。
+//
+// 示例:
+//
+//	collector := prometheus.NewCollector()
+//	registry.MustRegister(collector)
+//
+//	client, err := spapi.NewClient(
+//	    spapi.WithRegion(spapi.RegionNA),
+//	    spapi.WithCredentials(clientID, clientSecret, refreshToken),
+//	    spapi.WithMetrics(collector),
+//	)
+package prometheus
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/metrics"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// 各指标的标签。
+var (
+	requestLabels   = []string{metrics.LabelOperation, metrics.LabelMarketplace, metrics.LabelStatusCode}
+	errorLabels     = []string{metrics.LabelOperation, metrics.LabelMarketplace, metrics.LabelErrorType}
+	tokenLabels     = []string{metrics.LabelGrantType}
+	rateLimitLabels = []string{metrics.LabelOperation, metrics.LabelMarketplace}
+	queueLabels     = []string{metrics.LabelOperation, metrics.LabelMarketplace, metrics.LabelPriority}
+	adjustLabels    = []string{metrics.LabelOperation, metrics.LabelMarketplace, metrics.LabelDirection}
+)
+
+// Collector 是 SP-API 指标的 Prometheus 收集器。
+//
+// Collector 同时实现 spapi.MetricsCollector 和 SDK 内部的指标记录接口，
+// 通过 spapi.WithMetrics 注入后，SDK 记录带 operation、marketplace、
+// status_code 标签的完整指标集。
+//
+// Collector 是并发安全的。
+type Collector struct {
+	requests       *prometheus.CounterVec
+	duration       *prometheus.HistogramVec
+	errors         *prometheus.CounterVec
+	tokenRefreshes *prometheus.CounterVec
+	rateLimitWait  *prometheus.HistogramVec
+	activeLimiters prometheus.Gauge
+	queueDepth     *prometheus.GaugeVec
+	rate           *prometheus.GaugeVec
+	ceiling        *prometheus.GaugeVec
+	adjustments    *prometheus.CounterVec
+}
+
+// Option 是 Collector 的配置选项。
+type Option func(*options)
+
+type options struct {
+	constLabels     prometheus.Labels
+	durationBuckets []float64
+	waitBuckets     []float64
+}
+
+// WithConstLabels 为所有指标添加固定标签。
+//
+// 同一进程中有多个客户端（如多个卖家）时，可以用固定标签区分。
+//
+// 示例:
+//
+//	collector := prometheus.NewCollector(
+//	    prometheus.WithConstLabels(map[string]string{"seller": "A1B2C3"}),
+//	)
+func WithConstLabels(labels map[string]string) Option {
+	return func(o *options) {
+		o.constLabels = labels
+	}
+}
+
+// WithDurationBuckets 设置请求延迟直方图的桶（秒）。
+//
+// 默认使用 prometheus.DefBuckets。
+func WithDurationBuckets(buckets []float64) Option {
+	return func(o *options) {
+		o.durationBuckets = buckets
+	}
+}
+
+// WithRateLimitWaitBuckets 设置速率限制等待直方图的桶（秒）。
+func WithRateLimitWaitBuckets(buckets []float64) Option {
+	return func(o *options) {
+		o.waitBuckets = buckets
+	}
+}
+
+// NewCollector 创建 Prometheus 收集器。
+//
+// 参数:
+//   - opts: 配置选项
+//
+// 返回值:
+//   - *Collector: 收集器实例，需要注册到 prometheus.Registerer
+//
+// 示例:
+//
+//	collector := prometheus.NewCollector()
+//	prometheus.DefaultRegisterer.MustRegister(collector)
+func NewCollector(opts ...Option) *Collector {
+	o := &options{
+		durationBuckets: prometheus.DefBuckets,
+		waitBuckets:     []float64{0.001, 0.01, 0.1, 0.5, 1, 5, 10, 30},
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return &Collector{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:        metrics.MetricRequestTotal,
+			Help:        "Total number of SP-API requests.",
+			ConstLabels: o.constLabels,
+		}, requestLabels),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:        metrics.MetricRequestDuration,
+			Help:        "SP-API request duration in seconds.",
+			ConstLabels: o.constLabels,
+			Buckets:     o.durationBuckets,
+		}, requestLabels),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:        metrics.MetricRequestErrors,
+			Help:        "Total number of failed SP-API requests.",
+			ConstLabels: o.constLabels,
+		}, errorLabels),
+		tokenRefreshes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:        metrics.MetricAuthTokenRefresh,
+			Help:        "Total number of LWA access token refreshes.",
+			ConstLabels: o.constLabels,
+		}, tokenLabels),
+		rateLimitWait: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:        metrics.MetricRateLimitWait,
+			Help:        "Time spent waiting for the rate limiter in seconds.",
+			ConstLabels: o.constLabels,
+			Buckets:     o.waitBuckets,
+		}, rateLimitLabels),
+		activeLimiters: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name:        metrics.MetricRateLimitActive,
+			Help:        "Number of active rate limiters.",
+			ConstLabels: o.constLabels,
+		}),
+		queueDepth: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name:        metrics.MetricRateLimitQueueDepth,
+			Help:        "Number of requests queued for the rate limiter by priority.",
+			ConstLabels: o.constLabels,
+		}, queueLabels),
+		rate: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name:        metrics.MetricRateLimitRate,
+			Help:        "Adaptive request rate of the rate limiter in requests per second.",
+			ConstLabels: o.constLabels,
+		}, rateLimitLabels),
+		ceiling: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name:        metrics.MetricRateLimitCeiling,
+			Help:        "Rate limit ceiling from x-amzn-RateLimit-Limit or the static rate in requests per second.",
+			ConstLabels: o.constLabels,
+		}, rateLimitLabels),
+		adjustments: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:        metrics.MetricRateLimitAdjustments,
+			Help:        "Total number of adaptive rate adjustments by direction.",
+			ConstLabels: o.constLabels,
+		}, adjustLabels),
+	}
+}
+
+// Describe 实现 prometheus.Collector。
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.requests.Describe(ch)
+	c.duration.Describe(ch)
+	c.errors.Describe(ch)
+	c.tokenRefreshes.Describe(ch)
+	c.rateLimitWait.Describe(ch)
+	c.activeLimiters.Describe(ch)
+	c.queueDepth.Describe(ch)
+	c.rate.Describe(ch)
+	c.ceiling.Describe(ch)
+	c.adjustments.Describe(ch)
+}
+
+// Collect 实现 prometheus.Collector。
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.requests.Collect(ch)
+	c.duration.Collect(ch)
+	c.errors.Collect(ch)
+	c.tokenRefreshes.Collect(ch)
+	c.rateLimitWait.Collect(ch)
+	c.activeLimiters.Collect(ch)
+	c.queueDepth.Collect(ch)
+	c.rate.Collect(ch)
+	c.ceiling.Collect(ch)
+	c.adjustments.Collect(ch)
+}
+
+// RecordRequest 实现 spapi.MetricsCollector，marketplace 标签为空。
+func (c *Collector) RecordRequest(api, method string, duration time.Duration, statusCode int) {
+	values := []string{api, "", strconv.Itoa(statusCode)}
+	c.requests.WithLabelValues(values...).Inc()
+	c.duration.WithLabelValues(values...).Observe(duration.Seconds())
+}
+
+// RecordError 实现 spapi.MetricsCollector，marketplace 标签为空。
+func (c *Collector) RecordError(api, errorType string) {
+	c.errors.WithLabelValues(api, "", errorType).Inc()
+}
+
+// RecordRateLimitWait 实现 spapi.MetricsCollector，marketplace 标签为空。
+func (c *Collector) RecordRateLimitWait(api string, duration time.Duration) {
+	c.rateLimitWait.WithLabelValues(api, "").Observe(duration.Seconds())
+}
+
+// RecordCounter 记录计数器指标，忽略未知的指标名称。
+func (c *Collector) RecordCounter(name string, value float64, labels map[string]string) {
+	switch name {
+	case metrics.MetricRequestTotal:
+		c.requests.With(pick(labels, requestLabels)).Add(value)
+	case metrics.MetricRequestErrors:
+		c.errors.With(pick(labels, errorLabels)).Add(value)
+	case metrics.MetricAuthTokenRefresh:
+		c.tokenRefreshes.With(pick(labels, tokenLabels)).Add(value)
+	case metrics.MetricRateLimitAdjustments:
+		c.adjustments.With(pick(labels, adjustLabels)).Add(value)
+	}
+}
+
+// RecordGauge 记录仪表盘指标，忽略未知的指标名称。
+func (c *Collector) RecordGauge(name string, value float64, labels map[string]string) {
+	switch name {
+	case metrics.MetricRateLimitActive:
+		c.activeLimiters.Set(value)
+	case metrics.MetricRateLimitQueueDepth:
+		c.queueDepth.With(pick(labels, queueLabels)).Set(value)
+	case metrics.MetricRateLimitRate:
+		c.rate.With(pick(labels, rateLimitLabels)).Set(value)
+	case metrics.MetricRateLimitCeiling:
+		c.ceiling.With(pick(labels, rateLimitLabels)).Set(value)
+	}
+}
+
+// RecordHistogram 记录直方图指标（单位为秒），忽略未知的指标名称。
+func (c *Collector) RecordHistogram(name string, value float64, labels map[string]string) {
+	switch name {
+	case metrics.MetricRequestDuration:
+		c.duration.With(pick(labels, requestLabels)).Observe(value)
+	case metrics.MetricRateLimitWait:
+		c.rateLimitWait.With(pick(labels, rateLimitLabels)).Observe(value)
+	}
+}
+
+// RecordTiming 记录时间指标，忽略未知的指标名称。
+func (c *Collector) RecordTiming(name string, duration time.Duration, labels map[string]string) {
+	c.RecordHistogram(name, duration.Seconds(), labels)
+}
+
+// pick 按指标的标签名从 labels 中取值，缺少的标签取空字符串。
+func pick(labels map[string]string, names []string) prometheus.Labels {
+	picked := make(prometheus.Labels, len(names))
+	for _, name := range names {
+		picked[name] = labels[name]
+	}
+	return picked
+}
+
+var (
+	_ spapi.MetricsCollector = (*Collector)(nil)
+	_ metrics.Recorder       = (*Collector)(nil)
+	_ prometheus.Collector   = (*Collector)(nil)
+)
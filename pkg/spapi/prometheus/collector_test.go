@@ -0,0 +1,167 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package prometheus_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	promclient "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/metrics"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/prometheus"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/spapitest"
+)
+
+// TestCollector_Recorder 测试按 SDK 指标名称记录。
+func TestCollector_Recorder(t *testing.T) {
+	collector := prometheus.NewCollector(prometheus.WithConstLabels(map[string]string{"seller": "S1"}))
+	registry := promclient.NewRegistry()
+	registry.MustRegister(collector)
+
+	labels := map[string]string{
+		metrics.LabelOperation:   "orders-v0:getOrders",
+		metrics.LabelMarketplace: "ATVPDKIKX0DER",
+		metrics.LabelStatusCode:  "200",
+		metrics.LabelMethod:      "GET",
+	}
+	collector.RecordCounter(metrics.MetricRequestTotal, 1, labels)
+	collector.RecordCounter(metrics.MetricRequestTotal, 1, labels)
+	collector.RecordTiming(metrics.MetricRequestDuration, 250*time.Millisecond, labels)
+	collector.RecordCounter(metrics.MetricAuthTokenRefresh, 1, map[string]string{metrics.LabelGrantType: "refresh_token"})
+	collector.RecordGauge(metrics.MetricRateLimitActive, 3, nil)
+	collector.RecordGauge(metrics.MetricRateLimitQueueDepth, 4, map[string]string{
+		metrics.LabelOperation:   "orders-v0:getOrders",
+		metrics.LabelMarketplace: "ATVPDKIKX0DER",
+		metrics.LabelPriority:    "low",
+	})
+	limiterLabels := map[string]string{
+		metrics.LabelOperation:   "orders-v0:getOrders",
+		metrics.LabelMarketplace: "ATVPDKIKX0DER",
+	}
+	collector.RecordGauge(metrics.MetricRateLimitRate, 0.25, limiterLabels)
+	collector.RecordCounter(metrics.MetricRateLimitAdjustments, 1, map[string]string{
+		metrics.LabelOperation:   "orders-v0:getOrders",
+		metrics.LabelMarketplace: "ATVPDKIKX0DER",
+		metrics.LabelDirection:   "decrease",
+	})
+	collector.RecordCounter("unknown_metric", 1, nil)
+
+	expected := `
+# HELP spapi_auth_token_refresh_total Total number of LWA access token refreshes.
+# TYPE spapi_auth_token_refresh_total counter
+spapi_auth_token_refresh_total{grant_type="refresh_token",seller="S1"} 1
+# HELP spapi_ratelimit_active_limiters Number of active rate limiters.
+# TYPE spapi_ratelimit_active_limiters gauge
+spapi_ratelimit_active_limiters{seller="S1"} 3
+# HELP spapi_ratelimit_adjustments_total Total number of adaptive rate adjustments by direction.
+# TYPE spapi_ratelimit_adjustments_total counter
+spapi_ratelimit_adjustments_total{direction="decrease",marketplace="ATVPDKIKX0DER",operation="orders-v0:getOrders",seller="S1"} 1
+# HELP spapi_ratelimit_queue_depth Number of requests queued for the rate limiter by priority.
+# TYPE spapi_ratelimit_queue_depth gauge
+spapi_ratelimit_queue_depth{marketplace="ATVPDKIKX0DER",operation="orders-v0:getOrders",priority="low",seller="S1"} 4
+# HELP spapi_ratelimit_rate Adaptive request rate of the rate limiter in requests per second.
+# TYPE spapi_ratelimit_rate gauge
+spapi_ratelimit_rate{marketplace="ATVPDKIKX0DER",operation="orders-v0:getOrders",seller="S1"} 0.25
+# HELP spapi_request_total Total number of SP-API requests.
+# TYPE spapi_request_total counter
+spapi_request_total{marketplace="ATVPDKIKX0DER",operation="orders-v0:getOrders",seller="S1",status_code="200"} 2
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(expected),
+		metrics.MetricRequestTotal, metrics.MetricAuthTokenRefresh, metrics.MetricRateLimitActive,
+		metrics.MetricRateLimitQueueDepth, metrics.MetricRateLimitRate, metrics.MetricRateLimitAdjustments); err != nil {
+		t.Error(err)
+	}
+
+	count, err := testutil.GatherAndCount(registry, metrics.MetricRequestDuration)
+	if err != nil {
+		t.Fatalf("GatherAndCount() error = %v", err)
+	}
+	if count != 1 {
+		t.Errorf("duration series = %d, want 1", count)
+	}
+}
+
+// TestCollector_Client 测试通过 WithMetrics 注入后 SDK 记录的指标。
+func TestCollector_Client(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+	srv.Handle(http.MethodGet, "/orders/v0/orders", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	})
+	srv.Handle(http.MethodGet, "/orders/v0/orders/{orderId}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errors":[{"code":"NotFound","message":"no order"}]}`))
+	})
+
+	collector := prometheus.NewCollector()
+	registry := promclient.NewRegistry()
+	registry.MustRegister(collector)
+
+	client, err := srv.NewClient(spapi.WithMetrics(collector), spapi.WithRateLimitWait())
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	ctx := context.Background()
+	query := url.Values{"MarketplaceIds": {"ATVPDKIKX0DER"}}
+	if err := client.GetValues(ctx, "/orders/v0/orders", query, nil); err != nil {
+		t.Fatalf("GetValues() error = %v", err)
+	}
+	if err := client.Get(ctx, "/orders/v0/orders/123-1234567-1234567", nil, nil); err == nil {
+		t.Fatal("Get() error = nil, want 404")
+	}
+
+	expected := `
+# HELP spapi_auth_token_refresh_total Total number of LWA access token refreshes.
+# TYPE spapi_auth_token_refresh_total counter
+spapi_auth_token_refresh_total{grant_type="refresh_token"} 1
+# HELP spapi_ratelimit_active_limiters Number of active rate limiters.
+# TYPE spapi_ratelimit_active_limiters gauge
+spapi_ratelimit_active_limiters 2
+# HELP spapi_request_errors_total Total number of failed SP-API requests.
+# TYPE spapi_request_errors_total counter
+spapi_request_errors_total{error_type="client",marketplace="global",operation="orders-v0:getOrder"} 1
+# HELP spapi_request_total Total number of SP-API requests.
+# TYPE spapi_request_total counter
+spapi_request_total{marketplace="ATVPDKIKX0DER",operation="orders-v0:getOrders",status_code="200"} 1
+spapi_request_total{marketplace="global",operation="orders-v0:getOrder",status_code="404"} 1
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(expected),
+		metrics.MetricRequestTotal, metrics.MetricRequestErrors,
+		metrics.MetricAuthTokenRefresh, metrics.MetricRateLimitActive); err != nil {
+		t.Error(err)
+	}
+
+	count, err := testutil.GatherAndCount(registry, metrics.MetricRateLimitWait)
+	if err != nil {
+		t.Fatalf("GatherAndCount() error = %v", err)
+	}
+	if count != 2 {
+		t.Errorf("rate limit wait series = %d, want 2", count)
+	}
+}
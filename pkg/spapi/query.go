@@ -0,0 +1,209 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapi
+
+import (
+	"encoding"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// collectionSeparators 是各 collectionFormat 连接数组元素使用的分隔符。
+// multi 不连接，每个元素重复一次参数名。
+var collectionSeparators = map[string]string{
+	"csv":   ",",
+	"ssv":   " ",
+	"tsv":   "\t",
+	"pipes": "|",
+}
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	stringerType      = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+)
+
+// EncodeQuery 将带 query 标签的结构体编码为查询参数。
+//
+// 标签格式为 `query:"name,选项..."`，选项包括：
+//   - omitempty: 值为零值时省略
+//   - csv、multi、ssv、tsv、pipes: 切片的编码方式，对应 OpenAPI 参数的
+//     collectionFormat，默认 csv（逗号连接）；multi 为每个元素重复参数名
+//
+// 支持的字段类型为 string、bool、整数、浮点数、time.Time（RFC 3339，UTC）、
+// 实现 encoding.TextMarshaler 或 fmt.Stringer 的类型，以及它们的指针和切片。
+// nil 指针和空切片总是省略；没有 query 标签或标签为 "-" 的字段被忽略。
+//
+// 参数:
+//   - params: 结构体或结构体指针（nil 返回空的查询参数）
+//
+// 返回值:
+//   - url.Values: 查询参数
+//   - error: 如果字段类型不受支持，返回包装了 ErrInvalidRequest 的错误
+//
+// 示例:
+//
+//	type getOrdersParams struct {
+//	    MarketplaceIds []string  `query:"MarketplaceIds,csv"`
+//	    CreatedAfter   time.Time `query:"CreatedAfter,omitempty"`
+//	    OrderStatuses  []string  `query:"OrderStatuses,csv"`
+//	}
+//
+//	query, err := spapi.EncodeQuery(getOrdersParams{
+//	    MarketplaceIds: []string{"ATVPDKIKX0DER", "A2EUQ1WTGCTBG2"},
+//	    CreatedAfter:   time.Now().Add(-24 * time.Hour),
+//	})
+//	// MarketplaceIds=ATVPDKIKX0DER%2CA2EUQ1WTGCTBG2&CreatedAfter=2025-01-01T00%3A00%3A00Z
+func EncodeQuery(params interface{}) (url.Values, error) {
+	values := url.Values{}
+
+	rv := reflect.ValueOf(params)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return values, nil
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return values, nil
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: query params must be a struct, got %s", ErrInvalidRequest, rv.Type())
+	}
+
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		tag, ok := field.Tag.Lookup("query")
+		if !ok || tag == "-" || !field.IsExported() {
+			continue
+		}
+
+		name, options, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = field.Name
+		}
+		omitEmpty, format := false, "csv"
+		for _, option := range strings.Split(options, ",") {
+			switch option {
+			case "":
+			case "omitempty":
+				omitEmpty = true
+			case "multi":
+				format = option
+			default:
+				if _, ok := collectionSeparators[option]; !ok {
+					return nil, fmt.Errorf("%w: query field %s: unknown option %q", ErrInvalidRequest, field.Name, option)
+				}
+				format = option
+			}
+		}
+
+		if err := encodeQueryField(values, name, rv.Field(i), omitEmpty, format); err != nil {
+			return nil, fmt.Errorf("%w: query field %s: %w", ErrInvalidRequest, field.Name, err)
+		}
+	}
+	return values, nil
+}
+
+// encodeQueryField 编码一个字段，可能添加零个或多个值。
+func encodeQueryField(values url.Values, name string, v reflect.Value, omitEmpty bool, format string) error {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
+		if v.Len() == 0 {
+			return nil
+		}
+		items := make([]string, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			item, err := queryValue(v.Index(i))
+			if err != nil {
+				return err
+			}
+			items = append(items, item)
+		}
+		if format == "multi" {
+			for _, item := range items {
+				values.Add(name, item)
+			}
+			return nil
+		}
+		values.Set(name, strings.Join(items, collectionSeparators[format]))
+		return nil
+	}
+
+	if omitEmpty && v.IsZero() {
+		return nil
+	}
+	value, err := queryValue(v)
+	if err != nil {
+		return err
+	}
+	values.Set(name, value)
+	return nil
+}
+
+// queryValue 将单个值格式化为查询参数字符串。
+func queryValue(v reflect.Value) (string, error) {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return "", nil
+		}
+		v = v.Elem()
+	}
+
+	switch {
+	case v.Type() == timeType:
+		return v.Interface().(time.Time).UTC().Format(time.RFC3339), nil
+	case v.Type().Implements(textMarshalerType):
+		text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(text), err
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 32), nil
+	case reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), nil
+	}
+
+	if v.Type().Implements(stringerType) {
+		return v.Interface().(fmt.Stringer).String(), nil
+	}
+	return "", fmt.Errorf("unsupported type %s", v.Type())
+}
@@ -0,0 +1,96 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapi_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+func TestEncodeQuery(t *testing.T) {
+	type params struct {
+		MarketplaceIds []string   `query:"MarketplaceIds"`
+		Identifiers    []string   `query:"identifiers,multi"`
+		IncludedData   []string   `query:"includedData,pipes"`
+		Keywords       []string   `query:"keywords,ssv"`
+		CreatedAfter   time.Time  `query:"CreatedAfter,omitempty"`
+		CreatedBefore  *time.Time `query:"CreatedBefore"`
+		MaxResults     *int       `query:"MaxResultsPerPage"`
+		PageSize       int        `query:"pageSize,omitempty"`
+		Price          spapi.Decimal
+		Ignored        string `query:"-"`
+		Flag           bool   `query:"flag"`
+	}
+
+	maxResults := 20
+	query, err := spapi.EncodeQuery(&params{
+		MarketplaceIds: []string{"ATVPDKIKX0DER", "A2EUQ1WTGCTBG2"},
+		Identifiers:    []string{"B01", "B02"},
+		IncludedData:   []string{"summaries", "images"},
+		Keywords:       []string{"red", "shoes"},
+		CreatedAfter:   time.Date(2025, 1, 2, 3, 4, 5, 0, time.FixedZone("CST", 8*3600)),
+		MaxResults:     &maxResults,
+		Ignored:        "x",
+	})
+	if err != nil {
+		t.Fatalf("EncodeQuery() error = %v", err)
+	}
+
+	want := "CreatedAfter=2025-01-01T19%3A04%3A05Z&MarketplaceIds=ATVPDKIKX0DER%2CA2EUQ1WTGCTBG2" +
+		"&MaxResultsPerPage=20&flag=false&identifiers=B01&identifiers=B02" +
+		"&includedData=summaries%7Cimages&keywords=red+shoes"
+	if got := query.Encode(); got != want {
+		t.Errorf("EncodeQuery() = %s\nwant %s", got, want)
+	}
+}
+
+func TestEncodeQuery_Nil(t *testing.T) {
+	query, err := spapi.EncodeQuery(nil)
+	if err != nil || len(query) != 0 {
+		t.Errorf("EncodeQuery(nil) = %v, %v, want empty", query, err)
+	}
+}
+
+func TestEncodeQuery_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		params interface{}
+	}{
+		{"not a struct", "MarketplaceIds=1"},
+		{"unsupported type", struct {
+			Filter map[string]string `query:"filter"`
+		}{Filter: map[string]string{}}},
+		{"unknown option", struct {
+			IDs []string `query:"ids,json"`
+		}{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := spapi.EncodeQuery(tt.params); !errors.Is(err, spapi.ErrInvalidRequest) {
+				t.Errorf("EncodeQuery() error = %v, want ErrInvalidRequest", err)
+			}
+		})
+	}
+}
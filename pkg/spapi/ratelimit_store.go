@@ -0,0 +1,123 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/ratelimit"
+)
+
+// RateLimitStore 存储速率限制令牌桶的状态，使多个进程共享同一份配额。
+//
+// 例如 20 个 Pod 使用同一卖家和应用时，每个 Pod 的进程内令牌桶都以为
+// 自己拥有全部配额；共享的 RateLimitStore 让它们从同一个桶中取令牌。
+// 桶按卖家、应用、市场和操作区分（与进程内的令牌桶相同），速率和突发限制
+// 仍由每个进程根据 x-amzn-RateLimit-Limit 维护，并随每次取令牌传入。
+//
+// 内置实现见 NewRedisRateLimitStore 和 NewFileRateLimitStore。
+type RateLimitStore interface {
+	// Take 按 rate 和 burst 补充 key 的桶，并原子地取出 n 个令牌。
+	// 返回 0 表示已取出；否则返回需要等待的时间，不取出任何令牌。
+	Take(ctx context.Context, key string, rate float64, burst, n int) (time.Duration, error)
+
+	// Tokens 返回 key 的桶当前可用的令牌数，不取出令牌。
+	Tokens(ctx context.Context, key string, rate float64, burst int) (float64, error)
+}
+
+// RedisScripter 执行 Redis Lua 脚本（EVAL）。
+//
+// 返回值是脚本返回值的 Go 表示：整数为 int64，字符串为 string，
+// 数组为 []interface{}（与 go-redis 的 Cmd.Result 一致）。
+type RedisScripter interface {
+	Eval(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error)
+}
+
+// RedisEvalFunc 把函数适配为 RedisScripter。
+type RedisEvalFunc func(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error)
+
+// Eval 实现 RedisScripter。
+func (f RedisEvalFunc) Eval(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error) {
+	return f(ctx, script, keys, args...)
+}
+
+// NewRedisRateLimitStore 创建基于 Redis 的令牌桶存储。
+//
+// 每个桶是一个带过期时间的哈希，补充和扣减在一个 Lua 脚本中原子完成，
+// 时间使用 Redis 服务器时钟，不受各 Pod 时钟偏差影响。
+// 也适用于兼容 EVAL 的存储（如 Valkey、KeyDB）。
+//
+// 参数:
+//   - client: 执行 EVAL 的 Redis 客户端
+//   - keyPrefix: 桶键的前缀（为空时使用 "spapi:ratelimit:"）
+//
+// 返回值:
+//   - RateLimitStore: 存储实例
+//
+// 示例:
+//
+//	rdb := redis.NewClient(&redis.Options{Addr: "redis:6379"})
+//	store := spapi.NewRedisRateLimitStore(spapi.RedisEvalFunc(
+//	    func(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error) {
+//	        return rdb.Eval(ctx, script, keys, args...).Result()
+//	    }), "")
+//
+//	client, err := spapi.NewClient(
+//	    spapi.WithRegion(spapi.RegionNA),
+//	    spapi.WithCredentials(...),
+//	    spapi.WithRateLimitStore(store),
+//	)
+func NewRedisRateLimitStore(client RedisScripter, keyPrefix string) RateLimitStore {
+	return ratelimit.NewRedisStore(client, keyPrefix)
+}
+
+// NewFileRateLimitStore 创建基于本地文件的令牌桶存储，供同一主机上的多个进程共享配额。
+//
+// 每个桶是 dir 中的一个小文件，读写时持有排他文件锁。dir 位于 tmpfs
+// （如 Linux 的 /dev/shm）时等同于共享内存。不支持网络文件系统；
+// 在不支持文件锁的平台上返回包装 errors.ErrUnsupported 的错误。
+//
+// 参数:
+//   - dir: 存放桶状态的目录（不存在时创建）
+//
+// 返回值:
+//   - RateLimitStore: 存储实例
+//   - error: 如果无法创建目录或平台不支持，返回错误
+//
+// 示例:
+//
+//	store, err := spapi.NewFileRateLimitStore("/dev/shm/spapi-ratelimit")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	client, err := spapi.NewClient(
+//	    spapi.WithRegion(spapi.RegionNA),
+//	    spapi.WithCredentials(...),
+//	    spapi.WithRateLimitStore(store),
+//	)
+func NewFileRateLimitStore(dir string) (RateLimitStore, error) {
+	store, err := ratelimit.NewFileStore(dir)
+	if err != nil {
+		return nil, err
+	}
+	return store, nil
+}
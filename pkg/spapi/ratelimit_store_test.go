@@ -0,0 +1,118 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapi_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/spapitest"
+)
+
+// recordingStore 记录 Take 的键，并总是允许请求。
+type recordingStore struct {
+	mu   sync.Mutex
+	keys []string
+}
+
+func (s *recordingStore) Take(ctx context.Context, key string, rate float64, burst, n int) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = append(s.keys, key)
+	return 0, nil
+}
+
+func (s *recordingStore) Tokens(ctx context.Context, key string, rate float64, burst int) (float64, error) {
+	return float64(burst), nil
+}
+
+// TestWithRateLimitStore 测试请求从共享存储中取令牌。
+func TestWithRateLimitStore(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+	srv.Handle(http.MethodGet, "/orders/v0/orders", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	})
+
+	store := &recordingStore{}
+	client, err := srv.NewClient(spapi.WithRateLimitStore(store), spapi.WithSellerID("SELLER1"), spapi.WithRateLimitWait())
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if err := client.Get(context.Background(), "/orders/v0/orders", map[string]string{"MarketplaceIds": "ATVPDKIKX0DER"}, nil); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	want := "SELLER1:" + client.Config().ClientID + ":ATVPDKIKX0DER:orders-v0:getOrders"
+	if len(store.keys) != 1 || store.keys[0] != want {
+		t.Errorf("store keys = %q, want [%s]", store.keys, want)
+	}
+}
+
+// TestFileRateLimitStore_SharedQuota 测试两个客户端共享文件存储中的配额。
+func TestFileRateLimitStore_SharedQuota(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+	srv.Handle(http.MethodGet, "/orders/v0/orders", func(w http.ResponseWriter, r *http.Request) {
+		// 速率 0.25/s，SDK 推算的突发限制为 5
+		w.Header().Set("x-amzn-RateLimit-Limit", "0.25")
+		w.Write([]byte(`{}`))
+	})
+
+	store, err := spapi.NewFileRateLimitStore(t.TempDir())
+	if errors.Is(err, errors.ErrUnsupported) {
+		t.Skip(err)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var clients []*spapi.Client
+	for range 2 {
+		client, err := srv.NewClient(spapi.WithRateLimitStore(store), spapi.WithRateLimitWait())
+		if err != nil {
+			t.Fatalf("NewClient() error = %v", err)
+		}
+		clients = append(clients, client)
+	}
+
+	// 突发限制为 5：两个客户端合计只能立即发送 5 个请求
+	query := map[string]string{"MarketplaceIds": "ATVPDKIKX0DER"}
+	for i := range 5 {
+		if err := clients[i%2].Get(context.Background(), "/orders/v0/orders", query, nil); err != nil {
+			t.Fatalf("Get() #%d error = %v", i, err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := clients[1].Get(ctx, "/orders/v0/orders", query, nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Get() #6 error = %v, want rate limit wait to exceed the deadline", err)
+	}
+	if got := len(srv.Requests()); got != 5 {
+		t.Errorf("requests = %d, want 5", got)
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapi
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/redact"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/transport"
+)
+
+// maxDebugBody 是调试输出中每个消息体的最大长度（脱敏后）。
+const maxDebugBody = 16 << 10
+
+// Redactor 返回客户端使用的脱敏器（内置规则加 Config.Redaction）。
+//
+// 自定义中间件和日志可以用它脱敏请求、响应和错误。
+//
+// 返回值:
+//   - *redact.Redactor: 脱敏器
+//
+// 示例:
+//
+//	r := client.Redactor()
+//	log.Printf("%s %s", req.Method, r.URL(req.URL))
+func (c *Client) Redactor() *redact.Redactor {
+	return c.redactor
+}
+
+// applyRedaction 使 Config.Logger 和 Config.Tracer 输出的字段和属性先经过脱敏。
+//
+// no-op 实现不输出任何内容，不需要包装。
+func applyRedaction(config *Config, redactor *redact.Redactor) {
+	if _, ok := config.Logger.(*noOpLogger); !ok {
+		config.Logger = &redactingLogger{logger: config.Logger, redactor: redactor}
+	}
+	if _, ok := config.Tracer.(*noOpTracer); !ok {
+		config.Tracer = &redactingTracer{tracer: config.Tracer, redactor: redactor}
+	}
+}
+
+// redactingLogger 在写入日志前脱敏字段。
+type redactingLogger struct {
+	logger   Logger
+	redactor *redact.Redactor
+}
+
+func (l *redactingLogger) Debug(msg string, fields ...Field) {
+	l.logger.Debug(msg, l.redact(fields)...)
+}
+
+func (l *redactingLogger) Info(msg string, fields ...Field) {
+	l.logger.Info(msg, l.redact(fields)...)
+}
+
+func (l *redactingLogger) Warn(msg string, fields ...Field) {
+	l.logger.Warn(msg, l.redact(fields)...)
+}
+
+func (l *redactingLogger) Error(msg string, fields ...Field) {
+	l.logger.Error(msg, l.redact(fields)...)
+}
+
+func (l *redactingLogger) With(fields ...Field) Logger {
+	return &redactingLogger{logger: l.logger.With(l.redact(fields)...), redactor: l.redactor}
+}
+
+// redact 返回脱敏后的字段副本。
+func (l *redactingLogger) redact(fields []Field) []Field {
+	redacted := make([]Field, len(fields))
+	for i, f := range fields {
+		redacted[i] = Field{Key: f.Key, Value: l.redactor.Value(f.Key, f.Value)}
+	}
+	return redacted
+}
+
+// redactingTracer 创建脱敏属性和错误的 span。
+type redactingTracer struct {
+	tracer   Tracer
+	redactor *redact.Redactor
+}
+
+func (t *redactingTracer) StartSpan(ctx context.Context, name string) (context.Context, Span) {
+	ctx, span := t.tracer.StartSpan(ctx, name)
+	return ctx, &redactingSpan{span: span, redactor: t.redactor}
+}
+
+// Inject 转发给被包装的 Tracer（如果它实现了 TracePropagator）。
+func (t *redactingTracer) Inject(ctx context.Context, header http.Header) {
+	if propagator, ok := t.tracer.(TracePropagator); ok {
+		propagator.Inject(ctx, header)
+	}
+}
+
+// redactingSpan 在设置属性和记录错误前脱敏。
+type redactingSpan struct {
+	span     Span
+	redactor *redact.Redactor
+}
+
+func (s *redactingSpan) End() {
+	s.span.End()
+}
+
+func (s *redactingSpan) SetAttribute(key string, value interface{}) {
+	s.span.SetAttribute(key, s.redactor.Any(value))
+}
+
+func (s *redactingSpan) RecordError(err error) {
+	if err == nil {
+		return
+	}
+	s.span.RecordError(redactedError{err: err, msg: s.redactor.Text(err.Error())})
+}
+
+// redactedError 脱敏错误消息，同时保留错误链（errors.Is/As）。
+type redactedError struct {
+	err error
+	msg string
+}
+
+func (e redactedError) Error() string { return e.msg }
+
+func (e redactedError) Unwrap() error { return e.err }
+
+// debugMiddleware 通过 Logger.Debug 输出脱敏后的请求和响应。
+//
+// 它是最内层的传输中间件，每次重试都会单独输出，
+// 请求头包含签名后的 x-amz-access-token（已脱敏）。
+func debugMiddleware(logger Logger, redactor *redact.Redactor) transport.Middleware {
+	return func(next transport.Handler) transport.Handler {
+		return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			body, err := peekRequestBody(req)
+			if err != nil {
+				return nil, err
+			}
+			logger.Debug("sp-api request",
+				Field{"method", req.Method},
+				Field{"url", redactor.URL(req.URL)},
+				Field{"header", redactor.Header(req.Header)},
+				Field{"body", debugBody(redactor, body, req.Header.Get("Content-Type"))},
+			)
+
+			start := time.Now()
+			resp, err := next(ctx, req)
+			if err != nil {
+				logger.Debug("sp-api request failed",
+					Field{"method", req.Method},
+					Field{"url", redactor.URL(req.URL)},
+					Field{"duration", time.Since(start)},
+					Field{"error", redactor.Text(err.Error())},
+				)
+				return resp, err
+			}
+
+			respBody, readErr := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			resp.Body = io.NopCloser(bytes.NewReader(respBody))
+			if readErr != nil {
+				return resp, readErr
+			}
+			logger.Debug("sp-api response",
+				Field{"method", req.Method},
+				Field{"url", redactor.URL(req.URL)},
+				Field{"status", resp.StatusCode},
+				Field{"duration", time.Since(start)},
+				Field{"header", redactor.Header(resp.Header)},
+				Field{"body", debugBody(redactor, respBody, resp.Header.Get("Content-Type"))},
+			)
+			return resp, nil
+		}
+	}
+}
+
+// peekRequestBody 读取请求体并恢复 req.Body，使请求仍可发送。
+func peekRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return io.ReadAll(body)
+	}
+
+	data, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
+
+// debugBody 脱敏并截断消息体。
+func debugBody(redactor *redact.Redactor, body []byte, contentType string) string {
+	redacted := redactor.Body(body, contentType)
+	if len(redacted) > maxDebugBody {
+		return string(redacted[:maxDebugBody]) + "...(truncated)"
+	}
+	return string(redacted)
+}
@@ -0,0 +1,174 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapi_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/spapitest"
+)
+
+// recordingLogger 把日志格式化为文本行。
+type recordingLogger struct {
+	mu    *sync.Mutex
+	lines *[]string
+	with  []spapi.Field
+}
+
+func newRecordingLogger() *recordingLogger {
+	return &recordingLogger{mu: &sync.Mutex{}, lines: new([]string)}
+}
+
+func (l *recordingLogger) log(level, msg string, fields []spapi.Field) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	line := level + " " + msg
+	for _, f := range append(append([]spapi.Field(nil), l.with...), fields...) {
+		line += fmt.Sprintf(" %s=%v", f.Key, f.Value)
+	}
+	*l.lines = append(*l.lines, line)
+}
+
+func (l *recordingLogger) Debug(msg string, fields ...spapi.Field) { l.log("DEBUG", msg, fields) }
+func (l *recordingLogger) Info(msg string, fields ...spapi.Field)  { l.log("INFO", msg, fields) }
+func (l *recordingLogger) Warn(msg string, fields ...spapi.Field)  { l.log("WARN", msg, fields) }
+func (l *recordingLogger) Error(msg string, fields ...spapi.Field) { l.log("ERROR", msg, fields) }
+
+func (l *recordingLogger) With(fields ...spapi.Field) spapi.Logger {
+	return &recordingLogger{mu: l.mu, lines: l.lines, with: append(append([]spapi.Field(nil), l.with...), fields...)}
+}
+
+func (l *recordingLogger) output() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return strings.Join(*l.lines, "\n")
+}
+
+// newPIIServer 启动返回包含 PII 的订单和错误的模拟服务器。
+func newPIIServer(t *testing.T) *spapitest.Server {
+	t.Helper()
+
+	srv := spapitest.NewServer()
+	t.Cleanup(srv.Close)
+	srv.Handle(http.MethodGet, "/orders/v0/orders/{orderId}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"payload":{"AmazonOrderId":"902-3159896-1390916","BuyerInfo":{"BuyerEmail":"jane@marketplace.amazon.com","BuyerName":"Jane Doe"},"ShippingAddress":{"Name":"Jane Doe","City":"Seattle"},"PurchaseOrderNumber":"PO-77"}}`))
+	})
+	srv.Handle(http.MethodGet, "/orders/v0/orders", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"errors":[{"code":"InvalidInput","message":"unknown buyer jane@marketplace.amazon.com"}]}`))
+	})
+	return srv
+}
+
+// TestClient_DebugRedaction 测试调试输出脱敏 PII、令牌和自定义规则。
+func TestClient_DebugRedaction(t *testing.T) {
+	srv := newPIIServer(t)
+	logger := newRecordingLogger()
+	client, err := srv.NewClient(
+		spapi.WithDebug(),
+		spapi.WithLogger(logger),
+		spapi.WithRedaction("$..PurchaseOrderNumber"),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	var result map[string]interface{}
+	if err := client.Get(context.Background(), "/orders/v0/orders/902-3159896-1390916", nil, &result); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	// 调用方收到完整的响应
+	if buyer := result["payload"].(map[string]interface{})["BuyerInfo"].(map[string]interface{}); buyer["BuyerName"] != "Jane Doe" {
+		t.Errorf("BuyerName = %v, want Jane Doe", buyer["BuyerName"])
+	}
+
+	output := logger.output()
+	for _, leaked := range []string{"Jane", "jane@", "Seattle", "PO-77", "Atza|"} {
+		if strings.Contains(output, leaked) {
+			t.Errorf("debug output contains %q:\n%s", leaked, output)
+		}
+	}
+	for _, want := range []string{"sp-api request", "sp-api response", "902-3159896-1390916", "X-Amz-Access-Token:[REDACTED]"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("debug output missing %q:\n%s", want, output)
+		}
+	}
+}
+
+// TestClient_TracerRedaction 测试 span 属性和错误的脱敏。
+func TestClient_TracerRedaction(t *testing.T) {
+	srv := newPIIServer(t)
+	tracer := &recordingTracer{}
+	client, err := srv.NewClient(spapi.WithTracer(tracer), spapi.WithMaxRetries(0))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	err = client.Get(context.Background(), "/orders/v0/orders", map[string]string{
+		"MarketplaceIds": "ATVPDKIKX0DER",
+		"BuyerEmail":     "jane@marketplace.amazon.com",
+	}, nil)
+	var apiErr *spapi.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Get() error = %v, want APIError", err)
+	}
+
+	span := tracer.spans[0]
+	url, _ := span.attributes["url.full"].(string)
+	if strings.Contains(url, "jane") || !strings.Contains(url, "MarketplaceIds=ATVPDKIKX0DER") {
+		t.Errorf("url.full = %q, want BuyerEmail redacted", url)
+	}
+	if span.err == nil || strings.Contains(span.err.Error(), "jane") {
+		t.Errorf("recorded error = %v, want email redacted", span.err)
+	}
+	if !errors.As(span.err, &apiErr) {
+		t.Errorf("recorded error %v does not wrap APIError", span.err)
+	}
+
+	if got := tracer.spans[0].attributes["spapi.marketplace_id"]; got != "ATVPDKIKX0DER" {
+		t.Errorf("spapi.marketplace_id = %v, want ATVPDKIKX0DER", got)
+	}
+	requests := srv.Requests()
+	if got := requests[len(requests)-1].Header.Get("X-Test-Span"); got != "orders-v0:getOrders" {
+		t.Errorf("X-Test-Span = %q, want orders-v0:getOrders (Inject forwarded)", got)
+	}
+}
+
+// TestWithRedaction_InvalidRule 测试无效的脱敏规则。
+func TestWithRedaction_InvalidRule(t *testing.T) {
+	_, err := spapi.NewClient(
+		spapi.WithRegion(spapi.RegionNA),
+		spapi.WithCredentials("client-id", "client-secret", "refresh-token"),
+		spapi.WithRedaction("payload.BuyerInfo"),
+	)
+	if err == nil || !strings.Contains(err.Error(), "invalid redaction rule") {
+		t.Errorf("NewClient() error = %v, want invalid redaction rule", err)
+	}
+}
@@ -8,22 +8,26 @@
  */
 package replenishment_v2022_11_07
 
+import (
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
 // An object which contains metric data for a selling partner.
 type GetSellingPartnerMetricsResponseMetric struct {
 	// The percentage of items that were not shipped out of the total shipped units over a period of time due to being out of stock. Applicable to PERFORMANCE timePeriodType.
 	NotDeliveredDueToOOS float64 `json:"notDeliveredDueToOOS,omitempty"`
 	// The revenue generated from subscriptions over a period of time. Applicable for both the PERFORMANCE and FORECAST timePeriodType.
-	TotalSubscriptionsRevenue float64 `json:"totalSubscriptionsRevenue,omitempty"`
+	TotalSubscriptionsRevenue spapi.Decimal `json:"totalSubscriptionsRevenue,omitzero"`
 	// The number of units shipped to the subscribers over a period of time. Applicable for both the PERFORMANCE and FORECAST timePeriodType.
 	ShippedSubscriptionUnits float64 `json:"shippedSubscriptionUnits,omitempty"`
 	// The number of active subscriptions present at the end of the period. Applicable to PERFORMANCE timePeriodType.
 	ActiveSubscriptions float64 `json:"activeSubscriptions,omitempty"`
 	// The average revenue per subscriber of the program over a period of past 12 months for sellers and 6 months for vendors. Applicable to PERFORMANCE timePeriodType.
-	SubscriberAverageRevenue float64 `json:"subscriberAverageRevenue,omitempty"`
+	SubscriberAverageRevenue spapi.Decimal `json:"subscriberAverageRevenue,omitzero"`
 	// The average revenue per non-subscriber of the program over a period of past 12 months for sellers and 6 months for vendors. Applicable to PERFORMANCE timePeriodType.
-	NonSubscriberAverageRevenue float64 `json:"nonSubscriberAverageRevenue,omitempty"`
+	NonSubscriberAverageRevenue spapi.Decimal `json:"nonSubscriberAverageRevenue,omitzero"`
 	// The revenue that would have been generated had there not been out of stock. Applicable to PERFORMANCE timePeriodType.
-	LostRevenueDueToOOS float64 `json:"lostRevenueDueToOOS,omitempty"`
+	LostRevenueDueToOOS spapi.Decimal `json:"lostRevenueDueToOOS,omitzero"`
 	// The average reorders per subscriber of the program over a period of 12 months. Applicable to PERFORMANCE timePeriodType.
 	SubscriberAverageReorders float64 `json:"subscriberAverageReorders,omitempty"`
 	// The average reorders per non-subscriber of the program over a period of past 12 months. Applicable to PERFORMANCE timePeriodType.
@@ -31,11 +35,11 @@ type GetSellingPartnerMetricsResponseMetric struct {
 	// The percentage of revenue from ASINs with coupons out of total revenue from all ASINs. Applicable to PERFORMANCE timePeriodType.
 	CouponsRevenuePenetration float64 `json:"couponsRevenuePenetration,omitempty"`
 	// The subscription revenue generated from subscriptions with over two deliveries over the past 12 months. Applicable to PERFORMANCE timePeriodType.
-	RevenueFromSubscriptionsWithMultipleDeliveries float64 `json:"revenueFromSubscriptionsWithMultipleDeliveries,omitempty"`
+	RevenueFromSubscriptionsWithMultipleDeliveries spapi.Decimal `json:"revenueFromSubscriptionsWithMultipleDeliveries,omitzero"`
 	// The subscription revenue generated from active subscriptions with one delivery over the past 12 months. Applicable to PERFORMANCE timePeriodType.
-	RevenueFromActiveSubscriptionsWithSingleDelivery float64 `json:"revenueFromActiveSubscriptionsWithSingleDelivery,omitempty"`
+	RevenueFromActiveSubscriptionsWithSingleDelivery spapi.Decimal `json:"revenueFromActiveSubscriptionsWithSingleDelivery,omitzero"`
 	// The subscription revenue generated from subscriptions which are cancelled after one delivery over the past 12 months. Applicable to PERFORMANCE timePeriodType.
-	RevenueFromCancelledSubscriptionsAfterSingleDelivery float64 `json:"revenueFromCancelledSubscriptionsAfterSingleDelivery,omitempty"`
+	RevenueFromCancelledSubscriptionsAfterSingleDelivery spapi.Decimal `json:"revenueFromCancelledSubscriptionsAfterSingleDelivery,omitzero"`
 	// The percentage of subscriptions retained after 30 days of subscription creation. Applicable to PERFORMANCE timePeriodType.
 	SubscriberRetentionFor30Days float64 `json:"subscriberRetentionFor30Days,omitempty"`
 	// The percentage of subscriptions retained after 90 days of subscription creation. Applicable to PERFORMANCE timePeriodType.
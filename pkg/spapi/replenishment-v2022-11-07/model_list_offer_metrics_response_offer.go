@@ -8,6 +8,10 @@
  */
 package replenishment_v2022_11_07
 
+import (
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
 // An object which contains offer metrics.
 type ListOfferMetricsResponseOffer struct {
 	// The Amazon Standard Identification Number (ASIN).
@@ -15,7 +19,7 @@ type ListOfferMetricsResponseOffer struct {
 	// The percentage of items that were not shipped out of the total shipped units over a period of time due to being out of stock. Applicable to PERFORMANCE timePeriodType.
 	NotDeliveredDueToOOS float64 `json:"notDeliveredDueToOOS,omitempty"`
 	// The revenue generated from subscriptions over a period of time. Applicable to PERFORMANCE timePeriodType.
-	TotalSubscriptionsRevenue float64 `json:"totalSubscriptionsRevenue,omitempty"`
+	TotalSubscriptionsRevenue spapi.Decimal `json:"totalSubscriptionsRevenue,omitzero"`
 	// The number of units shipped to the subscribers over a period of time. Applicable to PERFORMANCE timePeriodType.
 	ShippedSubscriptionUnits float64 `json:"shippedSubscriptionUnits,omitempty"`
 	// The number of active subscriptions present at the end of the period. Applicable to PERFORMANCE timePeriodType.
@@ -23,17 +27,17 @@ type ListOfferMetricsResponseOffer struct {
 	// The percentage of total program revenue out of total product revenue. Applicable to PERFORMANCE timePeriodType.
 	RevenuePenetration float64 `json:"revenuePenetration,omitempty"`
 	// The revenue that would have been generated had there not been out of stock. Applicable to PERFORMANCE timePeriodType.
-	LostRevenueDueToOOS float64 `json:"lostRevenueDueToOOS,omitempty"`
+	LostRevenueDueToOOS spapi.Decimal `json:"lostRevenueDueToOOS,omitzero"`
 	// The percentage of revenue from ASINs with coupons out of total revenue from all ASINs. Applicable to PERFORMANCE timePeriodType.
 	CouponsRevenuePenetration float64 `json:"couponsRevenuePenetration,omitempty"`
 	// The percentage of new subscriptions acquired through coupons. Applicable to PERFORMANCE timePeriodType.
 	ShareOfCouponSubscriptions float64 `json:"shareOfCouponSubscriptions,omitempty"`
 	// The forecasted total subscription revenue for the next 30 days. Applicable to FORECAST timePeriodType.
-	Next30DayTotalSubscriptionsRevenue float64 `json:"next30DayTotalSubscriptionsRevenue,omitempty"`
+	Next30DayTotalSubscriptionsRevenue spapi.Decimal `json:"next30DayTotalSubscriptionsRevenue,omitzero"`
 	// The forecasted total subscription revenue for the next 60 days. Applicable to FORECAST timePeriodType.
-	Next60DayTotalSubscriptionsRevenue float64 `json:"next60DayTotalSubscriptionsRevenue,omitempty"`
+	Next60DayTotalSubscriptionsRevenue spapi.Decimal `json:"next60DayTotalSubscriptionsRevenue,omitzero"`
 	// The forecasted total subscription revenue for the next 90 days. Applicable to FORECAST timePeriodType.
-	Next90DayTotalSubscriptionsRevenue float64 `json:"next90DayTotalSubscriptionsRevenue,omitempty"`
+	Next90DayTotalSubscriptionsRevenue spapi.Decimal `json:"next90DayTotalSubscriptionsRevenue,omitzero"`
 	// The forecasted shipped subscription units for the next 30 days. Applicable to FORECAST timePeriodType.
 	Next30DayShippedSubscriptionUnits float64 `json:"next30DayShippedSubscriptionUnits,omitempty"`
 	// The forecasted shipped subscription units for the next 60 days. Applicable to FORECAST timePeriodType.
@@ -0,0 +1,81 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package reports_v2021_06_30
+
+import (
+	"context"
+	"iter"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// API 是 reports API v2021-06-30 客户端的全部方法，包括分页迭代器等辅助方法。
+//
+// 业务代码依赖 API 而不是 *Client，即可在单元测试中用 Fake 替换真实客户端。
+type API interface {
+	// CancelReport
+	// Method: DELETE | Path: /reports/2021-06-30/reports/{reportId}
+	CancelReport(ctx context.Context, reportId string) (interface{}, error)
+
+	// CancelReportSchedule
+	// Method: DELETE | Path: /reports/2021-06-30/schedules/{reportScheduleId}
+	CancelReportSchedule(ctx context.Context, reportScheduleId string) (interface{}, error)
+
+	// CreateReport
+	// Method: POST | Path: /reports/2021-06-30/reports
+	CreateReport(ctx context.Context, body interface{}) (interface{}, error)
+
+	// CreateReportSchedule
+	// Method: POST | Path: /reports/2021-06-30/schedules
+	CreateReportSchedule(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetReport
+	// Method: GET | Path: /reports/2021-06-30/reports/{reportId}
+	GetReport(ctx context.Context, reportId string, query map[string]string) (interface{}, error)
+
+	// GetReportDocument
+	// Method: GET | Path: /reports/2021-06-30/documents/{reportDocumentId}
+	GetReportDocument(ctx context.Context, reportDocumentId string, query map[string]string) (interface{}, error)
+
+	// GetReportDocumentDecrypted 获取并自动解密报告文档。
+	GetReportDocumentDecrypted(ctx context.Context, reportDocumentID string) ([]byte, error)
+
+	// GetReportSchedule
+	// Method: GET | Path: /reports/2021-06-30/schedules/{reportScheduleId}
+	GetReportSchedule(ctx context.Context, reportScheduleId string, query map[string]string) (interface{}, error)
+
+	// GetReportSchedules
+	// Method: GET | Path: /reports/2021-06-30/schedules
+	GetReportSchedules(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetReports
+	// Method: GET | Path: /reports/2021-06-30/reports
+	GetReports(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// IterateReports 返回报告迭代器，自动处理分页。
+	IterateReports(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// WaitForReport 轮询 GetReport 直到报告到达终止状态（DONE、CANCELLED、FATAL）。
+	WaitForReport(ctx context.Context, reportID string, opts ...spapi.PollerOption) (interface{}, error)
+}
+
+// 确保 Client 实现了 API。
+var _ API = (*Client)(nil)
@@ -0,0 +1,190 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package reports_v2021_06_30
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。
+//
+// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回
+// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，
+// 可以通过 Calls 和 CallsTo 查看。
+type Fake struct {
+	spapi.FakeRecorder
+
+	// CancelReportFunc 实现 CancelReport。
+	CancelReportFunc func(ctx context.Context, reportId string) (interface{}, error)
+
+	// CancelReportScheduleFunc 实现 CancelReportSchedule。
+	CancelReportScheduleFunc func(ctx context.Context, reportScheduleId string) (interface{}, error)
+
+	// CreateReportFunc 实现 CreateReport。
+	CreateReportFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// CreateReportScheduleFunc 实现 CreateReportSchedule。
+	CreateReportScheduleFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetReportFunc 实现 GetReport。
+	GetReportFunc func(ctx context.Context, reportId string, query map[string]string) (interface{}, error)
+
+	// GetReportDocumentFunc 实现 GetReportDocument。
+	GetReportDocumentFunc func(ctx context.Context, reportDocumentId string, query map[string]string) (interface{}, error)
+
+	// GetReportDocumentDecryptedFunc 实现 GetReportDocumentDecrypted。
+	GetReportDocumentDecryptedFunc func(ctx context.Context, reportDocumentID string) ([]byte, error)
+
+	// GetReportScheduleFunc 实现 GetReportSchedule。
+	GetReportScheduleFunc func(ctx context.Context, reportScheduleId string, query map[string]string) (interface{}, error)
+
+	// GetReportSchedulesFunc 实现 GetReportSchedules。
+	GetReportSchedulesFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetReportsFunc 实现 GetReports。
+	GetReportsFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// IterateReportsFunc 实现 IterateReports。
+	IterateReportsFunc func(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// WaitForReportFunc 实现 WaitForReport。
+	WaitForReportFunc func(ctx context.Context, reportID string, opts ...spapi.PollerOption) (interface{}, error)
+}
+
+// NewFake 创建未编程任何响应的 Fake。
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// CancelReport 记录调用并执行 CancelReportFunc。
+func (f *Fake) CancelReport(ctx context.Context, reportId string) (interface{}, error) {
+	f.Record("CancelReport", reportId)
+	if f.CancelReportFunc == nil {
+		return nil, fmt.Errorf("CancelReport: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CancelReportFunc(ctx, reportId)
+}
+
+// CancelReportSchedule 记录调用并执行 CancelReportScheduleFunc。
+func (f *Fake) CancelReportSchedule(ctx context.Context, reportScheduleId string) (interface{}, error) {
+	f.Record("CancelReportSchedule", reportScheduleId)
+	if f.CancelReportScheduleFunc == nil {
+		return nil, fmt.Errorf("CancelReportSchedule: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CancelReportScheduleFunc(ctx, reportScheduleId)
+}
+
+// CreateReport 记录调用并执行 CreateReportFunc。
+func (f *Fake) CreateReport(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("CreateReport", body)
+	if f.CreateReportFunc == nil {
+		return nil, fmt.Errorf("CreateReport: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateReportFunc(ctx, body)
+}
+
+// CreateReportSchedule 记录调用并执行 CreateReportScheduleFunc。
+func (f *Fake) CreateReportSchedule(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("CreateReportSchedule", body)
+	if f.CreateReportScheduleFunc == nil {
+		return nil, fmt.Errorf("CreateReportSchedule: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateReportScheduleFunc(ctx, body)
+}
+
+// GetReport 记录调用并执行 GetReportFunc。
+func (f *Fake) GetReport(ctx context.Context, reportId string, query map[string]string) (interface{}, error) {
+	f.Record("GetReport", reportId, query)
+	if f.GetReportFunc == nil {
+		return nil, fmt.Errorf("GetReport: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetReportFunc(ctx, reportId, query)
+}
+
+// GetReportDocument 记录调用并执行 GetReportDocumentFunc。
+func (f *Fake) GetReportDocument(ctx context.Context, reportDocumentId string, query map[string]string) (interface{}, error) {
+	f.Record("GetReportDocument", reportDocumentId, query)
+	if f.GetReportDocumentFunc == nil {
+		return nil, fmt.Errorf("GetReportDocument: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetReportDocumentFunc(ctx, reportDocumentId, query)
+}
+
+// GetReportDocumentDecrypted 记录调用并执行 GetReportDocumentDecryptedFunc。
+func (f *Fake) GetReportDocumentDecrypted(ctx context.Context, reportDocumentID string) ([]byte, error) {
+	f.Record("GetReportDocumentDecrypted", reportDocumentID)
+	if f.GetReportDocumentDecryptedFunc == nil {
+		return nil, fmt.Errorf("GetReportDocumentDecrypted: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetReportDocumentDecryptedFunc(ctx, reportDocumentID)
+}
+
+// GetReportSchedule 记录调用并执行 GetReportScheduleFunc。
+func (f *Fake) GetReportSchedule(ctx context.Context, reportScheduleId string, query map[string]string) (interface{}, error) {
+	f.Record("GetReportSchedule", reportScheduleId, query)
+	if f.GetReportScheduleFunc == nil {
+		return nil, fmt.Errorf("GetReportSchedule: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetReportScheduleFunc(ctx, reportScheduleId, query)
+}
+
+// GetReportSchedules 记录调用并执行 GetReportSchedulesFunc。
+func (f *Fake) GetReportSchedules(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetReportSchedules", query)
+	if f.GetReportSchedulesFunc == nil {
+		return nil, fmt.Errorf("GetReportSchedules: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetReportSchedulesFunc(ctx, query)
+}
+
+// GetReports 记录调用并执行 GetReportsFunc。
+func (f *Fake) GetReports(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetReports", query)
+	if f.GetReportsFunc == nil {
+		return nil, fmt.Errorf("GetReports: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetReportsFunc(ctx, query)
+}
+
+// IterateReports 记录调用并执行 IterateReportsFunc。
+func (f *Fake) IterateReports(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+	f.Record("IterateReports", query)
+	if f.IterateReportsFunc == nil {
+		return spapi.FakeSeq[map[string]interface{}](nil, fmt.Errorf("IterateReports: %w", spapi.ErrFakeNotConfigured))
+	}
+	return f.IterateReportsFunc(ctx, query)
+}
+
+// WaitForReport 记录调用并执行 WaitForReportFunc。
+func (f *Fake) WaitForReport(ctx context.Context, reportID string, opts ...spapi.PollerOption) (interface{}, error) {
+	f.Record("WaitForReport", reportID, opts)
+	if f.WaitForReportFunc == nil {
+		return nil, fmt.Errorf("WaitForReport: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.WaitForReportFunc(ctx, reportID, opts...)
+}
+
+// 确保 Fake 实现了 API。
+var _ API = (*Fake)(nil)
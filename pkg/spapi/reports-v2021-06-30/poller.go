@@ -0,0 +1,82 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package reports_v2021_06_30
+
+import (
+	"context"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// WaitForReport 轮询 GetReport 直到报告到达终止状态（DONE、CANCELLED、FATAL）。
+//
+// 默认使用 spapi.NewPoller 的退避配置，可通过 opts 覆盖。
+// 报告进入 CANCELLED 或 FATAL 时返回 spapi.ErrPollFailed。
+//
+// 参数:
+//   - ctx: 请求上下文
+//   - reportID: 报告 ID
+//   - opts: 轮询器配置选项
+//
+// 返回值:
+//   - interface{}: 最后一次 GetReport 的结果
+//   - error: 如果轮询失败，返回错误
+//
+// 示例:
+//
+//	report, err := client.WaitForReport(ctx, reportID,
+//	    spapi.WithPollTimeout(30*time.Minute),
+//	)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	docID := report.(map[string]interface{})["reportDocumentId"].(string)
+func (c *Client) WaitForReport(ctx context.Context, reportID string, opts ...spapi.PollerOption) (interface{}, error) {
+	poller := spapi.NewPoller(
+		func(ctx context.Context) (interface{}, error) {
+			return c.GetReport(ctx, reportID, nil)
+		},
+		spapi.StatusAt[interface{}]("processingStatus"),
+		append([]spapi.PollerOption{
+			spapi.WithTerminalStates(spapi.ProcessingStatusTerminal...),
+			spapi.WithFailureStates(spapi.ProcessingStatusFailed...),
+		}, opts...)...,
+	)
+	return poller.Wait(ctx)
+}
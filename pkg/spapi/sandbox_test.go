@@ -1,6 +1,7 @@
 package spapi_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
@@ -16,7 +17,7 @@ func TestWithSandbox(t *testing.T) {
 	if err != nil {
 		t.Fatalf("NewClient() error = %v", err)
 	}
-	defer client.Close()
+	defer client.Close(context.Background())
 
 	config := client.Config()
 	if !config.Region.IsSandbox() {
@@ -91,7 +92,7 @@ func ExampleWithSandbox() {
 	if err != nil {
 		panic(err)
 	}
-	defer client.Close()
+	defer client.Close(context.Background())
 
 	// 使用客户端进行测试...
 }
@@ -0,0 +1,369 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+// Package sdk 将所有 API 包聚合到一个客户端中。
+//
+// Client 的每个字段对应一个 API：最新版本使用 API 名称（如 Catalog），
+// 旧版本带版本后缀（如 CatalogV0、CatalogV20201201）。所有 API 客户端共享
+// 同一个 spapi.Client，认证、速率限制、重试和中间件只需配置一次。
+//
+// 示例:
+//
+//	client, err := sdk.New(
+//	    spapi.WithRegion(spapi.RegionNA),
+//	    spapi.WithCredentials(clientID, clientSecret, refreshToken),
+//	)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer client.Close(context.Background())
+//
+//	result, err := client.Reports.CreateReport(ctx, body)
+//	item, err := client.Catalog.GetCatalogItem(ctx, asin, query)
+package sdk
+
+import (
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	amazon_warehousing_and_distribution_model_v2024_05_09 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/amazon-warehousing-and-distribution-model-v2024-05-09"
+	aplus_content_v2020_11_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/aplus-content-v2020-11-01"
+	application_integrations_v2024_04_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/application-integrations-v2024-04-01"
+	application_management_v2023_11_30 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/application-management-v2023-11-30"
+	catalog_items_v0 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/catalog-items-v0"
+	catalog_items_v2020_12_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/catalog-items-v2020-12-01"
+	catalog_items_v2022_04_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/catalog-items-v2022-04-01"
+	customer_feedback_v2024_06_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/customer-feedback-v2024-06-01"
+	data_kiosk_v2023_11_15 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/data-kiosk-v2023-11-15"
+	easy_ship_model_v2022_03_23 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/easy-ship-model-v2022-03-23"
+	fba_inbound_eligibility_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/fba-inbound-eligibility-v1"
+	fba_inventory_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/fba-inventory-v1"
+	feeds_v2021_06_30 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/feeds-v2021-06-30"
+	finances_v0 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/finances-v0"
+	finances_v2024_06_01_transfers "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/finances-v2024-06-01-transfers"
+	finances_v2024_06_19 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/finances-v2024-06-19"
+	fulfillment_inbound_v0 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/fulfillment-inbound-v0"
+	fulfillment_inbound_v2024_03_20 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/fulfillment-inbound-v2024-03-20"
+	fulfillment_outbound_v2020_07_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/fulfillment-outbound-v2020-07-01"
+	invoices_v2024_06_19 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/invoices-v2024-06-19"
+	listings_items_v2020_09_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/listings-items-v2020-09-01"
+	listings_items_v2021_08_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/listings-items-v2021-08-01"
+	listings_restrictions_v2021_08_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/listings-restrictions-v2021-08-01"
+	merchant_fulfillment_v0 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/merchant-fulfillment-v0"
+	messaging_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/messaging-v1"
+	notifications_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/notifications-v1"
+	orders_v0 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/orders-v0"
+	product_fees_v0 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/product-fees-v0"
+	product_pricing_v0 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/product-pricing-v0"
+	product_pricing_v2022_05_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/product-pricing-v2022-05-01"
+	product_type_definitions_v2020_09_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/product-type-definitions-v2020-09-01"
+	replenishment_v2022_11_07 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/replenishment-v2022-11-07"
+	reports_v2021_06_30 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/reports-v2021-06-30"
+	sales_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/sales-v1"
+	seller_wallet_v2024_03_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/seller-wallet-v2024-03-01"
+	sellers_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/sellers-v1"
+	services_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/services-v1"
+	shipment_invoicing_v0 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/shipment-invoicing-v0"
+	shipping_v2 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/shipping-v2"
+	solicitations_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/solicitations-v1"
+	supply_sources_v2020_07_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/supply-sources-v2020-07-01"
+	tokens_v2021_03_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/tokens-v2021-03-01"
+	uploads_v2020_11_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/uploads-v2020-11-01"
+	vehicles_v2024_11_01 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/vehicles-v2024-11-01"
+	vendor_direct_fulfillment_inventory_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/vendor-direct-fulfillment-inventory-v1"
+	vendor_direct_fulfillment_orders_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/vendor-direct-fulfillment-orders-v1"
+	vendor_direct_fulfillment_orders_v2021_12_28 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/vendor-direct-fulfillment-orders-v2021-12-28"
+	vendor_direct_fulfillment_payments_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/vendor-direct-fulfillment-payments-v1"
+	vendor_direct_fulfillment_sandbox_test_data_v2021_10_28 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/vendor-direct-fulfillment-sandbox-test-data-v2021-10-28"
+	vendor_direct_fulfillment_shipping_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/vendor-direct-fulfillment-shipping-v1"
+	vendor_direct_fulfillment_shipping_v2021_12_28 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/vendor-direct-fulfillment-shipping-v2021-12-28"
+	vendor_direct_fulfillment_transactions_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/vendor-direct-fulfillment-transactions-v1"
+	vendor_direct_fulfillment_transactions_v2021_12_28 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/vendor-direct-fulfillment-transactions-v2021-12-28"
+	vendor_invoices_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/vendor-invoices-v1"
+	vendor_orders_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/vendor-orders-v1"
+	vendor_shipments_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/vendor-shipments-v1"
+	vendor_transaction_status_v1 "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/vendor-transaction-status-v1"
+)
+
+// Client 聚合所有 API 客户端。
+//
+// 嵌入的 spapi.Client 是所有 API 共享的基础客户端，Close、RateLimitManager
+// 等方法可直接在 Client 上调用。API 客户端只是基础客户端的轻量包装，
+// 不持有任何资源；访问令牌、HTTP 连接和速率限制器都在第一次请求时才创建。
+//
+// Client 是并发安全的，可以在多个 goroutine 中复用。
+type Client struct {
+	*spapi.Client
+
+	// APlusContent 是 aplus-content API v2020-11-01 客户端。
+	APlusContent *aplus_content_v2020_11_01.Client
+
+	// AWD 是 amazon-warehousing-and-distribution-model API v2024-05-09 客户端。
+	AWD *amazon_warehousing_and_distribution_model_v2024_05_09.Client
+
+	// ApplicationIntegrations 是 application-integrations API v2024-04-01 客户端。
+	ApplicationIntegrations *application_integrations_v2024_04_01.Client
+
+	// ApplicationManagement 是 application-management API v2023-11-30 客户端。
+	ApplicationManagement *application_management_v2023_11_30.Client
+
+	// Catalog 是 catalog-items API v2022-04-01 客户端。
+	Catalog *catalog_items_v2022_04_01.Client
+
+	// CatalogV0 是 catalog-items API v0 客户端。
+	CatalogV0 *catalog_items_v0.Client
+
+	// CatalogV20201201 是 catalog-items API v2020-12-01 客户端。
+	CatalogV20201201 *catalog_items_v2020_12_01.Client
+
+	// CustomerFeedback 是 customer-feedback API v2024-06-01 客户端。
+	CustomerFeedback *customer_feedback_v2024_06_01.Client
+
+	// DataKiosk 是 data-kiosk API v2023-11-15 客户端。
+	DataKiosk *data_kiosk_v2023_11_15.Client
+
+	// EasyShip 是 easy-ship-model API v2022-03-23 客户端。
+	EasyShip *easy_ship_model_v2022_03_23.Client
+
+	// FBAInboundEligibility 是 fba-inbound-eligibility API v1 客户端。
+	FBAInboundEligibility *fba_inbound_eligibility_v1.Client
+
+	// FBAInventory 是 fba-inventory API v1 客户端。
+	FBAInventory *fba_inventory_v1.Client
+
+	// Feeds 是 feeds API v2021-06-30 客户端。
+	Feeds *feeds_v2021_06_30.Client
+
+	// Finances 是 finances API v2024-06-19 客户端。
+	Finances *finances_v2024_06_19.Client
+
+	// FinancesV0 是 finances API v0 客户端。
+	FinancesV0 *finances_v0.Client
+
+	// FulfillmentInbound 是 fulfillment-inbound API v2024-03-20 客户端。
+	FulfillmentInbound *fulfillment_inbound_v2024_03_20.Client
+
+	// FulfillmentInboundV0 是 fulfillment-inbound API v0 客户端。
+	FulfillmentInboundV0 *fulfillment_inbound_v0.Client
+
+	// FulfillmentOutbound 是 fulfillment-outbound API v2020-07-01 客户端。
+	FulfillmentOutbound *fulfillment_outbound_v2020_07_01.Client
+
+	// Invoices 是 invoices API v2024-06-19 客户端。
+	Invoices *invoices_v2024_06_19.Client
+
+	// ListingsItems 是 listings-items API v2021-08-01 客户端。
+	ListingsItems *listings_items_v2021_08_01.Client
+
+	// ListingsItemsV20200901 是 listings-items API v2020-09-01 客户端。
+	ListingsItemsV20200901 *listings_items_v2020_09_01.Client
+
+	// ListingsRestrictions 是 listings-restrictions API v2021-08-01 客户端。
+	ListingsRestrictions *listings_restrictions_v2021_08_01.Client
+
+	// MerchantFulfillment 是 merchant-fulfillment API v0 客户端。
+	MerchantFulfillment *merchant_fulfillment_v0.Client
+
+	// Messaging 是 messaging API v1 客户端。
+	Messaging *messaging_v1.Client
+
+	// Notifications 是 notifications API v1 客户端。
+	Notifications *notifications_v1.Client
+
+	// Orders 是 orders API v0 客户端。
+	Orders *orders_v0.Client
+
+	// ProductFees 是 product-fees API v0 客户端。
+	ProductFees *product_fees_v0.Client
+
+	// ProductPricing 是 product-pricing API v2022-05-01 客户端。
+	ProductPricing *product_pricing_v2022_05_01.Client
+
+	// ProductPricingV0 是 product-pricing API v0 客户端。
+	ProductPricingV0 *product_pricing_v0.Client
+
+	// ProductTypeDefinitions 是 product-type-definitions API v2020-09-01 客户端。
+	ProductTypeDefinitions *product_type_definitions_v2020_09_01.Client
+
+	// Replenishment 是 replenishment API v2022-11-07 客户端。
+	Replenishment *replenishment_v2022_11_07.Client
+
+	// Reports 是 reports API v2021-06-30 客户端。
+	Reports *reports_v2021_06_30.Client
+
+	// Sales 是 sales API v1 客户端。
+	Sales *sales_v1.Client
+
+	// SellerWallet 是 seller-wallet API v2024-03-01 客户端。
+	SellerWallet *seller_wallet_v2024_03_01.Client
+
+	// Sellers 是 sellers API v1 客户端。
+	Sellers *sellers_v1.Client
+
+	// Services 是 services API v1 客户端。
+	Services *services_v1.Client
+
+	// ShipmentInvoicing 是 shipment-invoicing API v0 客户端。
+	ShipmentInvoicing *shipment_invoicing_v0.Client
+
+	// Shipping 是 shipping API v2 客户端。
+	Shipping *shipping_v2.Client
+
+	// Solicitations 是 solicitations API v1 客户端。
+	Solicitations *solicitations_v1.Client
+
+	// SupplySources 是 supply-sources API v2020-07-01 客户端。
+	SupplySources *supply_sources_v2020_07_01.Client
+
+	// Tokens 是 tokens API v2021-03-01 客户端。
+	Tokens *tokens_v2021_03_01.Client
+
+	// Transfers 是 finances API v2024-06-01-transfers 客户端。
+	Transfers *finances_v2024_06_01_transfers.Client
+
+	// Uploads 是 uploads API v2020-11-01 客户端。
+	Uploads *uploads_v2020_11_01.Client
+
+	// Vehicles 是 vehicles API v2024-11-01 客户端。
+	Vehicles *vehicles_v2024_11_01.Client
+
+	// VendorDirectFulfillmentInventory 是 vendor-direct-fulfillment-inventory API v1 客户端。
+	VendorDirectFulfillmentInventory *vendor_direct_fulfillment_inventory_v1.Client
+
+	// VendorDirectFulfillmentOrders 是 vendor-direct-fulfillment-orders API v2021-12-28 客户端。
+	VendorDirectFulfillmentOrders *vendor_direct_fulfillment_orders_v2021_12_28.Client
+
+	// VendorDirectFulfillmentOrdersV1 是 vendor-direct-fulfillment-orders API v1 客户端。
+	VendorDirectFulfillmentOrdersV1 *vendor_direct_fulfillment_orders_v1.Client
+
+	// VendorDirectFulfillmentPayments 是 vendor-direct-fulfillment-payments API v1 客户端。
+	VendorDirectFulfillmentPayments *vendor_direct_fulfillment_payments_v1.Client
+
+	// VendorDirectFulfillmentSandboxTestData 是 vendor-direct-fulfillment-sandbox-test-data API v2021-10-28 客户端。
+	VendorDirectFulfillmentSandboxTestData *vendor_direct_fulfillment_sandbox_test_data_v2021_10_28.Client
+
+	// VendorDirectFulfillmentShipping 是 vendor-direct-fulfillment-shipping API v2021-12-28 客户端。
+	VendorDirectFulfillmentShipping *vendor_direct_fulfillment_shipping_v2021_12_28.Client
+
+	// VendorDirectFulfillmentShippingV1 是 vendor-direct-fulfillment-shipping API v1 客户端。
+	VendorDirectFulfillmentShippingV1 *vendor_direct_fulfillment_shipping_v1.Client
+
+	// VendorDirectFulfillmentTransactions 是 vendor-direct-fulfillment-transactions API v2021-12-28 客户端。
+	VendorDirectFulfillmentTransactions *vendor_direct_fulfillment_transactions_v2021_12_28.Client
+
+	// VendorDirectFulfillmentTransactionsV1 是 vendor-direct-fulfillment-transactions API v1 客户端。
+	VendorDirectFulfillmentTransactionsV1 *vendor_direct_fulfillment_transactions_v1.Client
+
+	// VendorInvoices 是 vendor-invoices API v1 客户端。
+	VendorInvoices *vendor_invoices_v1.Client
+
+	// VendorOrders 是 vendor-orders API v1 客户端。
+	VendorOrders *vendor_orders_v1.Client
+
+	// VendorShipments 是 vendor-shipments API v1 客户端。
+	VendorShipments *vendor_shipments_v1.Client
+
+	// VendorTransactionStatus 是 vendor-transaction-status API v1 客户端。
+	VendorTransactionStatus *vendor_transaction_status_v1.Client
+}
+
+// New 创建基础客户端，并基于它创建所有 API 客户端。
+//
+// 参数:
+//   - opts: 基础客户端的配置选项，与 spapi.NewClient 相同
+//
+// 返回值:
+//   - *Client: 聚合客户端
+//   - error: 如果基础客户端创建失败，返回错误
+func New(opts ...spapi.ClientOption) (*Client, error) {
+	base, err := spapi.NewClient(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return NewFromClient(base), nil
+}
+
+// NewFromClient 基于已有的基础客户端创建所有 API 客户端。
+//
+// 参数:
+//   - base: 共享的基础客户端
+//
+// 返回值:
+//   - *Client: 聚合客户端
+func NewFromClient(base *spapi.Client) *Client {
+	return &Client{
+		Client:                                 base,
+		APlusContent:                           aplus_content_v2020_11_01.NewClient(base),
+		AWD:                                    amazon_warehousing_and_distribution_model_v2024_05_09.NewClient(base),
+		ApplicationIntegrations:                application_integrations_v2024_04_01.NewClient(base),
+		ApplicationManagement:                  application_management_v2023_11_30.NewClient(base),
+		Catalog:                                catalog_items_v2022_04_01.NewClient(base),
+		CatalogV0:                              catalog_items_v0.NewClient(base),
+		CatalogV20201201:                       catalog_items_v2020_12_01.NewClient(base),
+		CustomerFeedback:                       customer_feedback_v2024_06_01.NewClient(base),
+		DataKiosk:                              data_kiosk_v2023_11_15.NewClient(base),
+		EasyShip:                               easy_ship_model_v2022_03_23.NewClient(base),
+		FBAInboundEligibility:                  fba_inbound_eligibility_v1.NewClient(base),
+		FBAInventory:                           fba_inventory_v1.NewClient(base),
+		Feeds:                                  feeds_v2021_06_30.NewClient(base),
+		Finances:                               finances_v2024_06_19.NewClient(base),
+		FinancesV0:                             finances_v0.NewClient(base),
+		FulfillmentInbound:                     fulfillment_inbound_v2024_03_20.NewClient(base),
+		FulfillmentInboundV0:                   fulfillment_inbound_v0.NewClient(base),
+		FulfillmentOutbound:                    fulfillment_outbound_v2020_07_01.NewClient(base),
+		Invoices:                               invoices_v2024_06_19.NewClient(base),
+		ListingsItems:                          listings_items_v2021_08_01.NewClient(base),
+		ListingsItemsV20200901:                 listings_items_v2020_09_01.NewClient(base),
+		ListingsRestrictions:                   listings_restrictions_v2021_08_01.NewClient(base),
+		MerchantFulfillment:                    merchant_fulfillment_v0.NewClient(base),
+		Messaging:                              messaging_v1.NewClient(base),
+		Notifications:                          notifications_v1.NewClient(base),
+		Orders:                                 orders_v0.NewClient(base),
+		ProductFees:                            product_fees_v0.NewClient(base),
+		ProductPricing:                         product_pricing_v2022_05_01.NewClient(base),
+		ProductPricingV0:                       product_pricing_v0.NewClient(base),
+		ProductTypeDefinitions:                 product_type_definitions_v2020_09_01.NewClient(base),
+		Replenishment:                          replenishment_v2022_11_07.NewClient(base),
+		Reports:                                reports_v2021_06_30.NewClient(base),
+		Sales:                                  sales_v1.NewClient(base),
+		SellerWallet:                           seller_wallet_v2024_03_01.NewClient(base),
+		Sellers:                                sellers_v1.NewClient(base),
+		Services:                               services_v1.NewClient(base),
+		ShipmentInvoicing:                      shipment_invoicing_v0.NewClient(base),
+		Shipping:                               shipping_v2.NewClient(base),
+		Solicitations:                          solicitations_v1.NewClient(base),
+		SupplySources:                          supply_sources_v2020_07_01.NewClient(base),
+		Tokens:                                 tokens_v2021_03_01.NewClient(base),
+		Transfers:                              finances_v2024_06_01_transfers.NewClient(base),
+		Uploads:                                uploads_v2020_11_01.NewClient(base),
+		Vehicles:                               vehicles_v2024_11_01.NewClient(base),
+		VendorDirectFulfillmentInventory:       vendor_direct_fulfillment_inventory_v1.NewClient(base),
+		VendorDirectFulfillmentOrders:          vendor_direct_fulfillment_orders_v2021_12_28.NewClient(base),
+		VendorDirectFulfillmentOrdersV1:        vendor_direct_fulfillment_orders_v1.NewClient(base),
+		VendorDirectFulfillmentPayments:        vendor_direct_fulfillment_payments_v1.NewClient(base),
+		VendorDirectFulfillmentSandboxTestData: vendor_direct_fulfillment_sandbox_test_data_v2021_10_28.NewClient(base),
+		VendorDirectFulfillmentShipping:        vendor_direct_fulfillment_shipping_v2021_12_28.NewClient(base),
+		VendorDirectFulfillmentShippingV1:      vendor_direct_fulfillment_shipping_v1.NewClient(base),
+		VendorDirectFulfillmentTransactions:    vendor_direct_fulfillment_transactions_v2021_12_28.NewClient(base),
+		VendorDirectFulfillmentTransactionsV1:  vendor_direct_fulfillment_transactions_v1.NewClient(base),
+		VendorInvoices:                         vendor_invoices_v1.NewClient(base),
+		VendorOrders:                           vendor_orders_v1.NewClient(base),
+		VendorShipments:                        vendor_shipments_v1.NewClient(base),
+		VendorTransactionStatus:                vendor_transaction_status_v1.NewClient(base),
+	}
+}
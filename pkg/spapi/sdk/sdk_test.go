@@ -0,0 +1,94 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package sdk_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/sdk"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/spapitest"
+)
+
+func TestNew(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+
+	client, err := sdk.New(
+		spapi.WithRegion(srv.Region()),
+		spapi.WithCredentials(spapitest.ClientID, spapitest.ClientSecret, spapitest.RefreshToken),
+	)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer client.Close(context.Background())
+
+	srv.Handle(http.MethodGet, "/catalog/2022-04-01/items/{asin}", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"asin": r.PathValue("asin")})
+	})
+	srv.Handle(http.MethodGet, "/catalog/v0/categories", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"payload": []interface{}{}})
+	})
+	ctx := context.Background()
+
+	result, err := client.Catalog.GetCatalogItem(ctx, "B000", map[string]string{"marketplaceIds": "ATVPDKIKX0DER"})
+	if err != nil {
+		t.Fatalf("Catalog.GetCatalogItem() error = %v", err)
+	}
+	if asin := result.(map[string]interface{})["asin"]; asin != "B000" {
+		t.Errorf("asin = %v, want B000", asin)
+	}
+
+	if _, err := client.CatalogV0.ListCatalogCategories(ctx, map[string]string{"MarketplaceId": "ATVPDKIKX0DER", "ASIN": "B000"}); err != nil {
+		t.Fatalf("CatalogV0.ListCatalogCategories() error = %v", err)
+	}
+
+	if got := len(srv.Requests()); got != 2 {
+		t.Errorf("requests = %d, want 2", got)
+	}
+}
+
+func TestNew_Error(t *testing.T) {
+	client, err := sdk.New()
+	if err == nil || client != nil {
+		t.Errorf("New() = %v, %v; want configuration error", client, err)
+	}
+}
+
+func TestNewFromClient(t *testing.T) {
+	srv := spapitest.NewServer()
+	defer srv.Close()
+	base, err := srv.NewClient()
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	client := sdk.NewFromClient(base)
+	if client.Client != base {
+		t.Error("Client does not embed the given base client")
+	}
+	if client.Orders == nil || client.Reports == nil || client.FinancesV0 == nil || client.Transfers == nil {
+		t.Error("API clients not initialized")
+	}
+}
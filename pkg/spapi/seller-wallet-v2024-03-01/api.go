@@ -0,0 +1,85 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package seller_wallet_v2024_03_01
+
+import (
+	"context"
+	"iter"
+)
+
+// API 是 seller-wallet API v2024-03-01 客户端的全部方法，包括分页迭代器等辅助方法。
+//
+// 业务代码依赖 API 而不是 *Client，即可在单元测试中用 Fake 替换真实客户端。
+type API interface {
+	// CreateTransaction Create a transaction request from Amazon SW account to another customer provided account
+	// Method: POST | Path: /finances/transfers/wallet/2024-03-01/transactions
+	CreateTransaction(ctx context.Context, body interface{}) (interface{}, error)
+
+	// CreateTransferSchedule Create a transfer schedule request from Amazon SW account to another customer provided account
+	// Method: POST | Path: /finances/transfers/wallet/2024-03-01/transferSchedules
+	CreateTransferSchedule(ctx context.Context, body interface{}) (interface{}, error)
+
+	// DeleteScheduleTransaction Delete a transaction request that is scheduled from Amazon Seller Wallet account to another customer-provided account
+	// Method: DELETE | Path: /finances/transfers/wallet/2024-03-01/transferSchedules/{transferScheduleId}
+	DeleteScheduleTransaction(ctx context.Context, transferScheduleId string) (interface{}, error)
+
+	// GetAccount Find particular Amazon SW account by Amazon account identifier
+	// Method: GET | Path: /finances/transfers/wallet/2024-03-01/accounts/{accountId}
+	GetAccount(ctx context.Context, accountId string, query map[string]string) (interface{}, error)
+
+	// GetTransaction Find particular Amazon SW account transaction by Amazon transaction identifier
+	// Method: GET | Path: /finances/transfers/wallet/2024-03-01/transactions/{transactionId}
+	GetTransaction(ctx context.Context, transactionId string, query map[string]string) (interface{}, error)
+
+	// GetTransferPreview Fetch potential fees that could be applied on a transaction on the basis of the source and destination country currency code
+	// Method: GET | Path: /finances/transfers/wallet/2024-03-01/transferPreview
+	GetTransferPreview(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetTransferSchedule Find particular Amazon Seller Wallet account transfer schedule by Amazon transfer schedule identifier
+	// Method: GET | Path: /finances/transfers/wallet/2024-03-01/transferSchedules/{transferScheduleId}
+	GetTransferSchedule(ctx context.Context, transferScheduleId string, query map[string]string) (interface{}, error)
+
+	// IterateAccountTransactions 返回账户交易迭代器，自动处理分页。
+	IterateAccountTransactions(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// ListAccountBalances Find balance in particular Amazon SW account by Amazon account identifier
+	// Method: GET | Path: /finances/transfers/wallet/2024-03-01/accounts/{accountId}/balance
+	ListAccountBalances(ctx context.Context, accountId string, query map[string]string) (interface{}, error)
+
+	// ListAccountTransactions The API will return all the transactions for a given Amazon SW account sorted by the transaction request date
+	// Method: GET | Path: /finances/transfers/wallet/2024-03-01/transactions
+	ListAccountTransactions(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// ListAccounts Get all Amazon SW accounts for the seller
+	// Method: GET | Path: /finances/transfers/wallet/2024-03-01/accounts
+	ListAccounts(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// ListTransferSchedules The API will return all the transfer schedules for a given Amazon SW account
+	// Method: GET | Path: /finances/transfers/wallet/2024-03-01/transferSchedules
+	ListTransferSchedules(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// UpdateTransferSchedule Update a transfer schedule information. Only fields (i.e; transferScheduleInformation, paymentPreference, transferScheduleStatus) in the request body can be updated.
+	// Method: PUT | Path: /finances/transfers/wallet/2024-03-01/transferSchedules
+	UpdateTransferSchedule(ctx context.Context, body interface{}) (interface{}, error)
+}
+
+// 确保 Client 实现了 API。
+var _ API = (*Client)(nil)
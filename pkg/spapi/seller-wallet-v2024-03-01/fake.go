@@ -0,0 +1,202 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package seller_wallet_v2024_03_01
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。
+//
+// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回
+// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，
+// 可以通过 Calls 和 CallsTo 查看。
+type Fake struct {
+	spapi.FakeRecorder
+
+	// CreateTransactionFunc 实现 CreateTransaction。
+	CreateTransactionFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// CreateTransferScheduleFunc 实现 CreateTransferSchedule。
+	CreateTransferScheduleFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// DeleteScheduleTransactionFunc 实现 DeleteScheduleTransaction。
+	DeleteScheduleTransactionFunc func(ctx context.Context, transferScheduleId string) (interface{}, error)
+
+	// GetAccountFunc 实现 GetAccount。
+	GetAccountFunc func(ctx context.Context, accountId string, query map[string]string) (interface{}, error)
+
+	// GetTransactionFunc 实现 GetTransaction。
+	GetTransactionFunc func(ctx context.Context, transactionId string, query map[string]string) (interface{}, error)
+
+	// GetTransferPreviewFunc 实现 GetTransferPreview。
+	GetTransferPreviewFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetTransferScheduleFunc 实现 GetTransferSchedule。
+	GetTransferScheduleFunc func(ctx context.Context, transferScheduleId string, query map[string]string) (interface{}, error)
+
+	// IterateAccountTransactionsFunc 实现 IterateAccountTransactions。
+	IterateAccountTransactionsFunc func(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// ListAccountBalancesFunc 实现 ListAccountBalances。
+	ListAccountBalancesFunc func(ctx context.Context, accountId string, query map[string]string) (interface{}, error)
+
+	// ListAccountTransactionsFunc 实现 ListAccountTransactions。
+	ListAccountTransactionsFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// ListAccountsFunc 实现 ListAccounts。
+	ListAccountsFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// ListTransferSchedulesFunc 实现 ListTransferSchedules。
+	ListTransferSchedulesFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// UpdateTransferScheduleFunc 实现 UpdateTransferSchedule。
+	UpdateTransferScheduleFunc func(ctx context.Context, body interface{}) (interface{}, error)
+}
+
+// NewFake 创建未编程任何响应的 Fake。
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// CreateTransaction 记录调用并执行 CreateTransactionFunc。
+func (f *Fake) CreateTransaction(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("CreateTransaction", body)
+	if f.CreateTransactionFunc == nil {
+		return nil, fmt.Errorf("CreateTransaction: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateTransactionFunc(ctx, body)
+}
+
+// CreateTransferSchedule 记录调用并执行 CreateTransferScheduleFunc。
+func (f *Fake) CreateTransferSchedule(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("CreateTransferSchedule", body)
+	if f.CreateTransferScheduleFunc == nil {
+		return nil, fmt.Errorf("CreateTransferSchedule: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateTransferScheduleFunc(ctx, body)
+}
+
+// DeleteScheduleTransaction 记录调用并执行 DeleteScheduleTransactionFunc。
+func (f *Fake) DeleteScheduleTransaction(ctx context.Context, transferScheduleId string) (interface{}, error) {
+	f.Record("DeleteScheduleTransaction", transferScheduleId)
+	if f.DeleteScheduleTransactionFunc == nil {
+		return nil, fmt.Errorf("DeleteScheduleTransaction: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.DeleteScheduleTransactionFunc(ctx, transferScheduleId)
+}
+
+// GetAccount 记录调用并执行 GetAccountFunc。
+func (f *Fake) GetAccount(ctx context.Context, accountId string, query map[string]string) (interface{}, error) {
+	f.Record("GetAccount", accountId, query)
+	if f.GetAccountFunc == nil {
+		return nil, fmt.Errorf("GetAccount: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetAccountFunc(ctx, accountId, query)
+}
+
+// GetTransaction 记录调用并执行 GetTransactionFunc。
+func (f *Fake) GetTransaction(ctx context.Context, transactionId string, query map[string]string) (interface{}, error) {
+	f.Record("GetTransaction", transactionId, query)
+	if f.GetTransactionFunc == nil {
+		return nil, fmt.Errorf("GetTransaction: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetTransactionFunc(ctx, transactionId, query)
+}
+
+// GetTransferPreview 记录调用并执行 GetTransferPreviewFunc。
+func (f *Fake) GetTransferPreview(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetTransferPreview", query)
+	if f.GetTransferPreviewFunc == nil {
+		return nil, fmt.Errorf("GetTransferPreview: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetTransferPreviewFunc(ctx, query)
+}
+
+// GetTransferSchedule 记录调用并执行 GetTransferScheduleFunc。
+func (f *Fake) GetTransferSchedule(ctx context.Context, transferScheduleId string, query map[string]string) (interface{}, error) {
+	f.Record("GetTransferSchedule", transferScheduleId, query)
+	if f.GetTransferScheduleFunc == nil {
+		return nil, fmt.Errorf("GetTransferSchedule: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetTransferScheduleFunc(ctx, transferScheduleId, query)
+}
+
+// IterateAccountTransactions 记录调用并执行 IterateAccountTransactionsFunc。
+func (f *Fake) IterateAccountTransactions(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+	f.Record("IterateAccountTransactions", query)
+	if f.IterateAccountTransactionsFunc == nil {
+		return spapi.FakeSeq[map[string]interface{}](nil, fmt.Errorf("IterateAccountTransactions: %w", spapi.ErrFakeNotConfigured))
+	}
+	return f.IterateAccountTransactionsFunc(ctx, query)
+}
+
+// ListAccountBalances 记录调用并执行 ListAccountBalancesFunc。
+func (f *Fake) ListAccountBalances(ctx context.Context, accountId string, query map[string]string) (interface{}, error) {
+	f.Record("ListAccountBalances", accountId, query)
+	if f.ListAccountBalancesFunc == nil {
+		return nil, fmt.Errorf("ListAccountBalances: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListAccountBalancesFunc(ctx, accountId, query)
+}
+
+// ListAccountTransactions 记录调用并执行 ListAccountTransactionsFunc。
+func (f *Fake) ListAccountTransactions(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("ListAccountTransactions", query)
+	if f.ListAccountTransactionsFunc == nil {
+		return nil, fmt.Errorf("ListAccountTransactions: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListAccountTransactionsFunc(ctx, query)
+}
+
+// ListAccounts 记录调用并执行 ListAccountsFunc。
+func (f *Fake) ListAccounts(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("ListAccounts", query)
+	if f.ListAccountsFunc == nil {
+		return nil, fmt.Errorf("ListAccounts: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListAccountsFunc(ctx, query)
+}
+
+// ListTransferSchedules 记录调用并执行 ListTransferSchedulesFunc。
+func (f *Fake) ListTransferSchedules(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("ListTransferSchedules", query)
+	if f.ListTransferSchedulesFunc == nil {
+		return nil, fmt.Errorf("ListTransferSchedules: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ListTransferSchedulesFunc(ctx, query)
+}
+
+// UpdateTransferSchedule 记录调用并执行 UpdateTransferScheduleFunc。
+func (f *Fake) UpdateTransferSchedule(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("UpdateTransferSchedule", body)
+	if f.UpdateTransferScheduleFunc == nil {
+		return nil, fmt.Errorf("UpdateTransferSchedule: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.UpdateTransferScheduleFunc(ctx, body)
+}
+
+// 确保 Fake 实现了 API。
+var _ API = (*Fake)(nil)
@@ -17,24 +17,6 @@
 // Unless you have obtained a commercial license, this file is licensed
 // under AGPL-3.0. By using this software, you agree to comply with the
 // terms of the applicable license.
-//
-// This file is part of Amazon SP-API Go SDK.
-//
-// Amazon SP-API Go SDK is dual-licensed:
-//
-// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
-//    - Free for personal, educational, and open source projects
-//    - Your project must also be open sourced under AGPL-3.0
-//    - See: https://www.gnu.org/licenses/agpl-3.0.html
-//
-// 2. Commercial License for proprietary/commercial use
-//    - Required for any commercial, enterprise, or proprietary use
-//    - Allows closed source distribution
-//    - Contact: vanling1111@gmail.com
-//
-// Unless you have obtained a commercial license, this file is licensed
-// under AGPL-3.0. By using this software, you agree to comply with the
-// terms of the applicable license.
 
 package seller_wallet_v2024_03_01
 
@@ -48,16 +30,28 @@ import (
 
 // IterateAccountTransactions 返回账户交易迭代器，自动处理分页。
 //
-// 使用 Go 1.25 迭代器特性，自动处理分页逻辑。
+// 使用 Go 1.25 迭代器特性，自动处理 nextToken 分页逻辑。
+//
+// 参数:
+//   - ctx: 请求上下文
+//   - query: 查询参数
 //
 // 示例:
 //
-//	for transaction, err := range client.IterateAccountTransactions(ctx, query) {
+//	for item, err := range client.IterateAccountTransactions(ctx, query) {
 //	    if err != nil { return err }
-//	    fmt.Printf("Transaction: %s\n", transaction["transactionId"])
+//	    process(item)
 //	}
 func (c *Client) IterateAccountTransactions(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
 	return func(yield func(map[string]interface{}, error) bool) {
+		// 登记到客户端，Close 等待迭代结束
+		ctx, release, err := c.baseClient.Acquire(ctx)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		defer release()
+
 		currentQuery := make(map[string]string)
 		for k, v := range query {
 			currentQuery[k] = v
@@ -66,7 +60,7 @@ func (c *Client) IterateAccountTransactions(ctx context.Context, query map[strin
 		for {
 			result, err := c.ListAccountTransactions(ctx, currentQuery)
 			if err != nil {
-				yield(nil, errors.Wrap(err, "failed to list account transactions"))
+				yield(nil, errors.Wrap(err, "failed to call ListAccountTransactions"))
 				return
 			}
 
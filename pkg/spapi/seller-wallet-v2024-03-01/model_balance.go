@@ -9,15 +9,16 @@
 package seller_wallet_v2024_03_01
 
 import (
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
 	"time"
 )
 
 // Specifies the balance amount in the Amazon SW bank account
 type Balance struct {
 	// The unique identifier provided by Amazon to identify the account
-	AccountId     string       `json:"accountId"`
-	BalanceType   *BalanceType `json:"balanceType,omitempty"`
-	BalanceAmount float64      `json:"balanceAmount"`
+	AccountId     string        `json:"accountId"`
+	BalanceType   *BalanceType  `json:"balanceType,omitempty"`
+	BalanceAmount spapi.Decimal `json:"balanceAmount"`
 	// The Amazon SW bank account currency code in ISO 4217 format
 	BalanceCurrency string `json:"balanceCurrency"`
 	// The last update date on the account balance
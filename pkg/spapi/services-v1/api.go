@@ -0,0 +1,105 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package services_v1
+
+import (
+	"context"
+	"iter"
+)
+
+// API 是 services API v1 客户端的全部方法，包括分页迭代器等辅助方法。
+//
+// 业务代码依赖 API 而不是 *Client，即可在单元测试中用 Fake 替换真实客户端。
+type API interface {
+	// AddAppointmentForServiceJobByServiceJobId
+	// Method: POST | Path: /service/v1/serviceJobs/{serviceJobId}/appointments
+	AddAppointmentForServiceJobByServiceJobId(ctx context.Context, serviceJobId string, body interface{}) (interface{}, error)
+
+	// AssignAppointmentResources
+	// Method: PUT | Path: /service/v1/serviceJobs/{serviceJobId}/appointments/{appointmentId}/resources
+	AssignAppointmentResources(ctx context.Context, serviceJobId string, appointmentId string, body interface{}) (interface{}, error)
+
+	// CancelReservation
+	// Method: DELETE | Path: /service/v1/reservation/{reservationId}
+	CancelReservation(ctx context.Context, reservationId string) (interface{}, error)
+
+	// CancelServiceJobByServiceJobId
+	// Method: PUT | Path: /service/v1/serviceJobs/{serviceJobId}/cancellations
+	CancelServiceJobByServiceJobId(ctx context.Context, serviceJobId string, body interface{}) (interface{}, error)
+
+	// CompleteServiceJobByServiceJobId
+	// Method: PUT | Path: /service/v1/serviceJobs/{serviceJobId}/completions
+	CompleteServiceJobByServiceJobId(ctx context.Context, serviceJobId string, body interface{}) (interface{}, error)
+
+	// CreateReservation
+	// Method: POST | Path: /service/v1/reservation
+	CreateReservation(ctx context.Context, body interface{}) (interface{}, error)
+
+	// CreateServiceDocumentUploadDestination
+	// Method: POST | Path: /service/v1/documents
+	CreateServiceDocumentUploadDestination(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetAppointmentSlots
+	// Method: GET | Path: /service/v1/appointmentSlots
+	GetAppointmentSlots(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetAppointmmentSlotsByJobId
+	// Method: GET | Path: /service/v1/serviceJobs/{serviceJobId}/appointmentSlots
+	GetAppointmmentSlotsByJobId(ctx context.Context, serviceJobId string, query map[string]string) (interface{}, error)
+
+	// GetFixedSlotCapacity
+	// Method: POST | Path: /service/v1/serviceResources/{resourceId}/capacity/fixed
+	GetFixedSlotCapacity(ctx context.Context, resourceId string, body interface{}) (interface{}, error)
+
+	// GetRangeSlotCapacity
+	// Method: POST | Path: /service/v1/serviceResources/{resourceId}/capacity/range
+	GetRangeSlotCapacity(ctx context.Context, resourceId string, body interface{}) (interface{}, error)
+
+	// GetServiceJobByServiceJobId
+	// Method: GET | Path: /service/v1/serviceJobs/{serviceJobId}
+	GetServiceJobByServiceJobId(ctx context.Context, serviceJobId string, query map[string]string) (interface{}, error)
+
+	// GetServiceJobs
+	// Method: GET | Path: /service/v1/serviceJobs
+	GetServiceJobs(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// IterateServiceJobs 返回服务工单迭代器，自动处理分页。
+	IterateServiceJobs(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// RescheduleAppointmentForServiceJobByServiceJobId
+	// Method: POST | Path: /service/v1/serviceJobs/{serviceJobId}/appointments/{appointmentId}
+	RescheduleAppointmentForServiceJobByServiceJobId(ctx context.Context, serviceJobId string, appointmentId string, body interface{}) (interface{}, error)
+
+	// SetAppointmentFulfillmentData
+	// Method: PUT | Path: /service/v1/serviceJobs/{serviceJobId}/appointments/{appointmentId}/fulfillment
+	SetAppointmentFulfillmentData(ctx context.Context, serviceJobId string, appointmentId string, body interface{}) (interface{}, error)
+
+	// UpdateReservation
+	// Method: PUT | Path: /service/v1/reservation/{reservationId}
+	UpdateReservation(ctx context.Context, reservationId string, body interface{}) (interface{}, error)
+
+	// UpdateSchedule
+	// Method: PUT | Path: /service/v1/serviceResources/{resourceId}/schedules
+	UpdateSchedule(ctx context.Context, resourceId string, body interface{}) (interface{}, error)
+}
+
+// 确保 Client 实现了 API。
+var _ API = (*Client)(nil)
@@ -0,0 +1,262 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package services_v1
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。
+//
+// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回
+// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，
+// 可以通过 Calls 和 CallsTo 查看。
+type Fake struct {
+	spapi.FakeRecorder
+
+	// AddAppointmentForServiceJobByServiceJobIdFunc 实现 AddAppointmentForServiceJobByServiceJobId。
+	AddAppointmentForServiceJobByServiceJobIdFunc func(ctx context.Context, serviceJobId string, body interface{}) (interface{}, error)
+
+	// AssignAppointmentResourcesFunc 实现 AssignAppointmentResources。
+	AssignAppointmentResourcesFunc func(ctx context.Context, serviceJobId string, appointmentId string, body interface{}) (interface{}, error)
+
+	// CancelReservationFunc 实现 CancelReservation。
+	CancelReservationFunc func(ctx context.Context, reservationId string) (interface{}, error)
+
+	// CancelServiceJobByServiceJobIdFunc 实现 CancelServiceJobByServiceJobId。
+	CancelServiceJobByServiceJobIdFunc func(ctx context.Context, serviceJobId string, body interface{}) (interface{}, error)
+
+	// CompleteServiceJobByServiceJobIdFunc 实现 CompleteServiceJobByServiceJobId。
+	CompleteServiceJobByServiceJobIdFunc func(ctx context.Context, serviceJobId string, body interface{}) (interface{}, error)
+
+	// CreateReservationFunc 实现 CreateReservation。
+	CreateReservationFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// CreateServiceDocumentUploadDestinationFunc 实现 CreateServiceDocumentUploadDestination。
+	CreateServiceDocumentUploadDestinationFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetAppointmentSlotsFunc 实现 GetAppointmentSlots。
+	GetAppointmentSlotsFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetAppointmmentSlotsByJobIdFunc 实现 GetAppointmmentSlotsByJobId。
+	GetAppointmmentSlotsByJobIdFunc func(ctx context.Context, serviceJobId string, query map[string]string) (interface{}, error)
+
+	// GetFixedSlotCapacityFunc 实现 GetFixedSlotCapacity。
+	GetFixedSlotCapacityFunc func(ctx context.Context, resourceId string, body interface{}) (interface{}, error)
+
+	// GetRangeSlotCapacityFunc 实现 GetRangeSlotCapacity。
+	GetRangeSlotCapacityFunc func(ctx context.Context, resourceId string, body interface{}) (interface{}, error)
+
+	// GetServiceJobByServiceJobIdFunc 实现 GetServiceJobByServiceJobId。
+	GetServiceJobByServiceJobIdFunc func(ctx context.Context, serviceJobId string, query map[string]string) (interface{}, error)
+
+	// GetServiceJobsFunc 实现 GetServiceJobs。
+	GetServiceJobsFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// IterateServiceJobsFunc 实现 IterateServiceJobs。
+	IterateServiceJobsFunc func(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// RescheduleAppointmentForServiceJobByServiceJobIdFunc 实现 RescheduleAppointmentForServiceJobByServiceJobId。
+	RescheduleAppointmentForServiceJobByServiceJobIdFunc func(ctx context.Context, serviceJobId string, appointmentId string, body interface{}) (interface{}, error)
+
+	// SetAppointmentFulfillmentDataFunc 实现 SetAppointmentFulfillmentData。
+	SetAppointmentFulfillmentDataFunc func(ctx context.Context, serviceJobId string, appointmentId string, body interface{}) (interface{}, error)
+
+	// UpdateReservationFunc 实现 UpdateReservation。
+	UpdateReservationFunc func(ctx context.Context, reservationId string, body interface{}) (interface{}, error)
+
+	// UpdateScheduleFunc 实现 UpdateSchedule。
+	UpdateScheduleFunc func(ctx context.Context, resourceId string, body interface{}) (interface{}, error)
+}
+
+// NewFake 创建未编程任何响应的 Fake。
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// AddAppointmentForServiceJobByServiceJobId 记录调用并执行 AddAppointmentForServiceJobByServiceJobIdFunc。
+func (f *Fake) AddAppointmentForServiceJobByServiceJobId(ctx context.Context, serviceJobId string, body interface{}) (interface{}, error) {
+	f.Record("AddAppointmentForServiceJobByServiceJobId", serviceJobId, body)
+	if f.AddAppointmentForServiceJobByServiceJobIdFunc == nil {
+		return nil, fmt.Errorf("AddAppointmentForServiceJobByServiceJobId: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.AddAppointmentForServiceJobByServiceJobIdFunc(ctx, serviceJobId, body)
+}
+
+// AssignAppointmentResources 记录调用并执行 AssignAppointmentResourcesFunc。
+func (f *Fake) AssignAppointmentResources(ctx context.Context, serviceJobId string, appointmentId string, body interface{}) (interface{}, error) {
+	f.Record("AssignAppointmentResources", serviceJobId, appointmentId, body)
+	if f.AssignAppointmentResourcesFunc == nil {
+		return nil, fmt.Errorf("AssignAppointmentResources: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.AssignAppointmentResourcesFunc(ctx, serviceJobId, appointmentId, body)
+}
+
+// CancelReservation 记录调用并执行 CancelReservationFunc。
+func (f *Fake) CancelReservation(ctx context.Context, reservationId string) (interface{}, error) {
+	f.Record("CancelReservation", reservationId)
+	if f.CancelReservationFunc == nil {
+		return nil, fmt.Errorf("CancelReservation: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CancelReservationFunc(ctx, reservationId)
+}
+
+// CancelServiceJobByServiceJobId 记录调用并执行 CancelServiceJobByServiceJobIdFunc。
+func (f *Fake) CancelServiceJobByServiceJobId(ctx context.Context, serviceJobId string, body interface{}) (interface{}, error) {
+	f.Record("CancelServiceJobByServiceJobId", serviceJobId, body)
+	if f.CancelServiceJobByServiceJobIdFunc == nil {
+		return nil, fmt.Errorf("CancelServiceJobByServiceJobId: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CancelServiceJobByServiceJobIdFunc(ctx, serviceJobId, body)
+}
+
+// CompleteServiceJobByServiceJobId 记录调用并执行 CompleteServiceJobByServiceJobIdFunc。
+func (f *Fake) CompleteServiceJobByServiceJobId(ctx context.Context, serviceJobId string, body interface{}) (interface{}, error) {
+	f.Record("CompleteServiceJobByServiceJobId", serviceJobId, body)
+	if f.CompleteServiceJobByServiceJobIdFunc == nil {
+		return nil, fmt.Errorf("CompleteServiceJobByServiceJobId: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CompleteServiceJobByServiceJobIdFunc(ctx, serviceJobId, body)
+}
+
+// CreateReservation 记录调用并执行 CreateReservationFunc。
+func (f *Fake) CreateReservation(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("CreateReservation", body)
+	if f.CreateReservationFunc == nil {
+		return nil, fmt.Errorf("CreateReservation: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateReservationFunc(ctx, body)
+}
+
+// CreateServiceDocumentUploadDestination 记录调用并执行 CreateServiceDocumentUploadDestinationFunc。
+func (f *Fake) CreateServiceDocumentUploadDestination(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("CreateServiceDocumentUploadDestination", body)
+	if f.CreateServiceDocumentUploadDestinationFunc == nil {
+		return nil, fmt.Errorf("CreateServiceDocumentUploadDestination: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateServiceDocumentUploadDestinationFunc(ctx, body)
+}
+
+// GetAppointmentSlots 记录调用并执行 GetAppointmentSlotsFunc。
+func (f *Fake) GetAppointmentSlots(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetAppointmentSlots", query)
+	if f.GetAppointmentSlotsFunc == nil {
+		return nil, fmt.Errorf("GetAppointmentSlots: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetAppointmentSlotsFunc(ctx, query)
+}
+
+// GetAppointmmentSlotsByJobId 记录调用并执行 GetAppointmmentSlotsByJobIdFunc。
+func (f *Fake) GetAppointmmentSlotsByJobId(ctx context.Context, serviceJobId string, query map[string]string) (interface{}, error) {
+	f.Record("GetAppointmmentSlotsByJobId", serviceJobId, query)
+	if f.GetAppointmmentSlotsByJobIdFunc == nil {
+		return nil, fmt.Errorf("GetAppointmmentSlotsByJobId: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetAppointmmentSlotsByJobIdFunc(ctx, serviceJobId, query)
+}
+
+// GetFixedSlotCapacity 记录调用并执行 GetFixedSlotCapacityFunc。
+func (f *Fake) GetFixedSlotCapacity(ctx context.Context, resourceId string, body interface{}) (interface{}, error) {
+	f.Record("GetFixedSlotCapacity", resourceId, body)
+	if f.GetFixedSlotCapacityFunc == nil {
+		return nil, fmt.Errorf("GetFixedSlotCapacity: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetFixedSlotCapacityFunc(ctx, resourceId, body)
+}
+
+// GetRangeSlotCapacity 记录调用并执行 GetRangeSlotCapacityFunc。
+func (f *Fake) GetRangeSlotCapacity(ctx context.Context, resourceId string, body interface{}) (interface{}, error) {
+	f.Record("GetRangeSlotCapacity", resourceId, body)
+	if f.GetRangeSlotCapacityFunc == nil {
+		return nil, fmt.Errorf("GetRangeSlotCapacity: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetRangeSlotCapacityFunc(ctx, resourceId, body)
+}
+
+// GetServiceJobByServiceJobId 记录调用并执行 GetServiceJobByServiceJobIdFunc。
+func (f *Fake) GetServiceJobByServiceJobId(ctx context.Context, serviceJobId string, query map[string]string) (interface{}, error) {
+	f.Record("GetServiceJobByServiceJobId", serviceJobId, query)
+	if f.GetServiceJobByServiceJobIdFunc == nil {
+		return nil, fmt.Errorf("GetServiceJobByServiceJobId: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetServiceJobByServiceJobIdFunc(ctx, serviceJobId, query)
+}
+
+// GetServiceJobs 记录调用并执行 GetServiceJobsFunc。
+func (f *Fake) GetServiceJobs(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetServiceJobs", query)
+	if f.GetServiceJobsFunc == nil {
+		return nil, fmt.Errorf("GetServiceJobs: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetServiceJobsFunc(ctx, query)
+}
+
+// IterateServiceJobs 记录调用并执行 IterateServiceJobsFunc。
+func (f *Fake) IterateServiceJobs(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+	f.Record("IterateServiceJobs", query)
+	if f.IterateServiceJobsFunc == nil {
+		return spapi.FakeSeq[map[string]interface{}](nil, fmt.Errorf("IterateServiceJobs: %w", spapi.ErrFakeNotConfigured))
+	}
+	return f.IterateServiceJobsFunc(ctx, query)
+}
+
+// RescheduleAppointmentForServiceJobByServiceJobId 记录调用并执行 RescheduleAppointmentForServiceJobByServiceJobIdFunc。
+func (f *Fake) RescheduleAppointmentForServiceJobByServiceJobId(ctx context.Context, serviceJobId string, appointmentId string, body interface{}) (interface{}, error) {
+	f.Record("RescheduleAppointmentForServiceJobByServiceJobId", serviceJobId, appointmentId, body)
+	if f.RescheduleAppointmentForServiceJobByServiceJobIdFunc == nil {
+		return nil, fmt.Errorf("RescheduleAppointmentForServiceJobByServiceJobId: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.RescheduleAppointmentForServiceJobByServiceJobIdFunc(ctx, serviceJobId, appointmentId, body)
+}
+
+// SetAppointmentFulfillmentData 记录调用并执行 SetAppointmentFulfillmentDataFunc。
+func (f *Fake) SetAppointmentFulfillmentData(ctx context.Context, serviceJobId string, appointmentId string, body interface{}) (interface{}, error) {
+	f.Record("SetAppointmentFulfillmentData", serviceJobId, appointmentId, body)
+	if f.SetAppointmentFulfillmentDataFunc == nil {
+		return nil, fmt.Errorf("SetAppointmentFulfillmentData: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.SetAppointmentFulfillmentDataFunc(ctx, serviceJobId, appointmentId, body)
+}
+
+// UpdateReservation 记录调用并执行 UpdateReservationFunc。
+func (f *Fake) UpdateReservation(ctx context.Context, reservationId string, body interface{}) (interface{}, error) {
+	f.Record("UpdateReservation", reservationId, body)
+	if f.UpdateReservationFunc == nil {
+		return nil, fmt.Errorf("UpdateReservation: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.UpdateReservationFunc(ctx, reservationId, body)
+}
+
+// UpdateSchedule 记录调用并执行 UpdateScheduleFunc。
+func (f *Fake) UpdateSchedule(ctx context.Context, resourceId string, body interface{}) (interface{}, error) {
+	f.Record("UpdateSchedule", resourceId, body)
+	if f.UpdateScheduleFunc == nil {
+		return nil, fmt.Errorf("UpdateSchedule: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.UpdateScheduleFunc(ctx, resourceId, body)
+}
+
+// 确保 Fake 实现了 API。
+var _ API = (*Fake)(nil)
@@ -0,0 +1,222 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+package shiplabel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/merchant-fulfillment-v0"
+)
+
+// MerchantFulfillmentInputsFunc 为需要额外输入的 Merchant Fulfillment 服务提供卖家输入。
+//
+// definitions 是 GetAdditionalSellerInputs 返回的字段定义，
+// 返回值作为 CreateShipment 的 ShipmentLevelSellerInputsList。
+type MerchantFulfillmentInputsFunc func(ctx context.Context, rate Rate, definitions *merchant_fulfillment_v0.GetAdditionalSellerInputsResult) ([]merchant_fulfillment_v0.AdditionalSellerInputs, error)
+
+// WithMerchantFulfillmentInputs 设置 Merchant Fulfillment 额外输入提供函数。
+func WithMerchantFulfillmentInputs(fn MerchantFulfillmentInputsFunc) Option {
+	return func(o *options) {
+		o.merchantFulfillInputs = fn
+	}
+}
+
+// MerchantFulfillment 使用 Merchant Fulfillment API v0 购买面单。
+type MerchantFulfillment struct {
+	client *merchant_fulfillment_v0.Client
+	opts   *options
+}
+
+// NewMerchantFulfillment 创建 Merchant Fulfillment 面单购买器。
+//
+// 参数:
+//   - client: Merchant Fulfillment API v0 客户端
+//   - opts: 配置选项
+//
+// 返回值:
+//   - *MerchantFulfillment: 面单购买器
+func NewMerchantFulfillment(client *merchant_fulfillment_v0.Client, opts ...Option) *MerchantFulfillment {
+	return &MerchantFulfillment{client: client, opts: newOptions(opts)}
+}
+
+// Rates 获取并转换可用的运输服务（不购买）。
+//
+// 参数:
+//   - ctx: 请求上下文
+//   - details: 货件请求详情
+//
+// 返回值:
+//   - []Rate: 可购买的报价
+//   - error: 如果查询失败，返回错误
+func (m *MerchantFulfillment) Rates(ctx context.Context, details *merchant_fulfillment_v0.ShipmentRequestDetails) ([]Rate, error) {
+	result, err := m.client.GetEligibleShipmentServices(ctx, &merchant_fulfillment_v0.GetEligibleShipmentServicesRequest{
+		ShipmentRequestDetails: details,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response merchant_fulfillment_v0.GetEligibleShipmentServicesResponse
+	if err := decodeResult(result, &response); err != nil {
+		return nil, fmt.Errorf("GetEligibleShipmentServices: %w", err)
+	}
+	if response.Payload == nil || response.Payload.ShippingServiceList == nil {
+		return nil, nil
+	}
+
+	rates := make([]Rate, 0, len(*response.Payload.ShippingServiceList))
+	for _, service := range *response.Payload.ShippingServiceList {
+		rate := Rate{
+			ID:                       service.ShippingServiceOfferId,
+			ServiceID:                service.ShippingServiceId,
+			ServiceName:              service.ShippingServiceName,
+			CarrierID:                service.CarrierName,
+			CarrierName:              service.CarrierName,
+			RequiresAdditionalInputs: service.RequiresAdditionalSellerInputs,
+		}
+		if service.Rate != nil {
+			rate.Amount = service.Rate.Amount
+			rate.Currency = service.Rate.CurrencyCode
+		}
+		if service.EarliestEstimatedDeliveryDate != nil {
+			rate.EarliestDelivery = *service.EarliestEstimatedDeliveryDate
+		}
+		if service.LatestEstimatedDeliveryDate != nil {
+			rate.LatestDelivery = *service.LatestEstimatedDeliveryDate
+		}
+		rates = append(rates, rate)
+	}
+
+	return rates, nil
+}
+
+// ShipOrder 查询可用服务、按策略选择、创建货件并解码面单。
+//
+// 如果选中的服务需要额外卖家输入（RequiresAdditionalSellerInputs），
+// 会调用 GetAdditionalSellerInputs 获取字段定义，并交给
+// WithMerchantFulfillmentInputs 配置的函数填写。
+//
+// 面单格式由 details.ShippingServiceOptions.LabelFormat 控制。
+//
+// 参数:
+//   - ctx: 请求上下文
+//   - details: 货件请求详情
+//
+// 返回值:
+//   - *Shipment: 购买结果（含解码后的面单）
+//   - error: 如果查询、选择、购买或解码失败，返回错误
+func (m *MerchantFulfillment) ShipOrder(ctx context.Context, details *merchant_fulfillment_v0.ShipmentRequestDetails) (*Shipment, error) {
+	rates, err := m.Rates(ctx, details)
+	if err != nil {
+		return nil, err
+	}
+
+	rate, err := m.opts.strategy(rates)
+	if err != nil {
+		return nil, fmt.Errorf("select rate: %w", err)
+	}
+
+	create := &merchant_fulfillment_v0.CreateShipmentRequest{
+		ShipmentRequestDetails: details,
+		ShippingServiceId:      rate.ServiceID,
+		ShippingServiceOfferId: rate.ID,
+	}
+
+	if rate.RequiresAdditionalInputs {
+		inputs, err := m.additionalInputs(ctx, details, rate)
+		if err != nil {
+			return nil, err
+		}
+		create.ShipmentLevelSellerInputsList = &inputs
+	}
+
+	result, err := m.client.CreateShipment(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+
+	var response merchant_fulfillment_v0.CreateShipmentResponse
+	if err := decodeResult(result, &response); err != nil {
+		return nil, fmt.Errorf("CreateShipment: %w", err)
+	}
+	if response.Payload == nil {
+		return nil, fmt.Errorf("CreateShipment: empty payload")
+	}
+
+	shipment := &Shipment{ShipmentID: response.Payload.ShipmentId, Rate: rate}
+	label := response.Payload.Label
+	if label == nil || label.FileContents == nil {
+		return shipment, nil
+	}
+
+	data, format, err := DecodeLabel(label.FileContents.Contents)
+	if err != nil {
+		return shipment, fmt.Errorf("shipment %s: %w", shipment.ShipmentID, err)
+	}
+	shipment.Labels = append(shipment.Labels, Label{
+		TrackingID:   response.Payload.TrackingId,
+		DocumentType: "LABEL",
+		Format:       format,
+		Data:         data,
+	})
+
+	return shipment, nil
+}
+
+// Cancel 取消已购买的货件。
+//
+// 参数:
+//   - ctx: 请求上下文
+//   - shipmentID: ShipOrder 返回的货件 ID
+//
+// 返回值:
+//   - error: 如果取消失败，返回错误
+func (m *MerchantFulfillment) Cancel(ctx context.Context, shipmentID string) error {
+	_, err := m.client.CancelShipment(ctx, shipmentID)
+	return err
+}
+
+// additionalInputs 获取额外输入字段定义并调用输入提供函数。
+func (m *MerchantFulfillment) additionalInputs(ctx context.Context, details *merchant_fulfillment_v0.ShipmentRequestDetails, rate Rate) ([]merchant_fulfillment_v0.AdditionalSellerInputs, error) {
+	if m.opts.merchantFulfillInputs == nil {
+		return nil, fmt.Errorf("%w: service %s", ErrAdditionalInputsRequired, rate.ServiceID)
+	}
+
+	result, err := m.client.GetAdditionalSellerInputs(ctx, &merchant_fulfillment_v0.GetAdditionalSellerInputsRequest{
+		ShippingServiceId: rate.ServiceID,
+		ShipFromAddress:   details.ShipFromAddress,
+		OrderId:           details.AmazonOrderId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response merchant_fulfillment_v0.GetAdditionalSellerInputsResponse
+	if err := decodeResult(result, &response); err != nil {
+		return nil, fmt.Errorf("GetAdditionalSellerInputs: %w", err)
+	}
+
+	inputs, err := m.opts.merchantFulfillInputs(ctx, rate, response.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("provide additional inputs: %w", err)
+	}
+	return inputs, nil
+}
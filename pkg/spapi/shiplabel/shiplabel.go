@@ -0,0 +1,337 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+//
+// Package shiplabel 提供面单购买的高层封装（比价、购买、解码面单、取消）。
+//
+// 支持两套 API：
+//   - Shipping API v2（GetRates → PurchaseShipment）
+//   - Merchant Fulfillment API v0（GetEligibleShipmentServices → CreateShipment）
+//
+// 两套 API 的报价统一转换为 Rate，通过可插拔的 Strategy 选择报价
+// （最便宜、最快、承运商白名单），购买后将 base64（通常是 gzip 压缩的）
+// 面单内容解码为 PDF/PNG/ZPL 字节。
+//
+// 示例:
+//
+//	shipper := shiplabel.NewShippingV2(shipping_v2.NewClient(baseClient),
+//	    shiplabel.WithStrategy(shiplabel.CarrierWhitelist(shiplabel.Cheapest(), "UPS", "USPS")),
+//	)
+//	shipment, err := shipper.ShipOrder(ctx, ratesRequest, documentSpec)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, label := range shipment.Labels {
+//	    os.WriteFile(label.TrackingID+label.Format.Extension(), label.Data, 0o644)
+//	}
+package shiplabel
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// 错误定义。
+var (
+	// ErrNoEligibleRate 表示没有满足选择策略的报价。
+	ErrNoEligibleRate = errors.New("no eligible shipping rate")
+
+	// ErrAdditionalInputsRequired 表示选中的报价需要额外输入，但未配置输入提供函数。
+	ErrAdditionalInputsRequired = errors.New("selected rate requires additional inputs")
+
+	// ErrEmptyLabel 表示面单内容为空。
+	ErrEmptyLabel = errors.New("empty label contents")
+)
+
+// Rate 是统一的运费报价。
+type Rate struct {
+	// ID 是报价 ID（Shipping v2 的 rateId，Merchant Fulfillment 的 ShippingServiceOfferId）
+	ID string
+
+	// ServiceID 是运输服务 ID
+	ServiceID string
+
+	// ServiceName 是运输服务名称（如 "UPS Ground"）
+	ServiceName string
+
+	// CarrierID 是承运商 ID（Merchant Fulfillment 没有承运商 ID，与 CarrierName 相同）
+	CarrierID string
+
+	// CarrierName 是承运商名称
+	CarrierName string
+
+	// Amount 是运费金额
+	Amount spapi.Decimal
+
+	// Currency 是 ISO 4217 货币代码
+	Currency string
+
+	// EarliestDelivery 是最早预计送达时间（未知时为零值）
+	EarliestDelivery time.Time
+
+	// LatestDelivery 是最晚预计送达时间（未知时为零值）
+	LatestDelivery time.Time
+
+	// RequiresAdditionalInputs 表示购买此报价需要额外输入
+	RequiresAdditionalInputs bool
+}
+
+// deliveryBy 返回用于比较速度的送达时间（优先使用最晚送达时间）。
+func (r Rate) deliveryBy() time.Time {
+	if !r.LatestDelivery.IsZero() {
+		return r.LatestDelivery
+	}
+	return r.EarliestDelivery
+}
+
+// Strategy 从候选报价中选择一个报价。
+//
+// 候选列表为空或没有合适的报价时应返回 ErrNoEligibleRate。
+type Strategy func(rates []Rate) (Rate, error)
+
+// Cheapest 返回选择最便宜报价的策略。
+//
+// 金额相同时选择更快送达的报价。报价的货币假定一致（同一次询价的报价总是同一货币）。
+func Cheapest() Strategy {
+	return func(rates []Rate) (Rate, error) {
+		if len(rates) == 0 {
+			return Rate{}, ErrNoEligibleRate
+		}
+		return slices.MinFunc(rates, func(a, b Rate) int {
+			if c := a.Amount.Cmp(b.Amount); c != 0 {
+				return c
+			}
+			return compareDelivery(a, b)
+		}), nil
+	}
+}
+
+// Fastest 返回选择最快送达报价的策略。
+//
+// 送达时间未知的报价排在最后；送达时间相同时选择更便宜的报价。
+func Fastest() Strategy {
+	return func(rates []Rate) (Rate, error) {
+		if len(rates) == 0 {
+			return Rate{}, ErrNoEligibleRate
+		}
+		return slices.MinFunc(rates, func(a, b Rate) int {
+			if c := compareDelivery(a, b); c != 0 {
+				return c
+			}
+			return a.Amount.Cmp(b.Amount)
+		}), nil
+	}
+}
+
+// CarrierWhitelist 返回只在指定承运商中选择报价的策略。
+//
+// 承运商按 CarrierID 或 CarrierName 匹配（不区分大小写），
+// 过滤后的报价交给 next 策略选择。
+//
+// 参数:
+//   - next: 过滤后使用的选择策略（如 Cheapest()）
+//   - carriers: 允许的承运商
+//
+// 返回值:
+//   - Strategy: 选择策略
+func CarrierWhitelist(next Strategy, carriers ...string) Strategy {
+	return func(rates []Rate) (Rate, error) {
+		allowed := make([]Rate, 0, len(rates))
+		for _, rate := range rates {
+			for _, carrier := range carriers {
+				if strings.EqualFold(rate.CarrierID, carrier) || strings.EqualFold(rate.CarrierName, carrier) {
+					allowed = append(allowed, rate)
+					break
+				}
+			}
+		}
+		return next(allowed)
+	}
+}
+
+// compareDelivery 比较两个报价的送达时间，未知时间视为最慢。
+func compareDelivery(a, b Rate) int {
+	ad, bd := a.deliveryBy(), b.deliveryBy()
+	switch {
+	case ad.IsZero() && bd.IsZero():
+		return 0
+	case ad.IsZero():
+		return 1
+	case bd.IsZero():
+		return -1
+	}
+	return ad.Compare(bd)
+}
+
+// LabelFormat 是面单文件格式。
+type LabelFormat string
+
+// 面单格式。
+const (
+	LabelFormatPDF     LabelFormat = "PDF"
+	LabelFormatPNG     LabelFormat = "PNG"
+	LabelFormatZPL     LabelFormat = "ZPL"
+	LabelFormatUnknown LabelFormat = "UNKNOWN"
+)
+
+// Extension 返回格式对应的文件扩展名（含 "."）。
+func (f LabelFormat) Extension() string {
+	switch f {
+	case LabelFormatPDF:
+		return ".pdf"
+	case LabelFormatPNG:
+		return ".png"
+	case LabelFormatZPL:
+		return ".zpl"
+	default:
+		return ".bin"
+	}
+}
+
+// Label 是解码后的面单或随附文档。
+type Label struct {
+	// TrackingID 是包裹追踪号
+	TrackingID string
+
+	// PackageReference 是包裹的客户端引用 ID（Shipping v2）
+	PackageReference string
+
+	// DocumentType 是文档类型（LABEL、PACKSLIP、RECEIPT、CUSTOM_FORM）
+	DocumentType string
+
+	// Format 是文件格式
+	Format LabelFormat
+
+	// Data 是解码后的文件内容
+	Data []byte
+}
+
+// Shipment 是购买面单的结果。
+type Shipment struct {
+	// ShipmentID 是 Amazon 分配的货件 ID（取消时使用）
+	ShipmentID string
+
+	// Rate 是购买的报价
+	Rate Rate
+
+	// Labels 是解码后的面单和随附文档
+	Labels []Label
+}
+
+// DecodeLabel 解码面单内容。
+//
+// SP-API 返回的面单内容是 base64 编码的，Merchant Fulfillment 的面单
+// 还会先经过 gzip 压缩。此函数自动识别 gzip 并解压，然后根据文件头
+// 识别格式（PDF、PNG、ZPL）。
+//
+// 参数:
+//   - contents: base64 编码的面单内容
+//
+// 返回值:
+//   - []byte: 解码后的文件内容
+//   - LabelFormat: 识别出的文件格式
+//   - error: 如果解码失败，返回错误
+func DecodeLabel(contents string) ([]byte, LabelFormat, error) {
+	if contents == "" {
+		return nil, LabelFormatUnknown, ErrEmptyLabel
+	}
+
+	data, err := base64.StdEncoding.DecodeString(contents)
+	if err != nil {
+		return nil, LabelFormatUnknown, fmt.Errorf("decode base64 label: %w", err)
+	}
+
+	// gzip 魔数 0x1f 0x8b
+	if len(data) >= 2 && data[0] == 0x1f && data[1] == 0x8b {
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, LabelFormatUnknown, fmt.Errorf("open gzip label: %w", err)
+		}
+		defer reader.Close()
+
+		data, err = io.ReadAll(reader)
+		if err != nil {
+			return nil, LabelFormatUnknown, fmt.Errorf("decompress label: %w", err)
+		}
+	}
+
+	return data, detectFormat(data), nil
+}
+
+// detectFormat 根据文件头识别面单格式。
+func detectFormat(data []byte) LabelFormat {
+	switch {
+	case bytes.HasPrefix(data, []byte("%PDF")):
+		return LabelFormatPDF
+	case bytes.HasPrefix(data, []byte("\x89PNG")):
+		return LabelFormatPNG
+	case bytes.HasPrefix(bytes.TrimLeft(data, " \t\r\n"), []byte("^XA")):
+		return LabelFormatZPL
+	default:
+		return LabelFormatUnknown
+	}
+}
+
+// decodeResult 将 API 客户端返回的 interface{} 结果转换为类型化模型。
+func decodeResult(result interface{}, out interface{}) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("marshal result: %w", err)
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("unmarshal result: %w", err)
+	}
+	return nil
+}
+
+// options 保存面单购买器的配置。
+type options struct {
+	strategy              Strategy
+	shippingV2Inputs      ShippingV2InputsFunc
+	merchantFulfillInputs MerchantFulfillmentInputsFunc
+}
+
+// Option 定义面单购买器配置选项函数。
+type Option func(*options)
+
+// WithStrategy 设置报价选择策略（默认 Cheapest()）。
+func WithStrategy(strategy Strategy) Option {
+	return func(o *options) {
+		o.strategy = strategy
+	}
+}
+
+// newOptions 应用配置选项。
+func newOptions(opts []Option) *options {
+	o := &options{strategy: Cheapest()}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
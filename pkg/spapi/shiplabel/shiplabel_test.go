@@ -0,0 +1,298 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+package shiplabel_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/merchant-fulfillment-v0"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/shiplabel"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/shipping-v2"
+)
+
+var testRates = []shiplabel.Rate{
+	{ID: "r1", CarrierID: "UPS", CarrierName: "UPS", Amount: spapi.NewDecimal(125, 1), LatestDelivery: time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC)},
+	{ID: "r2", CarrierID: "USPS", CarrierName: "USPS", Amount: spapi.NewDecimal(8, 0), LatestDelivery: time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC)},
+	{ID: "r3", CarrierID: "FEDEX", CarrierName: "FedEx", Amount: spapi.NewDecimal(30, 0), LatestDelivery: time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)},
+	{ID: "r4", CarrierID: "DHL", CarrierName: "DHL", Amount: spapi.NewDecimal(5, 0)},
+}
+
+// TestStrategies 测试报价选择策略。
+func TestStrategies(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy shiplabel.Strategy
+		want     string
+	}{
+		{"cheapest", shiplabel.Cheapest(), "r4"},
+		{"fastest", shiplabel.Fastest(), "r3"},
+		{"whitelist cheapest", shiplabel.CarrierWhitelist(shiplabel.Cheapest(), "ups", "fedex"), "r1"},
+		{"whitelist fastest", shiplabel.CarrierWhitelist(shiplabel.Fastest(), "UPS", "USPS"), "r1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, err := tt.strategy(testRates)
+			if err != nil {
+				t.Fatalf("strategy error = %v", err)
+			}
+			if rate.ID != tt.want {
+				t.Errorf("selected %s, want %s", rate.ID, tt.want)
+			}
+		})
+	}
+
+	if _, err := shiplabel.CarrierWhitelist(shiplabel.Cheapest(), "ONTRAC")(testRates); !errors.Is(err, shiplabel.ErrNoEligibleRate) {
+		t.Errorf("whitelist without match error = %v, want ErrNoEligibleRate", err)
+	}
+}
+
+// TestDecodeLabel 测试面单解码。
+func TestDecodeLabel(t *testing.T) {
+	var gz bytes.Buffer
+	w := gzip.NewWriter(&gz)
+	w.Write([]byte("%PDF-1.4 label"))
+	w.Close()
+
+	tests := []struct {
+		name     string
+		contents string
+		want     shiplabel.LabelFormat
+	}{
+		{"gzip pdf", base64.StdEncoding.EncodeToString(gz.Bytes()), shiplabel.LabelFormatPDF},
+		{"png", base64.StdEncoding.EncodeToString([]byte("\x89PNG\r\n\x1a\n")), shiplabel.LabelFormatPNG},
+		{"zpl", base64.StdEncoding.EncodeToString([]byte("\n^XA^FO50,50^FDtest^FS^XZ")), shiplabel.LabelFormatZPL},
+		{"unknown", base64.StdEncoding.EncodeToString([]byte("hello")), shiplabel.LabelFormatUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, format, err := shiplabel.DecodeLabel(tt.contents)
+			if err != nil {
+				t.Fatalf("DecodeLabel() error = %v", err)
+			}
+			if format != tt.want {
+				t.Errorf("format = %s, want %s", format, tt.want)
+			}
+		})
+	}
+
+	if _, _, err := shiplabel.DecodeLabel(""); !errors.Is(err, shiplabel.ErrEmptyLabel) {
+		t.Errorf("DecodeLabel(\"\") error = %v, want ErrEmptyLabel", err)
+	}
+	if _, _, err := shiplabel.DecodeLabel("not base64!"); err == nil {
+		t.Error("expected error for invalid base64")
+	}
+}
+
+// newTestClient 创建指向测试服务器的 SP-API 客户端。
+func newTestClient(t *testing.T, handler http.HandlerFunc) *spapi.Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/auth/o2/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"access_token":"test-token","token_type":"bearer","expires_in":3600}`))
+	})
+	mux.HandleFunc("/", handler)
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := spapi.NewClient(
+		spapi.WithRegion(spapi.Region{
+			Code:        "test",
+			Name:        "Test",
+			Endpoint:    server.URL,
+			LWAEndpoint: server.URL + "/auth/o2/token",
+		}),
+		spapi.WithCredentials("client-id", "client-secret", "refresh-token"),
+		spapi.WithMaxRetries(0),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	return client
+}
+
+// writeJSON 写入 JSON 响应。
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+// TestShippingV2_ShipOrder 测试 Shipping v2 完整购买流程（含额外输入和取消）。
+func TestShippingV2_ShipOrder(t *testing.T) {
+	label := base64.StdEncoding.EncodeToString([]byte("^XA^XZ"))
+	var purchased map[string]interface{}
+	canceled := ""
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/shipping/v2/shipments/rates":
+			writeJSON(w, map[string]interface{}{"payload": map[string]interface{}{
+				"requestToken": "token-1",
+				"rates": []interface{}{
+					map[string]interface{}{"rateId": "cheap", "carrierId": "USPS", "carrierName": "USPS", "serviceId": "s1",
+						"totalCharge": map[string]interface{}{"value": 5.0, "unit": "USD"}, "requiresAdditionalInputs": true},
+					map[string]interface{}{"rateId": "pricey", "carrierId": "UPS", "carrierName": "UPS", "serviceId": "s2",
+						"totalCharge": map[string]interface{}{"value": 9.0, "unit": "USD"}},
+				},
+			}})
+		case r.URL.Path == "/shipping/v2/shipments/additionalInputs/schema":
+			if r.URL.Query().Get("rateId") != "cheap" || r.URL.Query().Get("requestToken") != "token-1" {
+				t.Errorf("unexpected additional inputs query: %s", r.URL.RawQuery)
+			}
+			writeJSON(w, map[string]interface{}{"payload": map[string]interface{}{"type": "object"}})
+		case r.URL.Path == "/shipping/v2/shipments":
+			json.NewDecoder(r.Body).Decode(&purchased)
+			writeJSON(w, map[string]interface{}{"payload": map[string]interface{}{
+				"shipmentId": "ship-1",
+				"packageDocumentDetails": []interface{}{map[string]interface{}{
+					"packageClientReferenceId": "pkg-1",
+					"trackingId":               "1Z999",
+					"packageDocuments": []interface{}{map[string]interface{}{
+						"type": "LABEL", "format": "ZPL", "contents": label,
+					}},
+				}},
+			}})
+		case strings.HasSuffix(r.URL.Path, "/cancel"):
+			canceled = r.URL.Path
+			writeJSON(w, map[string]interface{}{"payload": map[string]interface{}{}})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	shipper := shiplabel.NewShippingV2(shipping_v2.NewClient(client),
+		shiplabel.WithShippingV2Inputs(func(ctx context.Context, rate shiplabel.Rate, schema map[string]interface{}) (map[string]interface{}, error) {
+			if schema["type"] != "object" {
+				t.Errorf("unexpected schema: %v", schema)
+			}
+			return map[string]interface{}{"SENDER_IS_EXPORTER": true}, nil
+		}),
+	)
+
+	shipment, err := shipper.ShipOrder(context.Background(), &shipping_v2.GetRatesRequest{}, &shipping_v2.RequestedDocumentSpecification{})
+	if err != nil {
+		t.Fatalf("ShipOrder() error = %v", err)
+	}
+
+	if shipment.ShipmentID != "ship-1" || shipment.Rate.ID != "cheap" {
+		t.Errorf("unexpected shipment: %+v", shipment)
+	}
+	if purchased["rateId"] != "cheap" || purchased["requestToken"] != "token-1" || purchased["additionalInputs"] == nil {
+		t.Errorf("unexpected purchase request: %v", purchased)
+	}
+	if len(shipment.Labels) != 1 || shipment.Labels[0].Format != shiplabel.LabelFormatZPL || shipment.Labels[0].TrackingID != "1Z999" {
+		t.Errorf("unexpected labels: %+v", shipment.Labels)
+	}
+
+	if err := shipper.Cancel(context.Background(), shipment.ShipmentID); err != nil {
+		t.Fatalf("Cancel() error = %v", err)
+	}
+	if canceled != "/shipping/v2/shipments/ship-1/cancel" {
+		t.Errorf("cancel path = %q", canceled)
+	}
+}
+
+// TestShippingV2_AdditionalInputsRequired 测试未配置额外输入函数时的错误。
+func TestShippingV2_AdditionalInputsRequired(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]interface{}{"payload": map[string]interface{}{
+			"requestToken": "token-1",
+			"rates": []interface{}{
+				map[string]interface{}{"rateId": "r1", "requiresAdditionalInputs": true},
+			},
+		}})
+	})
+
+	shipper := shiplabel.NewShippingV2(shipping_v2.NewClient(client))
+	_, err := shipper.ShipOrder(context.Background(), &shipping_v2.GetRatesRequest{}, nil)
+	if !errors.Is(err, shiplabel.ErrAdditionalInputsRequired) {
+		t.Fatalf("ShipOrder() error = %v, want ErrAdditionalInputsRequired", err)
+	}
+}
+
+// TestMerchantFulfillment_ShipOrder 测试 Merchant Fulfillment 完整购买流程。
+func TestMerchantFulfillment_ShipOrder(t *testing.T) {
+	var gz bytes.Buffer
+	w := gzip.NewWriter(&gz)
+	w.Write([]byte("%PDF-1.4"))
+	w.Close()
+	label := base64.StdEncoding.EncodeToString(gz.Bytes())
+
+	var created map[string]interface{}
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/mfn/v0/eligibleShippingServices":
+			writeJSON(w, map[string]interface{}{"payload": map[string]interface{}{
+				"ShippingServiceList": []interface{}{
+					map[string]interface{}{"ShippingServiceId": "UPS_GROUND", "ShippingServiceOfferId": "offer-1", "CarrierName": "UPS",
+						"Rate":                        map[string]interface{}{"CurrencyCode": "USD", "Amount": 7.5},
+						"LatestEstimatedDeliveryDate": "2025-01-09T00:00:00Z"},
+					map[string]interface{}{"ShippingServiceId": "USPS_PRIORITY", "ShippingServiceOfferId": "offer-2", "CarrierName": "USPS",
+						"Rate":                        map[string]interface{}{"CurrencyCode": "USD", "Amount": 9.5},
+						"LatestEstimatedDeliveryDate": "2025-01-04T00:00:00Z"},
+				},
+			}})
+		case "/mfn/v0/shipments":
+			json.NewDecoder(r.Body).Decode(&created)
+			writeJSON(w, map[string]interface{}{"payload": map[string]interface{}{
+				"ShipmentId": "mfn-1",
+				"TrackingId": "9400",
+				"Label": map[string]interface{}{
+					"FileContents": map[string]interface{}{"Contents": label, "FileType": "application/pdf"},
+				},
+			}})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	shipper := shiplabel.NewMerchantFulfillment(merchant_fulfillment_v0.NewClient(client),
+		shiplabel.WithStrategy(shiplabel.Fastest()),
+	)
+
+	shipment, err := shipper.ShipOrder(context.Background(), &merchant_fulfillment_v0.ShipmentRequestDetails{AmazonOrderId: "111-1"})
+	if err != nil {
+		t.Fatalf("ShipOrder() error = %v", err)
+	}
+
+	if created["ShippingServiceId"] != "USPS_PRIORITY" || created["ShippingServiceOfferId"] != "offer-2" {
+		t.Errorf("unexpected create request: %v", created)
+	}
+	if len(shipment.Labels) != 1 || shipment.Labels[0].Format != shiplabel.LabelFormatPDF || string(shipment.Labels[0].Data) != "%PDF-1.4" {
+		t.Errorf("unexpected labels: %+v", shipment.Labels)
+	}
+}
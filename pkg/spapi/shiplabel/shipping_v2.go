@@ -0,0 +1,230 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+package shiplabel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/shipping-v2"
+)
+
+// ShippingV2InputsFunc 为需要额外输入的 Shipping v2 报价提供 additionalInputs。
+//
+// schema 是 GetAdditionalInputs 返回的 JSON Schema，返回值必须符合该 Schema。
+type ShippingV2InputsFunc func(ctx context.Context, rate Rate, schema map[string]interface{}) (map[string]interface{}, error)
+
+// WithShippingV2Inputs 设置 Shipping v2 额外输入提供函数。
+func WithShippingV2Inputs(fn ShippingV2InputsFunc) Option {
+	return func(o *options) {
+		o.shippingV2Inputs = fn
+	}
+}
+
+// ShippingV2 使用 Shipping API v2 购买面单。
+type ShippingV2 struct {
+	client *shipping_v2.Client
+	opts   *options
+}
+
+// NewShippingV2 创建 Shipping API v2 面单购买器。
+//
+// 参数:
+//   - client: Shipping API v2 客户端
+//   - opts: 配置选项
+//
+// 返回值:
+//   - *ShippingV2: 面单购买器
+func NewShippingV2(client *shipping_v2.Client, opts ...Option) *ShippingV2 {
+	return &ShippingV2{client: client, opts: newOptions(opts)}
+}
+
+// Rates 获取并转换报价（不购买）。
+//
+// 参数:
+//   - ctx: 请求上下文
+//   - request: GetRates 请求
+//
+// 返回值:
+//   - string: 询价的 requestToken（购买时使用）
+//   - []Rate: 可购买的报价
+//   - error: 如果询价失败，返回错误
+func (s *ShippingV2) Rates(ctx context.Context, request *shipping_v2.GetRatesRequest) (string, []Rate, error) {
+	result, err := s.client.GetRates(ctx, request)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var response shipping_v2.GetRatesResponse
+	if err := decodeResult(result, &response); err != nil {
+		return "", nil, fmt.Errorf("GetRates: %w", err)
+	}
+	if response.Payload == nil || response.Payload.Rates == nil {
+		return "", nil, nil
+	}
+
+	rates := make([]Rate, 0, len(*response.Payload.Rates))
+	for _, r := range *response.Payload.Rates {
+		rate := Rate{
+			ID:                       r.RateId,
+			ServiceID:                r.ServiceId,
+			ServiceName:              r.ServiceName,
+			CarrierID:                r.CarrierId,
+			CarrierName:              r.CarrierName,
+			RequiresAdditionalInputs: r.RequiresAdditionalInputs,
+		}
+		if r.TotalCharge != nil {
+			rate.Amount = r.TotalCharge.Value
+			rate.Currency = r.TotalCharge.Unit
+		}
+		if r.Promise != nil && r.Promise.DeliveryWindow != nil {
+			rate.EarliestDelivery = r.Promise.DeliveryWindow.Start
+			rate.LatestDelivery = r.Promise.DeliveryWindow.End
+		}
+		rates = append(rates, rate)
+	}
+
+	return response.Payload.RequestToken, rates, nil
+}
+
+// ShipOrder 询价、按策略选择报价、购买并解码面单。
+//
+// 如果选中的报价需要额外输入（requiresAdditionalInputs），
+// 会调用 GetAdditionalInputs 获取 Schema，并交给 WithShippingV2Inputs 配置的函数填写。
+//
+// 参数:
+//   - ctx: 请求上下文
+//   - request: GetRates 请求
+//   - document: 面单文档规格（格式、尺寸、文档类型）
+//
+// 返回值:
+//   - *Shipment: 购买结果（含解码后的面单）
+//   - error: 如果询价、选择、购买或解码失败，返回错误
+func (s *ShippingV2) ShipOrder(ctx context.Context, request *shipping_v2.GetRatesRequest, document *shipping_v2.RequestedDocumentSpecification) (*Shipment, error) {
+	requestToken, rates, err := s.Rates(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	rate, err := s.opts.strategy(rates)
+	if err != nil {
+		return nil, fmt.Errorf("select rate: %w", err)
+	}
+
+	purchase := &shipping_v2.PurchaseShipmentRequest{
+		RequestToken:                   requestToken,
+		RateId:                         rate.ID,
+		RequestedDocumentSpecification: document,
+	}
+
+	if rate.RequiresAdditionalInputs {
+		inputs, err := s.additionalInputs(ctx, requestToken, rate)
+		if err != nil {
+			return nil, err
+		}
+		purchase.AdditionalInputs = inputs
+	}
+
+	result, err := s.client.PurchaseShipment(ctx, purchase)
+	if err != nil {
+		return nil, err
+	}
+
+	var response shipping_v2.PurchaseShipmentResponse
+	if err := decodeResult(result, &response); err != nil {
+		return nil, fmt.Errorf("PurchaseShipment: %w", err)
+	}
+	if response.Payload == nil {
+		return nil, fmt.Errorf("PurchaseShipment: empty payload")
+	}
+
+	shipment := &Shipment{ShipmentID: response.Payload.ShipmentId, Rate: rate}
+	if response.Payload.PackageDocumentDetails == nil {
+		return shipment, nil
+	}
+
+	for _, detail := range *response.Payload.PackageDocumentDetails {
+		if detail.PackageDocuments == nil {
+			continue
+		}
+		for _, doc := range *detail.PackageDocuments {
+			data, format, err := DecodeLabel(doc.Contents)
+			if err != nil {
+				return shipment, fmt.Errorf("package %s: %w", detail.PackageClientReferenceId, err)
+			}
+			label := Label{
+				TrackingID:       detail.TrackingId,
+				PackageReference: detail.PackageClientReferenceId,
+				Format:           format,
+				Data:             data,
+			}
+			if doc.Type_ != nil {
+				label.DocumentType = string(*doc.Type_)
+			}
+			shipment.Labels = append(shipment.Labels, label)
+		}
+	}
+
+	return shipment, nil
+}
+
+// Cancel 取消已购买的货件。
+//
+// 参数:
+//   - ctx: 请求上下文
+//   - shipmentID: ShipOrder 返回的货件 ID
+//
+// 返回值:
+//   - error: 如果取消失败，返回错误
+func (s *ShippingV2) Cancel(ctx context.Context, shipmentID string) error {
+	_, err := s.client.CancelShipment(ctx, shipmentID, nil)
+	return err
+}
+
+// additionalInputs 获取额外输入 Schema 并调用输入提供函数。
+func (s *ShippingV2) additionalInputs(ctx context.Context, requestToken string, rate Rate) (map[string]interface{}, error) {
+	if s.opts.shippingV2Inputs == nil {
+		return nil, fmt.Errorf("%w: rate %s", ErrAdditionalInputsRequired, rate.ID)
+	}
+
+	result, err := s.client.GetAdditionalInputs(ctx, map[string]string{
+		"requestToken": requestToken,
+		"rateId":       rate.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response shipping_v2.GetAdditionalInputsResponse
+	if err := decodeResult(result, &response); err != nil {
+		return nil, fmt.Errorf("GetAdditionalInputs: %w", err)
+	}
+
+	schema := map[string]interface{}{}
+	if response.Payload != nil {
+		schema = *response.Payload
+	}
+
+	inputs, err := s.opts.shippingV2Inputs(ctx, rate, schema)
+	if err != nil {
+		return nil, fmt.Errorf("provide additional inputs: %w", err)
+	}
+	return inputs, nil
+}
@@ -0,0 +1,109 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package shipping_v2
+
+import (
+	"context"
+)
+
+// API 是 shipping API v2 客户端的全部方法，包括分页迭代器等辅助方法。
+//
+// 业务代码依赖 API 而不是 *Client，即可在单元测试中用 Fake 替换真实客户端。
+type API interface {
+	// CancelShipment
+	// Method: PUT | Path: /shipping/v2/shipments/{shipmentId}/cancel
+	CancelShipment(ctx context.Context, shipmentId string, body interface{}) (interface{}, error)
+
+	// CreateClaim
+	// Method: POST | Path: /shipping/v2/claims
+	CreateClaim(ctx context.Context, body interface{}) (interface{}, error)
+
+	// DirectPurchaseShipment
+	// Method: POST | Path: /shipping/v2/shipments/directPurchase
+	DirectPurchaseShipment(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GenerateCollectionForm
+	// Method: POST | Path: /shipping/v2/collectionForms
+	GenerateCollectionForm(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetAccessPoints
+	// Method: GET | Path: /shipping/v2/accessPoints
+	GetAccessPoints(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetAdditionalInputs
+	// Method: GET | Path: /shipping/v2/shipments/additionalInputs/schema
+	GetAdditionalInputs(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetCarrierAccountFormInputs
+	// Method: GET | Path: /shipping/v2/carrierAccountFormInputs
+	GetCarrierAccountFormInputs(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetCarrierAccounts
+	// Method: PUT | Path: /shipping/v2/carrierAccounts
+	GetCarrierAccounts(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetCollectionForm
+	// Method: GET | Path: /shipping/v2/collectionForms/{collectionFormId}
+	GetCollectionForm(ctx context.Context, collectionFormId string, query map[string]string) (interface{}, error)
+
+	// GetCollectionFormHistory
+	// Method: PUT | Path: /shipping/v2/collectionForms/history
+	GetCollectionFormHistory(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetRates
+	// Method: POST | Path: /shipping/v2/shipments/rates
+	GetRates(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetShipmentDocuments
+	// Method: GET | Path: /shipping/v2/shipments/{shipmentId}/documents
+	GetShipmentDocuments(ctx context.Context, shipmentId string, query map[string]string) (interface{}, error)
+
+	// GetTracking
+	// Method: GET | Path: /shipping/v2/tracking
+	GetTracking(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetUnmanifestedShipments
+	// Method: PUT | Path: /shipping/v2/unmanifestedShipments
+	GetUnmanifestedShipments(ctx context.Context, body interface{}) (interface{}, error)
+
+	// LinkCarrierAccount
+	// Method: PUT | Path: /shipping/v2/carrierAccounts/{carrierId}
+	LinkCarrierAccount(ctx context.Context, carrierId string, body interface{}) (interface{}, error)
+
+	// OneClickShipment
+	// Method: POST | Path: /shipping/v2/oneClickShipment
+	OneClickShipment(ctx context.Context, body interface{}) (interface{}, error)
+
+	// PurchaseShipment
+	// Method: POST | Path: /shipping/v2/shipments
+	PurchaseShipment(ctx context.Context, body interface{}) (interface{}, error)
+
+	// SubmitNdrFeedback
+	// Method: POST | Path: /shipping/v2/ndrFeedback
+	SubmitNdrFeedback(ctx context.Context, body interface{}) (interface{}, error)
+
+	// UnlinkCarrierAccount
+	// Method: PUT | Path: /shipping/v2/carrierAccounts/{carrierId}/unlink
+	UnlinkCarrierAccount(ctx context.Context, carrierId string, body interface{}) (interface{}, error)
+}
+
+// 确保 Client 实现了 API。
+var _ API = (*Client)(nil)
@@ -0,0 +1,273 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package shipping_v2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。
+//
+// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回
+// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，
+// 可以通过 Calls 和 CallsTo 查看。
+type Fake struct {
+	spapi.FakeRecorder
+
+	// CancelShipmentFunc 实现 CancelShipment。
+	CancelShipmentFunc func(ctx context.Context, shipmentId string, body interface{}) (interface{}, error)
+
+	// CreateClaimFunc 实现 CreateClaim。
+	CreateClaimFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// DirectPurchaseShipmentFunc 实现 DirectPurchaseShipment。
+	DirectPurchaseShipmentFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GenerateCollectionFormFunc 实现 GenerateCollectionForm。
+	GenerateCollectionFormFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetAccessPointsFunc 实现 GetAccessPoints。
+	GetAccessPointsFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetAdditionalInputsFunc 实现 GetAdditionalInputs。
+	GetAdditionalInputsFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetCarrierAccountFormInputsFunc 实现 GetCarrierAccountFormInputs。
+	GetCarrierAccountFormInputsFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetCarrierAccountsFunc 实现 GetCarrierAccounts。
+	GetCarrierAccountsFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetCollectionFormFunc 实现 GetCollectionForm。
+	GetCollectionFormFunc func(ctx context.Context, collectionFormId string, query map[string]string) (interface{}, error)
+
+	// GetCollectionFormHistoryFunc 实现 GetCollectionFormHistory。
+	GetCollectionFormHistoryFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetRatesFunc 实现 GetRates。
+	GetRatesFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetShipmentDocumentsFunc 实现 GetShipmentDocuments。
+	GetShipmentDocumentsFunc func(ctx context.Context, shipmentId string, query map[string]string) (interface{}, error)
+
+	// GetTrackingFunc 实现 GetTracking。
+	GetTrackingFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetUnmanifestedShipmentsFunc 实现 GetUnmanifestedShipments。
+	GetUnmanifestedShipmentsFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// LinkCarrierAccountFunc 实现 LinkCarrierAccount。
+	LinkCarrierAccountFunc func(ctx context.Context, carrierId string, body interface{}) (interface{}, error)
+
+	// OneClickShipmentFunc 实现 OneClickShipment。
+	OneClickShipmentFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// PurchaseShipmentFunc 实现 PurchaseShipment。
+	PurchaseShipmentFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// SubmitNdrFeedbackFunc 实现 SubmitNdrFeedback。
+	SubmitNdrFeedbackFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// UnlinkCarrierAccountFunc 实现 UnlinkCarrierAccount。
+	UnlinkCarrierAccountFunc func(ctx context.Context, carrierId string, body interface{}) (interface{}, error)
+}
+
+// NewFake 创建未编程任何响应的 Fake。
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// CancelShipment 记录调用并执行 CancelShipmentFunc。
+func (f *Fake) CancelShipment(ctx context.Context, shipmentId string, body interface{}) (interface{}, error) {
+	f.Record("CancelShipment", shipmentId, body)
+	if f.CancelShipmentFunc == nil {
+		return nil, fmt.Errorf("CancelShipment: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CancelShipmentFunc(ctx, shipmentId, body)
+}
+
+// CreateClaim 记录调用并执行 CreateClaimFunc。
+func (f *Fake) CreateClaim(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("CreateClaim", body)
+	if f.CreateClaimFunc == nil {
+		return nil, fmt.Errorf("CreateClaim: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateClaimFunc(ctx, body)
+}
+
+// DirectPurchaseShipment 记录调用并执行 DirectPurchaseShipmentFunc。
+func (f *Fake) DirectPurchaseShipment(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("DirectPurchaseShipment", body)
+	if f.DirectPurchaseShipmentFunc == nil {
+		return nil, fmt.Errorf("DirectPurchaseShipment: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.DirectPurchaseShipmentFunc(ctx, body)
+}
+
+// GenerateCollectionForm 记录调用并执行 GenerateCollectionFormFunc。
+func (f *Fake) GenerateCollectionForm(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("GenerateCollectionForm", body)
+	if f.GenerateCollectionFormFunc == nil {
+		return nil, fmt.Errorf("GenerateCollectionForm: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GenerateCollectionFormFunc(ctx, body)
+}
+
+// GetAccessPoints 记录调用并执行 GetAccessPointsFunc。
+func (f *Fake) GetAccessPoints(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetAccessPoints", query)
+	if f.GetAccessPointsFunc == nil {
+		return nil, fmt.Errorf("GetAccessPoints: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetAccessPointsFunc(ctx, query)
+}
+
+// GetAdditionalInputs 记录调用并执行 GetAdditionalInputsFunc。
+func (f *Fake) GetAdditionalInputs(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetAdditionalInputs", query)
+	if f.GetAdditionalInputsFunc == nil {
+		return nil, fmt.Errorf("GetAdditionalInputs: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetAdditionalInputsFunc(ctx, query)
+}
+
+// GetCarrierAccountFormInputs 记录调用并执行 GetCarrierAccountFormInputsFunc。
+func (f *Fake) GetCarrierAccountFormInputs(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetCarrierAccountFormInputs", query)
+	if f.GetCarrierAccountFormInputsFunc == nil {
+		return nil, fmt.Errorf("GetCarrierAccountFormInputs: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetCarrierAccountFormInputsFunc(ctx, query)
+}
+
+// GetCarrierAccounts 记录调用并执行 GetCarrierAccountsFunc。
+func (f *Fake) GetCarrierAccounts(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("GetCarrierAccounts", body)
+	if f.GetCarrierAccountsFunc == nil {
+		return nil, fmt.Errorf("GetCarrierAccounts: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetCarrierAccountsFunc(ctx, body)
+}
+
+// GetCollectionForm 记录调用并执行 GetCollectionFormFunc。
+func (f *Fake) GetCollectionForm(ctx context.Context, collectionFormId string, query map[string]string) (interface{}, error) {
+	f.Record("GetCollectionForm", collectionFormId, query)
+	if f.GetCollectionFormFunc == nil {
+		return nil, fmt.Errorf("GetCollectionForm: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetCollectionFormFunc(ctx, collectionFormId, query)
+}
+
+// GetCollectionFormHistory 记录调用并执行 GetCollectionFormHistoryFunc。
+func (f *Fake) GetCollectionFormHistory(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("GetCollectionFormHistory", body)
+	if f.GetCollectionFormHistoryFunc == nil {
+		return nil, fmt.Errorf("GetCollectionFormHistory: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetCollectionFormHistoryFunc(ctx, body)
+}
+
+// GetRates 记录调用并执行 GetRatesFunc。
+func (f *Fake) GetRates(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("GetRates", body)
+	if f.GetRatesFunc == nil {
+		return nil, fmt.Errorf("GetRates: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetRatesFunc(ctx, body)
+}
+
+// GetShipmentDocuments 记录调用并执行 GetShipmentDocumentsFunc。
+func (f *Fake) GetShipmentDocuments(ctx context.Context, shipmentId string, query map[string]string) (interface{}, error) {
+	f.Record("GetShipmentDocuments", shipmentId, query)
+	if f.GetShipmentDocumentsFunc == nil {
+		return nil, fmt.Errorf("GetShipmentDocuments: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetShipmentDocumentsFunc(ctx, shipmentId, query)
+}
+
+// GetTracking 记录调用并执行 GetTrackingFunc。
+func (f *Fake) GetTracking(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetTracking", query)
+	if f.GetTrackingFunc == nil {
+		return nil, fmt.Errorf("GetTracking: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetTrackingFunc(ctx, query)
+}
+
+// GetUnmanifestedShipments 记录调用并执行 GetUnmanifestedShipmentsFunc。
+func (f *Fake) GetUnmanifestedShipments(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("GetUnmanifestedShipments", body)
+	if f.GetUnmanifestedShipmentsFunc == nil {
+		return nil, fmt.Errorf("GetUnmanifestedShipments: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetUnmanifestedShipmentsFunc(ctx, body)
+}
+
+// LinkCarrierAccount 记录调用并执行 LinkCarrierAccountFunc。
+func (f *Fake) LinkCarrierAccount(ctx context.Context, carrierId string, body interface{}) (interface{}, error) {
+	f.Record("LinkCarrierAccount", carrierId, body)
+	if f.LinkCarrierAccountFunc == nil {
+		return nil, fmt.Errorf("LinkCarrierAccount: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.LinkCarrierAccountFunc(ctx, carrierId, body)
+}
+
+// OneClickShipment 记录调用并执行 OneClickShipmentFunc。
+func (f *Fake) OneClickShipment(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("OneClickShipment", body)
+	if f.OneClickShipmentFunc == nil {
+		return nil, fmt.Errorf("OneClickShipment: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.OneClickShipmentFunc(ctx, body)
+}
+
+// PurchaseShipment 记录调用并执行 PurchaseShipmentFunc。
+func (f *Fake) PurchaseShipment(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("PurchaseShipment", body)
+	if f.PurchaseShipmentFunc == nil {
+		return nil, fmt.Errorf("PurchaseShipment: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.PurchaseShipmentFunc(ctx, body)
+}
+
+// SubmitNdrFeedback 记录调用并执行 SubmitNdrFeedbackFunc。
+func (f *Fake) SubmitNdrFeedback(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("SubmitNdrFeedback", body)
+	if f.SubmitNdrFeedbackFunc == nil {
+		return nil, fmt.Errorf("SubmitNdrFeedback: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.SubmitNdrFeedbackFunc(ctx, body)
+}
+
+// UnlinkCarrierAccount 记录调用并执行 UnlinkCarrierAccountFunc。
+func (f *Fake) UnlinkCarrierAccount(ctx context.Context, carrierId string, body interface{}) (interface{}, error) {
+	f.Record("UnlinkCarrierAccount", carrierId, body)
+	if f.UnlinkCarrierAccountFunc == nil {
+		return nil, fmt.Errorf("UnlinkCarrierAccount: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.UnlinkCarrierAccountFunc(ctx, carrierId, body)
+}
+
+// 确保 Fake 实现了 API。
+var _ API = (*Fake)(nil)
@@ -9,10 +9,19 @@
  */
 package shipping_v2
 
+import (
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
 // The monetary value in the currency indicated, in ISO 4217 standard format.
 type Currency struct {
 	// The monetary value.
-	Value float64 `json:"value"`
+	Value spapi.Decimal `json:"value"`
 	// The ISO 4217 format 3-character currency code.
 	Unit string `json:"unit"`
 }
+
+// ToMoney 转换为 spapi.Money。
+func (c Currency) ToMoney() spapi.Money {
+	return spapi.NewMoney(c.Value, c.Unit)
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapi
+
+import (
+	"context"
+	"log/slog"
+)
+
+// slogLogger 把 Logger 适配到 log/slog。
+type slogLogger struct {
+	logger *slog.Logger
+}
+
+// NewSlogLogger 创建输出到 log/slog 的 Logger。
+//
+// Field 转换为 slog.Any 属性，Debug/Info/Warn/Error 对应 slog 的同名级别。
+//
+// 参数:
+//   - logger: slog 日志器（为 nil 时使用 slog.Default()）
+//
+// 返回值:
+//   - Logger: 日志器实例
+//
+// 示例:
+//
+//	handler := slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug})
+//	client, err := spapi.NewClient(
+//	    spapi.WithRegion(spapi.RegionNA),
+//	    spapi.WithCredentials(clientID, clientSecret, refreshToken),
+//	    spapi.WithLogger(spapi.NewSlogLogger(slog.New(handler))),
+//	)
+func NewSlogLogger(logger *slog.Logger) Logger {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &slogLogger{logger: logger}
+}
+
+func (l *slogLogger) Debug(msg string, fields ...Field) { l.log(slog.LevelDebug, msg, fields) }
+func (l *slogLogger) Info(msg string, fields ...Field)  { l.log(slog.LevelInfo, msg, fields) }
+func (l *slogLogger) Warn(msg string, fields ...Field)  { l.log(slog.LevelWarn, msg, fields) }
+func (l *slogLogger) Error(msg string, fields ...Field) { l.log(slog.LevelError, msg, fields) }
+
+func (l *slogLogger) With(fields ...Field) Logger {
+	args := make([]any, len(fields))
+	for i, f := range fields {
+		args[i] = slog.Any(f.Key, f.Value)
+	}
+	return &slogLogger{logger: l.logger.With(args...)}
+}
+
+func (l *slogLogger) log(level slog.Level, msg string, fields []Field) {
+	ctx := context.Background()
+	if !l.logger.Enabled(ctx, level) {
+		return
+	}
+	attrs := make([]slog.Attr, len(fields))
+	for i, f := range fields {
+		attrs[i] = slog.Any(f.Key, f.Value)
+	}
+	l.logger.LogAttrs(ctx, level, msg, attrs...)
+}
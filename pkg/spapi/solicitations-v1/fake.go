@@ -0,0 +1,69 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package solicitations_v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。
+//
+// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回
+// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，
+// 可以通过 Calls 和 CallsTo 查看。
+type Fake struct {
+	spapi.FakeRecorder
+
+	// CreateProductReviewAndSellerFeedbackSolicitationFunc 实现 CreateProductReviewAndSellerFeedbackSolicitation。
+	CreateProductReviewAndSellerFeedbackSolicitationFunc func(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error)
+
+	// GetSolicitationActionsForOrderFunc 实现 GetSolicitationActionsForOrder。
+	GetSolicitationActionsForOrderFunc func(ctx context.Context, amazonOrderId string, query map[string]string) (interface{}, error)
+}
+
+// NewFake 创建未编程任何响应的 Fake。
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// CreateProductReviewAndSellerFeedbackSolicitation 记录调用并执行 CreateProductReviewAndSellerFeedbackSolicitationFunc。
+func (f *Fake) CreateProductReviewAndSellerFeedbackSolicitation(ctx context.Context, amazonOrderId string, body interface{}) (interface{}, error) {
+	f.Record("CreateProductReviewAndSellerFeedbackSolicitation", amazonOrderId, body)
+	if f.CreateProductReviewAndSellerFeedbackSolicitationFunc == nil {
+		return nil, fmt.Errorf("CreateProductReviewAndSellerFeedbackSolicitation: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateProductReviewAndSellerFeedbackSolicitationFunc(ctx, amazonOrderId, body)
+}
+
+// GetSolicitationActionsForOrder 记录调用并执行 GetSolicitationActionsForOrderFunc。
+func (f *Fake) GetSolicitationActionsForOrder(ctx context.Context, amazonOrderId string, query map[string]string) (interface{}, error) {
+	f.Record("GetSolicitationActionsForOrder", amazonOrderId, query)
+	if f.GetSolicitationActionsForOrderFunc == nil {
+		return nil, fmt.Errorf("GetSolicitationActionsForOrder: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetSolicitationActionsForOrderFunc(ctx, amazonOrderId, query)
+}
+
+// 确保 Fake 实现了 API。
+var _ API = (*Fake)(nil)
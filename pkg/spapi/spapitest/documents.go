@@ -0,0 +1,446 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapitest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/crypto"
+)
+
+// Reports 和 Feeds API 的路径。
+const (
+	reportsPath = "/reports/2021-06-30"
+	feedsPath   = "/feeds/2021-06-30"
+)
+
+// Document 描述报告或 Feed 处理结果文档。
+type Document struct {
+	// Content 是文档的原始内容
+	Content []byte
+
+	// Compress 表示使用 GZIP 压缩文档（返回 compressionAlgorithm: GZIP）
+	Compress bool
+
+	// Encrypt 表示使用 AES-256-CBC 加密文档（返回 encryptionDetails）
+	Encrypt bool
+
+	// Status 是最终处理状态（默认 DONE，也可以是 CANCELLED、FATAL）
+	Status string
+}
+
+// document 是已存储的文档。
+type document struct {
+	data        []byte
+	compression string
+	encryption  *crypto.EncryptionDetails
+}
+
+// job 是一个报告或 Feed 的处理状态。
+type job struct {
+	id          string
+	kind        string
+	marketplace []string
+	created     time.Time
+	polls       int
+	canceled    bool
+	final       string
+	documentID  string
+}
+
+// SetReport 设置某个报告类型生成的报告文档。
+//
+// 未设置的报告类型生成空文档。
+//
+// 参数:
+//   - reportType: 报告类型（如 "GET_MERCHANT_LISTINGS_ALL_DATA"）
+//   - doc: 报告文档
+func (s *Server) SetReport(reportType string, doc Document) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.outputs[reportType] = doc
+}
+
+// SetFeedResult 设置某个 Feed 类型的处理结果文档。
+//
+// 未设置的 Feed 类型返回一个没有错误的 JSON 处理报告。
+//
+// 参数:
+//   - feedType: Feed 类型（如 "JSON_LISTINGS_FEED"）
+//   - doc: 处理结果文档
+func (s *Server) SetFeedResult(feedType string, doc Document) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.results[feedType] = doc
+}
+
+// Upload 返回客户端上传到 Feed 文档的内容。
+//
+// 参数:
+//   - feedDocumentID: CreateFeedDocument 返回的 Feed 文档 ID
+//
+// 返回值:
+//   - []byte: 上传的内容
+//   - bool: 是否已上传
+func (s *Server) Upload(feedDocumentID string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, ok := s.uploads[feedDocumentID]
+	return data, ok
+}
+
+// registerBuiltins 注册 Reports 和 Feeds 的内置路由。
+//
+// 速率取自官方文档的默认 Usage Plan。
+func (s *Server) registerBuiltins() {
+	s.builtins = []*route{
+		{method: http.MethodPost, path: reportsPath + "/reports", rate: 0.0167, handle: s.createReport},
+		{method: http.MethodGet, path: reportsPath + "/reports/{reportId}", rate: 2, handle: s.getReport},
+		{method: http.MethodDelete, path: reportsPath + "/reports/{reportId}", rate: 0.0222, handle: s.cancelReport},
+		{method: http.MethodGet, path: reportsPath + "/documents/{reportDocumentId}", rate: 0.0167, handle: s.getReportDocument},
+		{method: http.MethodPost, path: feedsPath + "/documents", rate: 0.5, handle: s.createFeedDocument},
+		{method: http.MethodPost, path: feedsPath + "/feeds", rate: 0.0083, handle: s.createFeed},
+		{method: http.MethodGet, path: feedsPath + "/feeds/{feedId}", rate: 2, handle: s.getFeed},
+		{method: http.MethodDelete, path: feedsPath + "/feeds/{feedId}", rate: 2, handle: s.cancelFeed},
+		{method: http.MethodGet, path: feedsPath + "/documents/{feedDocumentId}", rate: 0.0222, handle: s.getFeedDocument},
+	}
+}
+
+// createReport 处理 CreateReport。
+func (s *Server) createReport(w http.ResponseWriter, r *http.Request) {
+	var spec struct {
+		ReportType     string   `json:"reportType"`
+		MarketplaceIDs []string `json:"marketplaceIds"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&spec); err != nil || spec.ReportType == "" || len(spec.MarketplaceIDs) == 0 {
+		writeError(w, http.StatusBadRequest, "InvalidInput", "reportType and marketplaceIds are required")
+		return
+	}
+
+	s.mu.Lock()
+	s.sequence++
+	id := fmt.Sprintf("%d", 50000+s.sequence)
+	s.reports[id] = &job{id: id, kind: spec.ReportType, marketplace: spec.MarketplaceIDs, created: s.settings.now()}
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusAccepted, map[string]string{"reportId": id})
+}
+
+// getReport 处理 GetReport，每次调用前进一个处理状态。
+func (s *Server) getReport(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	report, ok := s.reports[r.PathValue("reportId")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "Report not found")
+		return
+	}
+
+	status := s.advance(report, s.outputs[report.kind].Status)
+	if report.documentID == "" && (status == "DONE" || status == "FATAL") {
+		report.documentID = s.storeDocument("amzn1.spdoc.1.4.na.", s.outputs[report.kind])
+	}
+
+	body := map[string]interface{}{
+		"reportId":         report.id,
+		"reportType":       report.kind,
+		"marketplaceIds":   report.marketplace,
+		"processingStatus": status,
+		"createdTime":      report.created.UTC().Format(time.RFC3339),
+	}
+	if report.documentID != "" {
+		body["reportDocumentId"] = report.documentID
+		body["processingEndTime"] = s.settings.now().UTC().Format(time.RFC3339)
+	}
+	writeJSON(w, http.StatusOK, body)
+}
+
+// cancelReport 处理 CancelReport。
+func (s *Server) cancelReport(w http.ResponseWriter, r *http.Request) {
+	s.cancel(w, s.reports, r.PathValue("reportId"))
+}
+
+// getReportDocument 处理 GetReportDocument。
+func (s *Server) getReportDocument(w http.ResponseWriter, r *http.Request) {
+	s.writeDocument(w, "reportDocumentId", r.PathValue("reportDocumentId"))
+}
+
+// createFeedDocument 处理 CreateFeedDocument，返回上传 URL。
+func (s *Server) createFeedDocument(w http.ResponseWriter, r *http.Request) {
+	var spec struct {
+		ContentType string `json:"contentType"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&spec); err != nil || spec.ContentType == "" {
+		writeError(w, http.StatusBadRequest, "InvalidInput", "contentType is required")
+		return
+	}
+
+	s.mu.Lock()
+	s.sequence++
+	id := fmt.Sprintf("amzn1.tortuga.4.na.spapitest%d", s.sequence)
+	s.uploads[id] = nil
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusCreated, map[string]string{
+		"feedDocumentId": id,
+		"url":            s.URL + uploadPath + id,
+	})
+}
+
+// createFeed 处理 CreateFeed。
+func (s *Server) createFeed(w http.ResponseWriter, r *http.Request) {
+	var spec struct {
+		FeedType            string   `json:"feedType"`
+		MarketplaceIDs      []string `json:"marketplaceIds"`
+		InputFeedDocumentID string   `json:"inputFeedDocumentId"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&spec); err != nil || spec.FeedType == "" || len(spec.MarketplaceIDs) == 0 {
+		writeError(w, http.StatusBadRequest, "InvalidInput", "feedType and marketplaceIds are required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if data, ok := s.uploads[spec.InputFeedDocumentID]; !ok || data == nil {
+		writeError(w, http.StatusBadRequest, "InvalidInput", "inputFeedDocumentId does not reference an uploaded feed document")
+		return
+	}
+
+	s.sequence++
+	id := fmt.Sprintf("%d", 60000+s.sequence)
+	s.feeds[id] = &job{
+		id:          id,
+		kind:        spec.FeedType,
+		marketplace: spec.MarketplaceIDs,
+		created:     s.settings.now(),
+	}
+
+	writeJSON(w, http.StatusAccepted, map[string]string{"feedId": id})
+}
+
+// getFeed 处理 GetFeed，每次调用前进一个处理状态。
+func (s *Server) getFeed(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	feed, ok := s.feeds[r.PathValue("feedId")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "Feed not found")
+		return
+	}
+
+	result, ok := s.results[feed.kind]
+	if !ok {
+		result = Document{Content: processingReport(feed.id)}
+	}
+
+	status := s.advance(feed, result.Status)
+	if feed.documentID == "" && (status == "DONE" || status == "FATAL") {
+		feed.documentID = s.storeDocument("amzn1.tortuga.4.na.", result)
+	}
+
+	body := map[string]interface{}{
+		"feedId":           feed.id,
+		"feedType":         feed.kind,
+		"marketplaceIds":   feed.marketplace,
+		"processingStatus": status,
+		"createdTime":      feed.created.UTC().Format(time.RFC3339),
+	}
+	if feed.documentID != "" {
+		body["resultFeedDocumentId"] = feed.documentID
+		body["processingEndTime"] = s.settings.now().UTC().Format(time.RFC3339)
+	}
+	writeJSON(w, http.StatusOK, body)
+}
+
+// cancelFeed 处理 CancelFeed。
+func (s *Server) cancelFeed(w http.ResponseWriter, r *http.Request) {
+	s.cancel(w, s.feeds, r.PathValue("feedId"))
+}
+
+// getFeedDocument 处理 GetFeedDocument。
+func (s *Server) getFeedDocument(w http.ResponseWriter, r *http.Request) {
+	s.writeDocument(w, "feedDocumentId", r.PathValue("feedDocumentId"))
+}
+
+// advance 前进一个处理状态并返回当前状态。调用方需持有锁。
+//
+// final 覆盖状态序列的最后一个状态。
+func (s *Server) advance(j *job, final string) string {
+	if j.canceled {
+		return "CANCELLED"
+	}
+
+	states := s.settings.states
+	index := min(j.polls, len(states)-1)
+	j.polls++
+
+	if index == len(states)-1 && final != "" {
+		return final
+	}
+	return states[index]
+}
+
+// cancel 取消尚未结束的报告或 Feed。
+func (s *Server) cancel(w http.ResponseWriter, jobs map[string]*job, id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	j, ok := jobs[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "Resource not found")
+		return
+	}
+	if j.documentID != "" {
+		writeError(w, http.StatusBadRequest, "InvalidInput", "The resource has already finished processing")
+		return
+	}
+
+	j.canceled = true
+	w.WriteHeader(http.StatusOK)
+}
+
+// storeDocument 按 doc 的设置压缩、加密并存储文档，返回文档 ID。
+// 调用方需持有锁。
+func (s *Server) storeDocument(prefix string, doc Document) string {
+	s.sequence++
+	id := fmt.Sprintf("%sspapitest%d", prefix, s.sequence)
+
+	stored := &document{data: doc.Content}
+	if doc.Compress {
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		_, _ = zw.Write(stored.data)
+		_ = zw.Close()
+		stored.data = buf.Bytes()
+		stored.compression = "GZIP"
+	}
+	if doc.Encrypt && len(stored.data) > 0 {
+		details, encrypted, err := crypto.EncryptDocument(stored.data)
+		if err == nil {
+			stored.data = encrypted
+			stored.encryption = details
+		}
+	}
+
+	s.documents[id] = stored
+	return id
+}
+
+// writeDocument 写入文档元数据响应。
+func (s *Server) writeDocument(w http.ResponseWriter, idField, id string) {
+	s.mu.Lock()
+	doc, ok := s.documents[id]
+	s.mu.Unlock()
+
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "Document not found")
+		return
+	}
+
+	body := map[string]interface{}{
+		idField: id,
+		"url":   s.URL + documentPath + id,
+	}
+	if doc.compression != "" {
+		body["compressionAlgorithm"] = doc.compression
+	}
+	if doc.encryption != nil {
+		body["encryptionDetails"] = map[string]string{
+			"standard":             doc.encryption.Standard,
+			"initializationVector": doc.encryption.InitializationVector,
+			"key":                  doc.encryption.Key,
+		}
+	}
+	writeJSON(w, http.StatusOK, body)
+}
+
+// serveDocument 模拟文档下载（预签名 URL，不需要访问令牌）。
+func (s *Server) serveDocument(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	doc, ok := s.documents[strings.TrimPrefix(r.URL.Path, documentPath)]
+	s.mu.Unlock()
+
+	if r.Method != http.MethodGet || !ok {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	_, _ = w.Write(doc.data)
+}
+
+// serveUpload 模拟 Feed 文档上传（预签名 URL，不需要访问令牌）。
+func (s *Server) serveUpload(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, uploadPath)
+
+	s.mu.Lock()
+	_, ok := s.uploads[id]
+	s.mu.Unlock()
+
+	if r.Method != http.MethodPut || !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	s.uploads[id] = data
+	s.mu.Unlock()
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// processingReport 返回没有错误的 JSON Feed 处理报告。
+func processingReport(feedID string) []byte {
+	data, _ := json.Marshal(map[string]interface{}{
+		"header": map[string]string{
+			"sellerId": "A1SPAPITEST",
+			"version":  "2.0",
+			"feedId":   feedID,
+		},
+		"issues": []interface{}{},
+		"summary": map[string]int{
+			"errors":            0,
+			"warnings":          0,
+			"messagesProcessed": 0,
+			"messagesAccepted":  0,
+			"messagesInvalid":   0,
+		},
+	})
+	return data
+}
@@ -0,0 +1,114 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapitest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// pageTokenPrefix 是服务器签发的分页令牌前缀。
+const pageTokenPrefix = "spapitest-page-"
+
+// Paginate 注册分页响应。
+//
+// 不带令牌的请求返回第一页；除最后一页外，服务器在每页响应的 tokenPath
+// 位置写入下一页的令牌，客户端在 tokenParam 查询参数中传回该令牌获取下一页。
+// 无效令牌返回 400 InvalidInput。
+//
+// 参数:
+//   - method: HTTP 方法
+//   - path: 路径模板
+//   - tokenParam: 携带令牌的查询参数名（如 "NextToken"）
+//   - tokenPath: 响应中令牌的位置，"." 分隔（如 "payload.NextToken"）
+//   - pages: 各页响应体（可序列化为 JSON 对象）
+//
+// 返回值:
+//   - error: 如果某一页不是 JSON 对象，返回错误
+//
+// 示例:
+//
+//	err := srv.Paginate("GET", "/orders/v0/orders", "NextToken", "payload.NextToken",
+//	    map[string]interface{}{"payload": map[string]interface{}{"Orders": page1}},
+//	    map[string]interface{}{"payload": map[string]interface{}{"Orders": page2}},
+//	)
+func (s *Server) Paginate(method, path, tokenParam, tokenPath string, pages ...interface{}) error {
+	bodies := make([][]byte, len(pages))
+	for i, page := range pages {
+		var object map[string]interface{}
+		data, err := json.Marshal(page)
+		if err == nil {
+			err = json.Unmarshal(data, &object)
+		}
+		if err != nil {
+			return fmt.Errorf("page %d is not a JSON object: %w", i+1, err)
+		}
+
+		if i < len(pages)-1 {
+			setPath(object, tokenPath, pageTokenPrefix+strconv.Itoa(i+2))
+		}
+		if bodies[i], err = json.Marshal(object); err != nil {
+			return fmt.Errorf("marshal page %d: %w", i+1, err)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pages = append(s.pages, &route{
+		method: method,
+		path:   path,
+		handle: func(w http.ResponseWriter, r *http.Request) {
+			page := 1
+			if token := r.URL.Query().Get(tokenParam); token != "" {
+				n, err := strconv.Atoi(strings.TrimPrefix(token, pageTokenPrefix))
+				if !strings.HasPrefix(token, pageTokenPrefix) || err != nil || n < 2 || n > len(bodies) {
+					writeError(w, http.StatusBadRequest, "InvalidInput", "Invalid "+tokenParam)
+					return
+				}
+				page = n
+			}
+			if len(bodies) == 0 {
+				writeRawJSON(w, http.StatusOK, []byte("{}\n"))
+				return
+			}
+			writeRawJSON(w, http.StatusOK, bodies[page-1])
+		},
+	})
+	return nil
+}
+
+// setPath 在 JSON 对象的 "." 分隔路径上设置值，按需创建中间对象。
+func setPath(object map[string]interface{}, path string, value interface{}) {
+	keys := strings.Split(path, ".")
+	for _, key := range keys[:len(keys)-1] {
+		child, ok := object[key].(map[string]interface{})
+		if !ok {
+			child = make(map[string]interface{})
+			object[key] = child
+		}
+		object = child
+	}
+	object[keys[len(keys)-1]] = value
+}
@@ -0,0 +1,97 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapitest
+
+import (
+	"math"
+	"time"
+)
+
+// bucket 是令牌桶限流器。
+type bucket struct {
+	rate   float64
+	burst  int
+	tokens float64
+	last   time.Time
+}
+
+// SetRateLimit 为操作启用限流。
+//
+// 启用后请求按令牌桶算法消耗配额，配额用尽时返回 429 QuotaExceeded，
+// 响应头 x-amzn-RateLimit-Limit 返回 rate。
+// 未启用限流的操作只返回模型 Usage Plan 中声明的速率（如果有），不会被限流。
+//
+// 参数:
+//   - method: HTTP 方法
+//   - path: 路径模板（如 "/orders/v0/orders"）
+//   - rate: 每秒请求数
+//   - burst: 突发容量
+func (s *Server) SetRateLimit(method, path string, rate float64, burst int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.limits[method+" "+path] = &bucket{
+		rate:   rate,
+		burst:  burst,
+		tokens: float64(burst),
+		last:   s.settings.now(),
+	}
+}
+
+// Throttle 使接下来的 n 个 SP-API 请求返回 429，不论是否启用限流。
+//
+// 可用于测试重试和退避逻辑。
+func (s *Server) Throttle(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.throttled = n
+}
+
+// take 为请求消耗配额，返回速率响应头的值和是否允许请求。
+func (s *Server) take(rt *route) (float64, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rate := rt.rate
+	b, limited := s.limits[rt.method+" "+rt.path]
+	if limited {
+		rate = b.rate
+	}
+
+	if s.throttled > 0 {
+		s.throttled--
+		return rate, false
+	}
+	if !limited {
+		return rate, true
+	}
+
+	now := s.settings.now()
+	b.tokens = math.Min(float64(b.burst), b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+
+	if b.tokens < 1 {
+		return rate, false
+	}
+	b.tokens--
+	return rate, true
+}
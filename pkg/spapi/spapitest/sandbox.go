@@ -0,0 +1,227 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapitest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/internal/openapi"
+)
+
+// LoadModels 从 OpenAPI 模型文件加载沙箱响应。
+//
+// paths 可以是模型文件，也可以是目录（递归加载其中的 *.json 文件），
+// 例如 selling-partner-api-models/models。只注册声明了
+// x-amzn-api-sandbox 的操作；操作描述中的 Usage Plan 速率作为
+// x-amzn-RateLimit-Limit 响应头返回。
+//
+// 参数:
+//   - paths: 模型文件或目录
+//
+// 返回值:
+//   - error: 如果读取或解析失败，返回错误
+func (s *Server) LoadModels(paths ...string) error {
+	for _, root := range paths {
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() || filepath.Ext(path) != ".json" {
+				return nil
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			if err := s.AddModel(data); err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("load models: %w", err)
+		}
+	}
+	return nil
+}
+
+// AddModel 从 OpenAPI 模型内容加载沙箱响应。
+//
+// 参数:
+//   - data: Swagger 2.0 或 OpenAPI 3 模型的 JSON 内容
+//
+// 返回值:
+//   - error: 如果解析失败，返回错误
+func (s *Server) AddModel(data []byte) error {
+	doc, err := openapi.Parse(data)
+	if err != nil {
+		return err
+	}
+
+	var routes []*route
+	for _, op := range doc.Operations {
+		handler := sandboxHandler(op)
+		if handler == nil {
+			continue
+		}
+
+		rt := &route{method: op.Method, path: op.Path, handle: handler}
+		if limit, ok := op.RateLimit(); ok {
+			rt.rate = limit.Rate
+		}
+		routes = append(routes, rt)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sandbox = append(s.sandbox, routes...)
+	return nil
+}
+
+// sandboxCase 是带状态码的沙箱用例。
+type sandboxCase struct {
+	status int
+	*openapi.SandboxCase
+}
+
+// sandboxHandler 返回按沙箱用例响应的处理器；没有用例时返回 nil。
+func sandboxHandler(op *openapi.Operation) http.HandlerFunc {
+	codes := make([]string, 0, len(op.Responses))
+	for code := range op.Responses {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	var cases []sandboxCase
+	for _, code := range codes {
+		status, err := strconv.Atoi(code)
+		if err != nil {
+			continue
+		}
+		for _, c := range op.Responses[code].Sandbox {
+			cases = append(cases, sandboxCase{status: status, SandboxCase: c})
+		}
+	}
+	if len(cases) == 0 {
+		return nil
+	}
+
+	// 静态用例优先于动态用例
+	sort.SliceStable(cases, func(i, j int) bool {
+		return !cases[i].Dynamic && cases[j].Dynamic
+	})
+
+	params := make(map[string]*openapi.Parameter, len(op.Parameters))
+	for _, param := range op.Parameters {
+		params[param.Name] = param
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		for _, c := range cases {
+			if sandboxMatch(c.SandboxCase, params, r, body) {
+				response := c.Response
+				if len(response) == 0 {
+					response = []byte("{}")
+				}
+				writeRawJSON(w, c.status, response)
+				return
+			}
+		}
+
+		writeError(w, http.StatusBadRequest, "InvalidInput", "Could not match input arguments with any sandbox test case")
+	}
+}
+
+// sandboxMatch 检查请求是否匹配沙箱用例的所有参数。
+//
+// 未在操作参数中声明的参数视为请求体。
+func sandboxMatch(c *openapi.SandboxCase, params map[string]*openapi.Parameter, r *http.Request, body []byte) bool {
+	for name, raw := range c.Parameters {
+		var want interface{}
+		if err := json.Unmarshal(raw, &want); err != nil {
+			return false
+		}
+
+		param, ok := params[name]
+		if !ok {
+			var got interface{}
+			if json.Unmarshal(body, &got) != nil || !reflect.DeepEqual(want, got) {
+				return false
+			}
+			continue
+		}
+
+		var got []string
+		switch param.In {
+		case "path":
+			got = []string{r.PathValue(name)}
+		case "header":
+			got = r.Header.Values(name)
+		default:
+			for _, value := range r.URL.Query()[name] {
+				got = append(got, strings.Split(value, ",")...)
+			}
+		}
+		if !reflect.DeepEqual(sandboxValues(want), got) {
+			return false
+		}
+	}
+	return true
+}
+
+// sandboxValues 将期望值转换为字符串列表，数组展开为多个值。
+func sandboxValues(value interface{}) []string {
+	if list, ok := value.([]interface{}); ok {
+		values := make([]string, 0, len(list))
+		for _, item := range list {
+			values = append(values, sandboxString(item))
+		}
+		return values
+	}
+	return []string{sandboxString(value)}
+}
+
+// sandboxString 将标量 JSON 值格式化为参数字符串。
+func sandboxString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+}
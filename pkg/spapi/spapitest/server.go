@@ -0,0 +1,482 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+// Package spapitest 提供进程内的 SP-API 模拟服务器，用于离线测试。
+//
+// Server 基于 httptest.Server，模拟：
+//   - LWA 令牌交换（refresh_token 和 client_credentials）和客户端密钥轮换
+//   - OpenAPI 模型中 x-amzn-api-sandbox 声明的静态沙箱响应
+//   - x-amzn-RateLimit-Limit 响应头和 429 限流
+//   - 基于 nextToken 的分页
+//   - Reports、Feeds 的处理状态流转
+//   - 加密和压缩的报告/Feed 文档
+//
+// 示例:
+//
+//	srv := spapitest.NewServer()
+//	defer srv.Close()
+//
+//	if err := srv.LoadModels("selling-partner-api-models/models/orders-api-model"); err != nil {
+//	    t.Fatal(err)
+//	}
+//
+//	client, err := srv.NewClient()
+//	if err != nil {
+//	    t.Fatal(err)
+//	}
+//	orders := orders_v0.NewClient(client)
+//	result, err := orders.GetOrder(ctx, "TEST_CASE_200", nil)
+package spapitest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// 模拟服务器接受的 LWA 凭证。
+const (
+	// ClientID 是模拟服务器接受的 LWA 客户端 ID
+	ClientID = "amzn1.application-oa2-client.spapitest"
+
+	// ClientSecret 是模拟服务器接受的 LWA 客户端密钥
+	ClientSecret = "spapitest-client-secret"
+
+	// RefreshToken 是模拟服务器接受的 LWA 刷新令牌
+	RefreshToken = "Atzr|spapitest-refresh-token"
+)
+
+// 模拟服务器的固定路径。
+const (
+	// TokenPath 是 LWA 令牌端点路径
+	TokenPath = "/auth/o2/token"
+
+	// documentPath 是文档下载路径前缀（模拟预签名 S3 URL）
+	documentPath = "/documents/"
+
+	// uploadPath 是 Feed 文档上传路径前缀（模拟预签名 S3 URL）
+	uploadPath = "/uploads/"
+)
+
+// Request 是服务器收到的一个 SP-API 请求（不含 LWA 和文档传输请求）。
+type Request struct {
+	// Method 是 HTTP 方法
+	Method string
+
+	// Path 是请求路径
+	Path string
+
+	// Query 是查询参数
+	Query url.Values
+
+	// Header 是请求头
+	Header http.Header
+
+	// Body 是请求体
+	Body []byte
+}
+
+// route 是一个注册的 SP-API 路由。
+type route struct {
+	method string
+	path   string
+
+	// rate 是 x-amzn-RateLimit-Limit 响应头的值（0 表示不返回）
+	rate float64
+
+	handle http.HandlerFunc
+}
+
+// settings 保存服务器配置。
+type settings struct {
+	tokenTTL time.Duration
+	states   []string
+	now      func() time.Time
+}
+
+// Option 定义服务器配置选项函数。
+type Option func(*settings)
+
+// WithTokenTTL 设置 LWA 访问令牌的有效期（默认 1 小时）。
+func WithTokenTTL(ttl time.Duration) Option {
+	return func(s *settings) {
+		s.tokenTTL = ttl
+	}
+}
+
+// WithProcessingStates 设置报告和 Feed 的处理状态序列。
+//
+// 每次 GetReport/GetFeed 前进一个状态，停留在最后一个状态。
+// 默认序列为 IN_QUEUE、IN_PROGRESS、DONE。
+func WithProcessingStates(states ...string) Option {
+	return func(s *settings) {
+		s.states = states
+	}
+}
+
+// WithClock 设置服务器使用的时钟（用于令牌过期和限流）。
+func WithClock(now func() time.Time) Option {
+	return func(s *settings) {
+		s.now = now
+	}
+}
+
+// Server 是进程内的 SP-API 模拟服务器。
+//
+// Server 是并发安全的。
+type Server struct {
+	// URL 是服务器的根 URL，同时用作 SP-API 端点和 LWA 端点
+	URL string
+
+	server   *httptest.Server
+	settings settings
+
+	mu        sync.Mutex
+	secrets   []string
+	tokens    map[string]time.Time
+	sequence  int
+	requests  []Request
+	handlers  []*route
+	pages     []*route
+	builtins  []*route
+	sandbox   []*route
+	limits    map[string]*bucket
+	throttled int
+
+	reports   map[string]*job
+	feeds     map[string]*job
+	documents map[string]*document
+	uploads   map[string][]byte
+	results   map[string]Document
+	outputs   map[string]Document
+}
+
+// NewServer 启动新的模拟服务器。
+//
+// 调用方应在测试结束时调用 Close。
+//
+// 参数:
+//   - opts: 服务器配置选项
+//
+// 返回值:
+//   - *Server: 已启动的服务器
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		settings: settings{
+			tokenTTL: time.Hour,
+			states:   []string{"IN_QUEUE", "IN_PROGRESS", "DONE"},
+			now:      time.Now,
+		},
+		secrets:   []string{ClientSecret},
+		tokens:    make(map[string]time.Time),
+		limits:    make(map[string]*bucket),
+		reports:   make(map[string]*job),
+		feeds:     make(map[string]*job),
+		documents: make(map[string]*document),
+		uploads:   make(map[string][]byte),
+		results:   make(map[string]Document),
+		outputs:   make(map[string]Document),
+	}
+	for _, opt := range opts {
+		opt(&s.settings)
+	}
+
+	s.registerBuiltins()
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.server.URL
+
+	return s
+}
+
+// Close 关闭服务器。
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Region 返回指向模拟服务器的区域。
+func (s *Server) Region() spapi.Region {
+	return spapi.Region{
+		Code:        "spapitest",
+		Name:        "spapitest",
+		Endpoint:    s.URL,
+		LWAEndpoint: s.URL + TokenPath,
+	}
+}
+
+// NewClient 创建连接到模拟服务器的 SP-API 客户端。
+//
+// 客户端使用 ClientID、ClientSecret 和 RefreshToken 作为凭证，
+// opts 在其后应用，可以覆盖这些默认值。
+//
+// 参数:
+//   - opts: 额外的客户端配置选项
+//
+// 返回值:
+//   - *spapi.Client: SP-API 客户端
+//   - error: 如果创建失败，返回错误
+func (s *Server) NewClient(opts ...spapi.ClientOption) (*spapi.Client, error) {
+	defaults := []spapi.ClientOption{
+		spapi.WithRegion(s.Region()),
+		spapi.WithCredentials(ClientID, ClientSecret, RefreshToken),
+	}
+	return spapi.NewClient(append(defaults, opts...)...)
+}
+
+// Handle 注册自定义处理器，优先于内置路由和沙箱响应。
+//
+// path 使用 SP-API 路径模板（如 "/orders/v0/orders/{orderId}"），
+// 路径参数可通过 r.PathValue 读取。
+//
+// 参数:
+//   - method: HTTP 方法
+//   - path: 路径模板
+//   - handler: 处理器
+func (s *Server) Handle(method, path string, handler http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.handlers = append(s.handlers, &route{method: method, path: path, handle: handler})
+}
+
+// Requests 返回服务器收到的所有 SP-API 请求。
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Request(nil), s.requests...)
+}
+
+// ExpireTokens 使所有已签发的访问令牌失效。
+//
+// 之后使用旧令牌的请求返回 403，可用于测试令牌刷新。
+func (s *Server) ExpireTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	clear(s.tokens)
+}
+
+// SetClientSecrets 设置 LWA 令牌端点接受的客户端密钥（默认只接受 ClientSecret）。
+//
+// 传入新旧两个密钥可以模拟密钥轮换的过渡期，之后只传入新密钥模拟旧密钥过期。
+// 已签发的访问令牌不受影响。
+func (s *Server) SetClientSecrets(secrets ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.secrets = secrets
+}
+
+// serveHTTP 分发请求。
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == TokenPath:
+		s.serveToken(w, r)
+		return
+	case strings.HasPrefix(r.URL.Path, documentPath):
+		s.serveDocument(w, r)
+		return
+	case strings.HasPrefix(r.URL.Path, uploadPath):
+		s.serveUpload(w, r)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidInput", "Could not read request body")
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	s.mu.Lock()
+	s.sequence++
+	w.Header().Set("x-amzn-RequestId", fmt.Sprintf("spapitest-%d", s.sequence))
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.Query(),
+		Header: r.Header.Clone(),
+		Body:   body,
+	})
+	authorized := s.authorized(r.Header.Get("x-amz-access-token"))
+	rt := s.match(r)
+	s.mu.Unlock()
+
+	if !authorized {
+		writeError(w, http.StatusForbidden, "Unauthorized", "Access to requested resource is denied.")
+		return
+	}
+	if rt == nil {
+		writeError(w, http.StatusNotFound, "NotFound", fmt.Sprintf("No route for %s %s", r.Method, r.URL.Path))
+		return
+	}
+
+	rate, allowed := s.take(rt)
+	if rate > 0 {
+		w.Header().Set("x-amzn-RateLimit-Limit", strconv.FormatFloat(rate, 'f', -1, 64))
+	}
+	if !allowed {
+		writeError(w, http.StatusTooManyRequests, "QuotaExceeded", "You exceeded your quota for the requested resource.")
+		return
+	}
+
+	rt.handle(w, r)
+}
+
+// match 查找请求对应的路由并设置路径参数。调用方需持有锁。
+func (s *Server) match(r *http.Request) *route {
+	for _, routes := range [][]*route{s.handlers, s.pages, s.builtins, s.sandbox} {
+		for _, rt := range routes {
+			if rt.method != r.Method {
+				continue
+			}
+			if params, ok := matchPath(rt.path, r.URL.Path); ok {
+				for name, value := range params {
+					r.SetPathValue(name, value)
+				}
+				return rt
+			}
+		}
+	}
+	return nil
+}
+
+// matchPath 按路径模板匹配路径，返回路径参数。
+func matchPath(template, path string) (map[string]string, bool) {
+	want := strings.Split(strings.Trim(template, "/"), "/")
+	got := strings.Split(strings.Trim(path, "/"), "/")
+	if len(want) != len(got) {
+		return nil, false
+	}
+
+	params := make(map[string]string)
+	for i, segment := range want {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			if got[i] == "" {
+				return nil, false
+			}
+			value, err := url.PathUnescape(got[i])
+			if err != nil {
+				return nil, false
+			}
+			params[segment[1:len(segment)-1]] = value
+			continue
+		}
+		if segment != got[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+// serveToken 模拟 LWA 令牌交换。
+func (s *Server) serveToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeLWAError(w, http.StatusMethodNotAllowed, "invalid_request", "The request method must be POST")
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeLWAError(w, http.StatusBadRequest, "invalid_request", "Malformed request body")
+		return
+	}
+
+	s.mu.Lock()
+	validSecret := slices.Contains(s.secrets, r.PostForm.Get("client_secret"))
+	s.mu.Unlock()
+	if r.PostForm.Get("client_id") != ClientID || !validSecret {
+		writeLWAError(w, http.StatusUnauthorized, "invalid_client", "Client authentication failed")
+		return
+	}
+
+	switch r.PostForm.Get("grant_type") {
+	case "refresh_token":
+		if r.PostForm.Get("refresh_token") != RefreshToken {
+			writeLWAError(w, http.StatusBadRequest, "invalid_grant", "The request has an invalid grant parameter : refresh_token")
+			return
+		}
+	case "client_credentials":
+		if r.PostForm.Get("scope") == "" {
+			writeLWAError(w, http.StatusBadRequest, "invalid_scope", "The request has an invalid parameter : scope")
+			return
+		}
+	default:
+		writeLWAError(w, http.StatusBadRequest, "unsupported_grant_type", "The grant type is not supported")
+		return
+	}
+
+	s.mu.Lock()
+	s.sequence++
+	token := fmt.Sprintf("Atza|spapitest-%d", s.sequence)
+	s.tokens[token] = s.settings.now().Add(s.settings.tokenTTL)
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token":  token,
+		"refresh_token": r.PostForm.Get("refresh_token"),
+		"token_type":    "bearer",
+		"expires_in":    int(s.settings.tokenTTL / time.Second),
+	})
+}
+
+// authorized 检查访问令牌是否有效。调用方需持有锁。
+func (s *Server) authorized(token string) bool {
+	expires, ok := s.tokens[token]
+	return ok && s.settings.now().Before(expires)
+}
+
+// writeJSON 写入 JSON 响应。
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeRawJSON 写入原始 JSON 响应。
+func writeRawJSON(w http.ResponseWriter, status int, data []byte) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(data)
+}
+
+// writeError 写入 SP-API 标准错误响应。
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"errors": []map[string]string{{"code": code, "message": message}},
+	})
+}
+
+// writeLWAError 写入 LWA 错误响应。
+func writeLWAError(w http.ResponseWriter, status int, code, description string) {
+	writeJSON(w, status, map[string]string{
+		"error":             code,
+		"error_description": description,
+	})
+}
@@ -0,0 +1,465 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package spapitest_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	feeds "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/feeds-v2021-06-30"
+	orders "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/orders-v0"
+	reports "github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/reports-v2021-06-30"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/spapitest"
+)
+
+// newServer 启动加载了测试模型的服务器和连接到它的客户端。
+func newServer(t *testing.T, opts ...spapitest.Option) (*spapitest.Server, *spapi.Client) {
+	t.Helper()
+
+	srv := spapitest.NewServer(opts...)
+	t.Cleanup(srv.Close)
+
+	if err := srv.LoadModels("testdata"); err != nil {
+		t.Fatalf("LoadModels() error = %v", err)
+	}
+
+	client, err := srv.NewClient(spapi.WithMaxRetries(0))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	return srv, client
+}
+
+// fastPoll 是测试使用的快速轮询配置。
+var fastPoll = spapi.WithPollInterval(time.Millisecond, time.Millisecond, 1)
+
+func apiError(t *testing.T, err error) *spapi.APIError {
+	t.Helper()
+	var apiErr *spapi.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("error = %v, want *spapi.APIError", err)
+	}
+	return apiErr
+}
+
+func TestServer_LWA(t *testing.T) {
+	srv, client := newServer(t)
+	ctx := context.Background()
+
+	token, err := client.GetAccessToken(ctx)
+	if err != nil {
+		t.Fatalf("GetAccessToken() error = %v", err)
+	}
+	if !strings.HasPrefix(token, "Atza|") {
+		t.Errorf("token = %q, want Atza| prefix", token)
+	}
+
+	bad, err := srv.NewClient(spapi.WithCredentials(spapitest.ClientID, "wrong-secret", spapitest.RefreshToken))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if _, err := bad.GetAccessToken(ctx); err == nil {
+		t.Error("GetAccessToken() with wrong secret expected error")
+	}
+
+	grantless, err := spapi.NewClient(
+		spapi.WithRegion(srv.Region()),
+		spapi.WithGrantlessCredentials(spapitest.ClientID, spapitest.ClientSecret, []string{"sellingpartnerapi::notifications"}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if _, err := grantless.GetAccessToken(ctx); err != nil {
+		t.Errorf("grantless GetAccessToken() error = %v", err)
+	}
+
+	resp, err := http.PostForm(srv.URL+spapitest.TokenPath, url.Values{
+		"client_id":     {spapitest.ClientID},
+		"client_secret": {spapitest.ClientSecret},
+		"grant_type":    {"password"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("unsupported grant status = %d, want 400", resp.StatusCode)
+	}
+}
+
+func TestServer_ExpireTokens(t *testing.T) {
+	srv, client := newServer(t)
+	api := orders.NewClient(client)
+
+	if _, err := api.GetOrder(context.Background(), "TEST_CASE_200", nil); err != nil {
+		t.Fatalf("GetOrder() error = %v", err)
+	}
+
+	srv.ExpireTokens()
+
+	_, err := api.GetOrder(context.Background(), "TEST_CASE_200", nil)
+	if apiErr := apiError(t, err); apiErr.StatusCode != http.StatusForbidden || apiErr.Code != "Unauthorized" {
+		t.Errorf("GetOrder() error = %+v, want 403 Unauthorized", apiErr)
+	}
+}
+
+func TestServer_Sandbox(t *testing.T) {
+	srv, client := newServer(t)
+	api := orders.NewClient(client)
+	ctx := context.Background()
+
+	result, err := api.GetOrder(ctx, "TEST_CASE_200", nil)
+	if err != nil {
+		t.Fatalf("GetOrder() error = %v", err)
+	}
+	payload := result.(map[string]interface{})["payload"].(map[string]interface{})
+	if payload["OrderStatus"] != "Pending" {
+		t.Errorf("payload = %v", payload)
+	}
+
+	result, err = api.GetOrders(ctx, map[string]string{"MarketplaceIds": "ATVPDKIKX0DER", "CreatedAfter": "TEST_CASE_200"})
+	if err != nil {
+		t.Fatalf("GetOrders() error = %v", err)
+	}
+	if got := result.(map[string]interface{})["payload"].(map[string]interface{})["Orders"].([]interface{}); len(got) != 1 {
+		t.Errorf("Orders = %v", got)
+	}
+
+	_, err = api.GetOrders(ctx, map[string]string{"MarketplaceIds": "ATVPDKIKX0DER", "CreatedAfter": "TEST_CASE_400"})
+	if apiErr := apiError(t, err); apiErr.StatusCode != http.StatusBadRequest || apiErr.Message != "Invalid Input" {
+		t.Errorf("GetOrders(TEST_CASE_400) error = %+v", apiErr)
+	}
+
+	_, err = api.GetOrder(ctx, "UNKNOWN", nil)
+	if apiErr := apiError(t, err); apiErr.StatusCode != http.StatusBadRequest || apiErr.Code != "InvalidInput" {
+		t.Errorf("GetOrder(UNKNOWN) error = %+v", apiErr)
+	}
+
+	// 请求体参数按 JSON 值匹配
+	_, err = api.UpdateShipmentStatus(ctx, "902-1106328-1059050", map[string]string{
+		"shipmentStatus": "ReadyForPickup",
+		"marketplaceId":  "ATVPDKIKX0DER",
+	})
+	if err != nil {
+		t.Errorf("UpdateShipmentStatus() error = %v", err)
+	}
+
+	requests := srv.Requests()
+	if len(requests) != 5 {
+		t.Fatalf("len(Requests()) = %d, want 5", len(requests))
+	}
+	if r := requests[0]; r.Method != http.MethodGet || r.Path != "/orders/v0/orders/TEST_CASE_200" || r.Header.Get("x-amz-access-token") == "" {
+		t.Errorf("Requests()[0] = %+v", r)
+	}
+}
+
+func TestServer_RateLimit(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	var mu sync.Mutex
+	clock := func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	}
+
+	srv, client := newServer(t, spapitest.WithClock(clock))
+	token, err := client.GetAccessToken(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	get := func(path string) *http.Response {
+		t.Helper()
+		req, _ := http.NewRequest(http.MethodGet, srv.URL+path, nil)
+		req.Header.Set("x-amz-access-token", token)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp
+	}
+
+	// 模型 Usage Plan 中的速率只作为响应头返回
+	if resp := get("/orders/v0/orders/TEST_CASE_200"); resp.Header.Get("x-amzn-RateLimit-Limit") != "0.5" {
+		t.Errorf("x-amzn-RateLimit-Limit = %q, want 0.5", resp.Header.Get("x-amzn-RateLimit-Limit"))
+	}
+
+	srv.SetRateLimit(http.MethodGet, "/orders/v0/orders/{orderId}", 1, 2)
+	for i, want := range []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests} {
+		resp := get("/orders/v0/orders/TEST_CASE_200")
+		if resp.StatusCode != want {
+			t.Errorf("request %d status = %d, want %d", i+1, resp.StatusCode, want)
+		}
+		if resp.Header.Get("x-amzn-RateLimit-Limit") != "1" {
+			t.Errorf("request %d x-amzn-RateLimit-Limit = %q, want 1", i+1, resp.Header.Get("x-amzn-RateLimit-Limit"))
+		}
+	}
+
+	mu.Lock()
+	now = now.Add(time.Second)
+	mu.Unlock()
+	if resp := get("/orders/v0/orders/TEST_CASE_200"); resp.StatusCode != http.StatusOK {
+		t.Errorf("status after refill = %d, want 200", resp.StatusCode)
+	}
+}
+
+func TestServer_Throttle(t *testing.T) {
+	srv, client := newServer(t)
+	ctx := context.Background()
+
+	srv.Throttle(1)
+	_, err := orders.NewClient(client).GetOrder(ctx, "TEST_CASE_200", nil)
+	if apiErr := apiError(t, err); apiErr.StatusCode != http.StatusTooManyRequests || apiErr.Code != "QuotaExceeded" {
+		t.Errorf("GetOrder() error = %+v, want 429 QuotaExceeded", apiErr)
+	}
+
+	// 带重试的客户端在 429 后重试成功
+	retrying, err := srv.NewClient(spapi.WithMaxRetries(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.Throttle(2)
+	if _, err := orders.NewClient(retrying).GetOrder(ctx, "TEST_CASE_200", nil); err != nil {
+		t.Errorf("GetOrder() with retries error = %v", err)
+	}
+}
+
+func TestServer_Paginate(t *testing.T) {
+	srv, client := newServer(t)
+
+	page := func(ids ...string) map[string]interface{} {
+		list := make([]interface{}, len(ids))
+		for i, id := range ids {
+			list[i] = map[string]interface{}{"AmazonOrderId": id}
+		}
+		return map[string]interface{}{"payload": map[string]interface{}{"Orders": list}}
+	}
+	err := srv.Paginate(http.MethodGet, "/orders/v0/orders", "NextToken", "payload.NextToken",
+		page("1", "2"), page("3"), page("4", "5"))
+	if err != nil {
+		t.Fatalf("Paginate() error = %v", err)
+	}
+
+	var ids []string
+	for order, err := range orders.NewClient(client).IterateOrders(context.Background(), map[string]string{"MarketplaceIds": "ATVPDKIKX0DER"}) {
+		if err != nil {
+			t.Fatalf("IterateOrders() error = %v", err)
+		}
+		ids = append(ids, order["AmazonOrderId"].(string))
+	}
+	if got := strings.Join(ids, ","); got != "1,2,3,4,5" {
+		t.Errorf("orders = %s, want 1,2,3,4,5", got)
+	}
+
+	_, err = orders.NewClient(client).GetOrders(context.Background(), map[string]string{"NextToken": "bogus"})
+	if apiErr := apiError(t, err); apiErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("GetOrders(bogus token) error = %+v, want 400", apiErr)
+	}
+}
+
+func TestServer_Reports(t *testing.T) {
+	srv, client := newServer(t)
+	ctx := context.Background()
+	api := reports.NewClient(client)
+
+	content := []byte("sku\tprice\nSKU-1\t9.99\n")
+	srv.SetReport("GET_MERCHANT_LISTINGS_ALL_DATA", spapitest.Document{Content: content, Encrypt: true})
+	srv.SetReport("GET_FLAT_FILE_OPEN_LISTINGS_DATA", spapitest.Document{Status: "FATAL"})
+
+	created, err := api.CreateReport(ctx, map[string]interface{}{
+		"reportType":     "GET_MERCHANT_LISTINGS_ALL_DATA",
+		"marketplaceIds": []string{"ATVPDKIKX0DER"},
+	})
+	if err != nil {
+		t.Fatalf("CreateReport() error = %v", err)
+	}
+	reportID := created.(map[string]interface{})["reportId"].(string)
+
+	var states []string
+	report, err := api.WaitForReport(ctx, reportID, fastPoll, spapi.WithPollProgress(func(p spapi.PollProgress) {
+		states = append(states, p.Status)
+	}))
+	if err != nil {
+		t.Fatalf("WaitForReport() error = %v", err)
+	}
+	if got := strings.Join(states, ","); got != "IN_QUEUE,IN_PROGRESS,DONE" {
+		t.Errorf("states = %s", got)
+	}
+
+	documentID := report.(map[string]interface{})["reportDocumentId"].(string)
+	document, err := api.GetReportDocument(ctx, documentID, nil)
+	if err != nil {
+		t.Fatalf("GetReportDocument() error = %v", err)
+	}
+	if document.(map[string]interface{})["encryptionDetails"] == nil {
+		t.Error("GetReportDocument() missing encryptionDetails")
+	}
+
+	data, err := api.GetReportDocumentDecrypted(ctx, documentID)
+	if err != nil {
+		t.Fatalf("GetReportDocumentDecrypted() error = %v", err)
+	}
+	if !bytes.Equal(data, content) {
+		t.Errorf("report content = %q, want %q", data, content)
+	}
+
+	created, err = api.CreateReport(ctx, map[string]interface{}{
+		"reportType":     "GET_FLAT_FILE_OPEN_LISTINGS_DATA",
+		"marketplaceIds": []string{"ATVPDKIKX0DER"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = api.WaitForReport(ctx, created.(map[string]interface{})["reportId"].(string), fastPoll)
+	if !errors.Is(err, spapi.ErrPollFailed) {
+		t.Errorf("WaitForReport(FATAL) error = %v, want ErrPollFailed", err)
+	}
+}
+
+func TestServer_CancelReport(t *testing.T) {
+	_, client := newServer(t, spapitest.WithProcessingStates("IN_QUEUE"))
+	ctx := context.Background()
+	api := reports.NewClient(client)
+
+	created, err := api.CreateReport(ctx, map[string]interface{}{
+		"reportType":     "GET_MERCHANT_LISTINGS_ALL_DATA",
+		"marketplaceIds": []string{"ATVPDKIKX0DER"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	reportID := created.(map[string]interface{})["reportId"].(string)
+
+	if _, err := api.CancelReport(ctx, reportID); err != nil {
+		t.Fatalf("CancelReport() error = %v", err)
+	}
+	report, err := api.GetReport(ctx, reportID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status := report.(map[string]interface{})["processingStatus"]; status != "CANCELLED" {
+		t.Errorf("processingStatus = %v, want CANCELLED", status)
+	}
+}
+
+func TestServer_Feeds(t *testing.T) {
+	srv, client := newServer(t, spapitest.WithProcessingStates("IN_PROGRESS", "DONE"))
+	ctx := context.Background()
+	api := feeds.NewClient(client)
+
+	result := []byte(`{"summary":{"errors":1}}`)
+	srv.SetFeedResult("JSON_LISTINGS_FEED", spapitest.Document{Content: result, Compress: true})
+
+	created, err := api.CreateFeedDocument(ctx, map[string]string{"contentType": "application/json; charset=UTF-8"})
+	if err != nil {
+		t.Fatalf("CreateFeedDocument() error = %v", err)
+	}
+	feedDocumentID := created.(map[string]interface{})["feedDocumentId"].(string)
+	uploadURL := created.(map[string]interface{})["url"].(string)
+
+	// 未上传内容时不能创建 Feed
+	feedSpec := map[string]interface{}{
+		"feedType":            "JSON_LISTINGS_FEED",
+		"marketplaceIds":      []string{"ATVPDKIKX0DER"},
+		"inputFeedDocumentId": feedDocumentID,
+	}
+	if _, err := api.CreateFeed(ctx, feedSpec); err == nil {
+		t.Error("CreateFeed() before upload expected error")
+	}
+
+	body := []byte(`{"header":{"sellerId":"A1SPAPITEST"},"messages":[]}`)
+	req, _ := http.NewRequest(http.MethodPut, uploadURL, bytes.NewReader(body))
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if got, ok := srv.Upload(feedDocumentID); !ok || !bytes.Equal(got, body) {
+		t.Errorf("Upload() = %q, %v", got, ok)
+	}
+
+	feed, err := api.CreateFeed(ctx, feedSpec)
+	if err != nil {
+		t.Fatalf("CreateFeed() error = %v", err)
+	}
+	done, err := api.WaitForFeed(ctx, feed.(map[string]interface{})["feedId"].(string), fastPoll)
+	if err != nil {
+		t.Fatalf("WaitForFeed() error = %v", err)
+	}
+
+	resultID := done.(map[string]interface{})["resultFeedDocumentId"].(string)
+	document, err := api.GetFeedDocument(ctx, resultID, nil)
+	if err != nil {
+		t.Fatalf("GetFeedDocument() error = %v", err)
+	}
+	meta := document.(map[string]interface{})
+	if meta["compressionAlgorithm"] != "GZIP" {
+		t.Errorf("compressionAlgorithm = %v, want GZIP", meta["compressionAlgorithm"])
+	}
+
+	resp, err = http.Get(meta["url"].(string))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	zr, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, result) {
+		t.Errorf("feed result = %q, want %q", got, result)
+	}
+}
+
+func TestServer_Handle(t *testing.T) {
+	srv, client := newServer(t)
+
+	srv.Handle(http.MethodGet, "/orders/v0/orders/{orderId}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"payload": map[string]string{"AmazonOrderId": r.PathValue("orderId")},
+		})
+	})
+
+	result, err := orders.NewClient(client).GetOrder(context.Background(), "123-4567890-1234567", nil)
+	if err != nil {
+		t.Fatalf("GetOrder() error = %v", err)
+	}
+	if id := result.(map[string]interface{})["payload"].(map[string]interface{})["AmazonOrderId"]; id != "123-4567890-1234567" {
+		t.Errorf("AmazonOrderId = %v", id)
+	}
+}
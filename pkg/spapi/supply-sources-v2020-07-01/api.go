@@ -0,0 +1,61 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package supply_sources_v2020_07_01
+
+import (
+	"context"
+	"iter"
+)
+
+// API 是 supply-sources API v2020-07-01 客户端的全部方法，包括分页迭代器等辅助方法。
+//
+// 业务代码依赖 API 而不是 *Client，即可在单元测试中用 Fake 替换真实客户端。
+type API interface {
+	// ArchiveSupplySource
+	// Method: DELETE | Path: /supplySources/2020-07-01/supplySources/{supplySourceId}
+	ArchiveSupplySource(ctx context.Context, supplySourceId string) (interface{}, error)
+
+	// CreateSupplySource
+	// Method: POST | Path: /supplySources/2020-07-01/supplySources
+	CreateSupplySource(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetSupplySource
+	// Method: GET | Path: /supplySources/2020-07-01/supplySources/{supplySourceId}
+	GetSupplySource(ctx context.Context, supplySourceId string, query map[string]string) (interface{}, error)
+
+	// GetSupplySources
+	// Method: GET | Path: /supplySources/2020-07-01/supplySources
+	GetSupplySources(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// IterateSupplySources 返回供应来源迭代器，自动处理分页。
+	IterateSupplySources(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// UpdateSupplySource
+	// Method: PUT | Path: /supplySources/2020-07-01/supplySources/{supplySourceId}
+	UpdateSupplySource(ctx context.Context, supplySourceId string, body interface{}) (interface{}, error)
+
+	// UpdateSupplySourceStatus
+	// Method: PUT | Path: /supplySources/2020-07-01/supplySources/{supplySourceId}/status
+	UpdateSupplySourceStatus(ctx context.Context, supplySourceId string, body interface{}) (interface{}, error)
+}
+
+// 确保 Client 实现了 API。
+var _ API = (*Client)(nil)
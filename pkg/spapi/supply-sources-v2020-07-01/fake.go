@@ -0,0 +1,130 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package supply_sources_v2020_07_01
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。
+//
+// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回
+// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，
+// 可以通过 Calls 和 CallsTo 查看。
+type Fake struct {
+	spapi.FakeRecorder
+
+	// ArchiveSupplySourceFunc 实现 ArchiveSupplySource。
+	ArchiveSupplySourceFunc func(ctx context.Context, supplySourceId string) (interface{}, error)
+
+	// CreateSupplySourceFunc 实现 CreateSupplySource。
+	CreateSupplySourceFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetSupplySourceFunc 实现 GetSupplySource。
+	GetSupplySourceFunc func(ctx context.Context, supplySourceId string, query map[string]string) (interface{}, error)
+
+	// GetSupplySourcesFunc 实现 GetSupplySources。
+	GetSupplySourcesFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// IterateSupplySourcesFunc 实现 IterateSupplySources。
+	IterateSupplySourcesFunc func(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// UpdateSupplySourceFunc 实现 UpdateSupplySource。
+	UpdateSupplySourceFunc func(ctx context.Context, supplySourceId string, body interface{}) (interface{}, error)
+
+	// UpdateSupplySourceStatusFunc 实现 UpdateSupplySourceStatus。
+	UpdateSupplySourceStatusFunc func(ctx context.Context, supplySourceId string, body interface{}) (interface{}, error)
+}
+
+// NewFake 创建未编程任何响应的 Fake。
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// ArchiveSupplySource 记录调用并执行 ArchiveSupplySourceFunc。
+func (f *Fake) ArchiveSupplySource(ctx context.Context, supplySourceId string) (interface{}, error) {
+	f.Record("ArchiveSupplySource", supplySourceId)
+	if f.ArchiveSupplySourceFunc == nil {
+		return nil, fmt.Errorf("ArchiveSupplySource: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.ArchiveSupplySourceFunc(ctx, supplySourceId)
+}
+
+// CreateSupplySource 记录调用并执行 CreateSupplySourceFunc。
+func (f *Fake) CreateSupplySource(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("CreateSupplySource", body)
+	if f.CreateSupplySourceFunc == nil {
+		return nil, fmt.Errorf("CreateSupplySource: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.CreateSupplySourceFunc(ctx, body)
+}
+
+// GetSupplySource 记录调用并执行 GetSupplySourceFunc。
+func (f *Fake) GetSupplySource(ctx context.Context, supplySourceId string, query map[string]string) (interface{}, error) {
+	f.Record("GetSupplySource", supplySourceId, query)
+	if f.GetSupplySourceFunc == nil {
+		return nil, fmt.Errorf("GetSupplySource: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetSupplySourceFunc(ctx, supplySourceId, query)
+}
+
+// GetSupplySources 记录调用并执行 GetSupplySourcesFunc。
+func (f *Fake) GetSupplySources(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetSupplySources", query)
+	if f.GetSupplySourcesFunc == nil {
+		return nil, fmt.Errorf("GetSupplySources: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetSupplySourcesFunc(ctx, query)
+}
+
+// IterateSupplySources 记录调用并执行 IterateSupplySourcesFunc。
+func (f *Fake) IterateSupplySources(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+	f.Record("IterateSupplySources", query)
+	if f.IterateSupplySourcesFunc == nil {
+		return spapi.FakeSeq[map[string]interface{}](nil, fmt.Errorf("IterateSupplySources: %w", spapi.ErrFakeNotConfigured))
+	}
+	return f.IterateSupplySourcesFunc(ctx, query)
+}
+
+// UpdateSupplySource 记录调用并执行 UpdateSupplySourceFunc。
+func (f *Fake) UpdateSupplySource(ctx context.Context, supplySourceId string, body interface{}) (interface{}, error) {
+	f.Record("UpdateSupplySource", supplySourceId, body)
+	if f.UpdateSupplySourceFunc == nil {
+		return nil, fmt.Errorf("UpdateSupplySource: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.UpdateSupplySourceFunc(ctx, supplySourceId, body)
+}
+
+// UpdateSupplySourceStatus 记录调用并执行 UpdateSupplySourceStatusFunc。
+func (f *Fake) UpdateSupplySourceStatus(ctx context.Context, supplySourceId string, body interface{}) (interface{}, error) {
+	f.Record("UpdateSupplySourceStatus", supplySourceId, body)
+	if f.UpdateSupplySourceStatusFunc == nil {
+		return nil, fmt.Errorf("UpdateSupplySourceStatus: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.UpdateSupplySourceStatusFunc(ctx, supplySourceId, body)
+}
+
+// 确保 Fake 实现了 API。
+var _ API = (*Fake)(nil)
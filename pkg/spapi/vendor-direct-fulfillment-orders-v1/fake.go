@@ -0,0 +1,94 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package vendor_direct_fulfillment_orders_v1
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。
+//
+// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回
+// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，
+// 可以通过 Calls 和 CallsTo 查看。
+type Fake struct {
+	spapi.FakeRecorder
+
+	// GetOrderFunc 实现 GetOrder。
+	GetOrderFunc func(ctx context.Context, purchaseOrderNumber string, query map[string]string) (interface{}, error)
+
+	// GetOrdersFunc 实现 GetOrders。
+	GetOrdersFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// IterateOrdersFunc 实现 IterateOrders。
+	IterateOrdersFunc func(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// SubmitAcknowledgementFunc 实现 SubmitAcknowledgement。
+	SubmitAcknowledgementFunc func(ctx context.Context, body interface{}) (interface{}, error)
+}
+
+// NewFake 创建未编程任何响应的 Fake。
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// GetOrder 记录调用并执行 GetOrderFunc。
+func (f *Fake) GetOrder(ctx context.Context, purchaseOrderNumber string, query map[string]string) (interface{}, error) {
+	f.Record("GetOrder", purchaseOrderNumber, query)
+	if f.GetOrderFunc == nil {
+		return nil, fmt.Errorf("GetOrder: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetOrderFunc(ctx, purchaseOrderNumber, query)
+}
+
+// GetOrders 记录调用并执行 GetOrdersFunc。
+func (f *Fake) GetOrders(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetOrders", query)
+	if f.GetOrdersFunc == nil {
+		return nil, fmt.Errorf("GetOrders: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetOrdersFunc(ctx, query)
+}
+
+// IterateOrders 记录调用并执行 IterateOrdersFunc。
+func (f *Fake) IterateOrders(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+	f.Record("IterateOrders", query)
+	if f.IterateOrdersFunc == nil {
+		return spapi.FakeSeq[map[string]interface{}](nil, fmt.Errorf("IterateOrders: %w", spapi.ErrFakeNotConfigured))
+	}
+	return f.IterateOrdersFunc(ctx, query)
+}
+
+// SubmitAcknowledgement 记录调用并执行 SubmitAcknowledgementFunc。
+func (f *Fake) SubmitAcknowledgement(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("SubmitAcknowledgement", body)
+	if f.SubmitAcknowledgementFunc == nil {
+		return nil, fmt.Errorf("SubmitAcknowledgement: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.SubmitAcknowledgementFunc(ctx, body)
+}
+
+// 确保 Fake 实现了 API。
+var _ API = (*Fake)(nil)
@@ -8,9 +8,18 @@
  */
 package vendor_direct_fulfillment_orders_v2021_12_28
 
+import (
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
 // An amount of money, including units in the form of currency.
 type Money struct {
 	// Three digit currency code in ISO 4217 format. String of length 3.
-	CurrencyCode string `json:"currencyCode,omitempty"`
-	Amount       string `json:"amount,omitempty"`
+	CurrencyCode string              `json:"currencyCode,omitempty"`
+	Amount       spapi.DecimalString `json:"amount,omitzero"`
+}
+
+// ToMoney 转换为 spapi.Money。
+func (m Money) ToMoney() spapi.Money {
+	return spapi.NewMoney(m.Amount.Decimal, m.CurrencyCode)
 }
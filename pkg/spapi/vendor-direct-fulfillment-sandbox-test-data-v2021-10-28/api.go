@@ -0,0 +1,45 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package vendor_direct_fulfillment_sandbox_test_data_v2021_10_28
+
+import (
+	"context"
+	"iter"
+)
+
+// API 是 vendor-direct-fulfillment-sandbox-test-data API v2021-10-28 客户端的全部方法，包括分页迭代器等辅助方法。
+//
+// 业务代码依赖 API 而不是 *Client，即可在单元测试中用 Fake 替换真实客户端。
+type API interface {
+	// GenerateOrderScenarios
+	// Method: POST | Path: /vendor/directFulfillment/sandbox/2021-10-28/orders
+	GenerateOrderScenarios(ctx context.Context, body interface{}) (interface{}, error)
+
+	// GetOrderScenarios
+	// Method: GET | Path: /vendor/directFulfillment/sandbox/2021-10-28/transactions/{transactionId}
+	GetOrderScenarios(ctx context.Context, transactionId string, query map[string]string) (interface{}, error)
+
+	// IterateTestCaseData 返回测试用例数据迭代器，自动处理分页。
+	IterateTestCaseData(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+}
+
+// 确保 Client 实现了 API。
+var _ API = (*Client)(nil)
@@ -17,24 +17,6 @@
 // Unless you have obtained a commercial license, this file is licensed
 // under AGPL-3.0. By using this software, you agree to comply with the
 // terms of the applicable license.
-//
-// This file is part of Amazon SP-API Go SDK.
-//
-// Amazon SP-API Go SDK is dual-licensed:
-//
-// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
-//    - Free for personal, educational, and open source projects
-//    - Your project must also be open sourced under AGPL-3.0
-//    - See: https://www.gnu.org/licenses/agpl-3.0.html
-//
-// 2. Commercial License for proprietary/commercial use
-//    - Required for any commercial, enterprise, or proprietary use
-//    - Allows closed source distribution
-//    - Contact: vanling1111@gmail.com
-//
-// Unless you have obtained a commercial license, this file is licensed
-// under AGPL-3.0. By using this software, you agree to comply with the
-// terms of the applicable license.
 
 package vendor_direct_fulfillment_sandbox_test_data_v2021_10_28
 
@@ -47,8 +29,29 @@ import (
 )
 
 // IterateTestCaseData 返回测试用例数据迭代器，自动处理分页。
+//
+// 使用 Go 1.25 迭代器特性，自动处理 nextToken 分页逻辑。
+//
+// 参数:
+//   - ctx: 请求上下文
+//   - query: 查询参数
+//
+// 示例:
+//
+//	for item, err := range client.IterateTestCaseData(ctx, query) {
+//	    if err != nil { return err }
+//	    process(item)
+//	}
 func (c *Client) IterateTestCaseData(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
 	return func(yield func(map[string]interface{}, error) bool) {
+		// 登记到客户端，Close 等待迭代结束
+		ctx, release, err := c.baseClient.Acquire(ctx)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		defer release()
+
 		currentQuery := make(map[string]string)
 		for k, v := range query {
 			currentQuery[k] = v
@@ -57,7 +60,7 @@ func (c *Client) IterateTestCaseData(ctx context.Context, query map[string]strin
 		for {
 			result, err := c.GenerateOrderScenarios(ctx, currentQuery)
 			if err != nil {
-				yield(nil, errors.Wrap(err, "failed to generate order scenarios"))
+				yield(nil, errors.Wrap(err, "failed to call GenerateOrderScenarios"))
 				return
 			}
 
@@ -73,6 +76,7 @@ func (c *Client) IterateTestCaseData(ctx context.Context, query map[string]strin
 				return
 			}
 
+			// 获取 orders 数组
 			payload, ok := response["payload"].(map[string]interface{})
 			if !ok {
 				break
@@ -83,6 +87,7 @@ func (c *Client) IterateTestCaseData(ctx context.Context, query map[string]strin
 				break
 			}
 
+			// 遍历当前页
 			for _, item := range items {
 				itemMap, ok := item.(map[string]interface{})
 				if !ok {
@@ -93,6 +98,7 @@ func (c *Client) IterateTestCaseData(ctx context.Context, query map[string]strin
 				}
 			}
 
+			// 检查下一页
 			pagination, ok := payload["pagination"].(map[string]interface{})
 			if !ok {
 				break
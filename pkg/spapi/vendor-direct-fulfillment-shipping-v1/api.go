@@ -0,0 +1,73 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package vendor_direct_fulfillment_shipping_v1
+
+import (
+	"context"
+	"iter"
+)
+
+// API 是 vendor-direct-fulfillment-shipping API v1 客户端的全部方法，包括分页迭代器等辅助方法。
+//
+// 业务代码依赖 API 而不是 *Client，即可在单元测试中用 Fake 替换真实客户端。
+type API interface {
+	// GetCustomerInvoice
+	// Method: GET | Path: /vendor/directFulfillment/shipping/v1/customerInvoices/{purchaseOrderNumber}
+	GetCustomerInvoice(ctx context.Context, purchaseOrderNumber string, query map[string]string) (interface{}, error)
+
+	// GetCustomerInvoices
+	// Method: GET | Path: /vendor/directFulfillment/shipping/v1/customerInvoices
+	GetCustomerInvoices(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetPackingSlip
+	// Method: GET | Path: /vendor/directFulfillment/shipping/v1/packingSlips/{purchaseOrderNumber}
+	GetPackingSlip(ctx context.Context, purchaseOrderNumber string, query map[string]string) (interface{}, error)
+
+	// GetPackingSlips
+	// Method: GET | Path: /vendor/directFulfillment/shipping/v1/packingSlips
+	GetPackingSlips(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetShippingLabel
+	// Method: GET | Path: /vendor/directFulfillment/shipping/v1/shippingLabels/{purchaseOrderNumber}
+	GetShippingLabel(ctx context.Context, purchaseOrderNumber string, query map[string]string) (interface{}, error)
+
+	// GetShippingLabels
+	// Method: GET | Path: /vendor/directFulfillment/shipping/v1/shippingLabels
+	GetShippingLabels(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// IterateShippingLabels 返回物流标签迭代器，自动处理分页。
+	IterateShippingLabels(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// SubmitShipmentConfirmations
+	// Method: POST | Path: /vendor/directFulfillment/shipping/v1/shipmentConfirmations
+	SubmitShipmentConfirmations(ctx context.Context, body interface{}) (interface{}, error)
+
+	// SubmitShipmentStatusUpdates
+	// Method: POST | Path: /vendor/directFulfillment/shipping/v1/shipmentStatusUpdates
+	SubmitShipmentStatusUpdates(ctx context.Context, body interface{}) (interface{}, error)
+
+	// SubmitShippingLabelRequest
+	// Method: POST | Path: /vendor/directFulfillment/shipping/v1/shippingLabels
+	SubmitShippingLabelRequest(ctx context.Context, body interface{}) (interface{}, error)
+}
+
+// 确保 Client 实现了 API。
+var _ API = (*Client)(nil)
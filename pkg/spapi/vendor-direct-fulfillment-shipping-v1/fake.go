@@ -0,0 +1,166 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package vendor_direct_fulfillment_shipping_v1
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。
+//
+// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回
+// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，
+// 可以通过 Calls 和 CallsTo 查看。
+type Fake struct {
+	spapi.FakeRecorder
+
+	// GetCustomerInvoiceFunc 实现 GetCustomerInvoice。
+	GetCustomerInvoiceFunc func(ctx context.Context, purchaseOrderNumber string, query map[string]string) (interface{}, error)
+
+	// GetCustomerInvoicesFunc 实现 GetCustomerInvoices。
+	GetCustomerInvoicesFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetPackingSlipFunc 实现 GetPackingSlip。
+	GetPackingSlipFunc func(ctx context.Context, purchaseOrderNumber string, query map[string]string) (interface{}, error)
+
+	// GetPackingSlipsFunc 实现 GetPackingSlips。
+	GetPackingSlipsFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetShippingLabelFunc 实现 GetShippingLabel。
+	GetShippingLabelFunc func(ctx context.Context, purchaseOrderNumber string, query map[string]string) (interface{}, error)
+
+	// GetShippingLabelsFunc 实现 GetShippingLabels。
+	GetShippingLabelsFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// IterateShippingLabelsFunc 实现 IterateShippingLabels。
+	IterateShippingLabelsFunc func(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// SubmitShipmentConfirmationsFunc 实现 SubmitShipmentConfirmations。
+	SubmitShipmentConfirmationsFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// SubmitShipmentStatusUpdatesFunc 实现 SubmitShipmentStatusUpdates。
+	SubmitShipmentStatusUpdatesFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// SubmitShippingLabelRequestFunc 实现 SubmitShippingLabelRequest。
+	SubmitShippingLabelRequestFunc func(ctx context.Context, body interface{}) (interface{}, error)
+}
+
+// NewFake 创建未编程任何响应的 Fake。
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// GetCustomerInvoice 记录调用并执行 GetCustomerInvoiceFunc。
+func (f *Fake) GetCustomerInvoice(ctx context.Context, purchaseOrderNumber string, query map[string]string) (interface{}, error) {
+	f.Record("GetCustomerInvoice", purchaseOrderNumber, query)
+	if f.GetCustomerInvoiceFunc == nil {
+		return nil, fmt.Errorf("GetCustomerInvoice: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetCustomerInvoiceFunc(ctx, purchaseOrderNumber, query)
+}
+
+// GetCustomerInvoices 记录调用并执行 GetCustomerInvoicesFunc。
+func (f *Fake) GetCustomerInvoices(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetCustomerInvoices", query)
+	if f.GetCustomerInvoicesFunc == nil {
+		return nil, fmt.Errorf("GetCustomerInvoices: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetCustomerInvoicesFunc(ctx, query)
+}
+
+// GetPackingSlip 记录调用并执行 GetPackingSlipFunc。
+func (f *Fake) GetPackingSlip(ctx context.Context, purchaseOrderNumber string, query map[string]string) (interface{}, error) {
+	f.Record("GetPackingSlip", purchaseOrderNumber, query)
+	if f.GetPackingSlipFunc == nil {
+		return nil, fmt.Errorf("GetPackingSlip: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetPackingSlipFunc(ctx, purchaseOrderNumber, query)
+}
+
+// GetPackingSlips 记录调用并执行 GetPackingSlipsFunc。
+func (f *Fake) GetPackingSlips(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetPackingSlips", query)
+	if f.GetPackingSlipsFunc == nil {
+		return nil, fmt.Errorf("GetPackingSlips: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetPackingSlipsFunc(ctx, query)
+}
+
+// GetShippingLabel 记录调用并执行 GetShippingLabelFunc。
+func (f *Fake) GetShippingLabel(ctx context.Context, purchaseOrderNumber string, query map[string]string) (interface{}, error) {
+	f.Record("GetShippingLabel", purchaseOrderNumber, query)
+	if f.GetShippingLabelFunc == nil {
+		return nil, fmt.Errorf("GetShippingLabel: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetShippingLabelFunc(ctx, purchaseOrderNumber, query)
+}
+
+// GetShippingLabels 记录调用并执行 GetShippingLabelsFunc。
+func (f *Fake) GetShippingLabels(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetShippingLabels", query)
+	if f.GetShippingLabelsFunc == nil {
+		return nil, fmt.Errorf("GetShippingLabels: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetShippingLabelsFunc(ctx, query)
+}
+
+// IterateShippingLabels 记录调用并执行 IterateShippingLabelsFunc。
+func (f *Fake) IterateShippingLabels(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+	f.Record("IterateShippingLabels", query)
+	if f.IterateShippingLabelsFunc == nil {
+		return spapi.FakeSeq[map[string]interface{}](nil, fmt.Errorf("IterateShippingLabels: %w", spapi.ErrFakeNotConfigured))
+	}
+	return f.IterateShippingLabelsFunc(ctx, query)
+}
+
+// SubmitShipmentConfirmations 记录调用并执行 SubmitShipmentConfirmationsFunc。
+func (f *Fake) SubmitShipmentConfirmations(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("SubmitShipmentConfirmations", body)
+	if f.SubmitShipmentConfirmationsFunc == nil {
+		return nil, fmt.Errorf("SubmitShipmentConfirmations: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.SubmitShipmentConfirmationsFunc(ctx, body)
+}
+
+// SubmitShipmentStatusUpdates 记录调用并执行 SubmitShipmentStatusUpdatesFunc。
+func (f *Fake) SubmitShipmentStatusUpdates(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("SubmitShipmentStatusUpdates", body)
+	if f.SubmitShipmentStatusUpdatesFunc == nil {
+		return nil, fmt.Errorf("SubmitShipmentStatusUpdates: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.SubmitShipmentStatusUpdatesFunc(ctx, body)
+}
+
+// SubmitShippingLabelRequest 记录调用并执行 SubmitShippingLabelRequestFunc。
+func (f *Fake) SubmitShippingLabelRequest(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("SubmitShippingLabelRequest", body)
+	if f.SubmitShippingLabelRequestFunc == nil {
+		return nil, fmt.Errorf("SubmitShippingLabelRequest: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.SubmitShippingLabelRequestFunc(ctx, body)
+}
+
+// 确保 Fake 实现了 API。
+var _ API = (*Fake)(nil)
@@ -8,9 +8,18 @@
  */
 package vendor_invoices_v1
 
+import (
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
 // An amount of money, including units in the form of currency.
 type Money struct {
 	// Three-digit currency code in ISO 4217 format.
-	CurrencyCode string `json:"currencyCode,omitempty"`
-	Amount       string `json:"amount,omitempty"`
+	CurrencyCode string              `json:"currencyCode,omitempty"`
+	Amount       spapi.DecimalString `json:"amount,omitzero"`
+}
+
+// ToMoney 转换为 spapi.Money。
+func (m Money) ToMoney() spapi.Money {
+	return spapi.NewMoney(m.Amount.Decimal, m.CurrencyCode)
 }
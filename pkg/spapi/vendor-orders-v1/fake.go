@@ -0,0 +1,106 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package vendor_orders_v1
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。
+//
+// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回
+// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，
+// 可以通过 Calls 和 CallsTo 查看。
+type Fake struct {
+	spapi.FakeRecorder
+
+	// GetPurchaseOrderFunc 实现 GetPurchaseOrder。
+	GetPurchaseOrderFunc func(ctx context.Context, purchaseOrderNumber string, query map[string]string) (interface{}, error)
+
+	// GetPurchaseOrdersFunc 实现 GetPurchaseOrders。
+	GetPurchaseOrdersFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetPurchaseOrdersStatusFunc 实现 GetPurchaseOrdersStatus。
+	GetPurchaseOrdersStatusFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// IteratePurchaseOrdersFunc 实现 IteratePurchaseOrders。
+	IteratePurchaseOrdersFunc func(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// SubmitAcknowledgementFunc 实现 SubmitAcknowledgement。
+	SubmitAcknowledgementFunc func(ctx context.Context, body interface{}) (interface{}, error)
+}
+
+// NewFake 创建未编程任何响应的 Fake。
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// GetPurchaseOrder 记录调用并执行 GetPurchaseOrderFunc。
+func (f *Fake) GetPurchaseOrder(ctx context.Context, purchaseOrderNumber string, query map[string]string) (interface{}, error) {
+	f.Record("GetPurchaseOrder", purchaseOrderNumber, query)
+	if f.GetPurchaseOrderFunc == nil {
+		return nil, fmt.Errorf("GetPurchaseOrder: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetPurchaseOrderFunc(ctx, purchaseOrderNumber, query)
+}
+
+// GetPurchaseOrders 记录调用并执行 GetPurchaseOrdersFunc。
+func (f *Fake) GetPurchaseOrders(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetPurchaseOrders", query)
+	if f.GetPurchaseOrdersFunc == nil {
+		return nil, fmt.Errorf("GetPurchaseOrders: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetPurchaseOrdersFunc(ctx, query)
+}
+
+// GetPurchaseOrdersStatus 记录调用并执行 GetPurchaseOrdersStatusFunc。
+func (f *Fake) GetPurchaseOrdersStatus(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetPurchaseOrdersStatus", query)
+	if f.GetPurchaseOrdersStatusFunc == nil {
+		return nil, fmt.Errorf("GetPurchaseOrdersStatus: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetPurchaseOrdersStatusFunc(ctx, query)
+}
+
+// IteratePurchaseOrders 记录调用并执行 IteratePurchaseOrdersFunc。
+func (f *Fake) IteratePurchaseOrders(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+	f.Record("IteratePurchaseOrders", query)
+	if f.IteratePurchaseOrdersFunc == nil {
+		return spapi.FakeSeq[map[string]interface{}](nil, fmt.Errorf("IteratePurchaseOrders: %w", spapi.ErrFakeNotConfigured))
+	}
+	return f.IteratePurchaseOrdersFunc(ctx, query)
+}
+
+// SubmitAcknowledgement 记录调用并执行 SubmitAcknowledgementFunc。
+func (f *Fake) SubmitAcknowledgement(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("SubmitAcknowledgement", body)
+	if f.SubmitAcknowledgementFunc == nil {
+		return nil, fmt.Errorf("SubmitAcknowledgement: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.SubmitAcknowledgementFunc(ctx, body)
+}
+
+// 确保 Fake 实现了 API。
+var _ API = (*Fake)(nil)
@@ -8,11 +8,20 @@
  */
 package vendor_orders_v1
 
+import (
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
 // An amount of money. Includes the currency code and an optional unit of measure for items priced by weight.
 type Money struct {
 	// Three digit currency code in ISO 4217 format. String of length 3.
-	CurrencyCode string `json:"currencyCode,omitempty"`
-	Amount       string `json:"amount,omitempty"`
+	CurrencyCode string              `json:"currencyCode,omitempty"`
+	Amount       spapi.DecimalString `json:"amount,omitzero"`
 	// The unit of measure for prices of items sold by weight. If this field is absent, the item is sold by eaches.
 	UnitOfMeasure string `json:"unitOfMeasure,omitempty"`
 }
+
+// ToMoney 转换为 spapi.Money。
+func (m Money) ToMoney() spapi.Money {
+	return spapi.NewMoney(m.Amount.Decimal, m.CurrencyCode)
+}
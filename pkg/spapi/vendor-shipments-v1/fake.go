@@ -0,0 +1,106 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//    - Free for personal, educational, and open source projects
+//    - Your project must also be open sourced under AGPL-3.0
+//    - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//    - Required for any commercial, enterprise, or proprietary use
+//    - Allows closed source distribution
+//    - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license.
+
+package vendor_shipments_v1
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Fake 是 API 的内存实现，用于不发起 HTTP 请求的单元测试。
+//
+// 设置 XxxFunc 字段即可编程对应方法的响应；未设置的方法返回
+// spapi.ErrFakeNotConfigured。每次调用（ctx 以外的参数）都会被记录，
+// 可以通过 Calls 和 CallsTo 查看。
+type Fake struct {
+	spapi.FakeRecorder
+
+	// GetShipmentDetailsFunc 实现 GetShipmentDetails。
+	GetShipmentDetailsFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// GetShipmentLabelsFunc 实现 GetShipmentLabels。
+	GetShipmentLabelsFunc func(ctx context.Context, query map[string]string) (interface{}, error)
+
+	// IterateShipmentDetailsFunc 实现 IterateShipmentDetails。
+	IterateShipmentDetailsFunc func(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error]
+
+	// SubmitShipmentConfirmationsFunc 实现 SubmitShipmentConfirmations。
+	SubmitShipmentConfirmationsFunc func(ctx context.Context, body interface{}) (interface{}, error)
+
+	// SubmitShipmentsFunc 实现 SubmitShipments。
+	SubmitShipmentsFunc func(ctx context.Context, body interface{}) (interface{}, error)
+}
+
+// NewFake 创建未编程任何响应的 Fake。
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// GetShipmentDetails 记录调用并执行 GetShipmentDetailsFunc。
+func (f *Fake) GetShipmentDetails(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetShipmentDetails", query)
+	if f.GetShipmentDetailsFunc == nil {
+		return nil, fmt.Errorf("GetShipmentDetails: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetShipmentDetailsFunc(ctx, query)
+}
+
+// GetShipmentLabels 记录调用并执行 GetShipmentLabelsFunc。
+func (f *Fake) GetShipmentLabels(ctx context.Context, query map[string]string) (interface{}, error) {
+	f.Record("GetShipmentLabels", query)
+	if f.GetShipmentLabelsFunc == nil {
+		return nil, fmt.Errorf("GetShipmentLabels: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.GetShipmentLabelsFunc(ctx, query)
+}
+
+// IterateShipmentDetails 记录调用并执行 IterateShipmentDetailsFunc。
+func (f *Fake) IterateShipmentDetails(ctx context.Context, query map[string]string) iter.Seq2[map[string]interface{}, error] {
+	f.Record("IterateShipmentDetails", query)
+	if f.IterateShipmentDetailsFunc == nil {
+		return spapi.FakeSeq[map[string]interface{}](nil, fmt.Errorf("IterateShipmentDetails: %w", spapi.ErrFakeNotConfigured))
+	}
+	return f.IterateShipmentDetailsFunc(ctx, query)
+}
+
+// SubmitShipmentConfirmations 记录调用并执行 SubmitShipmentConfirmationsFunc。
+func (f *Fake) SubmitShipmentConfirmations(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("SubmitShipmentConfirmations", body)
+	if f.SubmitShipmentConfirmationsFunc == nil {
+		return nil, fmt.Errorf("SubmitShipmentConfirmations: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.SubmitShipmentConfirmationsFunc(ctx, body)
+}
+
+// SubmitShipments 记录调用并执行 SubmitShipmentsFunc。
+func (f *Fake) SubmitShipments(ctx context.Context, body interface{}) (interface{}, error) {
+	f.Record("SubmitShipments", body)
+	if f.SubmitShipmentsFunc == nil {
+		return nil, fmt.Errorf("SubmitShipments: %w", spapi.ErrFakeNotConfigured)
+	}
+	return f.SubmitShipmentsFunc(ctx, body)
+}
+
+// 确保 Fake 实现了 API。
+var _ API = (*Fake)(nil)
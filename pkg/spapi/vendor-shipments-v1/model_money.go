@@ -8,9 +8,18 @@
  */
 package vendor_shipments_v1
 
+import (
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
 // An amount of money, including units in the form of currency.
 type Money struct {
 	// Three digit currency code in ISO 4217 format.
-	CurrencyCode string `json:"currencyCode"`
-	Amount       string `json:"amount"`
+	CurrencyCode string              `json:"currencyCode"`
+	Amount       spapi.DecimalString `json:"amount"`
+}
+
+// ToMoney 转换为 spapi.Money。
+func (m Money) ToMoney() spapi.Money {
+	return spapi.NewMoney(m.Amount.Decimal, m.CurrencyCode)
 }
@@ -0,0 +1,86 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+//
+// Package zap 提供输出到 go.uber.org/zap 的 spapi.Logger。
+//
+// 示例:
+//
+//	logger, _ := gozap.NewProduction()
+//	client, err := spapi.NewClient(
+//	    spapi.WithRegion(spapi.RegionNA),
+//	    spapi.WithCredentials(clientID, clientSecret, refreshToken),
+//	    spapi.WithLogger(zap.NewLogger(logger)),
+//	)
+package zap
+
+import (
+	gozap "go.uber.org/zap"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Logger 是输出到 zap 的 spapi.Logger。
+type Logger struct {
+	logger *gozap.Logger
+}
+
+// NewLogger 创建输出到 zap 的 Logger。
+//
+// spapi.Field 转换为 zap.Any，Debug/Info/Warn/Error 对应 zap 的同名级别。
+//
+// 参数:
+//   - logger: zap 日志器（为 nil 时使用 zap.L()）
+//
+// 返回值:
+//   - *Logger: 日志器实例
+func NewLogger(logger *gozap.Logger) *Logger {
+	if logger == nil {
+		logger = gozap.L()
+	}
+	return &Logger{logger: logger}
+}
+
+// Debug 实现 spapi.Logger。
+func (l *Logger) Debug(msg string, fields ...spapi.Field) { l.logger.Debug(msg, zapFields(fields)...) }
+
+// Info 实现 spapi.Logger。
+func (l *Logger) Info(msg string, fields ...spapi.Field) { l.logger.Info(msg, zapFields(fields)...) }
+
+// Warn 实现 spapi.Logger。
+func (l *Logger) Warn(msg string, fields ...spapi.Field) { l.logger.Warn(msg, zapFields(fields)...) }
+
+// Error 实现 spapi.Logger。
+func (l *Logger) Error(msg string, fields ...spapi.Field) { l.logger.Error(msg, zapFields(fields)...) }
+
+// With 实现 spapi.Logger。
+func (l *Logger) With(fields ...spapi.Field) spapi.Logger {
+	return &Logger{logger: l.logger.With(zapFields(fields)...)}
+}
+
+// zapFields 把 spapi.Field 转换为 zap 字段。
+func zapFields(fields []spapi.Field) []gozap.Field {
+	out := make([]gozap.Field, len(fields))
+	for i, f := range fields {
+		out[i] = gozap.Any(f.Key, f.Value)
+	}
+	return out
+}
+
+var _ spapi.Logger = (*Logger)(nil)
@@ -0,0 +1,60 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package zap_test
+
+import (
+	"testing"
+	"time"
+
+	gozap "go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/zap"
+)
+
+// TestLogger 测试 zap 适配器的级别和字段。
+func TestLogger(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	logger := zap.NewLogger(gozap.New(core))
+
+	logger.Debug("hidden")
+	logger.With(spapi.Field{Key: "seller", Value: "SELLER1"}).Info(spapi.EventRequestRetry,
+		spapi.Field{Key: spapi.LogKeyOperation, Value: "orders-v0:getOrder"},
+		spapi.Field{Key: spapi.LogKeyAttempt, Value: 2},
+		spapi.Field{Key: spapi.LogKeyDuration, Value: 250 * time.Millisecond},
+	)
+
+	entries := logs.All()
+	if len(entries) != 1 {
+		t.Fatalf("entries = %d, want 1 (debug disabled)", len(entries))
+	}
+	entry := entries[0]
+	if entry.Level != zapcore.InfoLevel || entry.Message != spapi.EventRequestRetry {
+		t.Errorf("entry = %v %q", entry.Level, entry.Message)
+	}
+	fields := entry.ContextMap()
+	if fields["seller"] != "SELLER1" || fields[spapi.LogKeyOperation] != "orders-v0:getOrder" ||
+		fields[spapi.LogKeyAttempt] != int64(2) || fields[spapi.LogKeyDuration] != 250*time.Millisecond {
+		t.Errorf("fields = %v", fields)
+	}
+}
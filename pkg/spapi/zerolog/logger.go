@@ -0,0 +1,92 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+//
+// Package zerolog 提供输出到 github.com/rs/zerolog 的 spapi.Logger。
+//
+// 示例:
+//
+//	logger := gozerolog.New(os.Stderr).With().Timestamp().Logger()
+//	client, err := spapi.NewClient(
+//	    spapi.WithRegion(spapi.RegionNA),
+//	    spapi.WithCredentials(clientID, clientSecret, refreshToken),
+//	    spapi.WithLogger(zerolog.NewLogger(logger)),
+//	)
+package zerolog
+
+import (
+	gozerolog "github.com/rs/zerolog"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+)
+
+// Logger 是输出到 zerolog 的 spapi.Logger。
+type Logger struct {
+	logger gozerolog.Logger
+}
+
+// NewLogger 创建输出到 zerolog 的 Logger。
+//
+// spapi.Field 按 zerolog 的 Fields 规则转换（error、time.Duration 等使用
+// zerolog 的编码），Debug/Info/Warn/Error 对应 zerolog 的同名级别。
+//
+// 参数:
+//   - logger: zerolog 日志器
+//
+// 返回值:
+//   - *Logger: 日志器实例
+func NewLogger(logger gozerolog.Logger) *Logger {
+	return &Logger{logger: logger}
+}
+
+// Debug 实现 spapi.Logger。
+func (l *Logger) Debug(msg string, fields ...spapi.Field) { log(l.logger.Debug(), msg, fields) }
+
+// Info 实现 spapi.Logger。
+func (l *Logger) Info(msg string, fields ...spapi.Field) { log(l.logger.Info(), msg, fields) }
+
+// Warn 实现 spapi.Logger。
+func (l *Logger) Warn(msg string, fields ...spapi.Field) { log(l.logger.Warn(), msg, fields) }
+
+// Error 实现 spapi.Logger。
+func (l *Logger) Error(msg string, fields ...spapi.Field) { log(l.logger.Error(), msg, fields) }
+
+// With 实现 spapi.Logger。
+func (l *Logger) With(fields ...spapi.Field) spapi.Logger {
+	return &Logger{logger: l.logger.With().Fields(keyValues(fields)).Logger()}
+}
+
+// log 输出一条事件；级别未启用时 event 为 nil。
+func log(event *gozerolog.Event, msg string, fields []spapi.Field) {
+	if event == nil {
+		return
+	}
+	event.Fields(keyValues(fields)).Msg(msg)
+}
+
+// keyValues 把 spapi.Field 转换为 zerolog 的键值对列表。
+func keyValues(fields []spapi.Field) []interface{} {
+	out := make([]interface{}, 0, 2*len(fields))
+	for _, f := range fields {
+		out = append(out, f.Key, f.Value)
+	}
+	return out
+}
+
+var _ spapi.Logger = (*Logger)(nil)
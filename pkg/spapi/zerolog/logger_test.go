@@ -0,0 +1,61 @@
+// Copyright 2025 Amazon SP-API Go SDK Authors.
+//
+// This file is part of Amazon SP-API Go SDK.
+//
+// Amazon SP-API Go SDK is dual-licensed:
+//
+// 1. GNU Affero General Public License v3.0 (AGPL-3.0) for open source use
+//   - Free for personal, educational, and open source projects
+//   - Your project must also be open sourced under AGPL-3.0
+//   - See: https://www.gnu.org/licenses/agpl-3.0.html
+//
+// 2. Commercial License for proprietary/commercial use
+//   - Required for any commercial, enterprise, or proprietary use
+//   - Allows closed source distribution
+//   - Contact: vanling1111@gmail.com
+//
+// Unless you have obtained a commercial license, this file is licensed
+// under AGPL-3.0. By using this software, you agree to comply with the
+// terms of the applicable license. All rights reserved.
+
+package zerolog_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	gozerolog "github.com/rs/zerolog"
+
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi"
+	"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/zerolog"
+)
+
+// TestLogger 测试 zerolog 适配器的级别和字段。
+func TestLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := zerolog.NewLogger(gozerolog.New(&buf).Level(gozerolog.InfoLevel))
+
+	logger.Debug("hidden")
+	logger.With(spapi.Field{Key: "seller", Value: "SELLER1"}).Warn(spapi.EventRequestFailed,
+		spapi.Field{Key: spapi.LogKeyOperation, Value: "orders-v0:getOrder"},
+		spapi.Field{Key: spapi.LogKeyAttempt, Value: 3},
+		spapi.Field{Key: spapi.LogKeyError, Value: errors.New("boom")},
+	)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("output = %q, want one record (debug disabled)", buf.String())
+	}
+	var record map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[0]), &record); err != nil {
+		t.Fatal(err)
+	}
+	if record["level"] != "warn" || record["message"] != spapi.EventRequestFailed ||
+		record["seller"] != "SELLER1" || record[spapi.LogKeyOperation] != "orders-v0:getOrder" ||
+		record[spapi.LogKeyAttempt] != float64(3) || record[spapi.LogKeyError] != "boom" {
+		t.Errorf("record = %v", record)
+	}
+}
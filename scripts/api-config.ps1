@@ -1,61 +0,0 @@
-# API 配置列表
-$APIs = @(
-    @{Name="orders"; Version="v0"; JsonFile="ordersV0.json"},
-    @{Name="feeds"; Version="v2021-06-30"; JsonFile="feeds_2021-06-30.json"},
-    @{Name="catalog-items"; Version="v0"; JsonFile="catalogItemsV0.json"},
-    @{Name="catalog-items"; Version="v2020-12-01"; JsonFile="catalogItems_2020-12-01.json"},
-    @{Name="catalog-items"; Version="v2022-04-01"; JsonFile="catalogItems_2022-04-01.json"},
-    @{Name="reports"; Version="v2021-06-30"; JsonFile="reports_2021-06-30.json"},
-    @{Name="finances"; Version="v0"; JsonFile="financesV0.json"},
-    @{Name="finances"; Version="v2024-06-19"; JsonFile="finances_2024-06-19.json"},
-    @{Name="fba-inventory"; Version="v1"; JsonFile="fbaInventory.json"},
-    @{Name="fba-inbound-eligibility"; Version="v1"; JsonFile="fbaInbound.json"},
-    @{Name="fulfillment-inbound"; Version="v0"; JsonFile="fulfillmentInboundV0.json"},
-    @{Name="fulfillment-inbound"; Version="v2024-03-20"; JsonFile="fulfillmentInbound_2024-03-20.json"},
-    @{Name="fulfillment-outbound"; Version="v2020-07-01"; JsonFile="fulfillmentOutbound_2020-07-01.json"},
-    @{Name="listings-items"; Version="v2020-09-01"; JsonFile="listingsItems_2020-09-01.json"},
-    @{Name="listings-items"; Version="v2021-08-01"; JsonFile="listingsItems_2021-08-01.json"},
-    @{Name="listings-restrictions"; Version="v2021-08-01"; JsonFile="listingsRestrictions_2021-08-01.json"},
-    @{Name="merchant-fulfillment"; Version="v0"; JsonFile="merchantFulfillmentV0.json"},
-    @{Name="messaging"; Version="v1"; JsonFile="messaging.json"},
-    @{Name="notifications"; Version="v1"; JsonFile="notifications.json"},
-    @{Name="product-pricing"; Version="v0"; JsonFile="productPricingV0.json"},
-    @{Name="product-pricing"; Version="v2022-05-01"; JsonFile="productPricing_2022-05-01.json"},
-    @{Name="product-fees"; Version="v0"; JsonFile="productFeesV0.json"},
-    @{Name="product-type-definitions"; Version="v2020-09-01"; JsonFile="definitionsProductTypes_2020-09-01.json"},
-    @{Name="replenishment"; Version="v2022-11-07"; JsonFile="replenishment-2022-11-07.json"},
-    @{Name="sales"; Version="v1"; JsonFile="sales.json"},
-    @{Name="sellers"; Version="v1"; JsonFile="sellers.json"},
-    @{Name="seller-wallet"; Version="v2024-03-01"; JsonFile="sellerWallet_2024-03-01.json"},
-    @{Name="services"; Version="v1"; JsonFile="services.json"},
-    @{Name="shipping"; Version="v2"; JsonFile="shippingV2.json"},
-    @{Name="solicitations"; Version="v1"; JsonFile="solicitations.json"},
-    @{Name="supply-sources"; Version="v2020-07-01"; JsonFile="supplySources_2020-07-01.json"},
-    @{Name="tokens"; Version="v2021-03-01"; JsonFile="tokens_2021-03-01.json"},
-    @{Name="finances"; Version="v2024-06-01-transfers"; JsonFile="transfers_2024-06-01.json"},
-    @{Name="uploads"; Version="v2020-11-01"; JsonFile="uploads_2020-11-01.json"},
-    @{Name="vehicles"; Version="v2024-11-01"; JsonFile="vehicles_2024-11-01.json"},
-    @{Name="aplus-content"; Version="v2020-11-01"; JsonFile="aplusContent_2020-11-01.json"},
-    @{Name="application-integrations"; Version="v2024-04-01"; JsonFile="appIntegrations-2024-04-01.json"},
-    @{Name="application-management"; Version="v2023-11-30"; JsonFile="application_2023-11-30.json"},
-    @{Name="amazon-warehousing-and-distribution-model"; Version="v2024-05-09"; JsonFile="awd_2024-05-09.json"},
-    @{Name="customer-feedback"; Version="v2024-06-01"; JsonFile="customerFeedback_2024-06-01.json"},
-    @{Name="data-kiosk"; Version="v2023-11-15"; JsonFile="dataKiosk_2023-11-15.json"},
-    @{Name="easy-ship-model"; Version="v2022-03-23"; JsonFile="easyShip_2022-03-23.json"},
-    @{Name="invoices"; Version="v2024-06-19"; JsonFile="InvoicesApiModel_2024-06-19.json"},
-    @{Name="shipment-invoicing"; Version="v0"; JsonFile="shipmentInvoicingV0.json"},
-    @{Name="vendor-direct-fulfillment-inventory"; Version="v1"; JsonFile="vendorDirectFulfillmentInventoryV1.json"},
-    @{Name="vendor-direct-fulfillment-orders"; Version="v1"; JsonFile="vendorDirectFulfillmentOrdersV1.json"},
-    @{Name="vendor-direct-fulfillment-orders"; Version="v2021-12-28"; JsonFile="vendorDirectFulfillmentOrders_2021-12-28.json"},
-    @{Name="vendor-direct-fulfillment-payments"; Version="v1"; JsonFile="vendorDirectFulfillmentPaymentsV1.json"},
-    @{Name="vendor-direct-fulfillment-sandbox-test-data"; Version="v2021-10-28"; JsonFile="vendorDirectFulfillmentSandboxData_2021-10-28.json"},
-    @{Name="vendor-direct-fulfillment-shipping"; Version="v1"; JsonFile="vendorDirectFulfillmentShippingV1.json"},
-    @{Name="vendor-direct-fulfillment-shipping"; Version="v2021-12-28"; JsonFile="vendorDirectFulfillmentShipping_2021-12-28.json"},
-    @{Name="vendor-direct-fulfillment-transactions"; Version="v1"; JsonFile="vendorDirectFulfillmentTransactionsV1.json"},
-    @{Name="vendor-direct-fulfillment-transactions"; Version="v2021-12-28"; JsonFile="vendorDirectFulfillmentTransactions_2021-12-28.json"},
-    @{Name="vendor-invoices"; Version="v1"; JsonFile="vendorInvoices.json"},
-    @{Name="vendor-orders"; Version="v1"; JsonFile="vendorOrders.json"},
-    @{Name="vendor-shipments"; Version="v1"; JsonFile="vendorShipments.json"},
-    @{Name="vendor-transaction-status"; Version="v1"; JsonFile="vendorTransactionStatus.json"}
-)
-
@@ -1,75 +0,0 @@
-# Generate comprehensive unit tests for all 57 API clients
-
-$ErrorActionPreference = "Stop"
-$SDK_DIR = "C:\Users\Administrator\amazon-sp-api-go-sdk\pkg\spapi"
-
-function Generate-TestFile {
-    param([string]$PackageName, [string]$DirName, [array]$Methods)
-    
-    $lines = @()
-    $lines += "// Copyright 2025 Amazon SP-API Go SDK Authors."
-    $lines += "package ${PackageName}_test"
-    $lines += ""
-    $lines += "import ("
-    $lines += "`t`"testing`""
-    $lines += "`t`"github.com/vanling1111/amazon-sp-api-go-sdk/internal/models`""
-    $lines += "`t`"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi`""
-    $lines += "`tapi `"github.com/vanling1111/amazon-sp-api-go-sdk/pkg/spapi/$DirName`""
-    $lines += ")"
-    $lines += ""
-    
-    # Test NewClient
-    $lines += "func TestNewClient(t *testing.T) {"
-    $lines += "`tbaseClient, err := spapi.NewClient("
-    $lines += "`t`tspapi.WithRegion(models.RegionNA),"
-    $lines += "`t`tspapi.WithCredentials(`"test`", `"test`", `"test`"),"
-    $lines += "`t)"
-    $lines += "`tif err != nil { t.Fatalf(`"create base client: %v`", err) }"
-    $lines += "`tdefer baseClient.Close()"
-    $lines += "`t"
-    $lines += "`tclient := api.NewClient(baseClient)"
-    $lines += "`tif client == nil { t.Error(`"NewClient returned nil`") }"
-    $lines += "}"
-    $lines += ""
-    
-    # Test method count
-    $lines += "func TestMethodCount(t *testing.T) {"
-    $lines += "`t// Verify API has expected number of methods"
-    $lines += "`texpected := $($Methods.Count)"
-    $lines += "`tt.Logf(`"API has %d methods`", expected)"
-    $lines += "}"
-    $lines += ""
-    
-    return $lines -join "`n"
-}
-
-Write-Host "Generating unit tests..." -ForegroundColor Cyan
-. "$PSScriptRoot\api-config.ps1"
-
-$SuccessCount = 0
-foreach ($API in $APIs) {
-    $DirName = "$($API.Name)-$($API.Version)"
-    $PackageName = ($API.Name -replace '-','_') + "_" + ($API.Version -replace '-','_' -replace '\.','_')
-    $TargetDir = Join-Path $SDK_DIR $DirName
-    
-    if (-not (Test-Path $TargetDir)) { continue }
-    
-    $ClientFile = Join-Path $TargetDir "client.go"
-    if (-not (Test-Path $ClientFile)) { continue }
-    
-    $content = Get-Content $ClientFile -Raw
-    $methods = [regex]::Matches($content, 'func \(c \*Client\) (\w+)\(') | 
-        ForEach-Object { $_.Groups[1].Value }
-    
-    if ($methods.Count -eq 0) { continue }
-    
-    $testContent = Generate-TestFile -PackageName $PackageName -DirName $DirName -Methods $methods
-    $TestFile = Join-Path $TargetDir "client_test.go"
-    $utf8NoBom = New-Object System.Text.UTF8Encoding $false
-    [System.IO.File]::WriteAllText($TestFile, $testContent, $utf8NoBom)
-    
-    Write-Host "  + $DirName" -ForegroundColor Green
-    $SuccessCount++
-}
-
-Write-Host "`nGenerated $SuccessCount test files" -ForegroundColor Green
@@ -59,7 +59,7 @@ func BenchmarkClientCreation(b *testing.B) {
 			spapi.WithRegion(spapi.RegionNA),
 			spapi.WithCredentials("test", "test", "test"),
 		)
-		client.Close()
+		client.Close(context.Background())
 	}
 }
 
@@ -69,7 +69,7 @@ func BenchmarkAPIClientCreation(b *testing.B) {
 		spapi.WithRegion(spapi.RegionNA),
 		spapi.WithCredentials("test", "test", "test"),
 	)
-	defer baseClient.Close()
+	defer baseClient.Close(context.Background())
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -36,7 +36,7 @@ func TestCatalogItems_Integration(t *testing.T) {
 	}
 
 	baseClient := createTestClient(t)
-	defer baseClient.Close()
+	defer baseClient.Close(context.Background())
 
 	catalogClient := catalog.NewClient(baseClient)
 	ctx := context.Background()
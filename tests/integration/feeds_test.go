@@ -36,7 +36,7 @@ func TestFeeds_Integration(t *testing.T) {
 	}
 
 	baseClient := createTestClient(t)
-	defer baseClient.Close()
+	defer baseClient.Close(context.Background())
 
 	feedsClient := feeds.NewClient(baseClient)
 	ctx := context.Background()
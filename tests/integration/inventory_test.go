@@ -36,7 +36,7 @@ func TestFBAInventory_Integration(t *testing.T) {
 	}
 
 	baseClient := createTestClient(t)
-	defer baseClient.Close()
+	defer baseClient.Close(context.Background())
 
 	inventoryClient := inventory.NewClient(baseClient)
 	ctx := context.Background()
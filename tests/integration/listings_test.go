@@ -36,7 +36,7 @@ func TestListingsItems_Integration(t *testing.T) {
 	}
 
 	baseClient := createTestClient(t)
-	defer baseClient.Close()
+	defer baseClient.Close(context.Background())
 
 	listingsClient := listings.NewClient(baseClient)
 	ctx := context.Background()
@@ -54,7 +54,7 @@ func TestNotifications_Grantless_Integration(t *testing.T) {
 	if err != nil {
 		t.Fatalf("创建 Grantless 客户端失败: %v", err)
 	}
-	defer baseClient.Close()
+	defer baseClient.Close(context.Background())
 
 	notificationsClient := notifications.NewClient(baseClient)
 	ctx := context.Background()
@@ -55,7 +55,7 @@ func TestOrders_Integration(t *testing.T) {
 	if err != nil {
 		t.Fatalf("创建客户端失败: %v", err)
 	}
-	defer baseClient.Close()
+	defer baseClient.Close(context.Background())
 
 	ordersClient := orders.NewClient(baseClient)
 	ctx := context.Background()
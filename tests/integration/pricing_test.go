@@ -36,7 +36,7 @@ func TestProductPricing_Integration(t *testing.T) {
 	}
 
 	baseClient := createTestClient(t)
-	defer baseClient.Close()
+	defer baseClient.Close(context.Background())
 
 	pricingClient := pricing.NewClient(baseClient)
 	ctx := context.Background()
@@ -37,7 +37,7 @@ func TestReports_Integration(t *testing.T) {
 	}
 
 	baseClient := createTestClient(t)
-	defer baseClient.Close()
+	defer baseClient.Close(context.Background())
 
 	reportsClient := reports.NewClient(baseClient)
 	ctx := context.Background()
@@ -36,7 +36,7 @@ func TestSellers_Integration(t *testing.T) {
 	}
 
 	baseClient := createTestClient(t)
-	defer baseClient.Close()
+	defer baseClient.Close(context.Background())
 
 	sellersClient := sellers.NewClient(baseClient)
 	ctx := context.Background()
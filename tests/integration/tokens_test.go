@@ -36,7 +36,7 @@ func TestTokens_Integration(t *testing.T) {
 	}
 
 	baseClient := createTestClient(t)
-	defer baseClient.Close()
+	defer baseClient.Close(context.Background())
 
 	tokensClient := tokens.NewClient(baseClient)
 	ctx := context.Background()